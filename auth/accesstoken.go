@@ -15,6 +15,8 @@
 package auth
 
 import (
+	"crypto"
+	"errors"
 	"time"
 
 	"github.com/go-jose/go-jose/v3"
@@ -27,12 +29,25 @@ const (
 	defaultValidDuration = 6 * time.Hour
 )
 
-// AccessToken produces token signed with API key and secret
+var ErrInvalidValidFor = errors.New("token validity cannot be negative")
+
+// AccessToken produces token signed with API key and secret, or with a private key
 type AccessToken struct {
 	apiKey   string
 	secret   string
+	signer   crypto.Signer
 	grant    ClaimGrants
 	validFor time.Duration
+	keyID    string
+	id       string
+	policy   *IdentityPolicy
+
+	encrypted     bool
+	encryptionKey any
+	issuer        string
+	audience      []string
+	derivedKey    bool
+	cache         *TokenCache
 }
 
 func NewAccessToken(key string, secret string) *AccessToken {
@@ -42,11 +57,32 @@ func NewAccessToken(key string, secret string) *AccessToken {
 	}
 }
 
+// NewAccessTokenWithSigner creates a token signed with an RSA (RS256) or ECDSA (ES256, ES384, ES512) private key,
+// which can be verified with the public key.
+func NewAccessTokenWithSigner(key string, signer crypto.Signer) *AccessToken {
+	return &AccessToken{
+		apiKey: key,
+		signer: signer,
+	}
+}
+
 func (t *AccessToken) SetIdentity(identity string) *AccessToken {
 	t.grant.Identity = identity
 	return t
 }
 
+// SetID sets the jti claim of the token, letting it be revoked individually, see RevocationChecker
+func (t *AccessToken) SetID(id string) *AccessToken {
+	t.id = id
+	return t
+}
+
+// SetIdentityPolicy normalizes and validates the identity with the policy when the token is created
+func (t *AccessToken) SetIdentityPolicy(policy *IdentityPolicy) *AccessToken {
+	t.policy = policy
+	return t
+}
+
 func (t *AccessToken) SetValidFor(duration time.Duration) *AccessToken {
 	t.validFor = duration
 	return t
@@ -87,6 +123,11 @@ func (t *AccessToken) SetAgentGrant(grant *AgentGrant) *AccessToken {
 	return t
 }
 
+func (t *AccessToken) SetObservabilityGrant(grant *ObservabilityGrant) *AccessToken {
+	t.grant.Observability = grant
+	return t
+}
+
 func (t *AccessToken) SetMetadata(md string) *AccessToken {
 	t.grant.Metadata = md
 	return t
@@ -105,11 +146,67 @@ func (t *AccessToken) SetAttributes(attrs map[string]string) *AccessToken {
 	return t
 }
 
+// SetKeyID sets the kid header of the token, identifying the secret it is signed with
+func (t *AccessToken) SetKeyID(kid string) *AccessToken {
+	t.keyID = kid
+	return t
+}
+
+// SetIssuer sets the iss claim, which defaults to the API key. The API key is then sent in the api_key header.
+func (t *AccessToken) SetIssuer(issuer string) *AccessToken {
+	t.issuer = issuer
+	return t
+}
+
+// SetAudience sets the aud claim, e.g. the cluster the token is meant for, see APIKeyTokenVerifier.WithAudience.
+func (t *AccessToken) SetAudience(audience ...string) *AccessToken {
+	t.audience = audience
+	return t
+}
+
+// SetDerivedKey signs the token with a key derived from the API secret, see DeriveSigningKey,
+// letting it be verified by providers that do not store the secret, e.g. HashedKeyProvider.
+func (t *AccessToken) SetDerivedKey(derived bool) *AccessToken {
+	t.derivedKey = derived
+	return t
+}
+
+// SetCache reuses tokens signed for identical grants, see TokenCache
+func (t *AccessToken) SetCache(cache *TokenCache) *AccessToken {
+	t.cache = cache
+	return t
+}
+
+// SetEncrypted encrypts the claims (JWE) with a key derived from the API secret,
+// for tokens carrying sensitive metadata through third-party infrastructure.
+func (t *AccessToken) SetEncrypted(encrypted bool) *AccessToken {
+	t.encrypted = encrypted
+	return t
+}
+
+// SetEncryptionKey encrypts the claims for the holder of the key: a 32 byte symmetric key,
+// or the RSA or ECDSA public key of the recipient. Required to encrypt tokens signed with a private key.
+func (t *AccessToken) SetEncryptionKey(key any) *AccessToken {
+	t.encrypted = true
+	t.encryptionKey = key
+	return t
+}
+
 func (t *AccessToken) SetSha256(sha string) *AccessToken {
 	t.grant.Sha256 = sha
 	return t
 }
 
+func (t *AccessToken) SetProjectID(projectID string) *AccessToken {
+	t.grant.ProjectID = projectID
+	return t
+}
+
+func (t *AccessToken) SetAttributeClaims(claims *AttributeClaims) *AccessToken {
+	t.grant.AttributeClaims = claims
+	return t
+}
+
 func (t *AccessToken) SetRoomPreset(preset string) *AccessToken {
 	t.grant.RoomPreset = preset
 	return t
@@ -137,27 +234,139 @@ func (t *AccessToken) GetGrants() *ClaimGrants {
 	return &t.grant
 }
 
+// Validate returns a descriptive error for tokens the server would reject, e.g. a room join without an identity.
+// ToJWT only rejects tokens which cannot be encoded or scoped as requested, e.g. with an invalid room pattern,
+// use Build to validate them fully first.
+func (t *AccessToken) Validate() error {
+	if t.apiKey == "" || (t.secret == "" && t.signer == nil) {
+		return ErrKeysMissing
+	}
+	if t.validFor < 0 {
+		return ErrInvalidValidFor
+	}
+	if t.grant.Identity != "" {
+		if _, err := t.policy.Normalize(t.grant.Identity, t.grant.GetParticipantKind()); err != nil {
+			return err
+		}
+	}
+	return t.grant.Validate()
+}
+
+// Build validates the token and returns it encoded
+func (t *AccessToken) Build() (string, error) {
+	if err := t.Validate(); err != nil {
+		return "", err
+	}
+	return t.ToJWT()
+}
+
 func (t *AccessToken) ToJWT() (string, error) {
-	if t.apiKey == "" || t.secret == "" {
+	if t.apiKey == "" || (t.secret == "" && t.signer == nil) {
 		return "", ErrKeysMissing
 	}
 
-	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte(t.secret)},
-		(&jose.SignerOptions{}).WithType("JWT"))
+	if t.grant.Video != nil {
+		if err := t.grant.Video.ValidateRoomPattern(); err != nil {
+			return "", err
+		}
+	}
+	if err := t.grant.SIP.Validate(); err != nil {
+		return "", err
+	}
+	if err := t.grant.AttributeClaims.Validate(); err != nil {
+		return "", err
+	}
+
+	// normalize a copy, so that encoding the token leaves it unchanged
+	grant := t.grant
+	// tokens for server APIs do not need an identity
+	if grant.Identity != "" {
+		identity, err := t.policy.Normalize(grant.Identity, grant.GetParticipantKind())
+		if err != nil {
+			return "", err
+		}
+		grant.Identity = identity
+	}
+
+	if t.cache == nil || t.signer != nil || t.encryptionKey != nil {
+		return t.sign(&grant, time.Now())
+	}
+	key, err := t.cacheKey(&grant)
 	if err != nil {
 		return "", err
 	}
+	now := time.Now()
+	if token, ok := t.cache.get(key, now, t.getValidFor()); ok {
+		return token, nil
+	}
+	token, err := t.sign(&grant, now)
+	if err != nil {
+		return "", err
+	}
+	t.cache.put(key, token, now)
+	return token, nil
+}
 
-	validFor := defaultValidDuration
+func (t *AccessToken) getValidFor() time.Duration {
 	if t.validFor > 0 {
-		validFor = t.validFor
+		return t.validFor
+	}
+	return defaultValidDuration
+}
+
+func (t *AccessToken) sign(grant *ClaimGrants, now time.Time) (string, error) {
+	opts := (&jose.SignerOptions{}).WithType("JWT")
+	if t.keyID != "" {
+		opts = opts.WithHeader("kid", t.keyID)
+	}
+	issuer := t.apiKey
+	if t.issuer != "" && t.issuer != t.apiKey {
+		issuer = t.issuer
+		opts = opts.WithHeader(apiKeyHeader, t.apiKey)
+	}
+	secret := []byte(t.secret)
+	if t.derivedKey && t.signer == nil {
+		secret = DeriveSigningKey(t.apiKey, t.secret)
+		opts = opts.WithHeader(kdfHeader, kdfHKDFSHA256)
+	}
+	key := jose.SigningKey{Algorithm: jose.HS256, Key: secret}
+	if t.signer != nil {
+		alg, err := signingAlgorithm(t.signer)
+		if err != nil {
+			return "", err
+		}
+		key = jose.SigningKey{Algorithm: alg, Key: t.signer}
+	}
+	sig, err := jose.NewSigner(key, opts)
+	if err != nil {
+		return "", err
 	}
 
+	validFor := t.getValidFor()
+
 	cl := jwt.Claims{
-		Issuer:    t.apiKey,
-		NotBefore: jwt.NewNumericDate(time.Now()),
-		Expiry:    jwt.NewNumericDate(time.Now().Add(validFor)),
-		Subject:   t.grant.Identity,
+		ID:        t.id,
+		Issuer:    issuer,
+		Audience:  t.audience,
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		Expiry:    jwt.NewNumericDate(now.Add(validFor)),
+		Subject:   grant.Identity,
+	}
+	if !t.encrypted {
+		return jwt.Signed(sig).Claims(cl).Claims(grant).CompactSerialize()
+	}
+
+	encryptionKey := t.encryptionKey
+	if encryptionKey == nil {
+		if t.secret == "" {
+			return "", ErrEncryptionKeyMissing
+		}
+		encryptionKey = encryptionKeyFromSecret(secret)
+	}
+	enc, err := newEncrypter(encryptionKey, t.apiKey, t.keyID, t.derivedKey && t.signer == nil)
+	if err != nil {
+		return "", err
 	}
-	return jwt.Signed(sig).Claims(cl).Claims(&t.grant).CompactSerialize()
+	return jwt.SignedAndEncrypted(sig, enc).Claims(cl).Claims(grant).CompactSerialize()
 }
@@ -38,6 +38,36 @@ func TestAccessToken(t *testing.T) {
 		require.Equal(t, ErrKeysMissing, err)
 	})
 
+	t.Run("build validates grants", func(t *testing.T) {
+		apiKey, secret := apiKeypair()
+		_, err := NewAccessToken(apiKey, secret).
+			SetIdentity("me").
+			SetVideoGrant(&VideoGrant{RoomJoin: true, Room: "room"}).
+			Build()
+		require.NoError(t, err)
+
+		for name, at := range map[string]*AccessToken{
+			"no room":          NewAccessToken(apiKey, secret).SetIdentity("me").SetVideoGrant(&VideoGrant{RoomJoin: true}),
+			"no identity":      NewAccessToken(apiKey, secret).SetVideoGrant(&VideoGrant{RoomJoin: true, Room: "room"}),
+			"room and pattern": NewAccessToken(apiKey, secret).SetVideoGrant(&VideoGrant{Room: "room", RoomPattern: "room-*"}),
+			"destination":      NewAccessToken(apiKey, secret).SetVideoGrant(&VideoGrant{Room: "room", DestinationRoom: "other"}),
+			"limits source":    NewAccessToken(apiKey, secret).SetVideoGrant(&VideoGrant{PublishLimits: []TrackPublishLimits{{Source: "webcam"}}}),
+		} {
+			_, err = at.Build()
+			require.ErrorIs(t, err, ErrInvalidGrants, name)
+		}
+
+		at := NewAccessToken(apiKey, secret)
+		at.GetGrants().Kind = "robot"
+		_, err = at.Build()
+		require.ErrorIs(t, err, ErrInvalidGrants)
+
+		_, err = NewAccessToken(apiKey, secret).SetValidFor(-time.Minute).Build()
+		require.ErrorIs(t, err, ErrInvalidValidFor)
+		_, err = NewAccessToken("", "").Build()
+		require.ErrorIs(t, err, ErrKeysMissing)
+	})
+
 	t.Run("generates a decode-able key", func(t *testing.T) {
 		apiKey, secret := apiKeypair()
 		videoGrant := &VideoGrant{RoomJoin: true, Room: "myroom"}
@@ -0,0 +1,175 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/exp/slices"
+
+	"github.com/livekit/protocol/logger"
+)
+
+var (
+	ErrInvalidAttributeCondition = errors.New("invalid attribute condition")
+	ErrAttributeMismatch         = errors.New("attributes do not match claims")
+)
+
+type AttributeOperator string
+
+const (
+	// AttributeEquals matches when the attribute equals one of the values, it is the default operator
+	AttributeEquals AttributeOperator = "eq"
+	// AttributeNotEquals matches when the attribute is missing or equals none of the values
+	AttributeNotEquals AttributeOperator = "ne"
+	// AttributePrefix matches when the attribute starts with one of the values
+	AttributePrefix AttributeOperator = "prefix"
+	// AttributeExists matches when the attribute is set, values are ignored
+	AttributeExists AttributeOperator = "exists"
+)
+
+// AttributeCondition constrains the value of a single attribute.
+type AttributeCondition struct {
+	Key    string            `json:"key"`
+	Op     AttributeOperator `json:"op,omitempty"`
+	Values []string          `json:"values,omitempty"`
+}
+
+func (c AttributeCondition) Validate() error {
+	if c.Key == "" {
+		return fmt.Errorf("%w: missing key", ErrInvalidAttributeCondition)
+	}
+	switch c.Op {
+	case "", AttributeEquals, AttributeNotEquals, AttributePrefix:
+		if len(c.Values) == 0 {
+			return fmt.Errorf("%w: %s has no values", ErrInvalidAttributeCondition, c.Key)
+		}
+	case AttributeExists:
+	default:
+		return fmt.Errorf("%w: unknown operator %q", ErrInvalidAttributeCondition, c.Op)
+	}
+	return nil
+}
+
+// Matches returns true if the attributes satisfy the condition.
+func (c AttributeCondition) Matches(attributes map[string]string) bool {
+	value, ok := attributes[c.Key]
+	switch c.Op {
+	case "", AttributeEquals:
+		return ok && slices.Contains(c.Values, value)
+	case AttributeNotEquals:
+		return !ok || !slices.Contains(c.Values, value)
+	case AttributePrefix:
+		return ok && slices.ContainsFunc(c.Values, func(prefix string) bool {
+			return strings.HasPrefix(value, prefix)
+		})
+	case AttributeExists:
+		return ok
+	default:
+		return false
+	}
+}
+
+func (c AttributeCondition) MarshalLogObject(e zapcore.ObjectEncoder) error {
+	e.AddString("Key", c.Key)
+	e.AddString("Op", string(c.Op))
+	return e.AddArray("Values", logger.StringSlice(c.Values))
+}
+
+// AttributeClaims restrict a token to rooms and participants with matching attributes,
+// e.g. a condition {Key: "tenant", Values: ["acme"]} on Room only allows joining rooms of the acme tenant.
+// All conditions of a section must match.
+type AttributeClaims struct {
+	// conditions on the attributes of the room
+	Room []AttributeCondition `json:"room,omitempty"`
+	// conditions on the attributes of remote participants, e.g. whose tracks can be subscribed to
+	Participant []AttributeCondition `json:"participant,omitempty"`
+}
+
+func (c *AttributeClaims) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for _, cond := range c.Room {
+		if err := cond.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, cond := range c.Participant {
+		if err := cond.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MatchRoom returns an error if the room attributes do not satisfy the claims. Nil claims match any room.
+func (c *AttributeClaims) MatchRoom(attributes map[string]string) error {
+	if c == nil {
+		return nil
+	}
+	return matchConditions("room", c.Room, attributes)
+}
+
+// MatchParticipant returns an error if the participant attributes do not satisfy the claims.
+// Nil claims match any participant.
+func (c *AttributeClaims) MatchParticipant(attributes map[string]string) error {
+	if c == nil {
+		return nil
+	}
+	return matchConditions("participant", c.Participant, attributes)
+}
+
+func matchConditions(target string, conditions []AttributeCondition, attributes map[string]string) error {
+	for _, cond := range conditions {
+		if !cond.Matches(attributes) {
+			return fmt.Errorf("%w: %s attribute %s", ErrAttributeMismatch, target, cond.Key)
+		}
+	}
+	return nil
+}
+
+func (c *AttributeClaims) Clone() *AttributeClaims {
+	if c == nil {
+		return nil
+	}
+	return &AttributeClaims{
+		Room:        cloneConditions(c.Room),
+		Participant: cloneConditions(c.Participant),
+	}
+}
+
+func cloneConditions(conditions []AttributeCondition) []AttributeCondition {
+	if conditions == nil {
+		return nil
+	}
+	clone := make([]AttributeCondition, 0, len(conditions))
+	for _, cond := range conditions {
+		cond.Values = slices.Clone(cond.Values)
+		clone = append(clone, cond)
+	}
+	return clone
+}
+
+func (c *AttributeClaims) MarshalLogObject(e zapcore.ObjectEncoder) error {
+	if c == nil {
+		return nil
+	}
+	e.AddArray("Room", logger.ObjectSlice(c.Room))
+	return e.AddArray("Participant", logger.ObjectSlice(c.Participant))
+}
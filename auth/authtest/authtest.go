@@ -0,0 +1,292 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package authtest provides key providers and token helpers for testing token verification.
+package authtest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+
+	"github.com/livekit/protocol/auth"
+)
+
+const (
+	APIKey    = "APItestkey"
+	APISecret = "test-secret-0123456789abcdefghijklmnopqrstuvwxyz"
+
+	// second key of RotatingKeyProvider
+	NextAPISecret = "test-secret-next-0123456789abcdefghijklmnopqrst"
+	KeyID         = "test-key"
+	NextKeyID     = "test-key-next"
+)
+
+// KeyProvider returns a provider with APIKey and APISecret.
+func KeyProvider() *auth.SimpleKeyProvider {
+	return auth.NewSimpleKeyProvider(APIKey, APISecret)
+}
+
+// RotatingKeyProvider returns a provider in the middle of a rotation: tokens signed with APISecret and
+// NextAPISecret are both accepted, new tokens are signed with APISecret.
+func RotatingKeyProvider() *auth.RotatingKeyProvider {
+	return auth.NewRotatingKeyProvider(
+		auth.APIKeyPair{ID: KeyID, APIKey: APIKey, APISecret: APISecret},
+		auth.APIKeyPair{ID: NextKeyID, APIKey: APIKey, APISecret: NextAPISecret},
+	)
+}
+
+// FakeKeyProvider is an in-memory key provider recording the API keys it is asked for.
+// Keys can be added and removed while it is in use.
+type FakeKeyProvider struct {
+	mu      sync.Mutex
+	keys    map[string]string
+	lookups []string
+}
+
+var _ auth.KeyProvider = (*FakeKeyProvider)(nil)
+
+// NewFakeKeyProvider returns a provider holding the API key/secret pairs, APIKey and APISecret when none are given.
+func NewFakeKeyProvider(keys map[string]string) *FakeKeyProvider {
+	if keys == nil {
+		keys = map[string]string{APIKey: APISecret}
+	}
+	p := &FakeKeyProvider{keys: make(map[string]string, len(keys))}
+	for k, v := range keys {
+		p.keys[k] = v
+	}
+	return p
+}
+
+func (p *FakeKeyProvider) GetSecret(apiKey string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lookups = append(p.lookups, apiKey)
+	return p.keys[apiKey]
+}
+
+func (p *FakeKeyProvider) NumKeys() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.keys)
+}
+
+func (p *FakeKeyProvider) Set(apiKey, secret string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keys[apiKey] = secret
+}
+
+func (p *FakeKeyProvider) Delete(apiKey string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.keys, apiKey)
+}
+
+// Lookups returns the API keys secrets were requested for, in order.
+func (p *FakeKeyProvider) Lookups() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.lookups...)
+}
+
+// Clock is a fixed clock advanced explicitly, e.g. to use as Minter.Now.
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func NewClock(now time.Time) *Clock {
+	return &Clock{now: now}
+}
+
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Minter creates tokens with a controllable clock.
+type Minter struct {
+	APIKey    string
+	APISecret string
+	// kid header, omitted when empty
+	KeyID string
+	// time tokens are issued at
+	Now func() time.Time
+	// validity of the tokens, defaults to an hour
+	ValidFor time.Duration
+}
+
+// NewMinter returns a minter signing with APIKey and APISecret, issuing tokens at the given time.
+// Tokens only verify while the verifier's clock is within their validity.
+func NewMinter(now time.Time) *Minter {
+	return &Minter{
+		APIKey:    APIKey,
+		APISecret: APISecret,
+		Now:       func() time.Time { return now },
+	}
+}
+
+func (m *Minter) now() time.Time {
+	if m.Now == nil {
+		return time.Now()
+	}
+	return m.Now()
+}
+
+func (m *Minter) validFor() time.Duration {
+	if m.ValidFor <= 0 {
+		return time.Hour
+	}
+	return m.ValidFor
+}
+
+func (m *Minter) sign(t testing.TB, grants *auth.ClaimGrants, secret string, notBefore, expiry time.Time) string {
+	return m.signAs(t, grants, m.APIKey, secret, notBefore, expiry)
+}
+
+func (m *Minter) signAs(t testing.TB, grants *auth.ClaimGrants, apiKey, secret string, notBefore, expiry time.Time) string {
+	t.Helper()
+	opts := (&jose.SignerOptions{}).WithType("JWT")
+	if m.KeyID != "" {
+		opts = opts.WithHeader("kid", m.KeyID)
+	}
+	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte(secret)}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if grants == nil {
+		grants = &auth.ClaimGrants{}
+	}
+	cl := jwt.Claims{
+		Issuer:    apiKey,
+		Subject:   grants.Identity,
+		IssuedAt:  jwt.NewNumericDate(notBefore),
+		NotBefore: jwt.NewNumericDate(notBefore),
+		Expiry:    jwt.NewNumericDate(expiry),
+	}
+	token, err := jwt.Signed(sig).Claims(cl).Claims(grants).CompactSerialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// Token returns a valid token with the grants, issued at the current time of the minter.
+func (m *Minter) Token(t testing.TB, grants *auth.ClaimGrants) string {
+	t.Helper()
+	now := m.now()
+	return m.sign(t, grants, m.APISecret, now, now.Add(m.validFor()))
+}
+
+// ExpiredToken returns a token which expired a minute before the current time of the minter.
+func (m *Minter) ExpiredToken(t testing.TB, grants *auth.ClaimGrants) string {
+	t.Helper()
+	expiry := m.now().Add(-time.Minute)
+	return m.sign(t, grants, m.APISecret, expiry.Add(-m.validFor()), expiry)
+}
+
+// NotYetValidToken returns a token which becomes valid an hour after the current time of the minter.
+func (m *Minter) NotYetValidToken(t testing.TB, grants *auth.ClaimGrants) string {
+	t.Helper()
+	nbf := m.now().Add(time.Hour)
+	return m.sign(t, grants, m.APISecret, nbf, nbf.Add(m.validFor()))
+}
+
+// WrongSecretToken returns a token signed with a secret other than the minter's.
+func (m *Minter) WrongSecretToken(t testing.TB, grants *auth.ClaimGrants) string {
+	t.Helper()
+	now := m.now()
+	return m.sign(t, grants, m.APISecret+"-wrong", now, now.Add(m.validFor()))
+}
+
+// UnknownKeyToken returns a token issued by an API key no provider of this package holds.
+func (m *Minter) UnknownKeyToken(t testing.TB, grants *auth.ClaimGrants) string {
+	t.Helper()
+	now := m.now()
+	return m.signAs(t, grants, m.APIKey+"-unknown", m.APISecret, now, now.Add(m.validFor()))
+}
+
+// UnsignedToken returns the token with the none algorithm and no signature.
+func UnsignedToken(t testing.TB, token string) string {
+	t.Helper()
+	parts := splitToken(t, token)
+	header := map[string]any{}
+	decodeSegment(t, parts[0], &header)
+	header["alg"] = "none"
+	return encodeSegment(t, header) + "." + parts[1] + "."
+}
+
+// Tamper modifies the claims of the token, keeping its original signature.
+func Tamper(t testing.TB, token string, mutate func(claims map[string]any)) string {
+	t.Helper()
+	parts := splitToken(t, token)
+	claims := map[string]any{}
+	decodeSegment(t, parts[1], &claims)
+	mutate(claims)
+	return parts[0] + "." + encodeSegment(t, claims) + "." + parts[2]
+}
+
+// MalformedTokens returns tokens which cannot be parsed.
+func MalformedTokens() map[string]string {
+	return map[string]string{
+		"empty":           "",
+		"not a jwt":       "not-a-jwt",
+		"missing segment": "eyJhbGciOiJIUzI1NiJ9.e30",
+		"invalid base64":  "eyJhbGciOiJIUzI1NiJ9.!!!.sig",
+		"invalid header":  base64.RawURLEncoding.EncodeToString([]byte("{")) + ".e30.sig",
+	}
+}
+
+func splitToken(t testing.TB, token string) []string {
+	t.Helper()
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("invalid token %q", token)
+	}
+	return parts
+}
+
+func decodeSegment(t testing.TB, seg string, v any) {
+	t.Helper()
+	data, err := base64.RawURLEncoding.DecodeString(seg)
+	if err == nil {
+		err = json.Unmarshal(data, v)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func encodeSegment(t testing.TB, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/auth"
+)
+
+func verify(t *testing.T, token string, provider auth.KeyProvider) (*auth.ClaimGrants, error) {
+	v, err := auth.ParseAPIToken(token)
+	if err != nil {
+		return nil, err
+	}
+	return v.VerifyWithProvider(provider)
+}
+
+func TestMinter(t *testing.T) {
+	now := time.Now()
+	m := NewMinter(now)
+	grants := &auth.ClaimGrants{Identity: "me", Video: &auth.VideoGrant{RoomJoin: true, Room: "room"}}
+
+	token := m.Token(t, grants)
+	require.Equal(t, token, m.Token(t, grants), "tokens are deterministic with a frozen clock")
+
+	claims, err := verify(t, token, KeyProvider())
+	require.NoError(t, err)
+	require.Equal(t, "me", claims.Identity)
+	require.Equal(t, "room", claims.Video.Room)
+
+	for name, token := range map[string]string{
+		"expired":      m.ExpiredToken(t, grants),
+		"not yet":      m.NotYetValidToken(t, grants),
+		"wrong secret": m.WrongSecretToken(t, grants),
+		"unknown key":  m.UnknownKeyToken(t, grants),
+		"unsigned":     UnsignedToken(t, token),
+		"tampered": Tamper(t, token, func(claims map[string]any) {
+			claims["video"].(map[string]any)["roomAdmin"] = true
+		}),
+	} {
+		_, err := verify(t, token, KeyProvider())
+		require.Error(t, err, name)
+	}
+
+	for name, token := range MalformedTokens() {
+		_, err := auth.ParseAPIToken(token)
+		require.Error(t, err, name)
+	}
+}
+
+func TestRotatingKeyProvider(t *testing.T) {
+	p := RotatingKeyProvider()
+	m := NewMinter(time.Now())
+	_, err := verify(t, m.Token(t, nil), p)
+	require.NoError(t, err)
+
+	m.APISecret = NextAPISecret
+	m.KeyID = NextKeyID
+	_, err = verify(t, m.Token(t, nil), p)
+	require.NoError(t, err)
+}
+
+func TestFakeKeyProvider(t *testing.T) {
+	p := NewFakeKeyProvider(nil)
+	clock := NewClock(time.Now())
+	m := NewMinter(time.Time{})
+	m.Now = clock.Now
+
+	token := m.Token(t, nil)
+	_, err := verify(t, token, p)
+	require.NoError(t, err)
+	require.Equal(t, []string{APIKey}, p.Lookups())
+
+	clock.Advance(2 * time.Hour)
+	require.NotEqual(t, token, m.Token(t, nil))
+
+	p.Delete(APIKey)
+	_, err = verify(t, token, p)
+	require.ErrorIs(t, err, auth.ErrKeysMissing)
+	require.Equal(t, 0, p.NumKeys())
+}
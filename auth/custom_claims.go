@@ -0,0 +1,127 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var ErrInvalidCustomClaim = errors.New("invalid custom claim")
+
+var customClaims struct {
+	mu    sync.Mutex
+	names map[string]struct{}
+}
+
+// CustomClaim is a typed section of the custom claims of a token, letting applications carry their own
+// signed data in tokens. Sections are stored as JSON under their name in ClaimGrants.Custom.
+type CustomClaim[T any] struct {
+	name      string
+	marshal   func(T) ([]byte, error)
+	unmarshal func([]byte) (T, error)
+}
+
+// RegisterCustomClaim registers a section encoded as JSON. It panics if the name is empty or already registered,
+// sections are expected to be registered once, e.g. in a package level variable.
+func RegisterCustomClaim[T any](name string) CustomClaim[T] {
+	return RegisterCustomClaimWithCodec(name, func(v T) ([]byte, error) {
+		return json.Marshal(v)
+	}, func(data []byte) (T, error) {
+		var v T
+		err := json.Unmarshal(data, &v)
+		return v, err
+	})
+}
+
+// RegisterCustomClaimWithCodec registers a section encoded with the marshal and unmarshal hooks,
+// which must produce valid JSON.
+func RegisterCustomClaimWithCodec[T any](name string, marshal func(T) ([]byte, error), unmarshal func([]byte) (T, error)) CustomClaim[T] {
+	if name == "" {
+		panic("auth: custom claim name cannot be empty")
+	}
+	customClaims.mu.Lock()
+	defer customClaims.mu.Unlock()
+	if _, ok := customClaims.names[name]; ok {
+		panic(fmt.Sprintf("auth: custom claim %q already registered", name))
+	}
+	if customClaims.names == nil {
+		customClaims.names = make(map[string]struct{})
+	}
+	customClaims.names[name] = struct{}{}
+
+	return CustomClaim[T]{
+		name:      name,
+		marshal:   marshal,
+		unmarshal: unmarshal,
+	}
+}
+
+// RegisteredCustomClaims returns the names of the registered sections, sorted.
+func RegisteredCustomClaims() []string {
+	customClaims.mu.Lock()
+	defer customClaims.mu.Unlock()
+	names := make([]string, 0, len(customClaims.names))
+	for name := range customClaims.names {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (c CustomClaim[T]) Name() string {
+	return c.name
+}
+
+// Get returns the section of the grants, and false if it is not set.
+func (c CustomClaim[T]) Get(grants *ClaimGrants) (T, bool, error) {
+	var zero T
+	if grants == nil {
+		return zero, false, nil
+	}
+	data, ok := grants.Custom[c.name]
+	if !ok {
+		return zero, false, nil
+	}
+	v, err := c.unmarshal(data)
+	if err != nil {
+		return zero, false, fmt.Errorf("%w: %s: %w", ErrInvalidCustomClaim, c.name, err)
+	}
+	return v, true, nil
+}
+
+// Set stores the section in the grants.
+func (c CustomClaim[T]) Set(grants *ClaimGrants, v T) error {
+	data, err := c.marshal(v)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrInvalidCustomClaim, c.name, err)
+	}
+	if !json.Valid(data) {
+		return fmt.Errorf("%w: %s: invalid JSON", ErrInvalidCustomClaim, c.name)
+	}
+	if grants.Custom == nil {
+		grants.Custom = make(map[string]json.RawMessage)
+	}
+	grants.Custom[c.name] = data
+	return nil
+}
+
+// Delete removes the section from the grants.
+func (c CustomClaim[T]) Delete(grants *ClaimGrants) {
+	delete(grants.Custom, c.name)
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type billingClaim struct {
+	CustomerID string `json:"customerId"`
+	Plan       string `json:"plan"`
+}
+
+var (
+	testBillingClaim = RegisterCustomClaim[billingClaim]("test.billing")
+	testQuotaClaim   = RegisterCustomClaimWithCodec("test.quota", func(v int) ([]byte, error) {
+		return []byte(strconv.Itoa(v)), nil
+	}, func(data []byte) (int, error) {
+		return strconv.Atoi(string(data))
+	})
+)
+
+func TestCustomClaims(t *testing.T) {
+	apiKey, secret := apiKeypair()
+	at := NewAccessToken(apiKey, secret).SetIdentity("me")
+	require.NoError(t, testBillingClaim.Set(at.GetGrants(), billingClaim{CustomerID: "c1", Plan: "pro"}))
+	require.NoError(t, testQuotaClaim.Set(at.GetGrants(), 42))
+	token, err := at.ToJWT()
+	require.NoError(t, err)
+
+	v, err := ParseAPIToken(token)
+	require.NoError(t, err)
+	grants, err := v.Verify(secret)
+	require.NoError(t, err)
+
+	billing, ok, err := testBillingClaim.Get(grants)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, billingClaim{CustomerID: "c1", Plan: "pro"}, billing)
+
+	quota, ok, err := testQuotaClaim.Get(grants)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 42, quota)
+
+	testQuotaClaim.Delete(grants)
+	_, ok, err = testQuotaClaim.Get(grants)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	grants.Custom[testQuotaClaim.Name()] = []byte(`"many"`)
+	_, _, err = testQuotaClaim.Get(grants)
+	require.ErrorIs(t, err, ErrInvalidCustomClaim)
+
+	require.Subset(t, RegisteredCustomClaims(), []string{"test.billing", "test.quota"})
+	require.Panics(t, func() { RegisterCustomClaim[int]("test.billing") })
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"strings"
+
+	"github.com/go-jose/go-jose/v3"
+)
+
+// issuer header of encrypted tokens, letting verifiers look up the key before decrypting the claims
+const issuerHeader jose.HeaderKey = "iss"
+
+var (
+	ErrEncryptionKeyMissing = errors.New("missing encryption key, required for tokens signed with a private key")
+	ErrDecryptionKeyMissing = errors.New("missing decryption key for encrypted token")
+	ErrMissingIssuerHeader  = errors.New("encrypted token is missing the issuer header")
+)
+
+// encryptionKeyFromSecret derives the content encryption key of tokens signed with an API secret,
+// so the secret is not used for both signing and encryption.
+func encryptionKeyFromSecret(secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("livekit token encryption"))
+	return mac.Sum(nil)
+}
+
+// newEncrypter returns an A256GCM encrypter for a 32 byte symmetric key, or an RSA or ECDSA public key.
+// The header of tokens signed with a derived key is marked, as the key is needed before decrypting them.
+func newEncrypter(key any, apiKey, kid string, derivedKey bool) (jose.Encrypter, error) {
+	var alg jose.KeyAlgorithm
+	switch key.(type) {
+	case []byte:
+		alg = jose.DIRECT
+	case *rsa.PublicKey:
+		alg = jose.RSA_OAEP_256
+	case *ecdsa.PublicKey:
+		alg = jose.ECDH_ES_A256KW
+	default:
+		return nil, ErrUnsupportedKey
+	}
+	opts := (&jose.EncrypterOptions{}).
+		WithContentType("JWT").
+		WithHeader(issuerHeader, apiKey)
+	if derivedKey {
+		opts = opts.WithHeader(kdfHeader, kdfHKDFSHA256)
+	}
+	return jose.NewEncrypter(jose.A256GCM, jose.Recipient{Algorithm: alg, Key: key, KeyID: kid}, opts)
+}
+
+// decryptionKey returns the key to decrypt a token with: the explicit key, e.g. the private key of the recipient,
+// or the key derived from the API secret its signature is verified with.
+func decryptionKey(explicit, verificationKey any) (any, error) {
+	if explicit != nil {
+		return explicit, nil
+	}
+	if secret, ok := verificationKey.([]byte); ok {
+		return encryptionKeyFromSecret(secret), nil
+	}
+	return nil, ErrDecryptionKeyMissing
+}
+
+// isEncryptedToken returns true for JWE compact serializations, which have five parts instead of three
+func isEncryptedToken(raw string) bool {
+	return strings.Count(raw, ".") == 4
+}
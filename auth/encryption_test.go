@@ -0,0 +1,103 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedToken(t *testing.T) {
+	t.Run("with API secret", func(t *testing.T) {
+		apiKey, secret := apiKeypair()
+		token, err := NewAccessToken(apiKey, secret).
+			SetIdentity("user").
+			SetMetadata("sensitive").
+			SetVideoGrant(&VideoGrant{RoomJoin: true, Room: "room"}).
+			SetEncrypted(true).
+			ToJWT()
+		require.NoError(t, err)
+		require.Len(t, strings.Split(token, "."), 5)
+		for _, part := range strings.Split(token, ".") {
+			decoded, _ := base64.RawURLEncoding.DecodeString(part)
+			require.NotContains(t, string(decoded), "sensitive")
+		}
+
+		v, err := ParseAPIToken(token)
+		require.NoError(t, err)
+		require.True(t, v.IsEncrypted())
+		require.Equal(t, apiKey, v.APIKey())
+		require.Empty(t, v.Identity())
+
+		_, err = v.Verify("wrong")
+		require.Error(t, err)
+		require.True(t, v.IsEncrypted())
+
+		grants, err := v.VerifyWithProvider(NewSimpleKeyProvider(apiKey, secret))
+		require.NoError(t, err)
+		require.False(t, v.IsEncrypted())
+		require.Equal(t, "user", grants.Identity)
+		require.Equal(t, "sensitive", grants.Metadata)
+		require.Equal(t, "room", grants.Video.Room)
+		require.Equal(t, "HS256", v.Algorithm())
+	})
+
+	t.Run("with recipient key", func(t *testing.T) {
+		signer, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		recipient, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		_, err = NewAccessTokenWithSigner("issuer", signer).SetIdentity("user").SetEncrypted(true).ToJWT()
+		require.ErrorIs(t, err, ErrEncryptionKeyMissing)
+
+		token, err := NewAccessTokenWithSigner("issuer", signer).
+			SetIdentity("user").
+			SetEncryptionKey(&recipient.PublicKey).
+			ToJWT()
+		require.NoError(t, err)
+
+		v, err := ParseAPIToken(token)
+		require.NoError(t, err)
+		_, err = v.Verify(&signer.PublicKey)
+		require.ErrorIs(t, err, ErrDecryptionKeyMissing)
+
+		grants, err := v.WithDecryptionKey(recipient).Verify(&signer.PublicKey)
+		require.NoError(t, err)
+		require.Equal(t, "user", grants.Identity)
+	})
+
+	t.Run("rejects mismatched issuer", func(t *testing.T) {
+		apiKey, secret := apiKeypair()
+		token, err := NewAccessToken(apiKey, secret).
+			SetIdentity("user").
+			SetEncryptionKey(encryptionKeyFromSecret([]byte(secret))).
+			ToJWT()
+		require.NoError(t, err)
+
+		v, err := ParseAPIToken(token)
+		require.NoError(t, err)
+		v.apiKey = "other"
+		_, err = v.Verify(secret)
+		require.Error(t, err)
+	})
+}
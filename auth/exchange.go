@@ -0,0 +1,421 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/utils/guid"
+)
+
+const DefaultExchangeValidFor = 10 * time.Minute
+
+var (
+	ErrGrantEscalation    = errors.New("grants exceed parent token")
+	ErrParentTokenExpired = errors.New("parent token expired")
+)
+
+type TokenExchangerOptions struct {
+	// validity of derived tokens, capped at the expiry of the parent. Defaults to DefaultExchangeValidFor
+	ValidFor time.Duration
+	// when set, revoked parent tokens cannot be exchanged
+	RevocationChecker RevocationChecker
+}
+
+// TokenExchanger derives short-lived tokens with a subset of the grants of a parent token,
+// e.g. to hand browsers reduced-scope tokens derived from a service token.
+// Derived tokens are signed with the key of the parent, and record its id in ParentID.
+type TokenExchanger struct {
+	provider KeyProvider
+	opts     TokenExchangerOptions
+}
+
+func NewTokenExchanger(provider KeyProvider, opts TokenExchangerOptions) *TokenExchanger {
+	if opts.ValidFor <= 0 {
+		opts.ValidFor = DefaultExchangeValidFor
+	}
+	return &TokenExchanger{
+		provider: provider,
+		opts:     opts,
+	}
+}
+
+func (e *TokenExchanger) Exchange(parentToken string, grants *ClaimGrants) (string, error) {
+	return e.ExchangeContext(context.Background(), parentToken, grants)
+}
+
+// ExchangeContext verifies the parent token and returns a token with the narrower grants.
+// Fields of grants left empty are inherited from the parent, widening any of them fails with ErrGrantEscalation.
+func (e *TokenExchanger) ExchangeContext(ctx context.Context, parentToken string, grants *ClaimGrants) (string, error) {
+	v, err := ParseAPIToken(parentToken)
+	if err != nil {
+		return "", err
+	}
+	if e.opts.RevocationChecker != nil {
+		v.WithRevocationChecker(e.opts.RevocationChecker)
+	}
+	parent, err := v.VerifyWithProviderContext(ctx, e.provider)
+	if err != nil {
+		return "", err
+	}
+	// Verify falls back to the token id for tokens without an identity
+	parent.Identity = v.info.Identity
+
+	derived, err := NarrowGrants(parent, grants)
+	if err != nil {
+		return "", err
+	}
+	derived.ParentID = v.info.ID
+
+	secret := e.provider.GetSecret(v.APIKey())
+	if secret == "" {
+		return "", ErrKeysMissing
+	}
+	validFor := e.opts.ValidFor
+	if !v.info.ExpiresAt.IsZero() {
+		validFor = min(validFor, time.Until(v.info.ExpiresAt))
+	}
+	if validFor <= 0 {
+		return "", ErrParentTokenExpired
+	}
+
+	at := NewAccessToken(v.APIKey(), secret).
+		SetID(guid.New(guid.AccessTokenPrefix)).
+		SetValidFor(validFor).
+		SetIssuer(v.Issuer()).
+		SetAudience(v.Audience()...)
+	at.grant = *derived
+	return at.ToJWT()
+}
+
+// NarrowGrants returns grants with the empty fields of child inherited from parent,
+// or ErrGrantEscalation if child grants anything parent does not.
+// Parents without an identity, e.g. service tokens, may be narrowed to any identity.
+func NarrowGrants(parent, child *ClaimGrants) (*ClaimGrants, error) {
+	d := child.Clone()
+	if d == nil {
+		d = &ClaimGrants{}
+	}
+
+	if err := inheritEqual("identity", &d.Identity, parent.Identity); err != nil {
+		return nil, err
+	}
+	// tokens without a kind are standard participants, which cannot become agents or SIP participants
+	if d.Kind == "" {
+		d.Kind = parent.Kind
+	} else if strings.ToLower(d.Kind) != kindFromProto(parent.GetParticipantKind()) {
+		return nil, escalation("kind")
+	}
+	if err := inheritEqual("projectId", &d.ProjectID, parent.ProjectID); err != nil {
+		return nil, err
+	}
+	if err := inheritStrict("roomPreset", &d.RoomPreset, parent.RoomPreset); err != nil {
+		return nil, err
+	}
+	if d.RoomConfig == nil {
+		d.RoomConfig = parent.RoomConfig.Clone()
+	} else if !proto.Equal(d.GetRoomConfiguration(), parent.GetRoomConfiguration()) {
+		return nil, escalation("roomConfig")
+	}
+	if d.Sha256 != "" {
+		return nil, escalation("sha256")
+	}
+	// name, metadata and attributes are what other participants see and match AttributeClaims against,
+	// like custom claims they can be dropped but not changed
+	if err := inheritStrict("name", &d.Name, parent.Name); err != nil {
+		return nil, err
+	}
+	if err := inheritStrict("metadata", &d.Metadata, parent.Metadata); err != nil {
+		return nil, err
+	}
+	if d.Attributes == nil {
+		d.Attributes = maps.Clone(parent.Attributes)
+	}
+	for k, v := range d.Attributes {
+		if pv, ok := parent.Attributes[k]; !ok || v != pv {
+			return nil, escalation("attributes." + k)
+		}
+	}
+
+	// custom claims are signed application data, they can be dropped but not changed
+	if d.Custom == nil {
+		d.Custom = maps.Clone(parent.Custom)
+	}
+	for name, data := range d.Custom {
+		if pd, ok := parent.Custom[name]; !ok || !bytes.Equal(data, pd) {
+			return nil, escalation("custom." + name)
+		}
+	}
+
+	// the conditions of the parent keep applying, the child may add its own
+	if parent.AttributeClaims != nil {
+		claims := parent.AttributeClaims.Clone()
+		if d.AttributeClaims != nil {
+			claims.Room = append(claims.Room, d.AttributeClaims.Room...)
+			claims.Participant = append(claims.Participant, d.AttributeClaims.Participant...)
+		}
+		d.AttributeClaims = claims
+	}
+
+	if err := narrowVideoGrant(parent.Video, d.Video); err != nil {
+		return nil, err
+	}
+	if err := narrowSIPGrant(parent.SIP, d.SIP); err != nil {
+		return nil, err
+	}
+	if d.Agent != nil {
+		if parent.Agent == nil || (d.Agent.Admin && !parent.Agent.Admin) {
+			return nil, escalation("agent")
+		}
+	}
+	if o := d.Observability; o != nil {
+		p := parent.Observability
+		if p == nil || (o.RoomList && !p.RoomList) || (o.ParticipantList && !p.ParticipantList) ||
+			(o.SessionRead && !p.SessionRead) || (o.EgressRead && !p.EgressRead) {
+			return nil, escalation("observability")
+		}
+	}
+	return d, nil
+}
+
+func narrowVideoGrant(p, c *VideoGrant) error {
+	if c == nil {
+		return nil
+	}
+	if p == nil {
+		return escalation("video")
+	}
+
+	// unset permissions are inherited instead of falling back to their permissive defaults
+	parent := p.Clone()
+	inheritBool := func(child **bool, parent *bool) {
+		if *child == nil {
+			*child = parent
+		}
+	}
+	inheritBool(&c.CanPublish, parent.CanPublish)
+	inheritBool(&c.CanSubscribe, parent.CanSubscribe)
+	inheritBool(&c.CanPublishData, parent.CanPublishData)
+	inheritBool(&c.CanUpdateOwnMetadata, parent.CanUpdateOwnMetadata)
+	inheritBool(&c.CanSubscribeMetrics, parent.CanSubscribeMetrics)
+	if c.CanPublishSources == nil {
+		c.CanPublishSources = parent.CanPublishSources
+	}
+	if c.PublishLimits == nil {
+		c.PublishLimits = parent.PublishLimits
+	}
+	if c.CanPublishDataTopics == nil {
+		c.CanPublishDataTopics = parent.CanPublishDataTopics
+	}
+	if c.CanSubscribeDataTopics == nil {
+		c.CanSubscribeDataTopics = parent.CanSubscribeDataTopics
+	}
+
+	for _, g := range []struct {
+		name           string
+		child, granted bool
+	}{
+		{"roomCreate", c.RoomCreate, p.RoomCreate},
+		{"roomList", c.RoomList, p.RoomList},
+		{"roomRecord", c.RoomRecord, p.RoomRecord},
+		{"roomAdmin", c.RoomAdmin, p.RoomAdmin},
+		{"roomJoin", c.RoomJoin, p.RoomJoin},
+		{"ingressAdmin", c.IngressAdmin, p.IngressAdmin},
+		{"hidden", c.Hidden, p.Hidden},
+		{"recorder", c.Recorder, p.Recorder},
+		{"agent", c.Agent, p.Agent},
+		{"canPublish", c.GetCanPublish(), p.GetCanPublish()},
+		{"canSubscribe", c.GetCanSubscribe(), p.GetCanSubscribe()},
+		{"canPublishData", c.GetCanPublishData(), p.GetCanPublishData()},
+		{"canUpdateOwnMetadata", c.GetCanUpdateOwnMetadata(), p.GetCanUpdateOwnMetadata()},
+		{"canSubscribeMetrics", c.GetCanSubscribeMetrics(), p.GetCanSubscribeMetrics()},
+	} {
+		if g.child && !g.granted {
+			return escalation(g.name)
+		}
+	}
+
+	if c.Room == "" && c.RoomPattern == "" {
+		c.Room, c.RoomPattern = p.Room, p.RoomPattern
+	}
+	switch {
+	case p.Room != "":
+		if c.Room != p.Room || c.RoomPattern != "" {
+			return escalation("room")
+		}
+	case p.RoomPattern != "":
+		if c.Room != "" {
+			if !p.MatchesRoom(c.Room) {
+				return escalation("room")
+			}
+		} else if c.RoomPattern != p.RoomPattern {
+			return escalation("roomPattern")
+		}
+	default:
+		if c.Room != "" || c.RoomPattern != "" {
+			return escalation("room")
+		}
+	}
+	if c.DestinationRoom != "" && c.DestinationRoom != p.DestinationRoom {
+		return escalation("destinationRoom")
+	}
+	if !dataTopicsWithin(c.CanPublishDataTopics, p.CanPublishDataTopics) {
+		return escalation("canPublishDataTopics")
+	}
+	if !dataTopicsWithin(c.CanSubscribeDataTopics, p.CanSubscribeDataTopics) {
+		return escalation("canSubscribeDataTopics")
+	}
+
+	for v := range livekit.TrackSource_name {
+		source := livekit.TrackSource(v)
+		if !c.GetCanPublishSource(source) {
+			continue
+		}
+		if !p.GetCanPublishSource(source) {
+			return escalation("canPublishSources")
+		}
+		if pl := p.GetPublishLimits(source); pl != nil {
+			if cl := c.GetPublishLimits(source); cl == nil || !cl.within(pl) {
+				return escalation("publishLimits")
+			}
+		}
+	}
+	return nil
+}
+
+func narrowSIPGrant(p, c *SIPGrant) error {
+	if c == nil {
+		return nil
+	}
+	if p == nil || (c.Admin && !p.Admin) {
+		return escalation("sip")
+	}
+	if p.Admin {
+		return nil
+	}
+	if (c.Call && !p.Call) || (c.List && !p.List && !p.Create) || (c.Create && !p.Create) {
+		return escalation("sip")
+	}
+	if err := narrowIDs("sip.trunkIds", &c.TrunkIDs, p.TrunkIDs); err != nil {
+		return err
+	}
+	return narrowIDs("sip.dispatchRuleIds", &c.DispatchRuleIDs, p.DispatchRuleIDs)
+}
+
+// narrowIDs inherits the ids of the parent when the child has none, and requires them to be a subset otherwise
+func narrowIDs(field string, child *[]string, parent []string) error {
+	if len(parent) == 0 {
+		return nil
+	}
+	if len(*child) == 0 {
+		*child = slices.Clone(parent)
+		return nil
+	}
+	for _, id := range *child {
+		if !slices.Contains(parent, id) {
+			return escalation(field)
+		}
+	}
+	return nil
+}
+
+// dataTopicsWithin returns true if every topic pattern of the child is covered by a pattern of the parent
+func dataTopicsWithin(child, parent []string) bool {
+	if len(parent) == 0 {
+		return true
+	}
+	if len(child) == 0 {
+		return false
+	}
+	for _, topic := range child {
+		if !livekit.DataTopicAllowed(parent, topic) {
+			return false
+		}
+	}
+	return true
+}
+
+// within returns true if the limits are at least as strict as the parent limits
+func (l *TrackPublishLimits) within(p *TrackPublishLimits) bool {
+	within := func(c, p uint32) bool {
+		return p == 0 || (c != 0 && c <= p)
+	}
+	if !within(l.MaxWidth, p.MaxWidth) || !within(l.MaxHeight, p.MaxHeight) || !within(l.MaxBitrate, p.MaxBitrate) {
+		return false
+	}
+	if len(p.TrackNames) == 0 {
+		return true
+	}
+	if len(l.TrackNames) == 0 {
+		return false
+	}
+	for _, name := range l.TrackNames {
+		prefix, ok := strings.CutSuffix(name, "*")
+		if !ok {
+			if !p.allowsName(name) {
+				return false
+			}
+			continue
+		}
+		// a wildcard is only allowed within a wildcard of the parent
+		covered := false
+		for _, pn := range p.TrackNames {
+			if pp, ok := strings.CutSuffix(pn, "*"); ok && strings.HasPrefix(prefix, pp) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+func inheritEqual(field string, child *string, parent string) error {
+	switch {
+	case parent == "":
+	case *child == "":
+		*child = parent
+	case *child != parent:
+		return escalation(field)
+	}
+	return nil
+}
+
+// inheritStrict is inheritEqual for fields that must match the parent even when it has none
+func inheritStrict(field string, child *string, parent string) error {
+	switch {
+	case *child == "":
+		*child = parent
+	case *child != parent:
+		return escalation(field)
+	}
+	return nil
+}
+
+func escalation(field string) error {
+	return fmt.Errorf("%w: %s", ErrGrantEscalation, field)
+}
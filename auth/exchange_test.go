@@ -0,0 +1,182 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+func TestTokenExchanger(t *testing.T) {
+	apiKey, secret := apiKeypair()
+	provider := NewSimpleKeyProvider(apiKey, secret)
+
+	parentGrant := &VideoGrant{
+		RoomJoin:    true,
+		RoomAdmin:   true,
+		RoomPattern: "team-*",
+		PublishLimits: []TrackPublishLimits{
+			{Source: "camera", MaxWidth: 1280, MaxHeight: 720},
+		},
+		CanSubscribeDataTopics: []string{"chat*", "presence"},
+	}
+	parentGrant.SetCanPublishData(false)
+	parent, err := NewAccessToken(apiKey, secret).
+		SetID("parent").
+		SetValidFor(time.Hour).
+		SetVideoGrant(parentGrant).
+		ToJWT()
+	require.NoError(t, err)
+
+	e := NewTokenExchanger(provider, TokenExchangerOptions{ValidFor: time.Minute})
+
+	t.Run("derives narrower token", func(t *testing.T) {
+		child := &VideoGrant{RoomJoin: true, Room: "team-a"}
+		child.SetCanPublishSources([]livekit.TrackSource{livekit.TrackSource_CAMERA, livekit.TrackSource_MICROPHONE})
+		token, err := e.Exchange(parent, &ClaimGrants{Identity: "browser", Video: child})
+		require.NoError(t, err)
+
+		v, err := ParseAPIToken(token)
+		require.NoError(t, err)
+		grants, err := v.VerifyWithProvider(provider)
+		require.NoError(t, err)
+		require.Equal(t, "browser", grants.Identity)
+		require.Equal(t, "parent", grants.ParentID)
+		require.False(t, grants.Video.RoomAdmin)
+		require.True(t, grants.Video.CanJoinRoom("team-a"))
+		require.False(t, grants.Video.CanJoinRoom("team-b"))
+		// inherited from the parent
+		require.False(t, grants.Video.GetCanPublishData())
+		require.NotNil(t, grants.Video.GetPublishLimits(livekit.TrackSource_CAMERA))
+		require.Equal(t, []string{"chat*", "presence"}, grants.Video.CanSubscribeDataTopics)
+		require.WithinDuration(t, time.Now().Add(time.Minute), v.info.ExpiresAt, 5*time.Second)
+		require.NotEqual(t, "parent", v.info.ID)
+	})
+
+	t.Run("rejects escalation", func(t *testing.T) {
+		canPublishData := &VideoGrant{RoomJoin: true}
+		canPublishData.SetCanPublishData(true)
+		for name, grants := range map[string]*ClaimGrants{
+			"admin":      {Video: &VideoGrant{RoomCreate: true}},
+			"room":       {Video: &VideoGrant{RoomJoin: true, Room: "lobby"}},
+			"pattern":    {Video: &VideoGrant{RoomJoin: true, RoomPattern: "*"}},
+			"permission": {Video: canPublishData},
+			"limits":     {Video: &VideoGrant{RoomJoin: true, PublishLimits: []TrackPublishLimits{{Source: "camera", MaxWidth: 1920}}}},
+			"topics":     {Video: &VideoGrant{RoomJoin: true, CanSubscribeDataTopics: []string{"chat*", "control"}}},
+			"wildcard":   {Video: &VideoGrant{RoomJoin: true, CanSubscribeDataTopics: []string{"*"}}},
+			"sip":        {SIP: &SIPGrant{Admin: true}},
+			"sha256":     {Sha256: "sum"},
+			"kind":       {Kind: "agent"},
+			"roomPreset": {RoomPreset: "pro"},
+			"name":       {Name: "admin"},
+			"metadata":   {Metadata: "{}"},
+			"attributes": {Attributes: map[string]string{"role": "admin"}},
+			"custom":     {Custom: map[string]json.RawMessage{"plan": []byte(`"pro"`)}},
+		} {
+			_, err := e.Exchange(parent, grants)
+			require.ErrorIs(t, err, ErrGrantEscalation, name)
+		}
+	})
+
+	t.Run("keeps participant state", func(t *testing.T) {
+		parent := &ClaimGrants{
+			Identity:   "user",
+			Kind:       "sip",
+			Name:       "User",
+			Attributes: map[string]string{"role": "member", "team": "a"},
+		}
+		d, err := NarrowGrants(parent, &ClaimGrants{Attributes: map[string]string{"role": "member"}})
+		require.NoError(t, err)
+		require.Equal(t, "sip", d.Kind)
+		require.Equal(t, "User", d.Name)
+		require.Equal(t, map[string]string{"role": "member"}, d.Attributes)
+
+		_, err = NarrowGrants(parent, &ClaimGrants{Kind: "standard"})
+		require.ErrorIs(t, err, ErrGrantEscalation)
+		_, err = NarrowGrants(parent, &ClaimGrants{Attributes: map[string]string{"role": "owner"}})
+		require.ErrorIs(t, err, ErrGrantEscalation)
+
+		// tokens without a kind are standard
+		_, err = NarrowGrants(&ClaimGrants{}, &ClaimGrants{Kind: "standard"})
+		require.NoError(t, err)
+	})
+
+	t.Run("narrows data topics", func(t *testing.T) {
+		child := &VideoGrant{RoomJoin: true, CanSubscribeDataTopics: []string{"chat-support", "chat-sales*"}}
+		_, err := e.Exchange(parent, &ClaimGrants{Video: child})
+		require.NoError(t, err)
+	})
+
+	t.Run("keeps identity", func(t *testing.T) {
+		token, err := NewAccessToken(apiKey, secret).
+			SetIdentity("user").
+			SetVideoGrant(&VideoGrant{RoomJoin: true, Room: "room"}).
+			ToJWT()
+		require.NoError(t, err)
+
+		_, err = e.Exchange(token, &ClaimGrants{Identity: "other"})
+		require.ErrorIs(t, err, ErrGrantEscalation)
+
+		token, err = e.Exchange(token, &ClaimGrants{Video: &VideoGrant{RoomJoin: true}})
+		require.NoError(t, err)
+		v, err := ParseAPIToken(token)
+		require.NoError(t, err)
+		grants, err := v.Verify(secret)
+		require.NoError(t, err)
+		require.Equal(t, "user", grants.Identity)
+		require.Equal(t, "room", grants.Video.Room)
+	})
+
+	t.Run("narrows sip trunks", func(t *testing.T) {
+		token, err := NewAccessToken(apiKey, secret).
+			SetSIPGrant(&SIPGrant{List: true, Call: true, TrunkIDs: []string{"ST_a", "ST_b"}}).
+			ToJWT()
+		require.NoError(t, err)
+
+		for name, grant := range map[string]*SIPGrant{
+			"trunk":  {Call: true, TrunkIDs: []string{"ST_c"}},
+			"create": {Create: true},
+			"admin":  {Admin: true},
+		} {
+			_, err = e.Exchange(token, &ClaimGrants{SIP: grant})
+			require.ErrorIs(t, err, ErrGrantEscalation, name)
+		}
+
+		derived, err := e.Exchange(token, &ClaimGrants{SIP: &SIPGrant{Call: true}})
+		require.NoError(t, err)
+		v, err := ParseAPIToken(derived)
+		require.NoError(t, err)
+		grants, err := v.Verify(secret)
+		require.NoError(t, err)
+		require.Equal(t, []string{"ST_a", "ST_b"}, grants.SIP.TrunkIDs)
+		require.False(t, grants.SIP.List)
+	})
+
+	t.Run("rejects invalid parent", func(t *testing.T) {
+		_, err := e.Exchange(parent+"x", &ClaimGrants{})
+		require.Error(t, err)
+
+		revoked := NewMemoryRevocationList()
+		require.NoError(t, revoked.RevokeToken(context.Background(), "parent", time.Hour))
+		_, err = NewTokenExchanger(provider, TokenExchangerOptions{RevocationChecker: revoked}).Exchange(parent, &ClaimGrants{})
+		require.ErrorIs(t, err, ErrTokenRevoked)
+	})
+}
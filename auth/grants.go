@@ -15,7 +15,11 @@
 package auth
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"maps"
+	"path"
 	"strings"
 
 	"go.uber.org/zap/zapcore"
@@ -27,6 +31,11 @@ import (
 	"github.com/livekit/protocol/utils"
 )
 
+var (
+	ErrInvalidRoomPattern = errors.New("invalid room pattern")
+	ErrInvalidGrants      = errors.New("invalid grants")
+)
+
 type RoomConfiguration livekit.RoomConfiguration
 
 var tokenMarshaler = protojson.MarshalOptions{
@@ -55,6 +64,8 @@ type ClaimGrants struct {
 	Video    *VideoGrant `json:"video,omitempty"`
 	SIP      *SIPGrant   `json:"sip,omitempty"`
 	Agent    *AgentGrant `json:"agent,omitempty"`
+	// read-only access to the state of rooms, e.g. for monitoring dashboards
+	Observability *ObservabilityGrant `json:"observability,omitempty"`
 	// Room configuration to use if this participant initiates the room
 	RoomConfig *RoomConfiguration `json:"roomConfig,omitempty"`
 	// Cloud-only, config preset to use
@@ -65,6 +76,14 @@ type ClaimGrants struct {
 	Metadata string `json:"metadata,omitempty"`
 	// Key/value attributes to attach to the participant
 	Attributes map[string]string `json:"attributes,omitempty"`
+	// Project the token is scoped to, empty tokens are not scoped
+	ProjectID string `json:"projectId,omitempty"`
+	// restricts the rooms and participants the grants apply to by their attributes
+	AttributeClaims *AttributeClaims `json:"attributeClaims,omitempty"`
+	// id of the token this one was derived from, see TokenExchanger
+	ParentID string `json:"parentId,omitempty"`
+	// application defined sections, see CustomClaim
+	Custom map[string]json.RawMessage `json:"custom,omitempty"`
 }
 
 func (c *ClaimGrants) SetParticipantKind(kind livekit.ParticipantInfo_Kind) {
@@ -75,6 +94,96 @@ func (c *ClaimGrants) GetParticipantKind() livekit.ParticipantInfo_Kind {
 	return kindToProto(c.Kind)
 }
 
+// ValidateIdentity checks the identity against the policy, e.g. when the participant joins
+func (c *ClaimGrants) ValidateIdentity(policy *IdentityPolicy) error {
+	return policy.Validate(c.Identity, c.GetParticipantKind())
+}
+
+// Validate returns an error describing the first inconsistency in the grants,
+// e.g. a room join grant without a room, which the server would reject.
+func (c *ClaimGrants) Validate() error {
+	if c.Kind != "" && kindFromProto(kindToProto(c.Kind)) != strings.ToLower(c.Kind) {
+		return invalidGrants("unknown participant kind %q", c.Kind)
+	}
+	if v := c.Video; v != nil {
+		if err := v.ValidateRoomPattern(); err != nil {
+			return err
+		}
+		if v.Room != "" && v.RoomPattern != "" {
+			return invalidGrants("room and roomPattern cannot both be set")
+		}
+		if v.RoomJoin {
+			if v.Room == "" && v.RoomPattern == "" {
+				return invalidGrants("roomJoin requires a room or roomPattern")
+			}
+			if c.Identity == "" {
+				return invalidGrants("roomJoin requires an identity")
+			}
+		}
+		if v.DestinationRoom != "" {
+			if !v.RoomAdmin || v.Room == "" {
+				return invalidGrants("destinationRoom requires roomAdmin on a room")
+			}
+			if v.DestinationRoom == v.Room {
+				return invalidGrants("destinationRoom must differ from room")
+			}
+		}
+		for _, l := range v.PublishLimits {
+			if l.Source != "" && sourceToProto(l.Source) == livekit.TrackSource_UNKNOWN {
+				return invalidGrants("unknown publish limits source %q", l.Source)
+			}
+		}
+	}
+	if err := c.SIP.Validate(); err != nil {
+		return err
+	}
+	return c.AttributeClaims.Validate()
+}
+
+func invalidGrants(format string, args ...any) error {
+	return fmt.Errorf("%w: "+format, append([]any{ErrInvalidGrants}, args...)...)
+}
+
+// CanJoinRoomWithAttributes returns true if the video grant allows joining the room
+// and the room attributes satisfy the attribute claims.
+func (c *ClaimGrants) CanJoinRoomWithAttributes(room string, attributes map[string]string) bool {
+	return c.Video != nil && c.Video.CanJoinRoom(room) && c.AttributeClaims.MatchRoom(attributes) == nil
+}
+
+// CanAccessParticipant returns true if the attributes of a remote participant satisfy the attribute claims.
+func (c *ClaimGrants) CanAccessParticipant(attributes map[string]string) bool {
+	return c.AttributeClaims.MatchParticipant(attributes) == nil
+}
+
+// CanListRooms returns true if the grants allow listing rooms
+func (c *ClaimGrants) CanListRooms() bool {
+	return (c.Video != nil && c.Video.RoomList) || (c.Observability != nil && c.Observability.RoomList)
+}
+
+// CanRequestRoomPreview returns true if the grants allow requesting previews of active rooms, see RoomService.RequestRoomPreview.
+// Previews show the media of rooms, so the room list permission of observability grants is not enough
+func (c *ClaimGrants) CanRequestRoomPreview() bool {
+	return c.Video != nil && c.Video.RoomList
+}
+
+// CanListParticipants returns true if the grants allow listing the participants of the room
+func (c *ClaimGrants) CanListParticipants(room string) bool {
+	if c.Video != nil && c.Video.RoomAdmin && c.Video.MatchesRoom(room) {
+		return true
+	}
+	return c.Observability != nil && c.Observability.ParticipantList
+}
+
+// CanReadSessions returns true if the grants allow reading the analytics sessions of rooms
+func (c *ClaimGrants) CanReadSessions() bool {
+	return (c.Video != nil && c.Video.RoomAdmin) || (c.Observability != nil && c.Observability.SessionRead)
+}
+
+// CanReadEgress returns true if the grants allow listing egresses and reading their metadata
+func (c *ClaimGrants) CanReadEgress() bool {
+	return (c.Video != nil && c.Video.RoomRecord) || (c.Observability != nil && c.Observability.EgressRead)
+}
+
 func (c *ClaimGrants) GetRoomConfiguration() *livekit.RoomConfiguration {
 	if c.RoomConfig == nil {
 		return nil
@@ -82,6 +191,11 @@ func (c *ClaimGrants) GetRoomConfiguration() *livekit.RoomConfiguration {
 	return (*livekit.RoomConfiguration)(c.RoomConfig)
 }
 
+// MergeRoomConfiguration returns the room configuration of the token applied over the preset named by RoomPreset.
+func (c *ClaimGrants) MergeRoomConfiguration(preset *livekit.RoomConfiguration) *livekit.RoomConfiguration {
+	return preset.Merge(c.GetRoomConfiguration())
+}
+
 func (c *ClaimGrants) Clone() *ClaimGrants {
 	if c == nil {
 		return nil
@@ -90,8 +204,11 @@ func (c *ClaimGrants) Clone() *ClaimGrants {
 	clone := *c
 	clone.Video = c.Video.Clone()
 	clone.SIP = c.SIP.Clone()
+	clone.Observability = c.Observability.Clone()
 	clone.Attributes = maps.Clone(c.Attributes)
 	clone.RoomConfig = c.RoomConfig.Clone()
+	clone.AttributeClaims = c.AttributeClaims.Clone()
+	clone.Custom = maps.Clone(c.Custom)
 
 	return &clone
 }
@@ -105,8 +222,18 @@ func (c *ClaimGrants) MarshalLogObject(e zapcore.ObjectEncoder) error {
 	e.AddString("Kind", c.Kind)
 	e.AddObject("Video", c.Video)
 	e.AddObject("SIP", c.SIP)
+	e.AddObject("Observability", c.Observability)
 	e.AddObject("RoomConfig", logger.Proto((*livekit.RoomConfiguration)(c.RoomConfig)))
 	e.AddString("RoomPreset", c.RoomPreset)
+	e.AddString("ProjectID", c.ProjectID)
+	e.AddObject("AttributeClaims", c.AttributeClaims)
+	e.AddString("ParentID", c.ParentID)
+	custom := make([]string, 0, len(c.Custom))
+	for name := range c.Custom {
+		custom = append(custom, name)
+	}
+	slices.Sort(custom)
+	e.AddArray("Custom", logger.StringSlice(custom))
 	return nil
 }
 
@@ -122,6 +249,11 @@ type VideoGrant struct {
 	RoomAdmin bool   `json:"roomAdmin,omitempty"`
 	RoomJoin  bool   `json:"roomJoin,omitempty"`
 	Room      string `json:"room,omitempty"`
+	// room participants of Room can be moved to, with RoomAdmin
+	DestinationRoom string `json:"destinationRoom,omitempty"`
+	// glob pattern of rooms which can be joined when Room is empty, e.g. "classroom-*", see MatchesRoom.
+	// Only grants RoomJoin, admin actions require Room.
+	RoomPattern string `json:"roomPattern,omitempty"`
 
 	// permissions within a room, if none of the permissions are set explicitly
 	// it will be granted with all publish and subscribe permissions
@@ -131,6 +263,12 @@ type VideoGrant struct {
 	// TrackSource types that a participant may publish.
 	// When set, it supersedes CanPublish. Only sources explicitly set here can be published
 	CanPublishSources []string `json:"canPublishSources,omitempty"` // keys keep track of each source
+	// topics of user packets and data streams the participant can publish to and receive,
+	// a trailing * matches any suffix. All topics are allowed when empty
+	CanPublishDataTopics   []string `json:"canPublishDataTopics,omitempty"`
+	CanSubscribeDataTopics []string `json:"canSubscribeDataTopics,omitempty"`
+	// constraints on published tracks, see CheckPublishTrack
+	PublishLimits []TrackPublishLimits `json:"publishLimits,omitempty"`
 	// by default, a participant is not allowed to update its own metadata
 	CanUpdateOwnMetadata *bool `json:"canUpdateOwnMetadata,omitempty"`
 
@@ -218,6 +356,16 @@ func (v *VideoGrant) GetCanPublishData() bool {
 	return *v.CanPublishData
 }
 
+// GetCanPublishDataTopic returns true if the grant allows publishing user packets and data streams on the topic
+func (v *VideoGrant) GetCanPublishDataTopic(topic string) bool {
+	return v.GetCanPublishData() && livekit.DataTopicAllowed(v.CanPublishDataTopics, topic)
+}
+
+// GetCanSubscribeDataTopic returns true if the grant allows receiving user packets and data streams on the topic
+func (v *VideoGrant) GetCanSubscribeDataTopic(topic string) bool {
+	return livekit.DataTopicAllowed(v.CanSubscribeDataTopics, topic)
+}
+
 func (v *VideoGrant) GetCanSubscribe() bool {
 	if v.CanSubscribe == nil {
 		return true
@@ -271,10 +419,52 @@ func (v *VideoGrant) MatchesPermission(permission *livekit.ParticipantPermission
 	if v.GetCanSubscribeMetrics() != permission.CanSubscribeMetrics {
 		return false
 	}
+	if !slices.Equal(v.CanPublishDataTopics, permission.CanPublishDataTopics) {
+		return false
+	}
+	if !slices.Equal(v.CanSubscribeDataTopics, permission.CanSubscribeDataTopics) {
+		return false
+	}
 
 	return true
 }
 
+// MatchesRoom returns true if the grant applies to the room, by name or by RoomPattern
+func (v *VideoGrant) MatchesRoom(room string) bool {
+	if room == "" {
+		return false
+	}
+	if v.Room != "" {
+		return v.Room == room
+	}
+	if v.RoomPattern == "" {
+		return false
+	}
+	ok, err := path.Match(v.RoomPattern, room)
+	return err == nil && ok
+}
+
+// CanJoinRoom returns true if the grant allows joining the room
+func (v *VideoGrant) CanJoinRoom(room string) bool {
+	return v.RoomJoin && v.MatchesRoom(room)
+}
+
+// ValidateRoomPattern returns an error if RoomPattern is not a valid pattern
+func (v *VideoGrant) ValidateRoomPattern() error {
+	if v.RoomPattern == "" {
+		return nil
+	}
+	if _, err := path.Match(v.RoomPattern, ""); err != nil {
+		return fmt.Errorf("%w: %q", ErrInvalidRoomPattern, v.RoomPattern)
+	}
+	return nil
+}
+
+// CanMoveParticipant returns true if the grant allows moving participants from room to destinationRoom
+func (v *VideoGrant) CanMoveParticipant(room, destinationRoom string) bool {
+	return v.RoomAdmin && room != "" && v.Room == room && v.DestinationRoom == destinationRoom && destinationRoom != room
+}
+
 func (v *VideoGrant) UpdateFromPermission(permission *livekit.ParticipantPermission) {
 	if permission == nil {
 		return
@@ -289,6 +479,8 @@ func (v *VideoGrant) UpdateFromPermission(permission *livekit.ParticipantPermiss
 	v.Recorder = permission.Recorder
 	v.Agent = permission.Agent
 	v.SetCanSubscribeMetrics(permission.CanSubscribeMetrics)
+	v.CanPublishDataTopics = slices.Clone(permission.CanPublishDataTopics)
+	v.CanSubscribeDataTopics = slices.Clone(permission.CanSubscribeDataTopics)
 }
 
 func (v *VideoGrant) ToPermission() *livekit.ParticipantPermission {
@@ -302,6 +494,9 @@ func (v *VideoGrant) ToPermission() *livekit.ParticipantPermission {
 		Recorder:            v.Recorder,
 		Agent:               v.Agent,
 		CanSubscribeMetrics: v.GetCanSubscribeMetrics(),
+
+		CanPublishDataTopics:   slices.Clone(v.CanPublishDataTopics),
+		CanSubscribeDataTopics: slices.Clone(v.CanSubscribeDataTopics),
 	}
 }
 
@@ -332,11 +527,22 @@ func (v *VideoGrant) Clone() *VideoGrant {
 		copy(clone.CanPublishSources, v.CanPublishSources)
 	}
 
+	clone.CanPublishDataTopics = slices.Clone(v.CanPublishDataTopics)
+	clone.CanSubscribeDataTopics = slices.Clone(v.CanSubscribeDataTopics)
+
 	if v.CanUpdateOwnMetadata != nil {
 		canUpdateOwnMetadata := *v.CanUpdateOwnMetadata
 		clone.CanUpdateOwnMetadata = &canUpdateOwnMetadata
 	}
 
+	if v.PublishLimits != nil {
+		clone.PublishLimits = make([]TrackPublishLimits, 0, len(v.PublishLimits))
+		for _, l := range v.PublishLimits {
+			l.TrackNames = slices.Clone(l.TrackNames)
+			clone.PublishLimits = append(clone.PublishLimits, l)
+		}
+	}
+
 	return &clone
 }
 
@@ -360,11 +566,16 @@ func (v *VideoGrant) MarshalLogObject(e zapcore.ObjectEncoder) error {
 	logBoolPtr("RoomAdmin", &v.RoomAdmin)
 	logBoolPtr("RoomJoin", &v.RoomJoin)
 	e.AddString("Room", v.Room)
+	e.AddString("DestinationRoom", v.DestinationRoom)
+	e.AddString("RoomPattern", v.RoomPattern)
 
 	logBoolPtr("CanPublish", v.CanPublish)
 	logBoolPtr("CanSubscribe", v.CanSubscribe)
 	logBoolPtr("CanPublishData", v.CanPublishData)
 	e.AddArray("CanPublishSources", logger.StringSlice(v.CanPublishSources))
+	e.AddArray("CanPublishDataTopics", logger.StringSlice(v.CanPublishDataTopics))
+	e.AddArray("CanSubscribeDataTopics", logger.StringSlice(v.CanSubscribeDataTopics))
+	e.AddArray("PublishLimits", logger.ObjectSlice(v.PublishLimits))
 	logBoolPtr("CanUpdateOwnMetadata", v.CanUpdateOwnMetadata)
 
 	logBoolPtr("IngressAdmin", &v.IngressAdmin)
@@ -385,6 +596,92 @@ type SIPGrant struct {
 
 	// Call allows making outbound SIP calls.
 	Call bool `json:"call,omitempty"`
+
+	// List allows listing and reading trunks and dispatch rules.
+	List bool `json:"list,omitempty"`
+
+	// Create allows creating, updating and deleting trunks and dispatch rules.
+	Create bool `json:"create,omitempty"`
+
+	// TrunkIDs restricts List, Create and Call to the trunks with these ids, all trunks are allowed when empty.
+	// Servers which do not enforce TrunkIDs and DispatchRuleIDs ignore them, allowing calls through all trunks,
+	// so scoped grants should only be issued for servers which support them.
+	TrunkIDs []string `json:"trunkIds,omitempty"`
+
+	// DispatchRuleIDs restricts List and Create to the dispatch rules with these ids, all rules are allowed when empty.
+	DispatchRuleIDs []string `json:"dispatchRuleIds,omitempty"`
+}
+
+// Validate returns an error if the grant cannot be scoped as requested
+func (s *SIPGrant) Validate() error {
+	// servers unaware of scoping would grant admin access to all trunks
+	if s != nil && s.Admin && s.IsScoped() {
+		return invalidGrants("sip admin cannot be restricted to trunks or dispatch rules")
+	}
+	return nil
+}
+
+// IsScoped returns true if the grant is restricted to specific trunks or dispatch rules
+func (s *SIPGrant) IsScoped() bool {
+	return len(s.TrunkIDs) != 0 || len(s.DispatchRuleIDs) != 0
+}
+
+// MatchesTrunk returns true if the grant applies to the trunk
+func (s *SIPGrant) MatchesTrunk(trunkID string) bool {
+	return len(s.TrunkIDs) == 0 || slices.Contains(s.TrunkIDs, trunkID)
+}
+
+// MatchesDispatchRule returns true if the grant applies to the dispatch rule
+func (s *SIPGrant) MatchesDispatchRule(ruleID string) bool {
+	return len(s.DispatchRuleIDs) == 0 || slices.Contains(s.DispatchRuleIDs, ruleID)
+}
+
+// CanReadTrunk returns true if the grant allows listing and reading the trunk
+func (s *SIPGrant) CanReadTrunk(trunkID string) bool {
+	if s == nil {
+		return false
+	}
+	return s.Admin || ((s.List || s.Create) && s.MatchesTrunk(trunkID))
+}
+
+// CanManageTrunk returns true if the grant allows updating or deleting the trunk.
+// New trunks, with an empty id, can only be created by grants not restricted to specific trunks.
+func (s *SIPGrant) CanManageTrunk(trunkID string) bool {
+	if s == nil {
+		return false
+	}
+	if trunkID == "" {
+		return s.Admin || (s.Create && len(s.TrunkIDs) == 0)
+	}
+	return s.Admin || (s.Create && s.MatchesTrunk(trunkID))
+}
+
+// CanCallTrunk returns true if the grant allows outbound calls through the trunk
+func (s *SIPGrant) CanCallTrunk(trunkID string) bool {
+	if s == nil {
+		return false
+	}
+	return s.Admin || (s.Call && s.MatchesTrunk(trunkID))
+}
+
+// CanReadDispatchRule returns true if the grant allows listing and reading the dispatch rule
+func (s *SIPGrant) CanReadDispatchRule(ruleID string) bool {
+	if s == nil {
+		return false
+	}
+	return s.Admin || ((s.List || s.Create) && s.MatchesDispatchRule(ruleID))
+}
+
+// CanManageDispatchRule returns true if the grant allows updating or deleting the dispatch rule.
+// New rules, with an empty id, can only be created by grants not restricted to specific rules.
+func (s *SIPGrant) CanManageDispatchRule(ruleID string) bool {
+	if s == nil {
+		return false
+	}
+	if ruleID == "" {
+		return s.Admin || (s.Create && len(s.DispatchRuleIDs) == 0)
+	}
+	return s.Admin || (s.Create && s.MatchesDispatchRule(ruleID))
 }
 
 func (s *SIPGrant) Clone() *SIPGrant {
@@ -393,6 +690,8 @@ func (s *SIPGrant) Clone() *SIPGrant {
 	}
 
 	clone := *s
+	clone.TrunkIDs = slices.Clone(s.TrunkIDs)
+	clone.DispatchRuleIDs = slices.Clone(s.DispatchRuleIDs)
 
 	return &clone
 }
@@ -404,6 +703,10 @@ func (s *SIPGrant) MarshalLogObject(e zapcore.ObjectEncoder) error {
 
 	e.AddBool("Admin", s.Admin)
 	e.AddBool("Call", s.Call)
+	e.AddBool("List", s.List)
+	e.AddBool("Create", s.Create)
+	e.AddArray("TrunkIDs", logger.StringSlice(s.TrunkIDs))
+	e.AddArray("DispatchRuleIDs", logger.StringSlice(s.DispatchRuleIDs))
 	return nil
 }
 
@@ -437,6 +740,45 @@ func (s *AgentGrant) MarshalLogObject(e zapcore.ObjectEncoder) error {
 
 // ------------------------------------------------------------------
 
+// ObservabilityGrant allows reading the state of rooms, without any permission to change it.
+type ObservabilityGrant struct {
+	// RoomList allows listing rooms and reading their metadata.
+	RoomList bool `json:"roomList,omitempty"`
+
+	// ParticipantList allows listing the participants of any room.
+	ParticipantList bool `json:"participantList,omitempty"`
+
+	// SessionRead allows reading analytics sessions.
+	SessionRead bool `json:"sessionRead,omitempty"`
+
+	// EgressRead allows listing egresses and reading their metadata.
+	EgressRead bool `json:"egressRead,omitempty"`
+}
+
+func (s *ObservabilityGrant) Clone() *ObservabilityGrant {
+	if s == nil {
+		return nil
+	}
+
+	clone := *s
+
+	return &clone
+}
+
+func (s *ObservabilityGrant) MarshalLogObject(e zapcore.ObjectEncoder) error {
+	if s == nil {
+		return nil
+	}
+
+	e.AddBool("RoomList", s.RoomList)
+	e.AddBool("ParticipantList", s.ParticipantList)
+	e.AddBool("SessionRead", s.SessionRead)
+	e.AddBool("EgressRead", s.EgressRead)
+	return nil
+}
+
+// ------------------------------------------------------------------
+
 func sourceToString(source livekit.TrackSource) string {
 	return strings.ToLower(source.String())
 }
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/livekit/protocol/livekit"
 )
@@ -99,3 +100,267 @@ func TestParticipantKind(t *testing.T) {
 		t.Errorf("Please update kindMax to match protobuf. Missing value: %s", kindNext)
 	}
 }
+
+func TestCanMoveParticipant(t *testing.T) {
+	grant := &VideoGrant{RoomAdmin: true, Room: "lobby", DestinationRoom: "agent"}
+	require.True(t, grant.CanMoveParticipant("lobby", "agent"))
+	require.False(t, grant.CanMoveParticipant("lobby", "other"))
+	require.False(t, grant.CanMoveParticipant("other", "agent"))
+
+	require.False(t, (&VideoGrant{RoomAdmin: true, Room: "lobby"}).CanMoveParticipant("lobby", "agent"))
+	require.False(t, (&VideoGrant{Room: "lobby", DestinationRoom: "agent"}).CanMoveParticipant("lobby", "agent"))
+	require.False(t, (&VideoGrant{RoomAdmin: true, Room: "lobby", DestinationRoom: "lobby"}).CanMoveParticipant("lobby", "lobby"))
+}
+
+func TestCheckPublishTrack(t *testing.T) {
+	grant := &VideoGrant{
+		CanPublishSources: []string{"camera", "screen_share", "microphone"},
+		PublishLimits: []TrackPublishLimits{
+			{MaxBitrate: 64000},
+			{Source: "camera", MaxWidth: 1280, MaxHeight: 720, MaxBitrate: 1_500_000},
+			{Source: "screen_share", TrackNames: []string{"slides-*"}},
+		},
+	}
+	camera := func(width, height uint32, bitrates ...uint32) *livekit.AddTrackRequest {
+		req := &livekit.AddTrackRequest{Source: livekit.TrackSource_CAMERA, Width: width, Height: height}
+		for _, b := range bitrates {
+			req.Layers = append(req.Layers, &livekit.VideoLayer{Bitrate: b})
+		}
+		return req
+	}
+
+	require.NoError(t, grant.CheckPublishTrack(camera(1280, 720, 150_000, 500_000, 800_000)))
+	require.ErrorIs(t, grant.CheckPublishTrack(camera(1920, 1080)), ErrPublishResolutionExceeded)
+	require.ErrorIs(t, grant.CheckPublishTrack(camera(1280, 720, 1_000_000, 1_000_000)), ErrPublishBitrateExceeded)
+
+	require.NoError(t, grant.CheckPublishTrack(&livekit.AddTrackRequest{Source: livekit.TrackSource_SCREEN_SHARE, Name: "slides-1", Width: 3840}))
+	require.ErrorIs(t, grant.CheckPublishTrack(&livekit.AddTrackRequest{Source: livekit.TrackSource_SCREEN_SHARE, Name: "desktop"}), ErrPublishTrackNameNotAllowed)
+
+	// sources without their own limits use the default ones
+	require.ErrorIs(t, grant.CheckPublishTrack(&livekit.AddTrackRequest{
+		Source: livekit.TrackSource_MICROPHONE,
+		Layers: []*livekit.VideoLayer{{Bitrate: 128000}},
+	}), ErrPublishBitrateExceeded)
+	require.ErrorIs(t, grant.CheckPublishTrack(&livekit.AddTrackRequest{Source: livekit.TrackSource_SCREEN_SHARE_AUDIO}), ErrPublishSourceNotAllowed)
+
+	clone := grant.Clone()
+	clone.PublishLimits[2].TrackNames[0] = "*"
+	require.Equal(t, "slides-*", grant.PublishLimits[2].TrackNames[0])
+
+	require.Nil(t, (&VideoGrant{}).GetPublishLimits(livekit.TrackSource_CAMERA))
+}
+
+func TestRoomPattern(t *testing.T) {
+	grant := &VideoGrant{RoomJoin: true, RoomPattern: "classroom-*"}
+	require.NoError(t, grant.ValidateRoomPattern())
+	require.True(t, grant.CanJoinRoom("classroom-1"))
+	require.False(t, grant.CanJoinRoom("lobby"))
+	require.False(t, grant.CanJoinRoom(""))
+
+	// patterns do not grant admin actions
+	grant.RoomAdmin = true
+	grant.DestinationRoom = "classroom-2"
+	require.False(t, grant.CanMoveParticipant("classroom-1", "classroom-2"))
+
+	// an explicit room takes precedence
+	grant.Room = "lobby"
+	require.True(t, grant.CanJoinRoom("lobby"))
+	require.False(t, grant.CanJoinRoom("classroom-1"))
+
+	require.ErrorIs(t, (&VideoGrant{RoomPattern: "classroom-["}).ValidateRoomPattern(), ErrInvalidRoomPattern)
+	require.False(t, (&VideoGrant{RoomJoin: true, RoomPattern: "classroom-["}).CanJoinRoom("classroom-["))
+	require.False(t, (&VideoGrant{RoomJoin: true}).CanJoinRoom("lobby"))
+}
+
+func TestAttributeClaims(t *testing.T) {
+	claims := &AttributeClaims{
+		Room: []AttributeCondition{
+			{Key: "tenant", Values: []string{"acme"}},
+			{Key: "tier", Op: AttributePrefix, Values: []string{"pro-"}},
+		},
+		Participant: []AttributeCondition{
+			{Key: "role", Op: AttributeNotEquals, Values: []string{"admin"}},
+			{Key: "tenant", Op: AttributeExists},
+		},
+	}
+	require.NoError(t, claims.Validate())
+
+	require.NoError(t, claims.MatchRoom(map[string]string{"tenant": "acme", "tier": "pro-eu"}))
+	require.ErrorIs(t, claims.MatchRoom(map[string]string{"tenant": "other", "tier": "pro-eu"}), ErrAttributeMismatch)
+	require.ErrorIs(t, claims.MatchRoom(map[string]string{"tenant": "acme"}), ErrAttributeMismatch)
+	require.NoError(t, claims.MatchParticipant(map[string]string{"tenant": "acme"}))
+	require.ErrorIs(t, claims.MatchParticipant(map[string]string{"tenant": "acme", "role": "admin"}), ErrAttributeMismatch)
+	require.ErrorIs(t, claims.MatchParticipant(nil), ErrAttributeMismatch)
+
+	var unrestricted *AttributeClaims
+	require.NoError(t, unrestricted.MatchRoom(nil))
+	require.NoError(t, unrestricted.MatchParticipant(nil))
+
+	require.ErrorIs(t, (&AttributeClaims{Room: []AttributeCondition{{Values: []string{"x"}}}}).Validate(), ErrInvalidAttributeCondition)
+	require.ErrorIs(t, (&AttributeClaims{Room: []AttributeCondition{{Key: "tenant"}}}).Validate(), ErrInvalidAttributeCondition)
+	require.ErrorIs(t, (&AttributeClaims{Room: []AttributeCondition{{Key: "tenant", Op: "gt", Values: []string{"1"}}}}).Validate(), ErrInvalidAttributeCondition)
+
+	t.Run("grants", func(t *testing.T) {
+		grants := &ClaimGrants{
+			Video:           &VideoGrant{RoomJoin: true, RoomPattern: "*"},
+			AttributeClaims: claims,
+		}
+		require.True(t, grants.CanJoinRoomWithAttributes("room", map[string]string{"tenant": "acme", "tier": "pro-us"}))
+		require.False(t, grants.CanJoinRoomWithAttributes("room", map[string]string{"tenant": "other", "tier": "pro-us"}))
+		require.False(t, (&ClaimGrants{}).CanJoinRoomWithAttributes("room", nil))
+		require.True(t, grants.CanAccessParticipant(map[string]string{"tenant": "acme"}))
+
+		clone := grants.Clone()
+		require.Equal(t, grants.AttributeClaims, clone.AttributeClaims)
+		clone.AttributeClaims.Room[0].Values[0] = "changed"
+		require.Equal(t, "acme", grants.AttributeClaims.Room[0].Values[0])
+	})
+
+	t.Run("token", func(t *testing.T) {
+		apiKey, secret := apiKeypair()
+		token, err := NewAccessToken(apiKey, secret).
+			SetIdentity("user").
+			SetAttributeClaims(claims).
+			ToJWT()
+		require.NoError(t, err)
+
+		v, err := ParseAPIToken(token)
+		require.NoError(t, err)
+		grants, err := v.Verify(secret)
+		require.NoError(t, err)
+		require.Equal(t, claims, grants.AttributeClaims)
+
+		_, err = NewAccessToken(apiKey, secret).
+			SetAttributeClaims(&AttributeClaims{Room: []AttributeCondition{{Key: "tenant"}}}).
+			ToJWT()
+		require.ErrorIs(t, err, ErrInvalidAttributeCondition)
+	})
+}
+
+func TestProjectScope(t *testing.T) {
+	room := &livekit.Room{Name: "room", ProjectId: "p1"}
+
+	unscoped := &ClaimGrants{}
+	require.NoError(t, unscoped.CheckProject(room))
+	projectID, err := unscoped.ResolveProject(&livekit.ListRoomsRequest{ProjectId: "p2"})
+	require.NoError(t, err)
+	require.Equal(t, "p2", projectID)
+
+	scoped := &ClaimGrants{ProjectID: "p1"}
+	require.NoError(t, scoped.CheckProject(room))
+	require.ErrorIs(t, scoped.CheckProject(&livekit.Room{Name: "other", ProjectId: "p2"}), ErrProjectMismatch)
+	require.ErrorIs(t, scoped.CheckProject(&livekit.Room{Name: "legacy"}), ErrProjectMismatch)
+
+	projectID, err = scoped.ResolveProject(&livekit.ListRoomsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "p1", projectID)
+	_, err = scoped.ResolveProject(&livekit.ListRoomsRequest{ProjectId: "p2"})
+	require.ErrorIs(t, err, ErrProjectMismatch)
+
+	rooms := livekit.FilterByProject([]*livekit.Room{room, {Name: "other", ProjectId: "p2"}}, projectID)
+	require.Equal(t, []*livekit.Room{room}, rooms)
+}
+
+func TestDataTopics(t *testing.T) {
+	grant := &VideoGrant{
+		CanPublishDataTopics:   []string{"chat"},
+		CanSubscribeDataTopics: []string{"chat", "control.*"},
+	}
+	require.True(t, grant.GetCanPublishDataTopic("chat"))
+	require.False(t, grant.GetCanPublishDataTopic("control.mute"))
+	require.True(t, grant.GetCanSubscribeDataTopic("control.mute"))
+	require.False(t, grant.GetCanSubscribeDataTopic(""))
+
+	grant.SetCanPublishData(false)
+	require.False(t, grant.GetCanPublishDataTopic("chat"))
+	grant.SetCanPublishData(true)
+
+	permission := grant.ToPermission()
+	require.Equal(t, []string{"chat"}, permission.CanPublishDataTopics)
+	require.True(t, permission.CanSubscribeDataTopic("control.mute"))
+	require.True(t, grant.MatchesPermission(permission))
+
+	clone := grant.Clone()
+	clone.CanSubscribeDataTopics[0] = "other"
+	require.Equal(t, "chat", grant.CanSubscribeDataTopics[0])
+
+	updated := &VideoGrant{}
+	updated.UpdateFromPermission(permission)
+	require.True(t, updated.MatchesPermission(permission))
+}
+
+func TestMergeRoomConfiguration(t *testing.T) {
+	preset := &livekit.RoomConfiguration{Name: "support", EmptyTimeout: 300, MaxParticipants: 10}
+	grants := &ClaimGrants{
+		RoomPreset: "support",
+		RoomConfig: &RoomConfiguration{MaxParticipants: 2},
+	}
+	conf := grants.MergeRoomConfiguration(preset)
+	require.Equal(t, uint32(300), conf.EmptyTimeout)
+	require.Equal(t, uint32(2), conf.MaxParticipants)
+
+	conf = (&ClaimGrants{}).MergeRoomConfiguration(preset)
+	require.True(t, proto.Equal(preset, conf))
+}
+
+func TestSIPGrantScope(t *testing.T) {
+	g := &SIPGrant{List: true, Create: true, Call: true, TrunkIDs: []string{"ST_a"}, DispatchRuleIDs: []string{"SDR_a"}}
+	require.True(t, g.CanReadTrunk("ST_a"))
+	require.True(t, g.CanManageTrunk("ST_a"))
+	require.True(t, g.CanCallTrunk("ST_a"))
+	require.False(t, g.CanReadTrunk("ST_b"))
+	require.False(t, g.CanManageTrunk("ST_b"))
+	require.False(t, g.CanCallTrunk("ST_b"))
+	require.False(t, g.CanManageTrunk(""))
+	require.True(t, g.CanManageDispatchRule("SDR_a"))
+	require.False(t, g.CanReadDispatchRule("SDR_b"))
+
+	readOnly := &SIPGrant{List: true}
+	require.True(t, readOnly.CanReadTrunk("ST_b"))
+	require.False(t, readOnly.CanManageTrunk("ST_b"))
+	require.False(t, readOnly.CanCallTrunk("ST_b"))
+
+	admin := &SIPGrant{Admin: true}
+	require.True(t, admin.CanManageTrunk(""))
+	require.True(t, admin.CanCallTrunk("ST_b"))
+
+	var none *SIPGrant
+	require.False(t, none.CanReadTrunk("ST_a"))
+
+	clone := g.Clone()
+	clone.TrunkIDs[0] = "ST_b"
+	require.Equal(t, "ST_a", g.TrunkIDs[0])
+
+	require.NoError(t, (&ClaimGrants{SIP: g}).Validate())
+	require.ErrorIs(t, (&ClaimGrants{SIP: &SIPGrant{Admin: true, TrunkIDs: []string{"ST_a"}}}).Validate(), ErrInvalidGrants)
+
+	apiKey, secret := apiKeypair()
+	_, err := NewAccessToken(apiKey, secret).SetSIPGrant(&SIPGrant{Admin: true, DispatchRuleIDs: []string{"SDR_a"}}).ToJWT()
+	require.ErrorIs(t, err, ErrInvalidGrants)
+}
+
+func TestObservabilityGrant(t *testing.T) {
+	observer := &ClaimGrants{Observability: &ObservabilityGrant{RoomList: true, ParticipantList: true, SessionRead: true, EgressRead: true}}
+	require.True(t, observer.CanListRooms())
+	require.True(t, observer.CanListParticipants("room"))
+	require.True(t, observer.CanReadSessions())
+	require.True(t, observer.CanReadEgress())
+	// no mutating permissions
+	require.False(t, observer.CanJoinRoomWithAttributes("room", nil))
+
+	admin := &ClaimGrants{Video: &VideoGrant{RoomAdmin: true, Room: "room"}}
+	require.True(t, admin.CanListParticipants("room"))
+	require.False(t, admin.CanListParticipants("other"))
+	require.False(t, admin.CanListRooms())
+	require.False(t, admin.CanReadEgress())
+
+	// previews show the media of rooms
+	require.False(t, observer.CanRequestRoomPreview())
+	require.False(t, admin.CanRequestRoomPreview())
+	require.True(t, (&ClaimGrants{Video: &VideoGrant{RoomList: true}}).CanRequestRoomPreview())
+	require.False(t, (&ClaimGrants{}).CanRequestRoomPreview())
+
+	clone := observer.Clone()
+	clone.Observability.EgressRead = false
+	require.True(t, observer.Observability.EgressRead)
+}
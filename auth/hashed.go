@@ -0,0 +1,170 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-jose/go-jose/v3"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/hkdf"
+)
+
+// header marking tokens signed with a key derived from the API secret, see DeriveSigningKey
+const kdfHeader jose.HeaderKey = "kdf"
+
+const kdfHKDFSHA256 = "hkdf-sha256"
+
+const (
+	argon2Time    = 1
+	argon2Memory  = 64 * 1024
+	argon2Threads = 4
+	argon2KeyLen  = 32
+	argon2SaltLen = 16
+)
+
+var ErrUnsupportedSecretHash = errors.New("unsupported secret hash")
+
+// DeriveSigningKey derives the HMAC key of tokens created with AccessToken.SetDerivedKey from the API secret.
+// Verifiers can store the derived key instead of the secret, see HashedKeyProvider.
+func DeriveSigningKey(apiKey, secret string) []byte {
+	key := make([]byte, sha256.Size)
+	r := hkdf.New(sha256.New, []byte(secret), []byte(apiKey), []byte("livekit token signing"))
+	if _, err := io.ReadFull(r, key); err != nil {
+		panic(err) // only fails when reading more than 255 blocks
+	}
+	return key
+}
+
+// HashedSecret holds what is needed to authenticate an API secret and to verify the tokens signed with it,
+// without the secret itself. SigningKey can sign tokens and is as sensitive as the secret.
+type HashedSecret struct {
+	// argon2id hash of the secret in the PHC string format, or a bcrypt hash
+	Hash string `yaml:"hash"`
+	// key derived from the secret with DeriveSigningKey
+	SigningKey []byte `yaml:"signing_key"`
+}
+
+// HashSecret hashes the API secret with argon2id and derives its signing key.
+func HashSecret(apiKey, secret string) (HashedSecret, error) {
+	salt := make([]byte, argon2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return HashedSecret{}, err
+	}
+	hash := argon2.IDKey([]byte(secret), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+	return HashedSecret{
+		Hash: fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+			argon2.Version, argon2Memory, argon2Time, argon2Threads,
+			base64.RawStdEncoding.EncodeToString(salt),
+			base64.RawStdEncoding.EncodeToString(hash),
+		),
+		SigningKey: DeriveSigningKey(apiKey, secret),
+	}, nil
+}
+
+// Compare returns true if the hash matches the secret.
+func (h HashedSecret) Compare(secret string) (bool, error) {
+	switch {
+	case strings.HasPrefix(h.Hash, "$argon2id$"):
+		return compareArgon2(h.Hash, secret)
+	case strings.HasPrefix(h.Hash, "$2a$"), strings.HasPrefix(h.Hash, "$2b$"), strings.HasPrefix(h.Hash, "$2y$"):
+		err := bcrypt.CompareHashAndPassword([]byte(h.Hash), []byte(secret))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return err == nil, err
+	default:
+		return false, ErrUnsupportedSecretHash
+	}
+}
+
+func compareArgon2(encoded, secret string) (bool, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return false, ErrUnsupportedSecretHash
+	}
+	var version int
+	var memory, time uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, ErrUnsupportedSecretHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return false, ErrUnsupportedSecretHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, ErrUnsupportedSecretHash
+	}
+	hash, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return false, ErrUnsupportedSecretHash
+	}
+	computed := argon2.IDKey([]byte(secret), salt, time, memory, threads, uint32(len(hash)))
+	return subtle.ConstantTimeCompare(hash, computed) == 1, nil
+}
+
+// HashedKeyProvider verifies tokens signed with derived keys, without storing the API secrets.
+// A leak of the stored keys does not expose the secrets, but the derived signing keys are enough to
+// mint tokens accepted by this provider and by verifiers opting in with WithDerivedKeys,
+// so they must be protected like the secrets themselves.
+// It does not sign tokens, GetSecret always returns an empty string.
+type HashedKeyProvider struct {
+	keys map[string]HashedSecret
+}
+
+var _ KeyProvider = (*HashedKeyProvider)(nil)
+var _ VerificationKeyProvider = (*HashedKeyProvider)(nil)
+
+func NewHashedKeyProvider(keys map[string]HashedSecret) *HashedKeyProvider {
+	return &HashedKeyProvider{
+		keys: keys,
+	}
+}
+
+func (p *HashedKeyProvider) GetSecret(apiKey string) string {
+	return ""
+}
+
+func (p *HashedKeyProvider) NumKeys() int {
+	return len(p.keys)
+}
+
+// GetVerificationKeys returns the derived signing key of the API key.
+func (p *HashedKeyProvider) GetVerificationKeys(apiKey, kid string) []any {
+	h, ok := p.keys[apiKey]
+	if !ok || len(h.SigningKey) == 0 {
+		return nil
+	}
+	return []any{h.SigningKey}
+}
+
+// CompareSecret returns true if the secret is the one of the API key, e.g. to authenticate clients presenting it.
+func (p *HashedKeyProvider) CompareSecret(apiKey, secret string) bool {
+	h, ok := p.keys[apiKey]
+	if !ok {
+		return false
+	}
+	match, err := h.Compare(secret)
+	return err == nil && match
+}
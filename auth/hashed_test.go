@@ -0,0 +1,82 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestHashedKeyProvider(t *testing.T) {
+	apiKey, secret := apiKeypair()
+	hashed, err := HashSecret(apiKey, secret)
+	require.NoError(t, err)
+	require.NotContains(t, hashed.Hash, secret)
+	provider := NewHashedKeyProvider(map[string]HashedSecret{apiKey: hashed})
+
+	t.Run("compares secrets", func(t *testing.T) {
+		require.True(t, provider.CompareSecret(apiKey, secret))
+		require.False(t, provider.CompareSecret(apiKey, "wrong"))
+		require.False(t, provider.CompareSecret("other", secret))
+
+		bcryptHash, err := bcrypt.GenerateFromPassword([]byte(secret), bcrypt.MinCost)
+		require.NoError(t, err)
+		match, err := HashedSecret{Hash: string(bcryptHash)}.Compare(secret)
+		require.NoError(t, err)
+		require.True(t, match)
+
+		_, err = HashedSecret{Hash: "plain"}.Compare(secret)
+		require.ErrorIs(t, err, ErrUnsupportedSecretHash)
+	})
+
+	t.Run("verifies tokens signed with derived keys", func(t *testing.T) {
+		for _, encrypted := range []bool{false, true} {
+			token, err := NewAccessToken(apiKey, secret).
+				SetIdentity("me").
+				SetDerivedKey(true).
+				SetEncrypted(encrypted).
+				ToJWT()
+			require.NoError(t, err)
+
+			v, err := ParseAPIToken(token)
+			require.NoError(t, err)
+			grants, err := v.VerifyWithProvider(provider)
+			require.NoError(t, err)
+			require.Equal(t, "me", grants.Identity)
+
+			// providers holding the secret derive the key when derived keys are accepted
+			v, err = ParseAPIToken(token)
+			require.NoError(t, err)
+			_, err = v.VerifyWithProvider(NewSimpleKeyProvider(apiKey, secret))
+			require.ErrorIs(t, err, ErrDerivedKeyNotAccepted)
+
+			v, err = ParseAPIToken(token)
+			require.NoError(t, err)
+			_, err = v.WithDerivedKeys().VerifyWithProvider(NewSimpleKeyProvider(apiKey, secret))
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("rejects tokens signed with the secret", func(t *testing.T) {
+		token, err := NewAccessToken(apiKey, secret).SetIdentity("me").ToJWT()
+		require.NoError(t, err)
+		v, err := ParseAPIToken(token)
+		require.NoError(t, err)
+		_, err = v.VerifyWithProvider(provider)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+var (
+	ErrIdentityEmpty         = errors.New("identity is empty")
+	ErrIdentityTooLong       = errors.New("identity is too long")
+	ErrIdentityInvalidChar   = errors.New("identity contains an invalid character")
+	ErrIdentityReserved      = errors.New("identity uses a reserved prefix")
+	ErrIdentityNotNormalized = errors.New("identity is not normalized")
+)
+
+// IdentityError is returned when an identity is rejected by an IdentityPolicy.
+// It wraps one of the ErrIdentity errors.
+type IdentityError struct {
+	Identity string
+	Err      error
+	Detail   string
+}
+
+func (e *IdentityError) Error() string {
+	if e.Detail == "" {
+		return fmt.Sprintf("%s: %q", e.Err, e.Identity)
+	}
+	return fmt.Sprintf("%s: %q (%s)", e.Err, e.Identity, e.Detail)
+}
+
+func (e *IdentityError) Unwrap() error {
+	return e.Err
+}
+
+type IdentityCharset string
+
+const (
+	// any valid utf-8 string
+	IdentityCharsetAny IdentityCharset = ""
+	// letters, marks, numbers, punctuation and symbols, without spaces or control characters
+	IdentityCharsetPrintable IdentityCharset = "printable"
+	// ascii letters and digits
+	IdentityCharsetAlphanumeric IdentityCharset = "alphanumeric"
+)
+
+type IdentityCaseFolding string
+
+const (
+	IdentityCasePreserve IdentityCaseFolding = ""
+	// NFKC normalized and lower cased, so that e.g. fullwidth and composed variants of a letter match
+	IdentityCaseLower IdentityCaseFolding = "lower"
+)
+
+// DefaultReservedIdentityPrefixes are the identity prefixes used by agents and SIP participants.
+var DefaultReservedIdentityPrefixes = map[livekit.ParticipantInfo_Kind][]string{
+	livekit.ParticipantInfo_AGENT: {"agent-"},
+	livekit.ParticipantInfo_SIP:   {"sip_"},
+}
+
+// IdentityPolicy normalizes and validates participant identities, so that e.g. User1 and user1
+// are not treated as different participants by some services and the same one by others.
+// A nil policy accepts any identity.
+type IdentityPolicy struct {
+	// max length in characters, 0 for no limit
+	MaxLength int             `yaml:"max_length,omitempty"`
+	Charset   IdentityCharset `yaml:"charset,omitempty"`
+	// characters allowed in addition to the charset, e.g. "-_.@"
+	ExtraChars  string              `yaml:"extra_chars,omitempty"`
+	CaseFolding IdentityCaseFolding `yaml:"case_folding,omitempty"`
+	// prefixes which can only be used by participants of the kind
+	ReservedPrefixes map[livekit.ParticipantInfo_Kind][]string `yaml:"reserved_prefixes,omitempty"`
+}
+
+// Normalize applies case folding to the identity and validates it.
+func (p *IdentityPolicy) Normalize(identity string, kind livekit.ParticipantInfo_Kind) (string, error) {
+	if p == nil {
+		return identity, nil
+	}
+	identity = p.fold(identity)
+	if err := p.validate(identity, kind); err != nil {
+		return "", err
+	}
+	return identity, nil
+}
+
+// Validate checks that the identity is valid and already normalized, e.g. when a participant joins.
+func (p *IdentityPolicy) Validate(identity string, kind livekit.ParticipantInfo_Kind) error {
+	if p == nil {
+		return nil
+	}
+	if err := p.validate(identity, kind); err != nil {
+		return err
+	}
+	if p.fold(identity) != identity {
+		return &IdentityError{Identity: identity, Err: ErrIdentityNotNormalized}
+	}
+	return nil
+}
+
+func (p *IdentityPolicy) fold(identity string) string {
+	switch p.CaseFolding {
+	case IdentityCaseLower:
+		return strings.ToLower(norm.NFKC.String(identity))
+	default:
+		return identity
+	}
+}
+
+func (p *IdentityPolicy) validate(identity string, kind livekit.ParticipantInfo_Kind) error {
+	if identity == "" {
+		return &IdentityError{Identity: identity, Err: ErrIdentityEmpty}
+	}
+	if !utf8.ValidString(identity) {
+		return &IdentityError{Identity: identity, Err: ErrIdentityInvalidChar, Detail: "invalid utf-8"}
+	}
+	if n := utf8.RuneCountInString(identity); p.MaxLength > 0 && n > p.MaxLength {
+		return &IdentityError{Identity: identity, Err: ErrIdentityTooLong, Detail: fmt.Sprintf("%d > %d", n, p.MaxLength)}
+	}
+	for _, r := range identity {
+		if !p.allowed(r) {
+			return &IdentityError{Identity: identity, Err: ErrIdentityInvalidChar, Detail: fmt.Sprintf("%q", r)}
+		}
+	}
+	for k, prefixes := range p.ReservedPrefixes {
+		if k == kind {
+			continue
+		}
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(p.fold(identity), p.fold(prefix)) {
+				return &IdentityError{Identity: identity, Err: ErrIdentityReserved, Detail: fmt.Sprintf("%s is reserved for %s", prefix, k)}
+			}
+		}
+	}
+	return nil
+}
+
+func (p *IdentityPolicy) allowed(r rune) bool {
+	if strings.ContainsRune(p.ExtraChars, r) {
+		return true
+	}
+	switch p.Charset {
+	case IdentityCharsetPrintable:
+		return unicode.IsGraphic(r) && !unicode.IsSpace(r)
+	case IdentityCharsetAlphanumeric:
+		return r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r))
+	default:
+		return true
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+func TestIdentityPolicy(t *testing.T) {
+	policy := &IdentityPolicy{
+		MaxLength:        10,
+		Charset:          IdentityCharsetAlphanumeric,
+		ExtraChars:       "-_",
+		CaseFolding:      IdentityCaseLower,
+		ReservedPrefixes: DefaultReservedIdentityPrefixes,
+	}
+
+	t.Run("normalize", func(t *testing.T) {
+		cases := []struct {
+			identity string
+			kind     livekit.ParticipantInfo_Kind
+			expected string
+			err      error
+		}{
+			{identity: "User1", expected: "user1"},
+			{identity: "Ｕser1", expected: "user1"},
+			{identity: "agent-1", kind: livekit.ParticipantInfo_AGENT, expected: "agent-1"},
+			{identity: "Agent-1", err: ErrIdentityReserved},
+			{identity: "sip_123", err: ErrIdentityReserved},
+			{identity: "sip_123", kind: livekit.ParticipantInfo_SIP, expected: "sip_123"},
+			{identity: "", err: ErrIdentityEmpty},
+			{identity: "abcdefghijk", err: ErrIdentityTooLong},
+			{identity: "a b", err: ErrIdentityInvalidChar},
+			{identity: "é", err: ErrIdentityInvalidChar},
+		}
+		for _, c := range cases {
+			identity, err := policy.Normalize(c.identity, c.kind)
+			if c.err != nil {
+				require.ErrorIs(t, err, c.err, c.identity)
+				var ierr *IdentityError
+				require.ErrorAs(t, err, &ierr)
+				continue
+			}
+			require.NoError(t, err, c.identity)
+			require.Equal(t, c.expected, identity)
+		}
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		require.NoError(t, policy.Validate("user1", livekit.ParticipantInfo_STANDARD))
+		require.ErrorIs(t, policy.Validate("User1", livekit.ParticipantInfo_STANDARD), ErrIdentityNotNormalized)
+
+		// composed and decomposed forms are the same identity
+		printable := &IdentityPolicy{Charset: IdentityCharsetPrintable, CaseFolding: IdentityCaseLower}
+		identity, err := printable.Normalize("E\u0301", livekit.ParticipantInfo_STANDARD)
+		require.NoError(t, err)
+		require.Equal(t, "\u00e9", identity)
+		require.ErrorIs(t, printable.Validate("e\u0301", livekit.ParticipantInfo_STANDARD), ErrIdentityNotNormalized)
+
+		var nilPolicy *IdentityPolicy
+		require.NoError(t, nilPolicy.Validate("User 1", livekit.ParticipantInfo_STANDARD))
+	})
+
+	t.Run("access token", func(t *testing.T) {
+		at := NewAccessToken("key", "secret").
+			SetIdentity("User1").
+			SetIdentityPolicy(policy)
+		token, err := at.ToJWT()
+		require.NoError(t, err)
+		// the identity is normalized in the token only
+		require.Equal(t, "User1", at.GetGrants().Identity)
+
+		v, err := ParseAPIToken(token)
+		require.NoError(t, err)
+		require.Equal(t, "user1", v.Identity())
+		grants, err := v.Verify("secret")
+		require.NoError(t, err)
+		require.NoError(t, grants.ValidateIdentity(policy))
+
+		_, err = NewAccessToken("key", "secret").
+			SetIdentity("agent-1").
+			SetIdentityPolicy(policy).
+			ToJWT()
+		require.ErrorIs(t, err, ErrIdentityReserved)
+	})
+
+	t.Run("verifier", func(t *testing.T) {
+		verify := func(identity string, kind livekit.ParticipantInfo_Kind) error {
+			token, err := NewAccessToken("key", "secret").SetIdentity(identity).SetKind(kind).ToJWT()
+			require.NoError(t, err)
+			v, err := ParseAPIToken(token)
+			require.NoError(t, err)
+			_, err = v.WithIdentityPolicy(policy).Verify("secret")
+			return err
+		}
+
+		require.NoError(t, verify("user1", livekit.ParticipantInfo_STANDARD))
+		require.NoError(t, verify("agent-xyz", livekit.ParticipantInfo_AGENT))
+		require.ErrorIs(t, verify("agent-xyz", livekit.ParticipantInfo_STANDARD), ErrIdentityReserved)
+		require.ErrorIs(t, verify("sip_123", livekit.ParticipantInfo_STANDARD), ErrIdentityReserved)
+		require.ErrorIs(t, verify("User1", livekit.ParticipantInfo_STANDARD), ErrIdentityNotNormalized)
+
+		// the jti is not validated in place of a missing subject
+		token, err := NewAccessToken("key", "secret").SetID("agent-1").SetVideoGrant(&VideoGrant{RoomCreate: true}).ToJWT()
+		require.NoError(t, err)
+		v, err := ParseAPIToken(token)
+		require.NoError(t, err)
+		require.Equal(t, "agent-1", v.Identity())
+		_, err = v.WithIdentityPolicy(policy).Verify("secret")
+		require.ErrorIs(t, err, ErrIdentityEmpty)
+
+		v, err = ParseAPIToken(token)
+		require.NoError(t, err)
+		_, err = v.Verify("secret")
+		require.NoError(t, err)
+	})
+}
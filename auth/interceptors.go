@@ -0,0 +1,163 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/livekit/psrpc"
+	psrpcmd "github.com/livekit/psrpc/pkg/metadata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const metadataAuthorization = "authorization"
+
+var (
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// GrantCheck reports whether the grants allow calling a method.
+type GrantCheck func(grants *ClaimGrants) bool
+
+// RequireVideoGrant checks the video grant of the token, e.g. RequireVideoGrant(func(v *VideoGrant) bool { return v.RoomCreate }).
+func RequireVideoGrant(check func(v *VideoGrant) bool) GrantCheck {
+	return func(grants *ClaimGrants) bool {
+		return grants.Video != nil && check(grants.Video)
+	}
+}
+
+type InterceptorOptions struct {
+	// grants required by method. gRPC methods are keyed by full method name, e.g. "/livekit.RoomService/CreateRoom",
+	// psrpc methods by service and method name, e.g. "RoomManager/CreateRoom".
+	// Methods without an entry only require a valid token.
+	Methods map[string]GrantCheck
+	// methods which can be called without a token
+	Public map[string]bool
+	VerifierOptions
+}
+
+// AppendTokenToOutgoingContext attaches the token to gRPC and psrpc requests made with the context.
+func AppendTokenToOutgoingContext(ctx context.Context, token string) context.Context {
+	ctx = grpcmd.AppendToOutgoingContext(ctx, metadataAuthorization, bearerPrefix+token)
+	return psrpcmd.AppendMetadataToOutgoingContext(ctx, metadataAuthorization, bearerPrefix+token)
+}
+
+func bearerToken(v string) string {
+	if len(v) > len(bearerPrefix) && strings.EqualFold(v[:len(bearerPrefix)], bearerPrefix) {
+		return strings.TrimSpace(v[len(bearerPrefix):])
+	}
+	return ""
+}
+
+// authorize verifies the token and checks the grants of the method, returning a context carrying the grants
+func authorize(ctx context.Context, provider KeyProvider, opts *InterceptorOptions, method, token string) (context.Context, error) {
+	if token == "" {
+		if opts.Public[method] {
+			return ctx, nil
+		}
+		return nil, ErrMissingToken
+	}
+
+	v, err := ParseAPIToken(token)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	opts.apply(v)
+	grants, err := v.VerifyWithProviderContext(ctx, provider)
+	if err != nil {
+		if errors.Is(err, ErrTokenRevoked) {
+			return nil, err
+		}
+		return nil, ErrInvalidToken
+	}
+	if check, ok := opts.Methods[method]; ok && !check(grants) {
+		return nil, ErrPermissionDenied
+	}
+	return WithGrants(ctx, v.APIKey(), grants), nil
+}
+
+func grpcToken(ctx context.Context) string {
+	md, _ := grpcmd.FromIncomingContext(ctx)
+	for _, v := range md.Get(metadataAuthorization) {
+		if token := bearerToken(v); token != "" {
+			return token
+		}
+	}
+	return ""
+}
+
+func grpcError(err error) error {
+	if errors.Is(err, ErrPermissionDenied) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Unauthenticated, err.Error())
+}
+
+// UnaryServerInterceptor authenticates gRPC calls, see GetGrants.
+func UnaryServerInterceptor(provider KeyProvider, opts InterceptorOptions) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authorize(ctx, provider, &opts, info.FullMethod, grpcToken(ctx))
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+type grantsServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *grantsServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamServerInterceptor authenticates gRPC streams, see GetGrants.
+func StreamServerInterceptor(provider KeyProvider, opts InterceptorOptions) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), provider, &opts, info.FullMethod, grpcToken(ss.Context()))
+		if err != nil {
+			return grpcError(err)
+		}
+		return handler(srv, &grantsServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// PSRPCServerInterceptor authenticates psrpc calls, see GetGrants.
+// psrpc stream interceptors do not have access to the request metadata, streams are authenticated by their handlers.
+func PSRPCServerInterceptor(provider KeyProvider, opts InterceptorOptions) psrpc.ServerRPCInterceptor {
+	return func(ctx context.Context, req proto.Message, info psrpc.RPCInfo, handler psrpc.ServerRPCHandler) (proto.Message, error) {
+		var token string
+		if head := psrpcmd.IncomingHeader(ctx); head != nil {
+			token = bearerToken(head.Metadata[metadataAuthorization])
+		}
+		ctx, err := authorize(ctx, provider, &opts, info.Service+"/"+info.Method, token)
+		if err != nil {
+			code := psrpc.Unauthenticated
+			if errors.Is(err, ErrPermissionDenied) {
+				code = psrpc.PermissionDenied
+			}
+			return nil, psrpc.NewError(code, err)
+		}
+		return handler(ctx, req)
+	}
+}
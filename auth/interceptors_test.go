@@ -0,0 +1,141 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/livekit/psrpc"
+	psrpcmd "github.com/livekit/psrpc/pkg/metadata"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestInterceptors(t *testing.T) {
+	provider := NewSimpleKeyProvider("key", "secret")
+	opts := InterceptorOptions{
+		Methods: map[string]GrantCheck{
+			"/livekit.RoomService/CreateRoom": RequireVideoGrant(func(v *VideoGrant) bool { return v.RoomCreate }),
+			"RoomManager/CreateRoom":          RequireVideoGrant(func(v *VideoGrant) bool { return v.RoomCreate }),
+		},
+		Public: map[string]bool{"/livekit.RoomService/Ping": true},
+	}
+	token := func(grant *VideoGrant) string {
+		token, err := NewAccessToken("key", "secret").SetIdentity("me").SetVideoGrant(grant).ToJWT()
+		require.NoError(t, err)
+		return token
+	}
+	creator := token(&VideoGrant{RoomCreate: true})
+	joiner := token(&VideoGrant{RoomJoin: true})
+
+	t.Run("grpc unary", func(t *testing.T) {
+		interceptor := UnaryServerInterceptor(provider, opts)
+		call := func(ctx context.Context, method string) (*ClaimGrants, error) {
+			// outgoing metadata of the client becomes incoming metadata of the server
+			md, _ := grpcmd.FromOutgoingContext(ctx)
+			ctx = grpcmd.NewIncomingContext(context.Background(), md)
+			res, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+				return GetGrants(ctx), nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			return res.(*ClaimGrants), nil
+		}
+
+		grants, err := call(AppendTokenToOutgoingContext(context.Background(), creator), "/livekit.RoomService/CreateRoom")
+		require.NoError(t, err)
+		require.Equal(t, "me", grants.Identity)
+
+		_, err = call(AppendTokenToOutgoingContext(context.Background(), joiner), "/livekit.RoomService/CreateRoom")
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = call(AppendTokenToOutgoingContext(context.Background(), joiner), "/livekit.RoomService/ListRooms")
+		require.NoError(t, err)
+
+		_, err = call(context.Background(), "/livekit.RoomService/ListRooms")
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		grants, err = call(context.Background(), "/livekit.RoomService/Ping")
+		require.NoError(t, err)
+		require.Nil(t, grants)
+	})
+
+	t.Run("grpc stream", func(t *testing.T) {
+		interceptor := StreamServerInterceptor(provider, opts)
+		ctx := grpcmd.NewIncomingContext(context.Background(), grpcmd.Pairs("authorization", "Bearer "+joiner))
+		var grants *ClaimGrants
+		err := interceptor(nil, &testServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/livekit.Signal/Connect"}, func(srv any, ss grpc.ServerStream) error {
+			grants = GetGrants(ss.Context())
+			return nil
+		})
+		require.NoError(t, err)
+		require.True(t, grants.Video.RoomJoin)
+	})
+
+	t.Run("psrpc", func(t *testing.T) {
+		interceptor := PSRPCServerInterceptor(provider, opts)
+		call := func(token string) error {
+			ctx := AppendTokenToOutgoingContext(context.Background(), token)
+			ctx = psrpcmd.NewContextWithIncomingHeader(context.Background(), &psrpcmd.Header{
+				Metadata: psrpcmd.OutgoingContextMetadata(ctx),
+			})
+			_, err := interceptor(ctx, &livekit.CreateRoomRequest{}, psrpc.RPCInfo{Service: "RoomManager", Method: "CreateRoom"},
+				func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					require.NotNil(t, GetGrants(ctx))
+					return &livekit.Room{}, nil
+				})
+			return err
+		}
+
+		require.NoError(t, call(creator))
+		var perr psrpc.Error
+		require.ErrorAs(t, call(joiner), &perr)
+		require.Equal(t, psrpc.PermissionDenied, perr.Code())
+		require.ErrorAs(t, call("invalid"), &perr)
+		require.Equal(t, psrpc.Unauthenticated, perr.Code())
+	})
+
+	t.Run("strict leeway", func(t *testing.T) {
+		// issued by a server with a clock a second ahead
+		at := NewAccessToken("key", "secret").SetIdentity("me")
+		early, err := at.sign(at.GetGrants(), time.Now().Add(time.Second))
+		require.NoError(t, err)
+
+		_, err = authorize(context.Background(), provider, &InterceptorOptions{}, "RoomManager/ListRooms", early)
+		require.NoError(t, err)
+
+		var strict time.Duration
+		_, err = authorize(context.Background(), provider, &InterceptorOptions{VerifierOptions: VerifierOptions{Leeway: &strict}}, "RoomManager/ListRooms", early)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
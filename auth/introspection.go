@@ -0,0 +1,94 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+const introspectionTokenParam = "token"
+
+type IntrospectionOptions struct {
+	VerifierOptions
+}
+
+// IntrospectionResponse follows RFC 7662, with the grants of the token as an extension.
+// Only Active is set for tokens failing verification.
+type IntrospectionResponse struct {
+	Active    bool         `json:"active"`
+	ClientID  string       `json:"client_id,omitempty"`
+	Subject   string       `json:"sub,omitempty"`
+	Issuer    string       `json:"iss,omitempty"`
+	Audience  []string     `json:"aud,omitempty"`
+	ID        string       `json:"jti,omitempty"`
+	IssuedAt  int64        `json:"iat,omitempty"`
+	ExpiresAt int64        `json:"exp,omitempty"`
+	Grants    *ClaimGrants `json:"grants,omitempty"`
+}
+
+// Introspect verifies the token with the key provider and describes it.
+// Invalid tokens are reported inactive rather than failing, as they are for the introspection endpoint.
+func Introspect(ctx context.Context, token string, provider KeyProvider, opts IntrospectionOptions) *IntrospectionResponse {
+	v, err := ParseAPIToken(token)
+	if err != nil {
+		return &IntrospectionResponse{}
+	}
+	opts.apply(v)
+	grants, err := v.VerifyWithProviderContext(ctx, provider)
+	if err != nil {
+		return &IntrospectionResponse{}
+	}
+
+	res := &IntrospectionResponse{
+		Active:   true,
+		ClientID: v.APIKey(),
+		Subject:  v.info.Identity,
+		Issuer:   v.Issuer(),
+		Audience: v.Audience(),
+		ID:       v.info.ID,
+		Grants:   grants,
+	}
+	if !v.info.IssuedAt.IsZero() {
+		res.IssuedAt = v.info.IssuedAt.Unix()
+	}
+	if !v.info.ExpiresAt.IsZero() {
+		res.ExpiresAt = v.info.ExpiresAt.Unix()
+	}
+	return res
+}
+
+// IntrospectionHandler returns an RFC 7662 token introspection endpoint, reading the token
+// from the form encoded body of POST requests.
+// The endpoint reveals the grants of any token it is given, callers should be authenticated, e.g. with Middleware.
+func IntrospectionHandler(provider KeyProvider, opts IntrospectionOptions) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		token := r.PostFormValue(introspectionTokenParam)
+		if token == "" {
+			http.Error(w, ErrMissingToken.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		_ = json.NewEncoder(w).Encode(Introspect(r.Context(), token, provider, opts))
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntrospectionHandler(t *testing.T) {
+	provider := NewSimpleKeyProvider("key", "secret")
+	token, err := NewAccessToken("key", "secret").
+		SetIdentity("me").
+		SetID("t1").
+		SetValidFor(time.Hour).
+		SetVideoGrant(&VideoGrant{RoomJoin: true, Room: "room"}).
+		ToJWT()
+	require.NoError(t, err)
+
+	revoked := NewMemoryRevocationList()
+	handler := IntrospectionHandler(provider, IntrospectionOptions{VerifierOptions: VerifierOptions{RevocationChecker: revoked}})
+
+	introspect := func(token string) (int, *IntrospectionResponse) {
+		form := url.Values{"token": {token}}
+		r := httptest.NewRequest("POST", "/introspect", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			return w.Code, nil
+		}
+		require.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+		var res IntrospectionResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		return w.Code, &res
+	}
+
+	code, res := introspect(token)
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, res.Active)
+	require.Equal(t, "key", res.ClientID)
+	require.Equal(t, "me", res.Subject)
+	require.Equal(t, "t1", res.ID)
+	require.InDelta(t, time.Now().Add(time.Hour).Unix(), res.ExpiresAt, 5)
+	require.Equal(t, "room", res.Grants.Video.Room)
+
+	_, res = introspect(token + "x")
+	require.Equal(t, &IntrospectionResponse{}, res)
+
+	require.NoError(t, revoked.RevokeToken(context.Background(), "t1", time.Hour))
+	_, res = introspect(token)
+	require.False(t, res.Active)
+
+	code, _ = introspect("")
+	require.Equal(t, http.StatusBadRequest, code)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/introspect?token="+token, nil))
+	require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	// issued by a server with a clock a second ahead
+	at := NewAccessToken("key", "secret").SetIdentity("me")
+	early, err := at.sign(at.GetGrants(), time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, Introspect(context.Background(), early, provider, IntrospectionOptions{}).Active)
+	var strict time.Duration
+	require.False(t, Introspect(context.Background(), early, provider, IntrospectionOptions{VerifierOptions: VerifierOptions{Leeway: &strict}}).Active)
+}
@@ -0,0 +1,214 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/frostbyte73/core"
+	"github.com/go-jose/go-jose/v3"
+
+	"github.com/livekit/protocol/logger"
+)
+
+const (
+	DefaultJWKSRefreshInterval    = time.Hour
+	DefaultJWKSMinRefreshInterval = time.Minute
+	jwksTimeout                   = 10 * time.Second
+)
+
+var (
+	ErrJWKSFetch = errors.New("could not fetch jwks")
+)
+
+// VerificationKeyProvider is implemented by key providers holding keys other than API secrets,
+// e.g. the public keys of an external identity provider.
+type VerificationKeyProvider interface {
+	// GetVerificationKeys returns the keys a token issued by apiKey with the kid header may be signed with.
+	GetVerificationKeys(apiKey, kid string) []any
+}
+
+type JWKSConfig struct {
+	URL string `yaml:"url"`
+	// when set, only tokens with this issuer are verified with the keys
+	Issuer string `yaml:"issuer,omitempty"`
+	// interval of the background refresh, defaults to DefaultJWKSRefreshInterval
+	RefreshInterval time.Duration `yaml:"refresh_interval,omitempty"`
+	// unknown key ids trigger a refresh at most once per interval, defaults to DefaultJWKSMinRefreshInterval
+	MinRefreshInterval time.Duration `yaml:"min_refresh_interval,omitempty"`
+}
+
+// JWKSKeyProvider verifies tokens with the keys published at a JWKS URL.
+// Keys are refreshed in the background, and when a token references a key id which is not cached yet.
+type JWKSKeyProvider struct {
+	config JWKSConfig
+	client *http.Client
+
+	refreshMu   sync.Mutex
+	lastRefresh time.Time
+
+	mu   sync.RWMutex
+	keys jose.JSONWebKeySet
+
+	closed core.Fuse
+}
+
+var _ KeyProvider = (*JWKSKeyProvider)(nil)
+var _ VerificationKeyProvider = (*JWKSKeyProvider)(nil)
+
+// NewJWKSKeyProvider fetches the key set and starts the background refresh. Close stops it.
+func NewJWKSKeyProvider(ctx context.Context, config JWKSConfig) (*JWKSKeyProvider, error) {
+	if config.RefreshInterval <= 0 {
+		config.RefreshInterval = DefaultJWKSRefreshInterval
+	}
+	if config.MinRefreshInterval <= 0 {
+		config.MinRefreshInterval = DefaultJWKSMinRefreshInterval
+	}
+	p := &JWKSKeyProvider{
+		config: config,
+		client: &http.Client{Timeout: jwksTimeout},
+	}
+	if err := p.Refresh(ctx); err != nil {
+		return nil, err
+	}
+	go p.run()
+	return p, nil
+}
+
+func (p *JWKSKeyProvider) run() {
+	ticker := time.NewTicker(p.config.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.closed.Watch():
+			return
+		case <-ticker.C:
+			if err := p.Refresh(context.Background()); err != nil {
+				// keep verifying with the cached keys
+				logger.Warnw("failed to refresh jwks", err, "url", p.config.URL)
+			}
+		}
+	}
+}
+
+func (p *JWKSKeyProvider) Close() {
+	p.closed.Break()
+}
+
+// Refresh fetches the key set, replacing the cached keys on success.
+func (p *JWKSKeyProvider) Refresh(ctx context.Context) error {
+	p.refreshMu.Lock()
+	defer p.refreshMu.Unlock()
+	return p.refreshLocked(ctx)
+}
+
+func (p *JWKSKeyProvider) refreshLocked(ctx context.Context) error {
+	p.lastRefresh = time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", p.config.URL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("accept", "application/json")
+	res, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrJWKSFetch, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s", ErrJWKSFetch, res.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrJWKSFetch, err)
+	}
+
+	var keys jose.JSONWebKeySet
+	if err = json.Unmarshal(body, &keys); err != nil {
+		return fmt.Errorf("%w: %w", ErrJWKSFetch, err)
+	}
+
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+	return nil
+}
+
+// refreshForKeyID refreshes the key set when kid is unknown, rate limited by MinRefreshInterval
+func (p *JWKSKeyProvider) refreshForKeyID(kid string) {
+	p.refreshMu.Lock()
+	defer p.refreshMu.Unlock()
+
+	// another caller may have fetched the key while waiting for the lock
+	if len(p.lookup(kid)) != 0 || time.Since(p.lastRefresh) < p.config.MinRefreshInterval {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), jwksTimeout)
+	defer cancel()
+	if err := p.refreshLocked(ctx); err != nil {
+		logger.Warnw("failed to refresh jwks", err, "url", p.config.URL, "kid", kid)
+	}
+}
+
+func (p *JWKSKeyProvider) lookup(kid string) []any {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	var keys []any
+	for _, k := range p.keys.Keys {
+		if kid != "" && k.KeyID != kid {
+			continue
+		}
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		keys = append(keys, k.Key)
+	}
+	return keys
+}
+
+func (p *JWKSKeyProvider) GetVerificationKeys(apiKey, kid string) []any {
+	if p.config.Issuer != "" && apiKey != p.config.Issuer {
+		return nil
+	}
+	keys := p.lookup(kid)
+	if len(keys) == 0 && kid != "" {
+		p.refreshForKeyID(kid)
+		keys = p.lookup(kid)
+	}
+	return keys
+}
+
+// GetSecret returns the first symmetric key for the issuer, JWKS usually only publish public keys.
+func (p *JWKSKeyProvider) GetSecret(apiKey string) string {
+	for _, k := range p.GetVerificationKeys(apiKey, "") {
+		if b, ok := k.([]byte); ok {
+			return string(b)
+		}
+	}
+	return ""
+}
+
+func (p *JWKSKeyProvider) NumKeys() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.keys.Keys)
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWKSKeyProvider(t *testing.T) {
+	var mu sync.Mutex
+	var keys jose.JSONWebKeySet
+	fetches := 0
+	publish := func(kid string, key *ecdsa.PrivateKey) {
+		mu.Lock()
+		defer mu.Unlock()
+		keys.Keys = append(keys.Keys, jose.JSONWebKey{Key: key.Public(), KeyID: kid, Algorithm: "ES256", Use: "sig"})
+	}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		fetches++
+		_ = json.NewEncoder(w).Encode(keys)
+	}))
+	defer s.Close()
+
+	key1, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	key2, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publish("k1", key1)
+
+	p, err := NewJWKSKeyProvider(context.Background(), JWKSConfig{
+		URL:                s.URL,
+		Issuer:             "https://idp.example.com",
+		MinRefreshInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer p.Close()
+	require.Equal(t, 1, p.NumKeys())
+
+	verify := func(issuer, kid string, key *ecdsa.PrivateKey) (*ClaimGrants, error) {
+		token, err := NewAccessTokenWithSigner(issuer, key).
+			SetKeyID(kid).
+			SetIdentity("me").
+			SetVideoGrant(&VideoGrant{RoomJoin: true, Room: "room"}).
+			ToJWT()
+		require.NoError(t, err)
+		v, err := ParseAPIToken(token)
+		require.NoError(t, err)
+		return v.VerifyWithProvider(p)
+	}
+
+	grants, err := verify("https://idp.example.com", "k1", key1)
+	require.NoError(t, err)
+	require.Equal(t, "me", grants.Identity)
+
+	_, err = verify("https://other.example.com", "k1", key1)
+	require.ErrorIs(t, err, ErrKeysMissing)
+
+	_, err = verify("https://idp.example.com", "k1", key2)
+	require.Error(t, err)
+
+	// keys rotated in by the identity provider are fetched when first referenced
+	publish("k2", key2)
+	_, err = verify("https://idp.example.com", "k2", key2)
+	require.NoError(t, err)
+	require.Equal(t, 2, p.NumKeys())
+
+	// unknown key ids do not refresh more often than MinRefreshInterval
+	p.config.MinRefreshInterval = time.Hour
+	mu.Lock()
+	before := fetches
+	mu.Unlock()
+	_, err = verify("https://idp.example.com", "k3", key2)
+	require.ErrorIs(t, err, ErrKeysMissing)
+	_, err = verify("https://idp.example.com", "k3", key2)
+	require.ErrorIs(t, err, ErrKeysMissing)
+	mu.Lock()
+	require.Equal(t, before, fetches)
+	mu.Unlock()
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+	accessTokenParam    = "access_token"
+)
+
+var (
+	ErrMissingToken = errors.New("missing access token")
+	ErrInvalidToken = errors.New("invalid access token")
+)
+
+type MiddlewareOptions struct {
+	// requests without a token are passed through without grants, instead of being rejected
+	AllowAnonymous bool
+	// only accept tokens in the Authorization header
+	DisableQueryToken bool
+	VerifierOptions
+	// writes the response for requests failing authentication, defaults to 401 Unauthorized
+	OnError func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type grantsKey struct{}
+
+type requestGrants struct {
+	apiKey string
+	grants *ClaimGrants
+}
+
+// WithGrants returns a context carrying the grants of a token issued by apiKey.
+func WithGrants(ctx context.Context, apiKey string, grants *ClaimGrants) context.Context {
+	return context.WithValue(ctx, grantsKey{}, requestGrants{apiKey: apiKey, grants: grants})
+}
+
+// GetGrants returns the grants injected by Middleware, or nil for anonymous requests.
+func GetGrants(ctx context.Context) *ClaimGrants {
+	g, _ := ctx.Value(grantsKey{}).(requestGrants)
+	return g.grants
+}
+
+// GetAPIKey returns the API key of the token authenticating the request.
+func GetAPIKey(ctx context.Context) string {
+	g, _ := ctx.Value(grantsKey{}).(requestGrants)
+	return g.apiKey
+}
+
+// TokenFromRequest returns the bearer token of the Authorization header, or the access_token query parameter.
+func TokenFromRequest(r *http.Request, allowQuery bool) string {
+	if h := r.Header.Get(authorizationHeader); h != "" {
+		return bearerToken(h)
+	}
+	if allowQuery {
+		return r.URL.Query().Get(accessTokenParam)
+	}
+	return ""
+}
+
+// Middleware returns a wrapper verifying the access token of requests with the key provider,
+// and injecting its grants in the request context, see GetGrants.
+func Middleware(provider KeyProvider, opts MiddlewareOptions) func(http.Handler) http.Handler {
+	onError := opts.OnError
+	if onError == nil {
+		onError = func(w http.ResponseWriter, _ *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := TokenFromRequest(r, !opts.DisableQueryToken)
+			if token == "" {
+				if opts.AllowAnonymous {
+					next.ServeHTTP(w, r)
+				} else {
+					onError(w, r, ErrMissingToken)
+				}
+				return
+			}
+
+			v, err := ParseAPIToken(token)
+			if err != nil {
+				onError(w, r, ErrInvalidToken)
+				return
+			}
+			opts.apply(v)
+			grants, err := v.VerifyWithProviderContext(r.Context(), provider)
+			if err != nil {
+				// do not leak why verification failed, except for revoked tokens
+				if !errors.Is(err, ErrTokenRevoked) {
+					err = ErrInvalidToken
+				}
+				onError(w, r, err)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(WithGrants(r.Context(), v.APIKey(), grants)))
+		})
+	}
+}
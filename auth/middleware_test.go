@@ -0,0 +1,104 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware(t *testing.T) {
+	provider := NewSimpleKeyProvider("key", "secret")
+	token, err := NewAccessToken("key", "secret").
+		SetIdentity("me").
+		SetID("t1").
+		SetVideoGrant(&VideoGrant{RoomJoin: true, Room: "room"}).
+		ToJWT()
+	require.NoError(t, err)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		grants := GetGrants(r.Context())
+		if grants == nil {
+			_, _ = w.Write([]byte("anonymous"))
+			return
+		}
+		_, _ = w.Write([]byte(GetAPIKey(r.Context()) + "/" + grants.Identity + "/" + grants.Video.Room))
+	})
+
+	serve := func(opts MiddlewareOptions, prepare func(r *http.Request)) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", "/", nil)
+		prepare(r)
+		w := httptest.NewRecorder()
+		Middleware(provider, opts)(handler).ServeHTTP(w, r)
+		return w
+	}
+
+	w := serve(MiddlewareOptions{}, func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+token) })
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "key/me/room", w.Body.String())
+
+	w = serve(MiddlewareOptions{}, func(r *http.Request) { r.URL.RawQuery = "access_token=" + token })
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = serve(MiddlewareOptions{DisableQueryToken: true}, func(r *http.Request) { r.URL.RawQuery = "access_token=" + token })
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Contains(t, w.Body.String(), ErrMissingToken.Error())
+
+	w = serve(MiddlewareOptions{AllowAnonymous: true}, func(r *http.Request) {})
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "anonymous", w.Body.String())
+
+	w = serve(MiddlewareOptions{AllowAnonymous: true}, func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+token+"x") })
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Contains(t, w.Body.String(), ErrInvalidToken.Error())
+
+	revoked := NewMemoryRevocationList()
+	require.NoError(t, revoked.RevokeToken(context.Background(), "t1", time.Hour))
+	w = serve(MiddlewareOptions{
+		VerifierOptions: VerifierOptions{RevocationChecker: revoked},
+		OnError: func(w http.ResponseWriter, r *http.Request, err error) {
+			require.ErrorIs(t, err, ErrTokenRevoked)
+			w.WriteHeader(http.StatusForbidden)
+		},
+	}, func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+token) })
+	require.Equal(t, http.StatusForbidden, w.Code)
+
+	// issued by a server with a clock a second ahead
+	at := NewAccessToken("key", "secret").
+		SetIdentity("me").
+		SetVideoGrant(&VideoGrant{RoomJoin: true, Room: "room"})
+	early, err := at.sign(at.GetGrants(), time.Now().Add(time.Second))
+	require.NoError(t, err)
+	w = serve(MiddlewareOptions{}, func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+early) })
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var strict time.Duration
+	w = serve(MiddlewareOptions{VerifierOptions: VerifierOptions{Leeway: &strict}}, func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+early) })
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = serve(MiddlewareOptions{VerifierOptions: VerifierOptions{Issuer: []string{"key"}}}, func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+token) })
+	require.Equal(t, http.StatusOK, w.Code)
+	w = serve(MiddlewareOptions{VerifierOptions: VerifierOptions{Issuer: []string{"other"}}}, func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+token) })
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+
+	policy := &IdentityPolicy{MaxLength: 1}
+	w = serve(MiddlewareOptions{VerifierOptions: VerifierOptions{IdentityPolicy: policy}}, func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+token) })
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"errors"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+var ErrProjectMismatch = errors.New("resource does not belong to the project of the token")
+
+// CheckProject verifies that a resource can be accessed with the token.
+// Tokens without a project are not scoped, scoped tokens only access resources of their project.
+func (c *ClaimGrants) CheckProject(res livekit.ProjectScoped) error {
+	if c.ProjectID == "" || res.GetProjectId() == c.ProjectID {
+		return nil
+	}
+	return ErrProjectMismatch
+}
+
+// ResolveProject returns the project a request applies to: the project of the token, or the one set on the request
+// when the token is not scoped. A request for another project than the one of the token is rejected.
+func (c *ClaimGrants) ResolveProject(req livekit.ProjectScoped) (string, error) {
+	projectID := req.GetProjectId()
+	if c.ProjectID == "" {
+		return projectID, nil
+	}
+	if projectID != "" && projectID != c.ProjectID {
+		return "", ErrProjectMismatch
+	}
+	return c.ProjectID, nil
+}
@@ -15,6 +15,7 @@
 package auth
 
 import (
+	"context"
 	"io"
 
 	"gopkg.in/yaml.v3"
@@ -73,3 +74,13 @@ func (p *SimpleKeyProvider) GetSecret(key string) string {
 func (p *SimpleKeyProvider) NumKeys() int {
 	return 1
 }
+
+// KeyProviderHealthCheck returns a health check that fails when the provider has no keys.
+func KeyProviderHealthCheck(p KeyProvider) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if p.NumKeys() == 0 {
+			return ErrKeysMissing
+		}
+		return nil
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+)
+
+var (
+	ErrPublishSourceNotAllowed    = errors.New("track source not allowed")
+	ErrPublishTrackNameNotAllowed = errors.New("track name not allowed")
+	ErrPublishResolutionExceeded  = errors.New("track resolution exceeds limit")
+	ErrPublishBitrateExceeded     = errors.New("track bitrate exceeds limit")
+)
+
+// TrackPublishLimits constrain the tracks of a source a participant can publish.
+type TrackPublishLimits struct {
+	// source the limits apply to, e.g. "camera". Limits without a source apply to sources without their own
+	Source string `json:"source,omitempty"`
+	// maximum dimensions of video tracks, 0 is unlimited
+	MaxWidth  uint32 `json:"maxWidth,omitempty"`
+	MaxHeight uint32 `json:"maxHeight,omitempty"`
+	// maximum bitrate in bps, summed over simulcast layers. 0 is unlimited
+	MaxBitrate uint32 `json:"maxBitrate,omitempty"`
+	// names tracks can be published with, a trailing * matches any suffix. Any name is allowed when empty
+	TrackNames []string `json:"trackNames,omitempty"`
+}
+
+func (l TrackPublishLimits) allowsName(name string) bool {
+	if len(l.TrackNames) == 0 {
+		return true
+	}
+	for _, n := range l.TrackNames {
+		if prefix, ok := strings.CutSuffix(n, "*"); ok {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		} else if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (l TrackPublishLimits) MarshalLogObject(e zapcore.ObjectEncoder) error {
+	e.AddString("Source", l.Source)
+	e.AddUint32("MaxWidth", l.MaxWidth)
+	e.AddUint32("MaxHeight", l.MaxHeight)
+	e.AddUint32("MaxBitrate", l.MaxBitrate)
+	return e.AddArray("TrackNames", logger.StringSlice(l.TrackNames))
+}
+
+// GetPublishLimits returns the limits for tracks of the source, or nil if they are unconstrained.
+func (v *VideoGrant) GetPublishLimits(source livekit.TrackSource) *TrackPublishLimits {
+	sourceStr := sourceToString(source)
+	var fallback *TrackPublishLimits
+	for i := range v.PublishLimits {
+		l := &v.PublishLimits[i]
+		switch l.Source {
+		case sourceStr:
+			return l
+		case "":
+			if fallback == nil {
+				fallback = l
+			}
+		}
+	}
+	return fallback
+}
+
+// CheckPublishTrack returns an error if the grant does not allow publishing the track.
+func (v *VideoGrant) CheckPublishTrack(req *livekit.AddTrackRequest) error {
+	if !v.GetCanPublishSource(req.Source) {
+		return fmt.Errorf("%w: %s", ErrPublishSourceNotAllowed, sourceToString(req.Source))
+	}
+	l := v.GetPublishLimits(req.Source)
+	if l == nil {
+		return nil
+	}
+	if !l.allowsName(req.Name) {
+		return fmt.Errorf("%w: %q", ErrPublishTrackNameNotAllowed, req.Name)
+	}
+
+	width, height := req.Width, req.Height
+	var bitrate uint32
+	for _, layer := range req.Layers {
+		width = max(width, layer.Width)
+		height = max(height, layer.Height)
+		bitrate += layer.Bitrate
+	}
+	if (l.MaxWidth != 0 && width > l.MaxWidth) || (l.MaxHeight != 0 && height > l.MaxHeight) {
+		return fmt.Errorf("%w: %dx%d > %dx%d", ErrPublishResolutionExceeded, width, height, l.MaxWidth, l.MaxHeight)
+	}
+	if l.MaxBitrate != 0 && bitrate > l.MaxBitrate {
+		return fmt.Errorf("%w: %d > %d", ErrPublishBitrateExceeded, bitrate, l.MaxBitrate)
+	}
+	return nil
+}
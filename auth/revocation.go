@@ -0,0 +1,188 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	ErrTokenRevoked = errors.New("token has been revoked")
+)
+
+// TokenInfo identifies a token for revocation checks.
+type TokenInfo struct {
+	// jti claim, only set for tokens created with SetID
+	ID        string
+	APIKey    string
+	Identity  string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// RevocationChecker is consulted by the verifier after the signature and expiry of a token are validated.
+//
+//counterfeiter:generate . RevocationChecker
+type RevocationChecker interface {
+	IsRevoked(ctx context.Context, token TokenInfo) (bool, error)
+}
+
+// RevocationList denies tokens by id, and all tokens of an identity issued before a point in time.
+// Identities are scoped to the API key issuing the token, tenants sharing a list do not revoke each other.
+// Entries only need to be kept for as long as the revoked tokens are valid.
+type RevocationList interface {
+	RevocationChecker
+	// RevokeToken revokes the token with the jti, for ttl
+	RevokeToken(ctx context.Context, id string, ttl time.Duration) error
+	// RevokeIdentity revokes the tokens of the identity issued by apiKey until now, for ttl
+	RevokeIdentity(ctx context.Context, apiKey, identity string, ttl time.Duration) error
+}
+
+func isRevoked(token TokenInfo, tokenRevoked bool, identityRevokedAt time.Time) bool {
+	if tokenRevoked {
+		return true
+	}
+	// tokens issued in the same second as the revocation are revoked as well, iat has a resolution of seconds
+	return !identityRevokedAt.IsZero() && !token.IssuedAt.After(identityRevokedAt)
+}
+
+// identityScope keys identities by API key, length prefixed so keys and identities cannot collide
+func identityScope(apiKey, identity string) string {
+	return strconv.Itoa(len(apiKey)) + ":" + apiKey + identity
+}
+
+type revocation struct {
+	at      time.Time
+	expires time.Time
+}
+
+// MemoryRevocationList is a RevocationList for single node deployments.
+type MemoryRevocationList struct {
+	mu         sync.Mutex
+	tokens     map[string]revocation
+	identities map[string]revocation
+}
+
+var _ RevocationList = (*MemoryRevocationList)(nil)
+
+func NewMemoryRevocationList() *MemoryRevocationList {
+	return &MemoryRevocationList{
+		tokens:     make(map[string]revocation),
+		identities: make(map[string]revocation),
+	}
+}
+
+func (l *MemoryRevocationList) RevokeToken(_ context.Context, id string, ttl time.Duration) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	l.tokens[id] = revocation{at: now, expires: now.Add(ttl)}
+	return nil
+}
+
+func (l *MemoryRevocationList) RevokeIdentity(_ context.Context, apiKey, identity string, ttl time.Duration) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	l.identities[identityScope(apiKey, identity)] = revocation{at: now.Truncate(time.Second), expires: now.Add(ttl)}
+	return nil
+}
+
+func (l *MemoryRevocationList) IsRevoked(_ context.Context, token TokenInfo) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	l.expire(now)
+
+	_, tokenRevoked := l.tokens[token.ID]
+	return isRevoked(token, token.ID != "" && tokenRevoked, l.identities[identityScope(token.APIKey, token.Identity)].at), nil
+}
+
+func (l *MemoryRevocationList) expire(now time.Time) {
+	for id, r := range l.tokens {
+		if now.After(r.expires) {
+			delete(l.tokens, id)
+		}
+	}
+	for identity, r := range l.identities {
+		if now.After(r.expires) {
+			delete(l.identities, identity)
+		}
+	}
+}
+
+const defaultRevocationPrefix = "revoked:"
+
+// RedisRevocationList is a RevocationList shared by all nodes through redis.
+type RedisRevocationList struct {
+	rc     redis.UniversalClient
+	prefix string
+}
+
+var _ RevocationList = (*RedisRevocationList)(nil)
+
+// NewRedisRevocationList stores revocations in keys starting with prefix, "revoked:" when empty.
+func NewRedisRevocationList(rc redis.UniversalClient, prefix string) *RedisRevocationList {
+	if prefix == "" {
+		prefix = defaultRevocationPrefix
+	}
+	return &RedisRevocationList{rc: rc, prefix: prefix}
+}
+
+func (l *RedisRevocationList) tokenKey(id string) string {
+	return l.prefix + "token:" + id
+}
+
+func (l *RedisRevocationList) identityKey(apiKey, identity string) string {
+	return l.prefix + "identity:" + identityScope(apiKey, identity)
+}
+
+func (l *RedisRevocationList) RevokeToken(ctx context.Context, id string, ttl time.Duration) error {
+	return l.rc.Set(ctx, l.tokenKey(id), 1, ttl).Err()
+}
+
+func (l *RedisRevocationList) RevokeIdentity(ctx context.Context, apiKey, identity string, ttl time.Duration) error {
+	return l.rc.Set(ctx, l.identityKey(apiKey, identity), time.Now().Unix(), ttl).Err()
+}
+
+func (l *RedisRevocationList) IsRevoked(ctx context.Context, token TokenInfo) (bool, error) {
+	// pipelined rather than MGET, the keys may be in different cluster slots
+	var identityCmd, tokenCmd *redis.StringCmd
+	_, err := l.rc.Pipelined(ctx, func(p redis.Pipeliner) error {
+		identityCmd = p.Get(ctx, l.identityKey(token.APIKey, token.Identity))
+		if token.ID != "" {
+			tokenCmd = p.Get(ctx, l.tokenKey(token.ID))
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return false, err
+	}
+
+	var identityRevokedAt time.Time
+	if sec, err := identityCmd.Int64(); err == nil {
+		identityRevokedAt = time.Unix(sec, 0)
+	} else if !errors.Is(err, redis.Nil) {
+		return false, err
+	}
+	tokenRevoked := tokenCmd != nil && tokenCmd.Err() == nil
+	return isRevoked(token, tokenRevoked, identityRevokedAt), nil
+}
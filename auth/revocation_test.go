@@ -0,0 +1,97 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type revocationCheckerFunc func(ctx context.Context, token TokenInfo) (bool, error)
+
+func (f revocationCheckerFunc) IsRevoked(ctx context.Context, token TokenInfo) (bool, error) {
+	return f(ctx, token)
+}
+
+func TestRevocation(t *testing.T) {
+	ctx := context.Background()
+	l := NewMemoryRevocationList()
+
+	verify := func(token string, c RevocationChecker) error {
+		v, err := ParseAPIToken(token)
+		require.NoError(t, err)
+		_, err = v.WithRevocationChecker(c).Verify("secret")
+		return err
+	}
+
+	t.Run("token", func(t *testing.T) {
+		token, err := NewAccessToken("key", "secret").SetIdentity("a").SetID("t1").ToJWT()
+		require.NoError(t, err)
+		other, err := NewAccessToken("key", "secret").SetIdentity("a").SetID("t2").ToJWT()
+		require.NoError(t, err)
+
+		require.NoError(t, verify(token, l))
+		require.NoError(t, l.RevokeToken(ctx, "t1", time.Hour))
+		require.ErrorIs(t, verify(token, l), ErrTokenRevoked)
+		require.NoError(t, verify(other, l))
+	})
+
+	t.Run("identity", func(t *testing.T) {
+		token, err := NewAccessToken("key", "secret").SetIdentity("b").ToJWT()
+		require.NoError(t, err)
+		require.NoError(t, l.RevokeIdentity(ctx, "key", "b", time.Hour))
+		require.ErrorIs(t, verify(token, l), ErrTokenRevoked)
+
+		// tokens issued after the revocation are accepted
+		revoked, err := l.IsRevoked(ctx, TokenInfo{APIKey: "key", Identity: "b", IssuedAt: time.Now().Add(2 * time.Second)})
+		require.NoError(t, err)
+		require.False(t, revoked)
+
+		// the same identity of another API key is not revoked
+		revoked, err = l.IsRevoked(ctx, TokenInfo{APIKey: "other", Identity: "b", IssuedAt: time.Now()})
+		require.NoError(t, err)
+		require.False(t, revoked)
+	})
+
+	t.Run("expired entries", func(t *testing.T) {
+		require.NoError(t, l.RevokeToken(ctx, "t3", -time.Second))
+		revoked, err := l.IsRevoked(ctx, TokenInfo{ID: "t3"})
+		require.NoError(t, err)
+		require.False(t, revoked)
+	})
+
+	t.Run("checker errors", func(t *testing.T) {
+		token, err := NewAccessToken("key", "secret").SetIdentity("c").ToJWT()
+		require.NoError(t, err)
+		var calls []TokenInfo
+		c := revocationCheckerFunc(func(_ context.Context, token TokenInfo) (bool, error) {
+			calls = append(calls, token)
+			return false, errors.New("unavailable")
+		})
+		require.Error(t, verify(token, c))
+
+		// forged tokens are rejected before the checker is consulted
+		v, err := ParseAPIToken(token)
+		require.NoError(t, err)
+		_, err = v.WithRevocationChecker(c).Verify("other")
+		require.Error(t, err)
+		require.Len(t, calls, 1)
+		require.Equal(t, "c", calls[0].Identity)
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"errors"
+	"slices"
+	"sync"
+)
+
+var (
+	ErrKeyNotFound     = errors.New("key not found")
+	ErrRemovingPrimary = errors.New("cannot remove primary key")
+)
+
+// APIKeyPair is an API key and secret. ID is sent in the kid header of tokens signed with the pair,
+// and defaults to the API key.
+type APIKeyPair struct {
+	ID        string `yaml:"id,omitempty"`
+	APIKey    string `yaml:"api_key"`
+	APISecret string `yaml:"api_secret"`
+}
+
+func (k APIKeyPair) id() string {
+	if k.ID != "" {
+		return k.ID
+	}
+	return k.APIKey
+}
+
+// RotatingKeyProvider verifies tokens signed with any of its keys, and signs new tokens with the primary key.
+// To rotate keys without invalidating outstanding tokens, add the new key, make it primary,
+// and remove the old key once the tokens signed with it have expired.
+type RotatingKeyProvider struct {
+	mu      sync.RWMutex
+	keys    []APIKeyPair
+	primary string
+}
+
+var _ KeyProvider = (*RotatingKeyProvider)(nil)
+var _ VerificationKeyProvider = (*RotatingKeyProvider)(nil)
+
+func NewRotatingKeyProvider(primary APIKeyPair, keys ...APIKeyPair) *RotatingKeyProvider {
+	p := &RotatingKeyProvider{primary: primary.id()}
+	p.Add(primary)
+	for _, k := range keys {
+		p.Add(k)
+	}
+	return p
+}
+
+// Add adds a key or replaces the key with the same id.
+func (p *RotatingKeyProvider) Add(key APIKeyPair) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if i := p.index(key.id()); i >= 0 {
+		p.keys[i] = key
+	} else {
+		p.keys = append(p.keys, key)
+	}
+}
+
+// Remove removes a key, tokens signed with it no longer verify.
+func (p *RotatingKeyProvider) Remove(id string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if id == p.primary {
+		return ErrRemovingPrimary
+	}
+	i := p.index(id)
+	if i < 0 {
+		return ErrKeyNotFound
+	}
+	p.keys = slices.Delete(p.keys, i, i+1)
+	return nil
+}
+
+// SetPrimary switches the key used to sign new tokens.
+func (p *RotatingKeyProvider) SetPrimary(id string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.index(id) < 0 {
+		return ErrKeyNotFound
+	}
+	p.primary = id
+	return nil
+}
+
+func (p *RotatingKeyProvider) Primary() APIKeyPair {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.keys[p.index(p.primary)]
+}
+
+// NewAccessToken returns a token signed with the primary key.
+func (p *RotatingKeyProvider) NewAccessToken() *AccessToken {
+	k := p.Primary()
+	return NewAccessToken(k.APIKey, k.APISecret).SetKeyID(k.id())
+}
+
+func (p *RotatingKeyProvider) index(id string) int {
+	return slices.IndexFunc(p.keys, func(k APIKeyPair) bool {
+		return k.id() == id
+	})
+}
+
+// GetSecret returns the secret of the primary key when it has the API key, or of the first key with it.
+func (p *RotatingKeyProvider) GetSecret(apiKey string) string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if k := p.keys[p.index(p.primary)]; k.APIKey == apiKey {
+		return k.APISecret
+	}
+	for _, k := range p.keys {
+		if k.APIKey == apiKey {
+			return k.APISecret
+		}
+	}
+	return ""
+}
+
+// GetVerificationKeys returns the secrets of the keys with the API key, the key with a matching id first.
+// Tokens signed before ids were used do not have a kid header, they are verified against all secrets.
+func (p *RotatingKeyProvider) GetVerificationKeys(apiKey, kid string) []any {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	var keys []any
+	for _, k := range p.keys {
+		if k.APIKey != apiKey {
+			continue
+		}
+		if kid != "" && k.id() == kid {
+			keys = slices.Insert(keys, 0, any(k.APISecret))
+		} else {
+			keys = append(keys, k.APISecret)
+		}
+	}
+	return keys
+}
+
+func (p *RotatingKeyProvider) NumKeys() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.keys)
+}
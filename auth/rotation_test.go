@@ -0,0 +1,70 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingKeyProvider(t *testing.T) {
+	old := APIKeyPair{ID: "old", APIKey: "key", APISecret: "old-secret"}
+	next := APIKeyPair{ID: "next", APIKey: "key", APISecret: "next-secret"}
+	p := NewRotatingKeyProvider(old)
+
+	verify := func(token string) error {
+		v, err := ParseAPIToken(token)
+		require.NoError(t, err)
+		_, err = v.VerifyWithProvider(p)
+		return err
+	}
+	sign := func() string {
+		token, err := p.NewAccessToken().SetIdentity("me").ToJWT()
+		require.NoError(t, err)
+		return token
+	}
+
+	oldToken := sign()
+	// tokens signed before key ids were used
+	legacyToken, err := NewAccessToken("key", "old-secret").SetIdentity("me").ToJWT()
+	require.NoError(t, err)
+	require.NoError(t, verify(oldToken))
+
+	p.Add(next)
+	require.NoError(t, p.SetPrimary("next"))
+	require.Equal(t, next, p.Primary())
+	require.Equal(t, "next-secret", p.GetSecret("key"))
+	require.Equal(t, 2, p.NumKeys())
+
+	// outstanding tokens keep verifying during the switchover
+	nextToken := sign()
+	require.NoError(t, verify(oldToken))
+	require.NoError(t, verify(legacyToken))
+	require.NoError(t, verify(nextToken))
+
+	require.ErrorIs(t, p.Remove("next"), ErrRemovingPrimary)
+	require.NoError(t, p.Remove("old"))
+	require.Error(t, verify(oldToken))
+	require.Error(t, verify(legacyToken))
+	require.NoError(t, verify(nextToken))
+
+	require.ErrorIs(t, p.SetPrimary("old"), ErrKeyNotFound)
+	require.ErrorIs(t, p.Remove("old"), ErrKeyNotFound)
+
+	token, err := NewAccessToken("other", "next-secret").ToJWT()
+	require.NoError(t, err)
+	require.ErrorIs(t, verify(token), ErrKeysMissing)
+}
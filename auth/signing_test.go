@@ -0,0 +1,111 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsymmetricSigning(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	for _, c := range []struct {
+		name string
+		key  crypto.Signer
+		alg  string
+	}{
+		{name: "rsa", key: rsaKey, alg: "RS256"},
+		{name: "ecdsa", key: ecKey, alg: "ES256"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			token, err := NewAccessTokenWithSigner("key", c.key).
+				SetIdentity("me").
+				SetVideoGrant(&VideoGrant{RoomJoin: true, Room: "room"}).
+				ToJWT()
+			require.NoError(t, err)
+
+			v, err := ParseAPIToken(token)
+			require.NoError(t, err)
+			require.Equal(t, c.alg, v.Algorithm())
+
+			// round trip the public key through PEM, as a relying party would receive it
+			der, err := x509.MarshalPKIXPublicKey(c.key.Public())
+			require.NoError(t, err)
+			pub, err := ParsePublicKeyPEM(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+			require.NoError(t, err)
+
+			grants, err := v.Verify(pub)
+			require.NoError(t, err)
+			require.Equal(t, "me", grants.Identity)
+			require.Equal(t, "room", grants.Video.Room)
+
+			_, err = v.Verify(c.key)
+			require.NoError(t, err)
+
+			// neither secrets nor other keys verify the token
+			_, err = v.Verify("secret")
+			require.Error(t, err)
+			other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			require.NoError(t, err)
+			_, err = v.Verify(other.Public())
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("public keys do not verify secret tokens", func(t *testing.T) {
+		token, err := NewAccessToken("key", "secret").ToJWT()
+		require.NoError(t, err)
+		v, err := ParseAPIToken(token)
+		require.NoError(t, err)
+		require.Equal(t, "HS256", v.Algorithm())
+		_, err = v.Verify(rsaKey.Public())
+		require.Error(t, err)
+	})
+
+	t.Run("pem", func(t *testing.T) {
+		der, err := x509.MarshalPKCS8PrivateKey(ecKey)
+		require.NoError(t, err)
+		key, err := ParsePrivateKeyPEM(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+		require.NoError(t, err)
+		require.True(t, ecKey.Equal(key))
+
+		key, err = ParsePrivateKeyPEM(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}))
+		require.NoError(t, err)
+		require.True(t, rsaKey.Equal(key))
+
+		_, edKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		der, err = x509.MarshalPKCS8PrivateKey(edKey)
+		require.NoError(t, err)
+		_, err = ParsePrivateKeyPEM(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+		require.ErrorIs(t, err, ErrUnsupportedKey)
+
+		_, err = ParsePublicKeyPEM([]byte("not pem"))
+		require.ErrorIs(t, err, ErrInvalidPEM)
+	})
+}
@@ -0,0 +1,219 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/utils"
+)
+
+const DefaultTemplateTTL = 6 * time.Hour
+
+var (
+	ErrTemplateNotFound        = errors.New("token template not found")
+	ErrTemplateRoomNotAllowed  = errors.New("room not allowed by token template")
+	ErrTemplateAttributeDenied = errors.New("attribute not overridable by token template")
+)
+
+// TokenTemplateRegistry resolves named token templates, so token issuing endpoints
+// can hand out tokens by template name, with grants controlled server side.
+type TokenTemplateRegistry struct {
+	mu        sync.RWMutex
+	templates map[string]*livekit.TokenTemplate
+}
+
+func NewTokenTemplateRegistry(templates ...*livekit.TokenTemplate) (*TokenTemplateRegistry, error) {
+	r := &TokenTemplateRegistry{
+		templates: make(map[string]*livekit.TokenTemplate),
+	}
+	for _, t := range templates {
+		if err := r.Register(t); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Register adds or replaces a template.
+func (r *TokenTemplateRegistry) Register(t *livekit.TokenTemplate) error {
+	if err := ValidateTokenTemplate(t); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.templates[t.Name] = utils.CloneProto(t)
+	return nil
+}
+
+func (r *TokenTemplateRegistry) Remove(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.templates, name)
+}
+
+// Get returns a copy of the template, changes to it do not affect the registry.
+func (r *TokenTemplateRegistry) Get(name string) (*livekit.TokenTemplate, bool) {
+	t, ok := r.get(name)
+	if !ok {
+		return nil, false
+	}
+	return utils.CloneProto(t), true
+}
+
+// get returns the registered template, which must not be modified
+func (r *TokenTemplateRegistry) get(name string) (*livekit.TokenTemplate, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.templates[name]
+	return t, ok
+}
+
+// NewAccessToken resolves the requested template and returns an access token for it.
+func (r *TokenTemplateRegistry) NewAccessToken(apiKey, apiSecret string, req *livekit.IssueTemplateTokenRequest) (*AccessToken, error) {
+	t, ok := r.get(req.Template)
+	if !ok {
+		return nil, ErrTemplateNotFound
+	}
+	return NewAccessTokenFromTemplate(apiKey, apiSecret, t, req)
+}
+
+// IssueToken resolves the requested template and returns a signed token.
+func (r *TokenTemplateRegistry) IssueToken(apiKey, apiSecret string, req *livekit.IssueTemplateTokenRequest) (*livekit.IssueTemplateTokenResponse, error) {
+	at, err := r.NewAccessToken(apiKey, apiSecret, req)
+	if err != nil {
+		return nil, err
+	}
+	token, err := at.ToJWT()
+	if err != nil {
+		return nil, err
+	}
+	// the expiry of the token as signed, rather than recomputed after signing
+	v, err := ParseAPIToken(token)
+	if err != nil {
+		return nil, err
+	}
+	return &livekit.IssueTemplateTokenResponse{
+		Token:     token,
+		ExpiresAt: v.info.ExpiresAt.Unix(),
+	}, nil
+}
+
+func ValidateTokenTemplate(t *livekit.TokenTemplate) error {
+	if t.GetName() == "" {
+		return errors.New("template name is required")
+	}
+	for _, pattern := range t.AllowedRooms {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid room pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// NewAccessTokenFromTemplate builds an access token for the room and identity of the request,
+// with grants, validity and attribute defaults taken from the template.
+func NewAccessTokenFromTemplate(apiKey, apiSecret string, t *livekit.TokenTemplate, req *livekit.IssueTemplateTokenRequest) (*AccessToken, error) {
+	if req.RoomName == "" {
+		return nil, errors.New("room name is required")
+	}
+	if req.Identity == "" {
+		return nil, errors.New("identity is required")
+	}
+	if !templateAllowsRoom(t, req.RoomName) {
+		return nil, ErrTemplateRoomNotAllowed
+	}
+
+	attrs := make(map[string]string, len(t.Attributes)+len(req.Attributes))
+	for k, v := range t.Attributes {
+		attrs[k] = v
+	}
+	for k, v := range req.Attributes {
+		if !slices.Contains(t.OverridableAttributes, k) {
+			return nil, fmt.Errorf("%w: %s", ErrTemplateAttributeDenied, k)
+		}
+		attrs[k] = v
+	}
+
+	ttl := DefaultTemplateTTL
+	if t.TtlSeconds != 0 {
+		ttl = time.Duration(t.TtlSeconds) * time.Second
+	}
+
+	at := NewAccessToken(apiKey, apiSecret).
+		SetIdentity(req.Identity).
+		SetName(req.Name).
+		SetKind(t.Kind).
+		SetValidFor(ttl).
+		SetVideoGrant(VideoGrantFromTemplate(t.Grants, req.RoomName)).
+		SetMetadata(t.Metadata).
+		SetAttributes(attrs).
+		SetRoomPreset(t.RoomPreset)
+	if t.RoomConfig != nil {
+		at.SetRoomConfig(utils.CloneProto(t.RoomConfig))
+	}
+	return at, nil
+}
+
+// VideoGrantFromTemplate returns a grant to join the room with the permissions of the template.
+func VideoGrantFromTemplate(g *livekit.TokenTemplateGrants, room string) *VideoGrant {
+	grant := &VideoGrant{
+		RoomJoin: true,
+		Room:     room,
+	}
+	if g == nil {
+		return grant
+	}
+	grant.RoomAdmin = g.RoomAdmin
+	grant.Hidden = g.Hidden
+	grant.CanPublish = clonePtr(g.CanPublish)
+	grant.CanSubscribe = clonePtr(g.CanSubscribe)
+	grant.CanPublishData = clonePtr(g.CanPublishData)
+	grant.CanUpdateOwnMetadata = clonePtr(g.CanUpdateOwnMetadata)
+	grant.CanSubscribeMetrics = clonePtr(g.CanSubscribeMetrics)
+	if len(g.CanPublishSources) != 0 {
+		grant.SetCanPublishSources(g.CanPublishSources)
+	}
+	grant.CanPublishDataTopics = slices.Clone(g.CanPublishDataTopics)
+	grant.CanSubscribeDataTopics = slices.Clone(g.CanSubscribeDataTopics)
+	return grant
+}
+
+func templateAllowsRoom(t *livekit.TokenTemplate, room string) bool {
+	if len(t.AllowedRooms) == 0 {
+		return true
+	}
+	for _, pattern := range t.AllowedRooms {
+		if ok, _ := path.Match(pattern, room); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func clonePtr[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	return &c
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+func TestTokenTemplateRegistry(t *testing.T) {
+	apiKey, secret := apiKeypair()
+
+	r, err := NewTokenTemplateRegistry(
+		&livekit.TokenTemplate{
+			Name:         "viewer",
+			TtlSeconds:   60,
+			AllowedRooms: []string{"event-*"},
+			Grants: &livekit.TokenTemplateGrants{
+				CanPublish:           proto.Bool(false),
+				CanPublishData:       proto.Bool(true),
+				CanPublishDataTopics: []string{"chat"},
+			},
+			Attributes:            map[string]string{"role": "viewer", "lang": "en"},
+			OverridableAttributes: []string{"lang"},
+		},
+		&livekit.TokenTemplate{
+			Name: "presenter",
+			Grants: &livekit.TokenTemplateGrants{
+				CanPublishSources: []livekit.TrackSource{livekit.TrackSource_CAMERA, livekit.TrackSource_MICROPHONE},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = NewTokenTemplateRegistry(&livekit.TokenTemplate{Name: "bad", AllowedRooms: []string{"["}})
+	require.Error(t, err)
+
+	t.Run("get returns a copy", func(t *testing.T) {
+		tmpl, ok := r.Get("viewer")
+		require.True(t, ok)
+		tmpl.AllowedRooms = nil
+		tmpl.Attributes["role"] = "admin"
+
+		tmpl, ok = r.Get("viewer")
+		require.True(t, ok)
+		require.Equal(t, []string{"event-*"}, tmpl.AllowedRooms)
+		require.Equal(t, "viewer", tmpl.Attributes["role"])
+	})
+
+	t.Run("viewer", func(t *testing.T) {
+		res, err := r.IssueToken(apiKey, secret, &livekit.IssueTemplateTokenRequest{
+			Template:   "viewer",
+			RoomName:   "event-1",
+			Identity:   "user",
+			Attributes: map[string]string{"lang": "fr"},
+		})
+		require.NoError(t, err)
+		require.InDelta(t, time.Now().Add(time.Minute).Unix(), res.ExpiresAt, 1)
+
+		v, err := ParseAPIToken(res.Token)
+		require.NoError(t, err)
+		require.Equal(t, v.info.ExpiresAt.Unix(), res.ExpiresAt)
+		grants, err := v.Verify(secret)
+		require.NoError(t, err)
+		require.Equal(t, "user", grants.Identity)
+		require.Equal(t, "event-1", grants.Video.Room)
+		require.True(t, grants.Video.RoomJoin)
+		require.False(t, grants.Video.GetCanPublish())
+		require.True(t, grants.Video.GetCanPublishData())
+		require.True(t, grants.Video.GetCanPublishDataTopic("chat"))
+		require.False(t, grants.Video.GetCanPublishDataTopic("control"))
+		require.Equal(t, map[string]string{"role": "viewer", "lang": "fr"}, grants.Attributes)
+	})
+
+	t.Run("presenter", func(t *testing.T) {
+		at, err := r.NewAccessToken(apiKey, secret, &livekit.IssueTemplateTokenRequest{
+			Template: "presenter",
+			RoomName: "any",
+			Identity: "host",
+		})
+		require.NoError(t, err)
+		require.Equal(t, DefaultTemplateTTL, at.validFor)
+		require.True(t, at.GetGrants().Video.GetCanPublishSource(livekit.TrackSource_CAMERA))
+		require.False(t, at.GetGrants().Video.GetCanPublishSource(livekit.TrackSource_SCREEN_SHARE))
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		_, err := r.IssueToken(apiKey, secret, &livekit.IssueTemplateTokenRequest{Template: "admin", RoomName: "event-1", Identity: "user"})
+		require.ErrorIs(t, err, ErrTemplateNotFound)
+
+		_, err = r.IssueToken(apiKey, secret, &livekit.IssueTemplateTokenRequest{Template: "viewer", RoomName: "private", Identity: "user"})
+		require.ErrorIs(t, err, ErrTemplateRoomNotAllowed)
+
+		_, err = r.IssueToken(apiKey, secret, &livekit.IssueTemplateTokenRequest{
+			Template:   "viewer",
+			RoomName:   "event-1",
+			Identity:   "user",
+			Attributes: map[string]string{"role": "admin"},
+		})
+		require.ErrorIs(t, err, ErrTemplateAttributeDenied)
+	})
+}
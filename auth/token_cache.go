@@ -0,0 +1,104 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	"github.com/livekit/protocol/utils"
+)
+
+const (
+	DefaultTokenCacheBucket  = time.Minute
+	DefaultTokenCacheMaxSize = 10000
+)
+
+type TokenCacheOptions struct {
+	// tokens are reused for this long after being signed, defaults to DefaultTokenCacheBucket.
+	// Reused tokens expire up to Bucket earlier than freshly signed ones, short-lived tokens are reused
+	// for at most half of their validity.
+	Bucket time.Duration
+	// maximum number of cached tokens, defaults to DefaultTokenCacheMaxSize
+	MaxSize int
+}
+
+// TokenCache reuses the tokens signed for identical grants and options, for hot paths signing many
+// identical tokens, see AccessToken.SetCache.
+type TokenCache struct {
+	opts    TokenCacheOptions
+	entries *utils.LRUCache[[sha256.Size]byte, cachedToken]
+}
+
+type cachedToken struct {
+	token    string
+	signedAt time.Time
+}
+
+func NewTokenCache(opts TokenCacheOptions) *TokenCache {
+	if opts.Bucket <= 0 {
+		opts.Bucket = DefaultTokenCacheBucket
+	}
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = DefaultTokenCacheMaxSize
+	}
+	return &TokenCache{
+		opts: opts,
+		entries: utils.NewLRUCache(utils.LRUCacheParams[[sha256.Size]byte, cachedToken]{
+			MaxSize: opts.MaxSize,
+			TTL:     opts.Bucket,
+		}),
+	}
+}
+
+func (c *TokenCache) Len() int {
+	return c.entries.Len()
+}
+
+func (c *TokenCache) get(key [sha256.Size]byte, now time.Time, validFor time.Duration) (string, bool) {
+	e, ok := c.entries.Get(key)
+	if !ok || now.Sub(e.signedAt) >= min(c.opts.Bucket, validFor/2) {
+		return "", false
+	}
+	return e.token, true
+}
+
+func (c *TokenCache) put(key [sha256.Size]byte, token string, now time.Time) {
+	c.entries.Set(key, cachedToken{token: token, signedAt: now})
+}
+
+// cacheKey identifies the token by everything it is signed from, including the secret,
+// so tokens are not shared between keys. Tokens signed with a private key or encrypted for a recipient are not cached.
+func (t *AccessToken) cacheKey(grant *ClaimGrants) ([sha256.Size]byte, error) {
+	grants, err := json.Marshal(grant)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	h := sha256.New()
+	_ = binary.Write(h, binary.BigEndian, uint32(len(t.audience)))
+	for _, s := range append([]string{t.apiKey, t.secret, t.keyID, t.id, t.issuer, grant.Identity}, t.audience...) {
+		_ = binary.Write(h, binary.BigEndian, uint32(len(s)))
+		h.Write([]byte(s))
+	}
+	_ = binary.Write(h, binary.BigEndian, int64(t.validFor))
+	_ = binary.Write(h, binary.BigEndian, []bool{t.encrypted, t.derivedKey})
+	h.Write(grants)
+
+	var key [sha256.Size]byte
+	h.Sum(key[:0])
+	return key, nil
+}
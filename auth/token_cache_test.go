@@ -0,0 +1,104 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenCache(t *testing.T) {
+	apiKey, secret := apiKeypair()
+	cache := NewTokenCache(TokenCacheOptions{MaxSize: 2})
+
+	sign := func(room string) string {
+		token, err := NewAccessToken(apiKey, secret).
+			SetCache(cache).
+			SetVideoGrant(&VideoGrant{RoomAdmin: true, Room: room}).
+			ToJWT()
+		require.NoError(t, err)
+		return token
+	}
+
+	token := sign("a")
+	require.Equal(t, token, sign("a"))
+	require.Equal(t, 1, cache.Len())
+
+	v, err := ParseAPIToken(token)
+	require.NoError(t, err)
+	grants, err := v.Verify(secret)
+	require.NoError(t, err)
+	require.Equal(t, "a", grants.Video.Room)
+
+	require.NotEqual(t, token, sign("b"))
+	require.Equal(t, 2, cache.Len())
+
+	// a different secret does not share tokens
+	other, err := NewAccessToken(apiKey, "other").
+		SetCache(cache).
+		SetVideoGrant(&VideoGrant{RoomAdmin: true, Room: "a"}).
+		ToJWT()
+	require.NoError(t, err)
+	require.NotEqual(t, token, other)
+	require.LessOrEqual(t, cache.Len(), 2)
+
+	at := NewAccessToken(apiKey, "other").SetVideoGrant(&VideoGrant{RoomAdmin: true, Room: "a"})
+	key, err := at.cacheKey(at.GetGrants())
+	require.NoError(t, err)
+	_, ok := cache.get(key, time.Now(), time.Hour)
+	require.True(t, ok)
+	_, ok = cache.get(key, time.Now().Add(DefaultTokenCacheBucket), time.Hour)
+	require.False(t, ok)
+}
+
+func TestTokenCacheShortValidity(t *testing.T) {
+	apiKey, secret := apiKeypair()
+	cache := NewTokenCache(TokenCacheOptions{})
+
+	at := NewAccessToken(apiKey, secret).
+		SetCache(cache).
+		SetValidFor(30 * time.Second).
+		SetVideoGrant(&VideoGrant{RoomJoin: true, Room: "a"})
+	token, err := at.ToJWT()
+	require.NoError(t, err)
+	again, err := at.ToJWT()
+	require.NoError(t, err)
+	require.Equal(t, token, again)
+
+	// reused for half of the validity rather than the whole bucket
+	key, err := at.cacheKey(at.GetGrants())
+	require.NoError(t, err)
+	_, ok := cache.get(key, time.Now().Add(10*time.Second), 30*time.Second)
+	require.True(t, ok)
+	_, ok = cache.get(key, time.Now().Add(20*time.Second), 30*time.Second)
+	require.False(t, ok)
+
+	// tokens shorter than the bucket never come back expired
+	at = NewAccessToken(apiKey, secret).
+		SetCache(cache).
+		SetValidFor(time.Second).
+		SetVideoGrant(&VideoGrant{RoomJoin: true, Room: "b"})
+	_, err = at.ToJWT()
+	require.NoError(t, err)
+	time.Sleep(time.Second)
+	token, err = at.ToJWT()
+	require.NoError(t, err)
+	v, err := ParseAPIToken(token)
+	require.NoError(t, err)
+	_, err = v.Verify(secret)
+	require.NoError(t, err)
+}
@@ -15,38 +15,125 @@
 package auth
 
 import (
+	"context"
+	"crypto"
+	"errors"
 	"time"
 
+	"github.com/go-jose/go-jose/v3"
 	"github.com/go-jose/go-jose/v3/jwt"
+	"golang.org/x/exp/slices"
 )
 
+// header carrying the API key of tokens with a custom issuer
+const apiKeyHeader jose.HeaderKey = "api_key"
+
+// DefaultLeeway is the clock skew tolerated when validating nbf, exp and iat
+const DefaultLeeway = jwt.DefaultLeeway
+
+var ErrTokenLifetimeExceeded = errors.New("token lifetime exceeds maximum")
+
+var ErrDerivedKeyNotAccepted = errors.New("tokens signed with derived keys are not accepted")
+
 type APIKeyTokenVerifier struct {
-	token    *jwt.JSONWebToken
-	identity string
-	apiKey   string
+	token *jwt.JSONWebToken
+	// set for encrypted tokens, token and the claims are only available once decrypted
+	encrypted     *jwt.NestedJSONWebToken
+	decryptionKey any
+	identity      string
+	apiKey        string
+	issuer        string
+	audience      []string
+	info          TokenInfo
+	revocation    RevocationChecker
+	// accepted issuers and audiences, any are accepted when empty
+	expectedIssuers   []string
+	expectedAudiences []string
+	leeway            time.Duration
+	maxTTL            time.Duration
+	identityPolicy    *IdentityPolicy
+	// signed with a key derived from the API secret, see DeriveSigningKey
+	derivedKey bool
+	// verifying with the API secret accepts tokens signed with the derived key
+	acceptDerivedKey bool
 }
 
 // ParseAPIToken parses an encoded JWT token and
 func ParseAPIToken(raw string) (*APIKeyTokenVerifier, error) {
+	if isEncryptedToken(raw) {
+		return parseEncryptedAPIToken(raw)
+	}
+
 	tok, err := jwt.ParseSigned(raw)
 	if err != nil {
 		return nil, err
 	}
 
-	out := jwt.Claims{}
-	if err := tok.UnsafeClaimsWithoutVerification(&out); err != nil {
+	v := &APIKeyTokenVerifier{
+		token:  tok,
+		leeway: DefaultLeeway,
+	}
+	if err = v.readClaims(); err != nil {
 		return nil, err
 	}
+	return v, nil
+}
 
-	v := &APIKeyTokenVerifier{
-		token:    tok,
-		apiKey:   out.Issuer,
-		identity: out.Subject,
+// parseEncryptedAPIToken parses a JWE token, reading the API key from its header.
+// Until the token is verified, its identity is unknown.
+func parseEncryptedAPIToken(raw string) (*APIKeyTokenVerifier, error) {
+	tok, err := jwt.ParseSignedAndEncrypted(raw)
+	if err != nil {
+		return nil, err
+	}
+	apiKey, _ := tok.Headers[0].ExtraHeaders[issuerHeader].(string)
+	if apiKey == "" {
+		return nil, ErrMissingIssuerHeader
 	}
+	kdf, _ := tok.Headers[0].ExtraHeaders[kdfHeader].(string)
+	return &APIKeyTokenVerifier{
+		encrypted:  tok,
+		apiKey:     apiKey,
+		info:       TokenInfo{APIKey: apiKey},
+		leeway:     DefaultLeeway,
+		derivedKey: kdf == kdfHKDFSHA256,
+	}, nil
+}
+
+func (v *APIKeyTokenVerifier) readClaims() error {
+	out := jwt.Claims{}
+	if err := v.token.UnsafeClaimsWithoutVerification(&out); err != nil {
+		return err
+	}
+
+	v.issuer = out.Issuer
+	v.audience = out.Audience
+	v.apiKey = out.Issuer
+	if apiKey, _ := v.token.Headers[0].ExtraHeaders[apiKeyHeader].(string); apiKey != "" {
+		v.apiKey = apiKey
+	}
+	if kdf, _ := v.token.Headers[0].ExtraHeaders[kdfHeader].(string); kdf == kdfHKDFSHA256 {
+		v.derivedKey = true
+	}
+	v.identity = out.Subject
 	if v.identity == "" {
 		v.identity = out.ID
 	}
-	return v, nil
+	v.info = TokenInfo{
+		ID:       out.ID,
+		APIKey:   v.apiKey,
+		Identity: out.Subject,
+	}
+	// tokens created before iat was set are treated as issued when they became valid
+	if out.IssuedAt != nil {
+		v.info.IssuedAt = out.IssuedAt.Time()
+	} else if out.NotBefore != nil {
+		v.info.IssuedAt = out.NotBefore.Time()
+	}
+	if out.Expiry != nil {
+		v.info.ExpiresAt = out.Expiry.Time()
+	}
+	return nil
 }
 
 // APIKey returns the API key this token was signed with
@@ -54,27 +141,253 @@ func (v *APIKeyTokenVerifier) APIKey() string {
 	return v.apiKey
 }
 
+// Algorithm returns the signing algorithm of the token, e.g. HS256 for tokens signed with an API secret.
+// It is empty for encrypted tokens until they are verified.
+func (v *APIKeyTokenVerifier) Algorithm() string {
+	if v.token == nil || len(v.token.Headers) == 0 {
+		return ""
+	}
+	return v.token.Headers[0].Algorithm
+}
+
+// KeyID returns the kid header of the token, if any
+func (v *APIKeyTokenVerifier) KeyID() string {
+	if v.encrypted != nil {
+		return v.encrypted.Headers[0].KeyID
+	}
+	if len(v.token.Headers) == 0 {
+		return ""
+	}
+	return v.token.Headers[0].KeyID
+}
+
 func (v *APIKeyTokenVerifier) Identity() string {
 	return v.identity
 }
 
+// Issuer returns the iss claim of the token, the API key unless set with AccessToken.SetIssuer.
+func (v *APIKeyTokenVerifier) Issuer() string {
+	return v.issuer
+}
+
+// Audience returns the aud claim of the token
+func (v *APIKeyTokenVerifier) Audience() []string {
+	return v.audience
+}
+
+// WithIssuer makes Verify reject tokens not issued by one of the issuers
+func (v *APIKeyTokenVerifier) WithIssuer(issuers ...string) *APIKeyTokenVerifier {
+	v.expectedIssuers = issuers
+	return v
+}
+
+// WithAudience makes Verify reject tokens not meant for one of the audiences, including tokens without an audience
+func (v *APIKeyTokenVerifier) WithAudience(audiences ...string) *APIKeyTokenVerifier {
+	v.expectedAudiences = audiences
+	return v
+}
+
+// WithLeeway sets the clock skew tolerated when validating nbf, exp and iat, defaults to DefaultLeeway.
+// A leeway of 0 validates them strictly.
+func (v *APIKeyTokenVerifier) WithLeeway(leeway time.Duration) *APIKeyTokenVerifier {
+	v.leeway = leeway
+	return v
+}
+
+// WithMaxTTL makes Verify reject tokens valid for longer than ttl from when they were issued,
+// including tokens without an expiry, e.g. year-long tokens minted by a misconfigured issuer.
+func (v *APIKeyTokenVerifier) WithMaxTTL(ttl time.Duration) *APIKeyTokenVerifier {
+	v.maxTTL = ttl
+	return v
+}
+
+// WithIdentityPolicy makes Verify reject tokens with an identity the policy does not accept as is,
+// e.g. a standard participant using an identity reserved for agents. Tokens without a subject are rejected.
+func (v *APIKeyTokenVerifier) WithIdentityPolicy(policy *IdentityPolicy) *APIKeyTokenVerifier {
+	v.identityPolicy = policy
+	return v
+}
+
+// WithDerivedKeys makes Verify accept tokens signed with the key derived from the API secret it is given,
+// see AccessToken.SetDerivedKey. They are rejected by default, so that a leaked derived key, e.g. from
+// the store of a HashedKeyProvider, cannot mint tokens accepted by servers holding the secret.
+// Keys of providers holding derived keys, such as HashedKeyProvider, are always used as is.
+func (v *APIKeyTokenVerifier) WithDerivedKeys() *APIKeyTokenVerifier {
+	v.acceptDerivedKey = true
+	return v
+}
+
+// IsEncrypted returns true if the claims of the token are encrypted (JWE) and were not decrypted yet
+func (v *APIKeyTokenVerifier) IsEncrypted() bool {
+	return v.encrypted != nil
+}
+
+// WithDecryptionKey sets the key encrypted tokens are decrypted with, e.g. the private key of the recipient.
+// By default, tokens are decrypted with the key derived from the API secret they are verified with.
+func (v *APIKeyTokenVerifier) WithDecryptionKey(key any) *APIKeyTokenVerifier {
+	v.decryptionKey = key
+	return v
+}
+
+// WithRevocationChecker makes Verify reject tokens revoked by the checker
+func (v *APIKeyTokenVerifier) WithRevocationChecker(c RevocationChecker) *APIKeyTokenVerifier {
+	v.revocation = c
+	return v
+}
+
+// VerifierOptions configures the verifiers of Middleware, the interceptors and Introspect.
+type VerifierOptions struct {
+	// consulted for each verified token
+	RevocationChecker RevocationChecker
+	// when set, tokens must be meant for one of the audiences
+	Audience []string
+	// when set, tokens must be issued by one of the issuers
+	Issuer []string
+	// clock skew tolerated when validating token times, defaults to DefaultLeeway when nil.
+	// A leeway of 0 validates them strictly
+	Leeway *time.Duration
+	// when set, tokens valid for longer are rejected
+	MaxTTL time.Duration
+	// when set, tokens must carry an identity accepted by the policy
+	IdentityPolicy *IdentityPolicy
+}
+
+func (o *VerifierOptions) apply(v *APIKeyTokenVerifier) {
+	if o.RevocationChecker != nil {
+		v.WithRevocationChecker(o.RevocationChecker)
+	}
+	if len(o.Audience) != 0 {
+		v.WithAudience(o.Audience...)
+	}
+	if len(o.Issuer) != 0 {
+		v.WithIssuer(o.Issuer...)
+	}
+	if o.Leeway != nil {
+		v.WithLeeway(*o.Leeway)
+	}
+	if o.MaxTTL > 0 {
+		v.WithMaxTTL(o.MaxTTL)
+	}
+	if o.IdentityPolicy != nil {
+		v.WithIdentityPolicy(o.IdentityPolicy)
+	}
+}
+
 func (v *APIKeyTokenVerifier) Verify(key interface{}) (*ClaimGrants, error) {
+	return v.VerifyContext(context.Background(), key)
+}
+
+func (v *APIKeyTokenVerifier) VerifyContext(ctx context.Context, key interface{}) (*ClaimGrants, error) {
 	if key == nil || key == "" {
 		return nil, ErrKeysMissing
 	}
-	if s, ok := key.(string); ok {
-		key = []byte(s)
+	switch k := key.(type) {
+	case string:
+		// keys from providers holding derived keys are []byte, and used as is
+		if v.derivedKey {
+			if !v.acceptDerivedKey {
+				return nil, ErrDerivedKeyNotAccepted
+			}
+			key = DeriveSigningKey(v.apiKey, k)
+		} else {
+			key = []byte(k)
+		}
+	case crypto.Signer:
+		// tokens signed with a private key are verified with its public key
+		key = k.Public()
+	}
+	if v.encrypted != nil {
+		if err := v.decrypt(key); err != nil {
+			return nil, err
+		}
 	}
 	out := jwt.Claims{}
 	claims := ClaimGrants{}
 	if err := v.token.Claims(key, &out, &claims); err != nil {
 		return nil, err
 	}
-	if err := out.Validate(jwt.Expected{Issuer: v.apiKey, Time: time.Now()}); err != nil {
+	if err := out.ValidateWithLeeway(jwt.Expected{Issuer: v.issuer, Time: time.Now()}, v.leeway); err != nil {
 		return nil, err
 	}
+	if v.maxTTL > 0 {
+		issuedAt := out.IssuedAt
+		if issuedAt == nil {
+			issuedAt = out.NotBefore
+		}
+		if out.Expiry == nil || issuedAt == nil || out.Expiry.Time().Sub(issuedAt.Time()) > v.maxTTL {
+			return nil, ErrTokenLifetimeExceeded
+		}
+	}
+	if len(v.expectedIssuers) != 0 && !slices.Contains(v.expectedIssuers, out.Issuer) {
+		return nil, jwt.ErrInvalidIssuer
+	}
+	if len(v.expectedAudiences) != 0 && !slices.ContainsFunc(v.expectedAudiences, out.Audience.Contains) {
+		return nil, jwt.ErrInvalidAudience
+	}
+	// the subject is validated, the jti fallback of Identity is not an identity
+	if err := v.identityPolicy.Validate(v.info.Identity, claims.GetParticipantKind()); err != nil {
+		return nil, err
+	}
+	if v.revocation != nil {
+		// only consulted for authentic tokens, forged ones cannot probe the denylist
+		revoked, err := v.revocation.IsRevoked(ctx, v.info)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			return nil, ErrTokenRevoked
+		}
+	}
 
 	// copy over identity
 	claims.Identity = v.identity
 	return &claims, nil
 }
+
+func (v *APIKeyTokenVerifier) decrypt(verificationKey any) error {
+	key, err := decryptionKey(v.decryptionKey, verificationKey)
+	if err != nil {
+		return err
+	}
+	tok, err := v.encrypted.Decrypt(key)
+	if err != nil {
+		return err
+	}
+	apiKey := v.apiKey
+	v.token, v.encrypted = tok, nil
+	if err = v.readClaims(); err != nil {
+		return err
+	}
+	// the API key must match the header the key was looked up with
+	if v.apiKey != apiKey {
+		return jwt.ErrInvalidIssuer
+	}
+	return nil
+}
+
+// VerifyWithProvider verifies the token with the keys the provider holds for its API key.
+// Providers implementing VerificationKeyProvider may return several keys, e.g. during key rotation.
+func (v *APIKeyTokenVerifier) VerifyWithProvider(provider KeyProvider) (*ClaimGrants, error) {
+	return v.VerifyWithProviderContext(context.Background(), provider)
+}
+
+func (v *APIKeyTokenVerifier) VerifyWithProviderContext(ctx context.Context, provider KeyProvider) (*ClaimGrants, error) {
+	var keys []any
+	if vp, ok := provider.(VerificationKeyProvider); ok {
+		keys = vp.GetVerificationKeys(v.apiKey, v.KeyID())
+	} else if secret := provider.GetSecret(v.apiKey); secret != "" {
+		keys = []any{secret}
+	}
+	if len(keys) == 0 {
+		return nil, ErrKeysMissing
+	}
+
+	var err error
+	for _, key := range keys {
+		var claims *ClaimGrants
+		if claims, err = v.VerifyContext(ctx, key); err == nil {
+			return claims, nil
+		}
+	}
+	return nil, err
+}
@@ -19,9 +19,11 @@ import (
 	"time"
 
 	"github.com/go-jose/go-jose/v3/json"
+	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/stretchr/testify/require"
 
 	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/auth/authtest"
 )
 
 func TestVerifier(t *testing.T) {
@@ -67,6 +69,25 @@ func TestVerifier(t *testing.T) {
 		require.Equal(t, &claim, decoded.Video)
 	})
 
+	t.Run("key id is passed in the header", func(t *testing.T) {
+		authToken, err := auth.NewAccessToken(apiKey, secret).
+			SetKeyID("key-2").
+			ToJWT()
+		require.NoError(t, err)
+
+		v, err := auth.ParseAPIToken(authToken)
+		require.NoError(t, err)
+		require.Equal(t, "key-2", v.KeyID())
+		_, err = v.Verify(secret)
+		require.NoError(t, err)
+
+		authToken, err = auth.NewAccessToken(apiKey, secret).ToJWT()
+		require.NoError(t, err)
+		v, err = auth.ParseAPIToken(authToken)
+		require.NoError(t, err)
+		require.Empty(t, v.KeyID())
+	})
+
 	t.Run("ensure metadata can be passed through", func(t *testing.T) {
 		metadata := map[string]interface{}{
 			"user":   "value",
@@ -115,4 +136,81 @@ func TestVerifier(t *testing.T) {
 		require.Nil(t, decoded.Video.CanPublish)
 		require.False(t, *decoded.Video.CanPublishData)
 	})
+	t.Run("audience and issuer are validated", func(t *testing.T) {
+		token, err := auth.NewAccessToken(apiKey, secret).
+			SetIdentity("me").
+			SetIssuer("cluster-a.example.com").
+			SetAudience("cluster-a").
+			ToJWT()
+		require.NoError(t, err)
+
+		v, err := auth.ParseAPIToken(token)
+		require.NoError(t, err)
+		require.Equal(t, apiKey, v.APIKey())
+		require.Equal(t, "cluster-a.example.com", v.Issuer())
+		require.Equal(t, []string{"cluster-a"}, v.Audience())
+
+		_, err = v.WithAudience("cluster-a", "cluster-c").WithIssuer("cluster-a.example.com").Verify(secret)
+		require.NoError(t, err)
+		_, err = v.WithAudience("cluster-b").Verify(secret)
+		require.ErrorIs(t, err, jwt.ErrInvalidAudience)
+		_, err = v.WithAudience().WithIssuer("cluster-b.example.com").Verify(secret)
+		require.ErrorIs(t, err, jwt.ErrInvalidIssuer)
+
+		// tokens without an audience are rejected when one is expected
+		token, err = auth.NewAccessToken(apiKey, secret).SetIdentity("me").ToJWT()
+		require.NoError(t, err)
+		v, err = auth.ParseAPIToken(token)
+		require.NoError(t, err)
+		require.Equal(t, apiKey, v.Issuer())
+		_, err = v.WithAudience("cluster-a").Verify(secret)
+		require.ErrorIs(t, err, jwt.ErrInvalidAudience)
+	})
+	t.Run("leeway tolerates clock skew", func(t *testing.T) {
+		// issued by a server with a clock two minutes ahead
+		m := authtest.NewMinter(time.Now().Add(2 * time.Minute))
+		token := m.Token(t, &auth.ClaimGrants{Identity: "me"})
+
+		v, err := auth.ParseAPIToken(token)
+		require.NoError(t, err)
+		_, err = v.Verify(authtest.APISecret)
+		require.ErrorIs(t, err, jwt.ErrNotValidYet)
+
+		_, err = v.WithLeeway(5 * time.Minute).Verify(authtest.APISecret)
+		require.NoError(t, err)
+
+		// a minute of skew is tolerated by default, unless disabled
+		token = authtest.NewMinter(time.Now().Add(30*time.Second)).Token(t, &auth.ClaimGrants{Identity: "me"})
+		v, err = auth.ParseAPIToken(token)
+		require.NoError(t, err)
+		_, err = v.Verify(authtest.APISecret)
+		require.NoError(t, err)
+		_, err = v.WithLeeway(0).Verify(authtest.APISecret)
+		require.Error(t, err)
+	})
+	t.Run("max ttl", func(t *testing.T) {
+		apiKey, secret := "key", "secret"
+		token, err := auth.NewAccessToken(apiKey, secret).
+			SetIdentity("me").
+			SetValidFor(365 * 24 * time.Hour).
+			ToJWT()
+		require.NoError(t, err)
+
+		v, err := auth.ParseAPIToken(token)
+		require.NoError(t, err)
+		_, err = v.Verify(secret)
+		require.NoError(t, err)
+		_, err = v.WithMaxTTL(24 * time.Hour).Verify(secret)
+		require.ErrorIs(t, err, auth.ErrTokenLifetimeExceeded)
+
+		token, err = auth.NewAccessToken(apiKey, secret).
+			SetIdentity("me").
+			SetValidFor(time.Hour).
+			ToJWT()
+		require.NoError(t, err)
+		v, err = auth.ParseAPIToken(token)
+		require.NoError(t, err)
+		_, err = v.WithMaxTTL(24 * time.Hour).Verify(secret)
+		require.NoError(t, err)
+	})
 }
@@ -0,0 +1,186 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package client wraps the Twirp service stubs with authentication, retries, pagination and typed errors,
+// for server side applications calling the LiveKit APIs.
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/twitchtv/twirp"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/utils/xtwirp"
+)
+
+// Version of the client facade, sent in the User-Agent header.
+const Version = "1.0.0"
+
+const (
+	DefaultTokenTTL     = 10 * time.Minute
+	DefaultMaxRetries   = 3
+	DefaultRetryBackoff = 200 * time.Millisecond
+)
+
+type Params struct {
+	// server URL, ws(s) URLs are converted to http(s)
+	URL       string
+	APIKey    string
+	APISecret string
+	// defaults to http.DefaultClient
+	HTTPClient livekit.HTTPClient
+	// validity of the tokens signed for each request
+	TokenTTL time.Duration
+	// retries of requests failing with a retryable error, negative disables retries
+	MaxRetries int
+	// initial delay between retries, doubled on every attempt
+	RetryBackoff time.Duration
+	// additional options for the Twirp clients
+	TwirpOptions []twirp.ClientOption
+}
+
+// Client groups the clients of all services.
+type Client struct {
+	Room    *RoomClient
+	Egress  *EgressClient
+	Ingress *IngressClient
+	SIP     *SIPClient
+}
+
+func New(params Params) *Client {
+	return &Client{
+		Room:    NewRoomClient(params),
+		Egress:  NewEgressClient(params),
+		Ingress: NewIngressClient(params),
+		SIP:     NewSIPClient(params),
+	}
+}
+
+type base struct {
+	params Params
+}
+
+func newBase(params Params) (base, string, livekit.HTTPClient, []twirp.ClientOption) {
+	if params.HTTPClient == nil {
+		params.HTTPClient = http.DefaultClient
+	}
+	if params.TokenTTL == 0 {
+		params.TokenTTL = DefaultTokenTTL
+	}
+	if params.MaxRetries == 0 {
+		params.MaxRetries = DefaultMaxRetries
+	}
+	if params.RetryBackoff == 0 {
+		params.RetryBackoff = DefaultRetryBackoff
+	}
+	opts := append(xtwirp.DefaultClientOptions(), params.TwirpOptions...)
+	return base{params: params}, toHTTPURL(params.URL), params.HTTPClient, opts
+}
+
+// grant adds the permissions required by a call to the token.
+type grant func(t *auth.AccessToken)
+
+func videoGrant(g *auth.VideoGrant) grant {
+	return func(t *auth.AccessToken) {
+		t.SetVideoGrant(g)
+	}
+}
+
+func sipGrant(g *auth.SIPGrant) grant {
+	return func(t *auth.AccessToken) {
+		t.SetSIPGrant(g)
+	}
+}
+
+func (b *base) withAuth(ctx context.Context, grants ...grant) (context.Context, error) {
+	at := auth.NewAccessToken(b.params.APIKey, b.params.APISecret).
+		SetValidFor(b.params.TokenTTL)
+	for _, g := range grants {
+		g(at)
+	}
+	token, err := at.ToJWT()
+	if err != nil {
+		return nil, err
+	}
+
+	h, ok := twirp.HTTPRequestHeaders(ctx)
+	if ok {
+		h = h.Clone()
+	} else {
+		h = make(http.Header)
+	}
+	h.Set("Authorization", "Bearer "+token)
+	h.Set("User-Agent", "livekit-protocol-go/"+Version)
+	return twirp.WithHTTPRequestHeaders(ctx, h)
+}
+
+// call signs a token with the grants, and invokes fnc, retrying on retryable errors.
+// Twirp errors are returned as *Error.
+func call[Req, Resp any](ctx context.Context, b *base, fnc func(context.Context, Req) (Resp, error), req Req, grants ...grant) (Resp, error) {
+	var zero Resp
+	ctx, err := b.withAuth(ctx, grants...)
+	if err != nil {
+		return zero, err
+	}
+
+	backoff := b.params.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		resp, err := fnc(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+		if attempt >= b.params.MaxRetries || !isRetryable(err) {
+			return zero, wrapError(err)
+		}
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			return zero, wrapError(err)
+		}
+	}
+}
+
+// isRetryable reports whether the request can be retried without side effects:
+// the server reported it is unavailable, or no connection could be established.
+func isRetryable(err error) bool {
+	var terr twirp.Error
+	if errors.As(err, &terr) {
+		switch terr.Code() {
+		case twirp.Unavailable, twirp.ResourceExhausted:
+			return true
+		}
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func toHTTPURL(u string) string {
+	if rest, ok := strings.CutPrefix(u, "ws"); ok {
+		u = "http" + rest
+	}
+	if parsed, err := url.Parse(u); err == nil && parsed.Scheme == "" {
+		u = "https://" + u
+	}
+	return strings.TrimSuffix(u, "/")
+}
@@ -0,0 +1,257 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dennwc/iters"
+	"github.com/stretchr/testify/require"
+	"github.com/twitchtv/twirp"
+	"go.uber.org/atomic"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+)
+
+const (
+	testAPIKey    = "key"
+	testAPISecret = "secret"
+)
+
+type testRoomService struct {
+	livekit.RoomService
+	grants   *auth.ClaimGrants
+	attempts atomic.Int32
+}
+
+func (s *testRoomService) CreateRoom(ctx context.Context, req *livekit.CreateRoomRequest) (*livekit.Room, error) {
+	if s.attempts.Inc() < 3 {
+		return nil, twirp.NewError(twirp.Unavailable, "try again")
+	}
+	return &livekit.Room{Name: req.Name}, nil
+}
+
+func (s *testRoomService) GetParticipant(ctx context.Context, req *livekit.RoomParticipantIdentity) (*livekit.ParticipantInfo, error) {
+	s.attempts.Inc()
+	return nil, twirp.NewError(twirp.NotFound, "participant not found")
+}
+
+type testSIPService struct {
+	livekit.SIP
+	rules []*livekit.SIPDispatchRuleInfo
+}
+
+func (s *testSIPService) ListSIPDispatchRule(ctx context.Context, req *livekit.ListSIPDispatchRuleRequest) (*livekit.ListSIPDispatchRuleResponse, error) {
+	var items []*livekit.SIPDispatchRuleInfo
+	for _, r := range s.rules {
+		if req.Page.Filter(r) && (req.Page == nil || req.Page.Limit == 0 || len(items) < int(req.Page.Limit)) {
+			items = append(items, r)
+		}
+	}
+	return &livekit.ListSIPDispatchRuleResponse{Items: items}, nil
+}
+
+func newTestServer(t *testing.T, rooms *testRoomService, sip *testSIPService) string {
+	mux := http.NewServeMux()
+	for _, srv := range []livekit.TwirpServer{
+		livekit.NewRoomServiceServer(rooms),
+		livekit.NewSIPServer(sip),
+	} {
+		mux.Handle(srv.PathPrefix(), srv)
+	}
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "livekit-protocol-go/"+Version, r.Header.Get("User-Agent"))
+
+		v, err := auth.ParseAPIToken(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+		require.NoError(t, err)
+		grants, err := v.Verify(testAPISecret)
+		require.NoError(t, err)
+		rooms.grants = grants
+
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(s.Close)
+	return s.URL
+}
+
+func TestClient(t *testing.T) {
+	rooms := &testRoomService{}
+	sip := &testSIPService{}
+	for _, id := range []string{"SDR_1", "SDR_2", "SDR_3", "SDR_4", "SDR_5"} {
+		sip.rules = append(sip.rules, &livekit.SIPDispatchRuleInfo{SipDispatchRuleId: id})
+	}
+	url := newTestServer(t, rooms, sip)
+
+	c := New(Params{
+		URL:          strings.Replace(url, "http", "ws", 1),
+		APIKey:       testAPIKey,
+		APISecret:    testAPISecret,
+		RetryBackoff: time.Millisecond,
+	})
+
+	t.Run("retries", func(t *testing.T) {
+		room, err := c.Room.CreateRoom(context.Background(), &livekit.CreateRoomRequest{Name: "room"})
+		require.NoError(t, err)
+		require.Equal(t, "room", room.Name)
+		require.Equal(t, int32(3), rooms.attempts.Load())
+		require.True(t, rooms.grants.Video.RoomCreate)
+	})
+
+	t.Run("typed errors", func(t *testing.T) {
+		rooms.attempts.Store(0)
+		_, err := c.Room.GetParticipant(context.Background(), &livekit.RoomParticipantIdentity{Room: "room", Identity: "p"})
+		require.ErrorIs(t, err, ErrNotFound)
+		require.False(t, errors.Is(err, ErrUnavailable))
+		require.Equal(t, int32(1), rooms.attempts.Load())
+
+		var cerr *Error
+		require.ErrorAs(t, err, &cerr)
+		require.Equal(t, twirp.NotFound, cerr.Code)
+		require.Equal(t, "participant not found", cerr.Msg)
+
+		require.True(t, rooms.grants.Video.RoomAdmin)
+		require.Equal(t, "room", rooms.grants.Video.Room)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		it := c.SIP.ListSIPDispatchRules(&livekit.ListSIPDispatchRuleRequest{Page: &livekit.Pagination{Limit: 2}})
+		defer it.Close()
+
+		var pages int
+		var ids []string
+		for {
+			page, err := it.NextPage(context.Background())
+			for _, r := range page {
+				ids = append(ids, r.SipDispatchRuleId)
+			}
+			if err != nil {
+				break
+			}
+			pages++
+		}
+		require.Equal(t, 3, pages)
+		require.Equal(t, []string{"SDR_1", "SDR_2", "SDR_3", "SDR_4", "SDR_5"}, ids)
+
+		all, err := iters.AllPages(context.Background(), c.SIP.ListSIPDispatchRules(&livekit.ListSIPDispatchRuleRequest{}))
+		require.NoError(t, err)
+		require.Len(t, all, 5)
+	})
+}
+
+func TestToHTTPURL(t *testing.T) {
+	require.Equal(t, "https://example.com", toHTTPURL("wss://example.com/"))
+	require.Equal(t, "http://localhost:7880", toHTTPURL("ws://localhost:7880"))
+	require.Equal(t, "https://example.com", toHTTPURL("https://example.com"))
+	require.Equal(t, "https://example.com", toHTTPURL("example.com"))
+}
+
+type subscriptionRecorder struct {
+	mu   sync.Mutex
+	reqs []*livekit.UpdateSubscriptionsRequest
+	err  error
+}
+
+func (r *subscriptionRecorder) UpdateSubscriptions(_ context.Context, req *livekit.UpdateSubscriptionsRequest) (*livekit.UpdateSubscriptionsResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reqs = append(r.reqs, req)
+	return &livekit.UpdateSubscriptionsResponse{}, r.err
+}
+
+func (r *subscriptionRecorder) requests() []*livekit.UpdateSubscriptionsRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.reqs
+}
+
+func TestSubscriptionBatcher(t *testing.T) {
+	t.Run("coalesces", func(t *testing.T) {
+		r := &subscriptionRecorder{}
+		b := NewSubscriptionBatcher(r, SubscriptionBatcherParams{FlushInterval: time.Hour, RateLimit: 1000})
+
+		require.NoError(t, b.Subscribe("room", "a", "TR_1", "TR_2", "TR_3"))
+		require.NoError(t, b.Subscribe("room", "b", "TR_1"))
+		require.NoError(t, b.Unsubscribe("room", "a", "TR_2", "TR_4"))
+		require.NoError(t, b.Flush(context.Background()))
+
+		require.Equal(t, []*livekit.UpdateSubscriptionsRequest{
+			{Room: "room", Identity: "a", TrackSids: []string{"TR_2", "TR_4"}, Subscribe: false},
+			{Room: "room", Identity: "a", TrackSids: []string{"TR_1", "TR_3"}, Subscribe: true},
+			{Room: "room", Identity: "b", TrackSids: []string{"TR_1"}, Subscribe: true},
+		}, r.requests())
+
+		require.NoError(t, b.Flush(context.Background()))
+		require.Len(t, r.requests(), 3)
+	})
+
+	t.Run("background flush", func(t *testing.T) {
+		r := &subscriptionRecorder{err: errors.New("unavailable")}
+		failed := make(chan *livekit.UpdateSubscriptionsRequest, 1)
+		b := NewSubscriptionBatcher(r, SubscriptionBatcherParams{
+			FlushInterval: 10 * time.Millisecond,
+			OnError: func(req *livekit.UpdateSubscriptionsRequest, err error) {
+				failed <- req
+			},
+		})
+		require.NoError(t, b.Subscribe("room", "a", "TR_1"))
+		select {
+		case req := <-failed:
+			require.Equal(t, []string{"TR_1"}, req.TrackSids)
+		case <-time.After(time.Second):
+			t.Fatal("pending changes were not flushed")
+		}
+
+		require.NoError(t, b.Subscribe("room", "a", "TR_2"))
+		require.Error(t, b.Close(context.Background()))
+		require.ErrorIs(t, b.Subscribe("room", "a", "TR_3"), ErrBatcherClosed)
+		require.Len(t, r.requests(), 2)
+	})
+
+	t.Run("requeues failed changes", func(t *testing.T) {
+		r := &subscriptionRecorder{err: errors.New("unavailable")}
+		b := NewSubscriptionBatcher(r, SubscriptionBatcherParams{FlushInterval: time.Hour, RateLimit: 1000})
+
+		require.NoError(t, b.Subscribe("room", "a", "TR_1", "TR_2"))
+		require.Error(t, b.Flush(context.Background()))
+
+		// later changes win over the failed ones
+		require.NoError(t, b.Unsubscribe("room", "a", "TR_2"))
+		r.mu.Lock()
+		r.err = nil
+		r.mu.Unlock()
+		require.NoError(t, b.Flush(context.Background()))
+		require.Equal(t, []*livekit.UpdateSubscriptionsRequest{
+			{Room: "room", Identity: "a", TrackSids: []string{"TR_2"}, Subscribe: false},
+			{Room: "room", Identity: "a", TrackSids: []string{"TR_1"}, Subscribe: true},
+		}, r.requests()[1:])
+
+		// rate limited requests give up when the context is done
+		b = NewSubscriptionBatcher(r, SubscriptionBatcherParams{FlushInterval: time.Hour, RateLimit: 1})
+		require.NoError(t, b.Subscribe("room", "a", "TR_1"))
+		require.NoError(t, b.Unsubscribe("room", "a", "TR_2"))
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, b.Flush(ctx), context.DeadlineExceeded)
+		require.Len(t, r.requests(), 4)
+	})
+}
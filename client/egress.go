@@ -0,0 +1,74 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package client
+
+import (
+	"context"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+)
+
+type EgressClient struct {
+	base
+	svc livekit.Egress
+}
+
+func NewEgressClient(params Params) *EgressClient {
+	b, url, hc, opts := newBase(params)
+	return &EgressClient{
+		base: b,
+		svc:  livekit.NewEgressProtobufClient(url, hc, opts...),
+	}
+}
+
+func roomRecord() grant {
+	return videoGrant(&auth.VideoGrant{RoomRecord: true})
+}
+
+func (c *EgressClient) StartRoomCompositeEgress(ctx context.Context, req *livekit.RoomCompositeEgressRequest) (*livekit.EgressInfo, error) {
+	return call(ctx, &c.base, c.svc.StartRoomCompositeEgress, req, roomRecord())
+}
+
+func (c *EgressClient) StartWebEgress(ctx context.Context, req *livekit.WebEgressRequest) (*livekit.EgressInfo, error) {
+	return call(ctx, &c.base, c.svc.StartWebEgress, req, roomRecord())
+}
+
+func (c *EgressClient) StartParticipantEgress(ctx context.Context, req *livekit.ParticipantEgressRequest) (*livekit.EgressInfo, error) {
+	return call(ctx, &c.base, c.svc.StartParticipantEgress, req, roomRecord())
+}
+
+func (c *EgressClient) StartTrackCompositeEgress(ctx context.Context, req *livekit.TrackCompositeEgressRequest) (*livekit.EgressInfo, error) {
+	return call(ctx, &c.base, c.svc.StartTrackCompositeEgress, req, roomRecord())
+}
+
+func (c *EgressClient) StartTrackEgress(ctx context.Context, req *livekit.TrackEgressRequest) (*livekit.EgressInfo, error) {
+	return call(ctx, &c.base, c.svc.StartTrackEgress, req, roomRecord())
+}
+
+func (c *EgressClient) UpdateLayout(ctx context.Context, req *livekit.UpdateLayoutRequest) (*livekit.EgressInfo, error) {
+	return call(ctx, &c.base, c.svc.UpdateLayout, req, roomRecord())
+}
+
+func (c *EgressClient) UpdateStream(ctx context.Context, req *livekit.UpdateStreamRequest) (*livekit.EgressInfo, error) {
+	return call(ctx, &c.base, c.svc.UpdateStream, req, roomRecord())
+}
+
+func (c *EgressClient) ListEgress(ctx context.Context, req *livekit.ListEgressRequest) (*livekit.ListEgressResponse, error) {
+	return call(ctx, &c.base, c.svc.ListEgress, req, roomRecord())
+}
+
+func (c *EgressClient) StopEgress(ctx context.Context, req *livekit.StopEgressRequest) (*livekit.EgressInfo, error) {
+	return call(ctx, &c.base, c.svc.StopEgress, req, roomRecord())
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"errors"
+
+	"github.com/twitchtv/twirp"
+)
+
+var (
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrResourceExhausted  = errors.New("resource exhausted")
+	ErrUnavailable        = errors.New("unavailable")
+	ErrUnimplemented      = errors.New("unimplemented")
+	ErrInternal           = errors.New("internal error")
+)
+
+var codeErrors = map[twirp.ErrorCode]error{
+	twirp.InvalidArgument:    ErrInvalidArgument,
+	twirp.Malformed:          ErrInvalidArgument,
+	twirp.OutOfRange:         ErrInvalidArgument,
+	twirp.NotFound:           ErrNotFound,
+	twirp.BadRoute:           ErrNotFound,
+	twirp.AlreadyExists:      ErrAlreadyExists,
+	twirp.PermissionDenied:   ErrPermissionDenied,
+	twirp.Unauthenticated:    ErrUnauthenticated,
+	twirp.FailedPrecondition: ErrFailedPrecondition,
+	twirp.ResourceExhausted:  ErrResourceExhausted,
+	twirp.Unavailable:        ErrUnavailable,
+	twirp.Unimplemented:      ErrUnimplemented,
+	twirp.Internal:           ErrInternal,
+	twirp.Unknown:            ErrInternal,
+	twirp.DataLoss:           ErrInternal,
+}
+
+// Error is returned for errors reported by the server.
+// It matches the Err* values with errors.Is, and unwraps to the twirp.Error.
+type Error struct {
+	Code twirp.ErrorCode
+	Msg  string
+	Meta map[string]string
+
+	err twirp.Error
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+func (e *Error) Is(target error) bool {
+	return target != nil && codeErrors[e.Code] == target
+}
+
+func wrapError(err error) error {
+	var terr twirp.Error
+	if !errors.As(err, &terr) {
+		return err
+	}
+	return &Error{
+		Code: terr.Code(),
+		Msg:  terr.Msg(),
+		Meta: terr.MetaMap(),
+		err:  terr,
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package client
+
+import (
+	"context"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+)
+
+type IngressClient struct {
+	base
+	svc livekit.Ingress
+}
+
+func NewIngressClient(params Params) *IngressClient {
+	b, url, hc, opts := newBase(params)
+	return &IngressClient{
+		base: b,
+		svc:  livekit.NewIngressProtobufClient(url, hc, opts...),
+	}
+}
+
+func ingressAdmin() grant {
+	return videoGrant(&auth.VideoGrant{IngressAdmin: true})
+}
+
+func (c *IngressClient) CreateIngress(ctx context.Context, req *livekit.CreateIngressRequest) (*livekit.IngressInfo, error) {
+	return call(ctx, &c.base, c.svc.CreateIngress, req, ingressAdmin())
+}
+
+func (c *IngressClient) UpdateIngress(ctx context.Context, req *livekit.UpdateIngressRequest) (*livekit.IngressInfo, error) {
+	return call(ctx, &c.base, c.svc.UpdateIngress, req, ingressAdmin())
+}
+
+func (c *IngressClient) ListIngress(ctx context.Context, req *livekit.ListIngressRequest) (*livekit.ListIngressResponse, error) {
+	return call(ctx, &c.base, c.svc.ListIngress, req, ingressAdmin())
+}
+
+func (c *IngressClient) DeleteIngress(ctx context.Context, req *livekit.DeleteIngressRequest) (*livekit.IngressInfo, error) {
+	return call(ctx, &c.base, c.svc.DeleteIngress, req, ingressAdmin())
+}
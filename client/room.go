@@ -0,0 +1,94 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package client
+
+import (
+	"context"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+)
+
+type RoomClient struct {
+	base
+	svc livekit.RoomService
+}
+
+func NewRoomClient(params Params) *RoomClient {
+	b, url, hc, opts := newBase(params)
+	return &RoomClient{
+		base: b,
+		svc:  livekit.NewRoomServiceProtobufClient(url, hc, opts...),
+	}
+}
+
+func roomAdmin(room string) grant {
+	return videoGrant(&auth.VideoGrant{RoomAdmin: true, Room: room})
+}
+
+func (c *RoomClient) CreateRoom(ctx context.Context, req *livekit.CreateRoomRequest) (*livekit.Room, error) {
+	return call(ctx, &c.base, c.svc.CreateRoom, req, videoGrant(&auth.VideoGrant{RoomCreate: true}))
+}
+
+func (c *RoomClient) ListRooms(ctx context.Context, req *livekit.ListRoomsRequest) (*livekit.ListRoomsResponse, error) {
+	return call(ctx, &c.base, c.svc.ListRooms, req, videoGrant(&auth.VideoGrant{RoomList: true}))
+}
+
+func (c *RoomClient) DeleteRoom(ctx context.Context, req *livekit.DeleteRoomRequest) (*livekit.DeleteRoomResponse, error) {
+	return call(ctx, &c.base, c.svc.DeleteRoom, req, videoGrant(&auth.VideoGrant{RoomCreate: true}))
+}
+
+func (c *RoomClient) ListParticipants(ctx context.Context, req *livekit.ListParticipantsRequest) (*livekit.ListParticipantsResponse, error) {
+	return call(ctx, &c.base, c.svc.ListParticipants, req, roomAdmin(req.Room))
+}
+
+func (c *RoomClient) GetParticipant(ctx context.Context, req *livekit.RoomParticipantIdentity) (*livekit.ParticipantInfo, error) {
+	return call(ctx, &c.base, c.svc.GetParticipant, req, roomAdmin(req.Room))
+}
+
+func (c *RoomClient) RemoveParticipant(ctx context.Context, req *livekit.RoomParticipantIdentity) (*livekit.RemoveParticipantResponse, error) {
+	return call(ctx, &c.base, c.svc.RemoveParticipant, req, roomAdmin(req.Room))
+}
+
+func (c *RoomClient) MutePublishedTrack(ctx context.Context, req *livekit.MuteRoomTrackRequest) (*livekit.MuteRoomTrackResponse, error) {
+	return call(ctx, &c.base, c.svc.MutePublishedTrack, req, roomAdmin(req.Room))
+}
+
+func (c *RoomClient) UpdateParticipant(ctx context.Context, req *livekit.UpdateParticipantRequest) (*livekit.ParticipantInfo, error) {
+	return call(ctx, &c.base, c.svc.UpdateParticipant, req, roomAdmin(req.Room))
+}
+
+func (c *RoomClient) UpdateSubscriptions(ctx context.Context, req *livekit.UpdateSubscriptionsRequest) (*livekit.UpdateSubscriptionsResponse, error) {
+	return call(ctx, &c.base, c.svc.UpdateSubscriptions, req, roomAdmin(req.Room))
+}
+
+func (c *RoomClient) SendData(ctx context.Context, req *livekit.SendDataRequest) (*livekit.SendDataResponse, error) {
+	return call(ctx, &c.base, c.svc.SendData, req, roomAdmin(req.Room))
+}
+
+func (c *RoomClient) UpdateRoomMetadata(ctx context.Context, req *livekit.UpdateRoomMetadataRequest) (*livekit.Room, error) {
+	return call(ctx, &c.base, c.svc.UpdateRoomMetadata, req, roomAdmin(req.Room))
+}
+
+func (c *RoomClient) ForwardParticipant(ctx context.Context, req *livekit.ForwardParticipantRequest) (*livekit.ForwardParticipantResponse, error) {
+	return call(ctx, &c.base, c.svc.ForwardParticipant, req, roomAdmin(req.Room))
+}
+
+func (c *RoomClient) MoveParticipant(ctx context.Context, req *livekit.MoveParticipantRequest) (*livekit.MoveParticipantResponse, error) {
+	return call(ctx, &c.base, c.svc.MoveParticipant, req, videoGrant(&auth.VideoGrant{RoomAdmin: true, Room: req.Room, DestinationRoom: req.DestinationRoom}))
+}
+
+func (c *RoomClient) RequestRoomPreview(ctx context.Context, req *livekit.RoomPreviewRequest) (*livekit.RoomPreviewResponse, error) {
+	return call(ctx, &c.base, c.svc.RequestRoomPreview, req, roomAdmin(req.Room))
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package client
+
+import (
+	"context"
+
+	"github.com/dennwc/iters"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+)
+
+type SIPClient struct {
+	base
+	svc livekit.SIP
+}
+
+func NewSIPClient(params Params) *SIPClient {
+	b, url, hc, opts := newBase(params)
+	return &SIPClient{
+		base: b,
+		svc:  livekit.NewSIPProtobufClient(url, hc, opts...),
+	}
+}
+
+func sipAdmin() grant {
+	return sipGrant(&auth.SIPGrant{Admin: true})
+}
+
+func (c *SIPClient) CreateSIPInboundTrunk(ctx context.Context, req *livekit.CreateSIPInboundTrunkRequest) (*livekit.SIPInboundTrunkInfo, error) {
+	return call(ctx, &c.base, c.svc.CreateSIPInboundTrunk, req, sipAdmin())
+}
+
+func (c *SIPClient) CreateSIPOutboundTrunk(ctx context.Context, req *livekit.CreateSIPOutboundTrunkRequest) (*livekit.SIPOutboundTrunkInfo, error) {
+	return call(ctx, &c.base, c.svc.CreateSIPOutboundTrunk, req, sipAdmin())
+}
+
+func (c *SIPClient) UpdateSIPInboundTrunk(ctx context.Context, req *livekit.UpdateSIPInboundTrunkRequest) (*livekit.SIPInboundTrunkInfo, error) {
+	return call(ctx, &c.base, c.svc.UpdateSIPInboundTrunk, req, sipAdmin())
+}
+
+func (c *SIPClient) UpdateSIPOutboundTrunk(ctx context.Context, req *livekit.UpdateSIPOutboundTrunkRequest) (*livekit.SIPOutboundTrunkInfo, error) {
+	return call(ctx, &c.base, c.svc.UpdateSIPOutboundTrunk, req, sipAdmin())
+}
+
+func (c *SIPClient) GetSIPInboundTrunk(ctx context.Context, req *livekit.GetSIPInboundTrunkRequest) (*livekit.GetSIPInboundTrunkResponse, error) {
+	return call(ctx, &c.base, c.svc.GetSIPInboundTrunk, req, sipAdmin())
+}
+
+func (c *SIPClient) GetSIPOutboundTrunk(ctx context.Context, req *livekit.GetSIPOutboundTrunkRequest) (*livekit.GetSIPOutboundTrunkResponse, error) {
+	return call(ctx, &c.base, c.svc.GetSIPOutboundTrunk, req, sipAdmin())
+}
+
+func (c *SIPClient) ListSIPInboundTrunk(ctx context.Context, req *livekit.ListSIPInboundTrunkRequest) (*livekit.ListSIPInboundTrunkResponse, error) {
+	return call(ctx, &c.base, c.svc.ListSIPInboundTrunk, req, sipAdmin())
+}
+
+// ListSIPInboundTrunks iterates over pages of inbound trunks. When req.Page is nil, all trunks are returned at once.
+func (c *SIPClient) ListSIPInboundTrunks(req *livekit.ListSIPInboundTrunkRequest) iters.PageIter[*livekit.SIPInboundTrunkInfo] {
+	return livekit.ListPageIter(c.ListSIPInboundTrunk, req)
+}
+
+func (c *SIPClient) ListSIPOutboundTrunk(ctx context.Context, req *livekit.ListSIPOutboundTrunkRequest) (*livekit.ListSIPOutboundTrunkResponse, error) {
+	return call(ctx, &c.base, c.svc.ListSIPOutboundTrunk, req, sipAdmin())
+}
+
+// ListSIPOutboundTrunks iterates over pages of outbound trunks. When req.Page is nil, all trunks are returned at once.
+func (c *SIPClient) ListSIPOutboundTrunks(req *livekit.ListSIPOutboundTrunkRequest) iters.PageIter[*livekit.SIPOutboundTrunkInfo] {
+	return livekit.ListPageIter(c.ListSIPOutboundTrunk, req)
+}
+
+func (c *SIPClient) DeleteSIPTrunk(ctx context.Context, req *livekit.DeleteSIPTrunkRequest) (*livekit.SIPTrunkInfo, error) {
+	return call(ctx, &c.base, c.svc.DeleteSIPTrunk, req, sipAdmin())
+}
+
+func (c *SIPClient) RotateSIPTrunkCredentials(ctx context.Context, req *livekit.RotateSIPTrunkCredentialsRequest) (*livekit.SIPTrunkCredentialRotation, error) {
+	return call(ctx, &c.base, c.svc.RotateSIPTrunkCredentials, req, sipAdmin())
+}
+
+func (c *SIPClient) GetSIPTrunkCredentialRotation(ctx context.Context, req *livekit.GetSIPTrunkCredentialRotationRequest) (*livekit.SIPTrunkCredentialRotation, error) {
+	return call(ctx, &c.base, c.svc.GetSIPTrunkCredentialRotation, req, sipAdmin())
+}
+
+func (c *SIPClient) RollbackSIPTrunkCredentialRotation(ctx context.Context, req *livekit.RollbackSIPTrunkCredentialRotationRequest) (*livekit.SIPTrunkCredentialRotation, error) {
+	return call(ctx, &c.base, c.svc.RollbackSIPTrunkCredentialRotation, req, sipAdmin())
+}
+
+func (c *SIPClient) CompleteSIPTrunkCredentialRotation(ctx context.Context, req *livekit.CompleteSIPTrunkCredentialRotationRequest) (*livekit.SIPTrunkCredentialRotation, error) {
+	return call(ctx, &c.base, c.svc.CompleteSIPTrunkCredentialRotation, req, sipAdmin())
+}
+
+func (c *SIPClient) CreateSIPDispatchRule(ctx context.Context, req *livekit.CreateSIPDispatchRuleRequest) (*livekit.SIPDispatchRuleInfo, error) {
+	return call(ctx, &c.base, c.svc.CreateSIPDispatchRule, req, sipAdmin())
+}
+
+func (c *SIPClient) UpdateSIPDispatchRule(ctx context.Context, req *livekit.UpdateSIPDispatchRuleRequest) (*livekit.SIPDispatchRuleInfo, error) {
+	return call(ctx, &c.base, c.svc.UpdateSIPDispatchRule, req, sipAdmin())
+}
+
+func (c *SIPClient) ListSIPDispatchRule(ctx context.Context, req *livekit.ListSIPDispatchRuleRequest) (*livekit.ListSIPDispatchRuleResponse, error) {
+	return call(ctx, &c.base, c.svc.ListSIPDispatchRule, req, sipAdmin())
+}
+
+// ListSIPDispatchRules iterates over pages of dispatch rules. When req.Page is nil, all rules are returned at once.
+func (c *SIPClient) ListSIPDispatchRules(req *livekit.ListSIPDispatchRuleRequest) iters.PageIter[*livekit.SIPDispatchRuleInfo] {
+	return livekit.ListPageIter(c.ListSIPDispatchRule, req)
+}
+
+func (c *SIPClient) DeleteSIPDispatchRule(ctx context.Context, req *livekit.DeleteSIPDispatchRuleRequest) (*livekit.SIPDispatchRuleInfo, error) {
+	return call(ctx, &c.base, c.svc.DeleteSIPDispatchRule, req, sipAdmin())
+}
+
+func (c *SIPClient) CreateSIPParticipant(ctx context.Context, req *livekit.CreateSIPParticipantRequest) (*livekit.SIPParticipantInfo, error) {
+	return call(ctx, &c.base, c.svc.CreateSIPParticipant, req, sipGrant(&auth.SIPGrant{Call: true}))
+}
+
+func (c *SIPClient) TransferSIPParticipant(ctx context.Context, req *livekit.TransferSIPParticipantRequest) (*emptypb.Empty, error) {
+	return call(ctx, &c.base, c.svc.TransferSIPParticipant, req, sipGrant(&auth.SIPGrant{Call: true}), roomAdmin(req.RoomName))
+}
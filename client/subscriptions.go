@@ -0,0 +1,221 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/utils"
+)
+
+const (
+	DefaultSubscriptionFlushInterval = 100 * time.Millisecond
+	DefaultSubscriptionRateLimit     = 10
+)
+
+var ErrBatcherClosed = errors.New("subscription batcher is closed")
+
+type SubscriptionUpdater interface {
+	UpdateSubscriptions(ctx context.Context, req *livekit.UpdateSubscriptionsRequest) (*livekit.UpdateSubscriptionsResponse, error)
+}
+
+var _ SubscriptionUpdater = (*RoomClient)(nil)
+
+type SubscriptionBatcherParams struct {
+	// pending changes are sent after this delay, coalescing the changes made meanwhile
+	FlushInterval time.Duration
+	// maximum number of requests per second, and the number of requests allowed above it in bursts
+	RateLimit int
+	Burst     int
+	// called for requests failing in background flushes, errors are logged by default
+	OnError func(req *livekit.UpdateSubscriptionsRequest, err error)
+}
+
+type participantKey struct {
+	room     string
+	identity string
+}
+
+// SubscriptionBatcher collects subscription changes and sends them as one request per participant
+// and direction. Changes to the same track made before a flush are coalesced, the last one wins.
+type SubscriptionBatcher struct {
+	updater SubscriptionUpdater
+	params  SubscriptionBatcherParams
+	limiter *utils.LeakyBucket
+
+	mu      sync.Mutex
+	pending map[participantKey]map[string]bool
+	order   []participantKey
+	timer   *time.Timer
+	closed  bool
+
+	// serializes flushes, so changes are applied in order
+	flushMu sync.Mutex
+}
+
+func NewSubscriptionBatcher(updater SubscriptionUpdater, params SubscriptionBatcherParams) *SubscriptionBatcher {
+	if params.FlushInterval <= 0 {
+		params.FlushInterval = DefaultSubscriptionFlushInterval
+	}
+	if params.RateLimit <= 0 {
+		params.RateLimit = DefaultSubscriptionRateLimit
+	}
+	if params.OnError == nil {
+		params.OnError = func(req *livekit.UpdateSubscriptionsRequest, err error) {
+			logger.Warnw("failed to update subscriptions", err, "room", req.Room, "participant", req.Identity)
+		}
+	}
+	return &SubscriptionBatcher{
+		updater: updater,
+		params:  params,
+		limiter: utils.NewLeakyBucket(params.RateLimit, params.Burst, utils.SystemClock{}),
+		pending: make(map[participantKey]map[string]bool),
+	}
+}
+
+func (b *SubscriptionBatcher) Subscribe(room, identity string, trackSids ...string) error {
+	return b.update(room, identity, trackSids, true)
+}
+
+func (b *SubscriptionBatcher) Unsubscribe(room, identity string, trackSids ...string) error {
+	return b.update(room, identity, trackSids, false)
+}
+
+func (b *SubscriptionBatcher) update(room, identity string, trackSids []string, subscribe bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return ErrBatcherClosed
+	}
+
+	key := participantKey{room: room, identity: identity}
+	tracks := b.pending[key]
+	if tracks == nil {
+		tracks = make(map[string]bool)
+		b.pending[key] = tracks
+		b.order = append(b.order, key)
+	}
+	for _, sid := range trackSids {
+		tracks[sid] = subscribe
+	}
+
+	if b.timer == nil {
+		b.timer = time.AfterFunc(b.params.FlushInterval, func() {
+			b.flush(context.Background(), b.params.OnError)
+		})
+	}
+	return nil
+}
+
+// Flush sends the pending changes immediately. Changes which fail to be sent stay pending.
+func (b *SubscriptionBatcher) Flush(ctx context.Context) error {
+	var errs []error
+	b.flush(ctx, func(_ *livekit.UpdateSubscriptionsRequest, err error) {
+		errs = append(errs, err)
+	})
+	return errors.Join(errs...)
+}
+
+// Close flushes the pending changes. Changes made afterwards are rejected.
+func (b *SubscriptionBatcher) Close(ctx context.Context) error {
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+	return b.Flush(ctx)
+}
+
+func (b *SubscriptionBatcher) flush(ctx context.Context, onError func(req *livekit.UpdateSubscriptionsRequest, err error)) {
+	b.flushMu.Lock()
+	defer b.flushMu.Unlock()
+
+	b.mu.Lock()
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	pending, order := b.pending, b.order
+	b.pending = make(map[participantKey]map[string]bool)
+	b.order = nil
+	b.mu.Unlock()
+
+	for _, req := range batchRequests(pending, order) {
+		err := b.limiter.Wait(ctx)
+		if err == nil {
+			_, err = b.updater.UpdateSubscriptions(ctx, req)
+		}
+		if err != nil {
+			b.requeue(req)
+			onError(req, err)
+		}
+	}
+}
+
+// requeue adds the changes of a failed request back to the pending ones, unless the tracks were updated since.
+// They are retried with the next flush.
+func (b *SubscriptionBatcher) requeue(req *livekit.UpdateSubscriptionsRequest) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := participantKey{room: req.Room, identity: req.Identity}
+	tracks := b.pending[key]
+	if tracks == nil {
+		tracks = make(map[string]bool)
+		b.pending[key] = tracks
+		b.order = append(b.order, key)
+	}
+	for _, sid := range req.TrackSids {
+		if _, ok := tracks[sid]; !ok {
+			tracks[sid] = req.Subscribe
+		}
+	}
+}
+
+// batchRequests returns the requests applying the changes, in the order participants were first updated
+func batchRequests(pending map[participantKey]map[string]bool, order []participantKey) []*livekit.UpdateSubscriptionsRequest {
+	var reqs []*livekit.UpdateSubscriptionsRequest
+	for _, key := range order {
+		var subscribe, unsubscribe []string
+		for sid, sub := range pending[key] {
+			if sub {
+				subscribe = append(subscribe, sid)
+			} else {
+				unsubscribe = append(unsubscribe, sid)
+			}
+		}
+		// unsubscribing first frees up bandwidth for the new subscriptions
+		for _, r := range []struct {
+			sids      []string
+			subscribe bool
+		}{{unsubscribe, false}, {subscribe, true}} {
+			if len(r.sids) == 0 {
+				continue
+			}
+			slices.Sort(r.sids)
+			reqs = append(reqs, &livekit.UpdateSubscriptionsRequest{
+				Room:      key.room,
+				Identity:  key.identity,
+				TrackSids: r.sids,
+				Subscribe: r.subscribe,
+			})
+		}
+	}
+	return reqs
+}
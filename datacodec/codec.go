@@ -0,0 +1,218 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package datacodec serializes structured user data into UserPacket payloads tagged with their content type.
+package datacodec
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+const (
+	ContentTypeRaw      = "application/octet-stream"
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+	// no codecs are built in for CBOR and MessagePack, applications register the library of their choice
+	ContentTypeCBOR    = "application/cbor"
+	ContentTypeMsgPack = "application/msgpack"
+
+	// max size of encoded payloads, data packets above it may not be delivered reliably
+	DefaultMaxPayloadSize = 15 * 1024
+)
+
+var (
+	ErrUnknownContentType = errors.New("unknown content type")
+	ErrPayloadTooLarge    = errors.New("payload is too large")
+	ErrUnsupportedValue   = errors.New("unsupported value type")
+)
+
+// Codec serializes values for a content type.
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// Registry maps content types to codecs. It is safe for concurrent use.
+type Registry struct {
+	mu             sync.RWMutex
+	codecs         map[string]Codec
+	maxPayloadSize int
+}
+
+// NewRegistry returns a registry with the raw, JSON and protobuf codecs.
+// A maxPayloadSize of 0 uses DefaultMaxPayloadSize, a negative one disables the limit.
+func NewRegistry(maxPayloadSize int) *Registry {
+	if maxPayloadSize == 0 {
+		maxPayloadSize = DefaultMaxPayloadSize
+	}
+	r := &Registry{
+		codecs:         make(map[string]Codec),
+		maxPayloadSize: maxPayloadSize,
+	}
+	r.Register(ContentTypeRaw, RawCodec{})
+	r.Register(ContentTypeJSON, JSONCodec{})
+	r.Register(ContentTypeProtobuf, ProtobufCodec{})
+	return r
+}
+
+var defaultRegistry = NewRegistry(0)
+
+// Default returns the shared registry used by the package level functions.
+func Default() *Registry {
+	return defaultRegistry
+}
+
+func normalizeContentType(contentType string) string {
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	if contentType == "" {
+		return ContentTypeRaw
+	}
+	return contentType
+}
+
+// Register adds or replaces the codec for the content type.
+func (r *Registry) Register(contentType string, c Codec) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.codecs[normalizeContentType(contentType)] = c
+}
+
+func (r *Registry) Lookup(contentType string) (Codec, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.codecs[normalizeContentType(contentType)]
+	return c, ok
+}
+
+// ContentTypes returns the registered content types, sorted.
+func (r *Registry) ContentTypes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	types := make([]string, 0, len(r.codecs))
+	for t := range r.codecs {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Encode serializes v into the payload of a UserPacket tagged with the content type.
+func (r *Registry) Encode(contentType string, v any) (*livekit.UserPacket, error) {
+	contentType = normalizeContentType(contentType)
+	c, ok := r.Lookup(contentType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownContentType, contentType)
+	}
+	payload, err := c.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if r.maxPayloadSize > 0 && len(payload) > r.maxPayloadSize {
+		return nil, fmt.Errorf("%w: %d > %d", ErrPayloadTooLarge, len(payload), r.maxPayloadSize)
+	}
+	pkt := &livekit.UserPacket{Payload: payload}
+	if contentType != ContentTypeRaw {
+		pkt.ContentType = contentType
+	}
+	return pkt, nil
+}
+
+// Decode deserializes the payload of a UserPacket into v, using the codec of its content type.
+func (r *Registry) Decode(pkt *livekit.UserPacket, v any) error {
+	if r.maxPayloadSize > 0 && len(pkt.Payload) > r.maxPayloadSize {
+		return fmt.Errorf("%w: %d > %d", ErrPayloadTooLarge, len(pkt.Payload), r.maxPayloadSize)
+	}
+	c, ok := r.Lookup(pkt.ContentType)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownContentType, pkt.ContentType)
+	}
+	return c.Unmarshal(pkt.Payload, v)
+}
+
+func Register(contentType string, c Codec) {
+	defaultRegistry.Register(contentType, c)
+}
+
+func Encode(contentType string, v any) (*livekit.UserPacket, error) {
+	return defaultRegistry.Encode(contentType, v)
+}
+
+func Decode(pkt *livekit.UserPacket, v any) error {
+	return defaultRegistry.Decode(pkt, v)
+}
+
+// RawCodec passes []byte payloads through.
+type RawCodec struct{}
+
+func (RawCodec) Marshal(v any) ([]byte, error) {
+	switch b := v.(type) {
+	case []byte:
+		return b, nil
+	case string:
+		return []byte(b), nil
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedValue, v)
+	}
+}
+
+func (RawCodec) Unmarshal(data []byte, v any) error {
+	switch b := v.(type) {
+	case *[]byte:
+		*b = append((*b)[:0], data...)
+		return nil
+	case *string:
+		*b = string(data)
+		return nil
+	default:
+		return fmt.Errorf("%w: %T", ErrUnsupportedValue, v)
+	}
+}
+
+type JSONCodec struct{}
+
+func (JSONCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (JSONCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+// ProtobufCodec serializes proto.Message values in the binary wire format.
+type ProtobufCodec struct{}
+
+func (ProtobufCodec) Marshal(v any) ([]byte, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedValue, v)
+	}
+	return proto.Marshal(m)
+}
+
+func (ProtobufCodec) Unmarshal(data []byte, v any) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrUnsupportedValue, v)
+	}
+	return proto.Unmarshal(data, m)
+}
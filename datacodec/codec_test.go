@@ -0,0 +1,125 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacodec
+
+import (
+	"bytes"
+	"encoding/gob"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+type gobCodec struct{}
+
+func (gobCodec) Marshal(v any) ([]byte, error) {
+	var b bytes.Buffer
+	err := gob.NewEncoder(&b).Encode(v)
+	return b.Bytes(), err
+}
+
+func (gobCodec) Unmarshal(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+type message struct {
+	Text  string `json:"text"`
+	Count int    `json:"count"`
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry(64)
+
+	t.Run("json", func(t *testing.T) {
+		pkt, err := r.Encode(ContentTypeJSON, message{Text: "hi", Count: 2})
+		require.NoError(t, err)
+		require.Equal(t, ContentTypeJSON, pkt.ContentType)
+
+		var m message
+		require.NoError(t, r.Decode(pkt, &m))
+		require.Equal(t, message{Text: "hi", Count: 2}, m)
+	})
+
+	t.Run("protobuf", func(t *testing.T) {
+		pkt, err := r.Encode(ContentTypeProtobuf, &livekit.SipDTMF{Code: 1, Digit: "1"})
+		require.NoError(t, err)
+
+		var m livekit.SipDTMF
+		require.NoError(t, r.Decode(pkt, &m))
+		require.True(t, proto.Equal(&livekit.SipDTMF{Code: 1, Digit: "1"}, &m))
+
+		_, err = r.Encode(ContentTypeProtobuf, message{})
+		require.ErrorIs(t, err, ErrUnsupportedValue)
+	})
+
+	t.Run("raw", func(t *testing.T) {
+		pkt, err := r.Encode("", []byte("data"))
+		require.NoError(t, err)
+		require.Empty(t, pkt.ContentType)
+
+		// packets of clients unaware of content types are raw
+		var b []byte
+		require.NoError(t, r.Decode(&livekit.UserPacket{Payload: []byte("data")}, &b))
+		require.Equal(t, []byte("data"), b)
+	})
+
+	t.Run("registered codec", func(t *testing.T) {
+		_, err := r.Encode("application/x-gob", message{})
+		require.ErrorIs(t, err, ErrUnknownContentType)
+
+		r.Register("Application/X-Gob", gobCodec{})
+		pkt, err := r.Encode("application/x-gob", message{Text: "hi"})
+		require.NoError(t, err)
+		require.Equal(t, "application/x-gob", pkt.ContentType)
+
+		var m message
+		require.NoError(t, r.Decode(pkt, &m))
+		require.Equal(t, "hi", m.Text)
+		require.Contains(t, r.ContentTypes(), "application/x-gob")
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		_, err := r.Encode(ContentTypeRaw, strings.Repeat("a", 65))
+		require.ErrorIs(t, err, ErrPayloadTooLarge)
+		err = r.Decode(&livekit.UserPacket{Payload: make([]byte, 65)}, new([]byte))
+		require.ErrorIs(t, err, ErrPayloadTooLarge)
+	})
+}
+
+func TestNegotiate(t *testing.T) {
+	a := &livekit.ParticipantInfo{Attributes: map[string]string{}}
+	SetSupportedContentTypes(a.Attributes, []string{ContentTypeCBOR, ContentTypeJSON, ContentTypeRaw})
+	b := &livekit.ParticipantInfo{Attributes: map[string]string{livekit.AttrDataContentTypes: "application/json, application/octet-stream"}}
+	legacy := &livekit.ParticipantInfo{}
+
+	contentType, ok := Negotiate([]string{ContentTypeCBOR, ContentTypeJSON}, a)
+	require.True(t, ok)
+	require.Equal(t, ContentTypeCBOR, contentType)
+
+	contentType, ok = Negotiate([]string{ContentTypeCBOR, ContentTypeJSON}, a, b)
+	require.True(t, ok)
+	require.Equal(t, ContentTypeJSON, contentType)
+
+	_, ok = Negotiate([]string{ContentTypeCBOR, ContentTypeJSON}, a, b, legacy)
+	require.False(t, ok)
+
+	contentType, ok = Negotiate([]string{ContentTypeJSON, ContentTypeRaw}, a, b, legacy)
+	require.True(t, ok)
+	require.Equal(t, ContentTypeRaw, contentType)
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacodec
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+// SetSupportedContentTypes advertises the content types a participant can decode, in order of preference,
+// in its attributes.
+func SetSupportedContentTypes(attrs map[string]string, contentTypes []string) {
+	normalized := make([]string, 0, len(contentTypes))
+	for _, t := range contentTypes {
+		normalized = append(normalized, normalizeContentType(t))
+	}
+	attrs[livekit.AttrDataContentTypes] = strings.Join(normalized, ",")
+}
+
+// SupportedContentTypes returns the content types advertised by the participant.
+// Participants which do not advertise any are assumed to only accept raw payloads.
+func SupportedContentTypes(p *livekit.ParticipantInfo) []string {
+	v := p.GetAttributes()[livekit.AttrDataContentTypes]
+	if v == "" {
+		return []string{ContentTypeRaw}
+	}
+	var types []string
+	for _, t := range strings.Split(v, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			types = append(types, normalizeContentType(t))
+		}
+	}
+	return types
+}
+
+// Negotiate returns the first of the preferred content types which all recipients support.
+func Negotiate(preferred []string, recipients ...*livekit.ParticipantInfo) (string, bool) {
+	for _, t := range preferred {
+		t = normalizeContentType(t)
+		supported := true
+		for _, p := range recipients {
+			if !slices.Contains(SupportedContentTypes(p), t) {
+				supported = false
+				break
+			}
+		}
+		if supported {
+			return t, true
+		}
+	}
+	return "", false
+}
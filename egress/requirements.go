@@ -0,0 +1,87 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/rpc"
+)
+
+type encodingRequest interface {
+	GetPreset() livekit.EncodingOptionsPreset
+	GetAdvanced() *livekit.EncodingOptions
+}
+
+type presetOptions struct {
+	width, height, framerate uint32
+}
+
+var presets = map[livekit.EncodingOptionsPreset]presetOptions{
+	livekit.EncodingOptionsPreset_H264_720P_30:           {1280, 720, 30},
+	livekit.EncodingOptionsPreset_H264_720P_60:           {1280, 720, 60},
+	livekit.EncodingOptionsPreset_H264_1080P_30:          {1920, 1080, 30},
+	livekit.EncodingOptionsPreset_H264_1080P_60:          {1920, 1080, 60},
+	livekit.EncodingOptionsPreset_PORTRAIT_H264_720P_30:  {720, 1280, 30},
+	livekit.EncodingOptionsPreset_PORTRAIT_H264_720P_60:  {720, 1280, 60},
+	livekit.EncodingOptionsPreset_PORTRAIT_H264_1080P_30: {1080, 1920, 30},
+	livekit.EncodingOptionsPreset_PORTRAIT_H264_1080P_60: {1080, 1920, 60},
+}
+
+// GetRequirements returns the worker requirements of a start request. Requirements set on the request take precedence.
+func GetRequirements(req *rpc.StartEgressRequest) *livekit.MediaWorkerRequirements {
+	if req.Requirements != nil {
+		return req.Requirements
+	}
+
+	r := &livekit.MediaWorkerRequirements{
+		EstimatedCpu: req.EstimatedCpu,
+	}
+
+	var enc encodingRequest
+	switch v := req.Request.(type) {
+	case *rpc.StartEgressRequest_RoomComposite:
+		r.RequestType = EgressTypeRoomComposite
+		enc = v.RoomComposite
+	case *rpc.StartEgressRequest_Web:
+		r.RequestType = EgressTypeWeb
+		enc = v.Web
+	case *rpc.StartEgressRequest_Participant:
+		r.RequestType = EgressTypeParticipant
+		enc = v.Participant
+	case *rpc.StartEgressRequest_TrackComposite:
+		r.RequestType = EgressTypeTrackComposite
+		enc = v.TrackComposite
+	case *rpc.StartEgressRequest_Track:
+		// track egress does not transcode
+		r.RequestType = EgressTypeTrack
+		return r
+	}
+	if enc == nil {
+		return r
+	}
+
+	if adv := enc.GetAdvanced(); adv != nil {
+		r.Width = uint32(max(adv.Width, 0))
+		r.Height = uint32(max(adv.Height, 0))
+		r.Framerate = uint32(max(adv.Framerate, 0))
+		r.VideoCodec = adv.VideoCodec
+		r.AudioCodec = adv.AudioCodec
+	} else if p, ok := presets[enc.GetPreset()]; ok {
+		r.Width, r.Height, r.Framerate = p.width, p.height, p.framerate
+		r.VideoCodec = livekit.VideoCodec_H264_MAIN
+		r.AudioCodec = livekit.AudioCodec_OPUS
+	}
+	return r
+}
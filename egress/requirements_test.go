@@ -0,0 +1,43 @@
+package egress
+
+import (
+	"testing"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRequirements(t *testing.T) {
+	r := GetRequirements(&rpc.StartEgressRequest{
+		Request: &rpc.StartEgressRequest_RoomComposite{
+			RoomComposite: &livekit.RoomCompositeEgressRequest{
+				Options: &livekit.RoomCompositeEgressRequest_Preset{Preset: livekit.EncodingOptionsPreset_PORTRAIT_H264_1080P_60},
+			},
+		},
+		EstimatedCpu: 3,
+	})
+	require.Equal(t, EgressTypeRoomComposite, r.RequestType)
+	require.Equal(t, uint32(1080), r.Width)
+	require.Equal(t, uint32(1920), r.Height)
+	require.Equal(t, uint32(60), r.Framerate)
+	require.Equal(t, float64(3), r.EstimatedCpu)
+
+	r = GetRequirements(&rpc.StartEgressRequest{
+		Request: &rpc.StartEgressRequest_Web{
+			Web: &livekit.WebEgressRequest{
+				Options: &livekit.WebEgressRequest_Advanced{Advanced: &livekit.EncodingOptions{
+					Width:      3840,
+					Height:     2160,
+					VideoCodec: livekit.VideoCodec_H264_HIGH,
+				}},
+			},
+		},
+	})
+	require.Equal(t, EgressTypeWeb, r.RequestType)
+	require.Equal(t, uint32(3840), r.Width)
+	require.Equal(t, livekit.VideoCodec_H264_HIGH, r.VideoCodec)
+
+	explicit := &livekit.MediaWorkerRequirements{RequireGpu: true}
+	require.Equal(t, explicit, GetRequirements(&rpc.StartEgressRequest{Requirements: explicit}))
+}
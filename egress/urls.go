@@ -0,0 +1,89 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"fmt"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/utils/urlguard"
+)
+
+var (
+	StreamURLSchemes    = []string{"rtmp", "rtmps", "srt"}
+	WebURLSchemes       = []string{"https", "http"}
+	WebsocketURLSchemes = []string{"wss", "ws"}
+)
+
+// ValidateURLs checks the user supplied URLs of a start or update request which egress will connect to:
+// stream outputs, the page of a web egress, the template of a room composite and the websocket of a track egress.
+// Upload endpoints are not included, since they are configured by the operator as often as by users.
+func ValidateURLs(req interface{}, g *urlguard.Guard) error {
+	if g == nil {
+		return nil
+	}
+
+	if r, ok := req.(*livekit.WebEgressRequest); ok {
+		if err := g.Validate(r.Url, WebURLSchemes...); err != nil {
+			return fmt.Errorf("url: %w", err)
+		}
+	}
+
+	// the template is loaded in a browser like the page of a web egress
+	if r, ok := req.(*livekit.RoomCompositeEgressRequest); ok && r.CustomBaseUrl != "" {
+		if err := g.Validate(r.CustomBaseUrl, WebURLSchemes...); err != nil {
+			return fmt.Errorf("custom_base_url: %w", err)
+		}
+	}
+
+	if r, ok := req.(EncodedOutputDeprecated); ok {
+		if err := validateStreamURLs("stream", r.GetStream().GetUrls(), g); err != nil {
+			return err
+		}
+	}
+
+	if r, ok := req.(EncodedOutput); ok {
+		for i, o := range r.GetStreamOutputs() {
+			if err := validateStreamURLs(fmt.Sprintf("stream_outputs[%d]", i), o.GetUrls(), g); err != nil {
+				return err
+			}
+		}
+	}
+
+	if r, ok := req.(DirectOutput); ok {
+		if u := r.GetWebsocketUrl(); u != "" {
+			if err := g.Validate(u, WebsocketURLSchemes...); err != nil {
+				return fmt.Errorf("websocket_url: %w", err)
+			}
+		}
+	}
+
+	if r, ok := req.(*livekit.UpdateStreamRequest); ok {
+		if err := validateStreamURLs("add_output_urls", r.AddOutputUrls, g); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateStreamURLs(field string, urls []string, g *urlguard.Guard) error {
+	for i, u := range urls {
+		if err := g.Validate(u, StreamURLSchemes...); err != nil {
+			return fmt.Errorf("%s.urls[%d]: %w", field, i, err)
+		}
+	}
+	return nil
+}
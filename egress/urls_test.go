@@ -0,0 +1,54 @@
+package egress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/utils/urlguard"
+)
+
+func TestValidateURLs(t *testing.T) {
+	g, err := urlguard.New(urlguard.Config{})
+	require.NoError(t, err)
+
+	require.NoError(t, ValidateURLs(&livekit.WebEgressRequest{
+		Url: "https://example.com/layout",
+		StreamOutputs: []*livekit.StreamOutput{{
+			Urls: []string{"rtmps://live.example.com/app/key", "srt://srt.example.com:9000"},
+		}},
+	}, g))
+	require.NoError(t, ValidateURLs(&livekit.WebEgressRequest{Url: "http://127.0.0.1"}, nil))
+
+	err = ValidateURLs(&livekit.WebEgressRequest{Url: "http://169.254.169.254/latest/meta-data"}, g)
+	require.ErrorIs(t, err, urlguard.ErrAddressNotAllowed)
+
+	err = ValidateURLs(&livekit.RoomCompositeEgressRequest{
+		StreamOutputs: []*livekit.StreamOutput{{
+			Urls: []string{"rtmp://live.example.com/app/key", "rtmp://10.0.0.1/app/key"},
+		}},
+	}, g)
+	require.ErrorIs(t, err, urlguard.ErrAddressNotAllowed)
+	require.Contains(t, err.Error(), "stream_outputs[0].urls[1]")
+
+	require.NoError(t, ValidateURLs(&livekit.RoomCompositeEgressRequest{CustomBaseUrl: "https://example.com/template"}, g))
+	err = ValidateURLs(&livekit.RoomCompositeEgressRequest{CustomBaseUrl: "http://10.0.0.1/template"}, g)
+	require.ErrorIs(t, err, urlguard.ErrAddressNotAllowed)
+	require.Contains(t, err.Error(), "custom_base_url")
+
+	err = ValidateURLs(&livekit.TrackCompositeEgressRequest{
+		Output: &livekit.TrackCompositeEgressRequest_Stream{
+			Stream: &livekit.StreamOutput{Urls: []string{"https://example.com"}},
+		},
+	}, g)
+	require.ErrorIs(t, err, urlguard.ErrSchemeNotAllowed)
+
+	err = ValidateURLs(&livekit.TrackEgressRequest{
+		Output: &livekit.TrackEgressRequest_WebsocketUrl{WebsocketUrl: "ws://localhost:8080"},
+	}, g)
+	require.ErrorIs(t, err, urlguard.ErrHostNotAllowed)
+
+	err = ValidateURLs(&livekit.UpdateStreamRequest{AddOutputUrls: []string{"rtmp://[::1]/app"}}, g)
+	require.ErrorIs(t, err, urlguard.ErrAddressNotAllowed)
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"fmt"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+// GetValidationWarnings returns non-fatal issues of a start request, for validate_only responses.
+// Upload credentials are not checked here and are reported as unverified.
+func GetValidationWarnings(req interface{}) []*livekit.ValidationWarning {
+	var w livekit.ValidationWarnings
+
+	if r, ok := req.(EncodedOutputDeprecated); ok {
+		if r.GetFile() != nil {
+			w.Add("file", livekit.ValidationWarningDeprecatedField, "use file_outputs instead")
+			addUploadWarnings(&w, "file", r.GetFile())
+		}
+		if r.GetStream() != nil {
+			w.Add("stream", livekit.ValidationWarningDeprecatedField, "use stream_outputs instead")
+		}
+		if r.GetSegments() != nil {
+			w.Add("segments", livekit.ValidationWarningDeprecatedField, "use segment_outputs instead")
+			addUploadWarnings(&w, "segments", r.GetSegments())
+		}
+	}
+
+	if r, ok := req.(EncodedOutput); ok {
+		for i, o := range r.GetFileOutputs() {
+			addUploadWarnings(&w, fmt.Sprintf("file_outputs[%d]", i), o)
+		}
+		for i, o := range r.GetSegmentOutputs() {
+			addUploadWarnings(&w, fmt.Sprintf("segment_outputs[%d]", i), o)
+		}
+		for i, o := range r.GetImageOutputs() {
+			addUploadWarnings(&w, fmt.Sprintf("image_outputs[%d]", i), o)
+		}
+	}
+
+	if r, ok := req.(DirectOutput); ok {
+		if f := r.GetFile(); f != nil {
+			addUploadWarnings(&w, "file", f)
+		}
+	}
+
+	return w
+}
+
+func addUploadWarnings(w *livekit.ValidationWarnings, field string, u UploadRequest) {
+	if u.GetS3() == nil && u.GetGcp() == nil && u.GetAzure() == nil && u.GetAliOSS() == nil {
+		w.Add(field, livekit.ValidationWarningServerDefault, "no upload configured, the server default storage is used")
+	} else {
+		w.Add(field, livekit.ValidationWarningUnverified, "upload credentials are not verified before the egress starts")
+	}
+}
@@ -0,0 +1,29 @@
+package egress
+
+import (
+	"testing"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetValidationWarnings(t *testing.T) {
+	w := GetValidationWarnings(&livekit.RoomCompositeEgressRequest{
+		Output: &livekit.RoomCompositeEgressRequest_File{File: &livekit.EncodedFileOutput{}},
+		SegmentOutputs: []*livekit.SegmentedFileOutput{{
+			Output: &livekit.SegmentedFileOutput_S3{S3: &livekit.S3Upload{}},
+		}},
+	})
+	require.Len(t, w, 3)
+	require.Equal(t, "file", w[0].Field)
+	require.Equal(t, livekit.ValidationWarningDeprecatedField, w[0].Code)
+	require.Equal(t, livekit.ValidationWarningServerDefault, w[1].Code)
+	require.Equal(t, "segment_outputs[0]", w[2].Field)
+	require.Equal(t, livekit.ValidationWarningUnverified, w[2].Code)
+
+	w = GetValidationWarnings(&livekit.TrackEgressRequest{
+		Output: &livekit.TrackEgressRequest_File{File: &livekit.DirectFileOutput{}},
+	})
+	require.Len(t, w, 1)
+	require.Equal(t, "file", w[0].Field)
+}
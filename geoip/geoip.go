@@ -0,0 +1,108 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package geoip enriches client info with the coarse location and network of the client address.
+package geoip
+
+import (
+	"context"
+	"net"
+	"net/netip"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+// Enricher looks up the location of an address. It returns nil when the address is not found.
+type Enricher interface {
+	Lookup(ctx context.Context, addr netip.Addr) (*livekit.NetworkLocation, error)
+}
+
+type Precision string
+
+const (
+	PrecisionNone      Precision = "none"
+	PrecisionContinent Precision = "continent"
+	PrecisionCountry   Precision = "country"
+	PrecisionRegion    Precision = "region"
+	PrecisionCity      Precision = "city"
+)
+
+// PrivacyConfig limits the location recorded for clients. The zero value records nothing.
+type PrivacyConfig struct {
+	Precision Precision `yaml:"precision,omitempty"`
+	// record the ASN and organization of the network
+	IncludeISP bool `yaml:"include_isp,omitempty"`
+}
+
+func (c PrivacyConfig) Enabled() bool {
+	return (c.Precision != "" && c.Precision != PrecisionNone) || c.IncludeISP
+}
+
+// Apply returns the location with the fields the config does not allow cleared, or nil if none remain.
+func (c PrivacyConfig) Apply(loc *livekit.NetworkLocation) *livekit.NetworkLocation {
+	if loc == nil {
+		return nil
+	}
+	res := &livekit.NetworkLocation{}
+	switch c.Precision {
+	case PrecisionCity:
+		res.City = loc.City
+		fallthrough
+	case PrecisionRegion:
+		res.RegionCode = loc.RegionCode
+		fallthrough
+	case PrecisionCountry:
+		res.CountryCode = loc.CountryCode
+		fallthrough
+	case PrecisionContinent:
+		res.ContinentCode = loc.ContinentCode
+	}
+	if c.IncludeISP {
+		res.Asn = loc.Asn
+		res.Isp = loc.Isp
+	}
+	if res.ContinentCode == "" && res.CountryCode == "" && res.Asn == 0 && res.Isp == "" {
+		return nil
+	}
+	return res
+}
+
+// Enrich sets the location of the client from its address. Private and invalid addresses are skipped.
+func Enrich(ctx context.Context, e Enricher, config PrivacyConfig, ci *livekit.ClientInfo) error {
+	if e == nil || ci == nil || !config.Enabled() {
+		return nil
+	}
+	addr, ok := parseAddress(ci.Address)
+	if !ok || !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return nil
+	}
+	loc, err := e.Lookup(ctx, addr)
+	if err != nil {
+		return err
+	}
+	ci.Location = config.Apply(loc)
+	return nil
+}
+
+// parseAddress accepts addresses with or without a port
+func parseAddress(s string) (netip.Addr, bool) {
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		s = host
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
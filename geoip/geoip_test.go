@@ -0,0 +1,91 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+// fakeReader fills records as *maxminddb.Reader would for a single known address
+type fakeReader struct {
+	ip net.IP
+}
+
+func (f fakeReader) Lookup(ip net.IP, result any) error {
+	if !ip.Equal(f.ip) {
+		return nil
+	}
+	switch r := result.(type) {
+	case *cityRecord:
+		r.Continent.Code = "EU"
+		r.Country.ISOCode = "DE"
+		r.Subdivisions = append(r.Subdivisions, struct {
+			ISOCode string `maxminddb:"iso_code"`
+		}{ISOCode: "BE"})
+		r.City.Names = map[string]string{"en": "Berlin"}
+	case *asnRecord:
+		r.Number = 3320
+		r.Organization = "Deutsche Telekom AG"
+	}
+	return nil
+}
+
+func TestEnrich(t *testing.T) {
+	reader := fakeReader{ip: net.ParseIP("203.0.113.7")}
+	e := NewMaxMindEnricher(reader, reader)
+
+	for _, c := range []struct {
+		name     string
+		config   PrivacyConfig
+		address  string
+		expected *livekit.NetworkLocation
+	}{
+		{name: "disabled", address: "203.0.113.7"},
+		{
+			name:     "country",
+			config:   PrivacyConfig{Precision: PrecisionCountry},
+			address:  "203.0.113.7:4433",
+			expected: &livekit.NetworkLocation{ContinentCode: "EU", CountryCode: "DE"},
+		},
+		{
+			name:     "city and isp",
+			config:   PrivacyConfig{Precision: PrecisionCity, IncludeISP: true},
+			address:  "::ffff:203.0.113.7",
+			expected: &livekit.NetworkLocation{ContinentCode: "EU", CountryCode: "DE", RegionCode: "BE", City: "Berlin", Asn: 3320, Isp: "Deutsche Telekom AG"},
+		},
+		{
+			name:     "isp only",
+			config:   PrivacyConfig{IncludeISP: true},
+			address:  "203.0.113.7",
+			expected: &livekit.NetworkLocation{Asn: 3320, Isp: "Deutsche Telekom AG"},
+		},
+		{name: "private", config: PrivacyConfig{Precision: PrecisionCity}, address: "10.0.0.1"},
+		{name: "not found", config: PrivacyConfig{Precision: PrecisionCity}, address: "198.51.100.1"},
+		{name: "invalid", config: PrivacyConfig{Precision: PrecisionCity}, address: "unknown"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			ci := &livekit.ClientInfo{Address: c.address}
+			require.NoError(t, Enrich(context.Background(), e, c.config, ci))
+			require.True(t, proto.Equal(c.expected, ci.Location), ci.Location.String())
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"context"
+	"net"
+	"net/netip"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+// MaxMindReader is implemented by *maxminddb.Reader of github.com/oschwald/maxminddb-golang,
+// keeping this package free of the dependency.
+type MaxMindReader interface {
+	Lookup(ip net.IP, result any) error
+}
+
+type cityRecord struct {
+	Continent struct {
+		Code string `maxminddb:"code"`
+	} `maxminddb:"continent"`
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	Subdivisions []struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"subdivisions"`
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+}
+
+type asnRecord struct {
+	Number       uint32 `maxminddb:"autonomous_system_number"`
+	Organization string `maxminddb:"autonomous_system_organization"`
+}
+
+// MaxMindEnricher looks up addresses in GeoIP2/GeoLite2 City (or Country) and ASN databases.
+type MaxMindEnricher struct {
+	city MaxMindReader
+	asn  MaxMindReader
+}
+
+var _ Enricher = (*MaxMindEnricher)(nil)
+
+// NewMaxMindEnricher uses the city and ASN databases, either can be nil.
+func NewMaxMindEnricher(city, asn MaxMindReader) *MaxMindEnricher {
+	return &MaxMindEnricher{city: city, asn: asn}
+}
+
+func (e *MaxMindEnricher) Lookup(_ context.Context, addr netip.Addr) (*livekit.NetworkLocation, error) {
+	ip := net.IP(addr.AsSlice())
+	loc := &livekit.NetworkLocation{}
+	if e.city != nil {
+		var r cityRecord
+		if err := e.city.Lookup(ip, &r); err != nil {
+			return nil, err
+		}
+		loc.ContinentCode = r.Continent.Code
+		loc.CountryCode = r.Country.ISOCode
+		if len(r.Subdivisions) > 0 {
+			loc.RegionCode = r.Subdivisions[0].ISOCode
+		}
+		loc.City = r.City.Names["en"]
+	}
+	if e.asn != nil {
+		var r asnRecord
+		if err := e.asn.Lookup(ip, &r); err != nil {
+			return nil, err
+		}
+		loc.Asn = r.Number
+		loc.Isp = r.Organization
+	}
+	if loc.ContinentCode == "" && loc.CountryCode == "" && loc.Asn == 0 {
+		return nil, nil
+	}
+	return loc, nil
+}
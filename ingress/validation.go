@@ -284,3 +284,15 @@ func ValidateAudioEncodingOptionsConsistency(options *livekit.IngressAudioEncodi
 
 	return nil
 }
+
+// GetValidationWarnings returns non-fatal issues of a create request, for validate_only responses.
+func GetValidationWarnings(req *livekit.CreateIngressRequest) []*livekit.ValidationWarning {
+	var w livekit.ValidationWarnings
+	if req.BypassTranscoding {
+		w.Add("bypass_transcoding", livekit.ValidationWarningDeprecatedField, "use enable_transcoding instead")
+	}
+	if req.Audio == nil && req.Video == nil && req.EnableTranscoding == nil && req.InputType != livekit.IngressInput_WHIP_INPUT {
+		w.Add("video", livekit.ValidationWarningServerDefault, "no encoding options set, the default presets are used")
+	}
+	return w
+}
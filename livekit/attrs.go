@@ -32,3 +32,10 @@ const (
 	// AttrIngressOutOfNetworkEventID contains the event ID of the current Out of network splice
 	AttrIngressOutOfNetworkEventID = AttrIngressOutOfNetworkPrefix + "eventID"
 )
+
+// Names of participant attributes for data packets.
+const (
+	// AttrDataContentTypes attribute contains the comma separated content types of UserPacket payloads
+	// the participant can decode, in order of preference.
+	AttrDataContentTypes = "data.contentTypes"
+)
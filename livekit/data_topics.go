@@ -0,0 +1,57 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import "strings"
+
+// DataTopicAllowed returns true if the topic matches one of the patterns, where a trailing * matches any suffix.
+// Any topic is allowed when there are no patterns. Packets without a topic match only an empty pattern or *.
+func DataTopicAllowed(patterns []string, topic string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if prefix, ok := strings.CutSuffix(p, "*"); ok {
+			if strings.HasPrefix(topic, prefix) {
+				return true
+			}
+		} else if p == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// CanPublishDataTopic returns true if the participant can publish user packets and data streams on the topic.
+func (p *ParticipantPermission) CanPublishDataTopic(topic string) bool {
+	return p.GetCanPublishData() && DataTopicAllowed(p.GetCanPublishDataTopics(), topic)
+}
+
+// CanSubscribeDataTopic returns true if user packets and data streams on the topic are delivered to the participant.
+func (p *ParticipantPermission) CanSubscribeDataTopic(topic string) bool {
+	return p != nil && DataTopicAllowed(p.CanSubscribeDataTopics, topic)
+}
+
+// Topic returns the topic of user packets and data stream headers. Chunks and trailers of a stream
+// belong to the topic of its header, which the caller has to track by stream id.
+func (p *DataPacket) Topic() (string, bool) {
+	switch v := p.GetValue().(type) {
+	case *DataPacket_User:
+		return v.User.GetTopic(), true
+	case *DataPacket_StreamHeader:
+		return v.StreamHeader.GetTopic(), true
+	}
+	return "", false
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDataTopics(t *testing.T) {
+	require.True(t, DataTopicAllowed(nil, "anything"))
+	require.True(t, DataTopicAllowed([]string{"chat"}, "chat"))
+	require.False(t, DataTopicAllowed([]string{"chat"}, "chatter"))
+	require.True(t, DataTopicAllowed([]string{"control.*"}, "control.mute"))
+	require.False(t, DataTopicAllowed([]string{"control.*"}, ""))
+	require.True(t, DataTopicAllowed([]string{"*"}, ""))
+
+	perm := &ParticipantPermission{
+		CanPublishData:         true,
+		CanPublishDataTopics:   []string{"chat"},
+		CanSubscribeDataTopics: []string{"chat", "control.*"},
+	}
+	require.True(t, perm.CanPublishDataTopic("chat"))
+	require.False(t, perm.CanPublishDataTopic("control.mute"))
+	require.True(t, perm.CanSubscribeDataTopic("control.mute"))
+	require.False(t, perm.CanSubscribeDataTopic("presence"))
+
+	perm.CanPublishData = false
+	require.False(t, perm.CanPublishDataTopic("chat"))
+
+	// unrestricted participants receive every topic
+	require.True(t, (&ParticipantPermission{}).CanSubscribeDataTopic("control.mute"))
+	require.False(t, (*ParticipantPermission)(nil).CanSubscribeDataTopic("chat"))
+
+	topic, ok := (&DataPacket{Value: &DataPacket_User{User: &UserPacket{Topic: proto.String("chat")}}}).Topic()
+	require.True(t, ok)
+	require.Equal(t, "chat", topic)
+	topic, ok = (&DataPacket{Value: &DataPacket_StreamHeader{StreamHeader: &DataStream_Header{Topic: "files"}}}).Topic()
+	require.True(t, ok)
+	require.Equal(t, "files", topic)
+	_, ok = (&DataPacket{Value: &DataPacket_StreamChunk{StreamChunk: &DataStream_Chunk{}}}).Topic()
+	require.False(t, ok)
+}
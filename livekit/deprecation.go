@@ -0,0 +1,32 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"time"
+)
+
+// SunsetTime returns the time after which the feature may stop working, zero when not scheduled.
+func (n *DeprecationNotice) SunsetTime() time.Time {
+	if n.GetSunsetAt() == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(n.GetSunsetAt())
+}
+
+// IsSunset reports whether the sunset of the feature has passed at the given time.
+func (n *DeprecationNotice) IsSunset(now time.Time) bool {
+	return n.GetSunsetAt() != 0 && !now.Before(n.SunsetTime())
+}
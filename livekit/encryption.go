@@ -0,0 +1,86 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import "errors"
+
+// MaxEncryptionKeyIndex is the highest key index, frames carry it in a single byte of their trailer.
+const MaxEncryptionKeyIndex = 255
+
+// ToProto implements DataPacket in Go SDK.
+func (p *EncryptionKeyRequest) ToProto() *DataPacket {
+	return &DataPacket{
+		Value: &DataPacket_EncryptionKeyRequest{
+			EncryptionKeyRequest: p,
+		},
+	}
+}
+
+// ToProto implements DataPacket in Go SDK.
+func (p *EncryptionKey) ToProto() *DataPacket {
+	return &DataPacket{
+		Value: &DataPacket_EncryptionKey{
+			EncryptionKey: p,
+		},
+	}
+}
+
+// ToProto implements DataPacket in Go SDK.
+func (p *EncryptionKeyRatchet) ToProto() *DataPacket {
+	return &DataPacket{
+		Value: &DataPacket_EncryptionKeyRatchet{
+			EncryptionKeyRatchet: p,
+		},
+	}
+}
+
+func (p *EncryptionKeyRequest) Validate() error {
+	if p.ParticipantIdentity == "" {
+		return errors.New("missing participant identity")
+	}
+	if p.KeyIndex != nil && *p.KeyIndex > MaxEncryptionKeyIndex {
+		return errors.New("key index out of range")
+	}
+	if len(p.PublicKey) == 0 {
+		return errors.New("missing public key")
+	}
+	return nil
+}
+
+func (p *EncryptionKey) Validate() error {
+	if p.KeyIndex > MaxEncryptionKeyIndex {
+		return errors.New("key index out of range")
+	}
+	if len(p.EncryptedKey) == 0 {
+		return errors.New("missing key")
+	}
+	if p.Encryption == Encryption_NONE {
+		return errors.New("missing encryption type")
+	}
+	return nil
+}
+
+func (p *EncryptionKeyRatchet) Validate() error {
+	if p.ParticipantIdentity == "" {
+		return errors.New("missing participant identity")
+	}
+	if p.KeyIndex > MaxEncryptionKeyIndex {
+		return errors.New("key index out of range")
+	}
+	if p.RatchetCount == 0 {
+		return errors.New("missing ratchet count")
+	}
+	return nil
+}
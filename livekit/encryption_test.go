@@ -0,0 +1,47 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEncryptionKeyMessages(t *testing.T) {
+	req := &EncryptionKeyRequest{ParticipantIdentity: "alice", KeyIndex: proto.Uint32(2), PublicKey: []byte{1}}
+	require.NoError(t, req.Validate())
+	require.Error(t, (&EncryptionKeyRequest{ParticipantIdentity: "alice"}).Validate())
+	require.Error(t, (&EncryptionKeyRequest{ParticipantIdentity: "alice", KeyIndex: proto.Uint32(256), PublicKey: []byte{1}}).Validate())
+
+	key := &EncryptionKey{ParticipantIdentity: "alice", KeyIndex: 3, EncryptedKey: []byte{1, 2}, Encryption: Encryption_GCM, SetCurrent: true}
+	require.NoError(t, key.Validate())
+	require.Error(t, (&EncryptionKey{KeyIndex: 3, EncryptedKey: []byte{1}}).Validate())
+	require.Error(t, (&EncryptionKey{KeyIndex: 3, Encryption: Encryption_GCM}).Validate())
+
+	ratchet := &EncryptionKeyRatchet{ParticipantIdentity: "alice", KeyIndex: 3, RatchetCount: 1}
+	require.NoError(t, ratchet.Validate())
+	require.Error(t, (&EncryptionKeyRatchet{ParticipantIdentity: "alice", KeyIndex: 3}).Validate())
+
+	for _, msg := range []interface{ ToProto() *DataPacket }{req, key, ratchet} {
+		data, err := proto.Marshal(msg.ToProto())
+		require.NoError(t, err)
+		var got DataPacket
+		require.NoError(t, proto.Unmarshal(data, &got))
+		require.True(t, proto.Equal(msg.ToProto(), &got))
+	}
+	require.True(t, proto.Equal(key, key.ToProto().GetEncryptionKey()))
+}
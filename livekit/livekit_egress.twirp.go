@@ -2754,203 +2754,208 @@ func (s *egressServer) PathPrefix() string {
 }
 
 var twirpFileDescriptor1 = []byte{
-	// 3166 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0xcb, 0x72, 0x1b, 0xc7,
-	0x91, 0xbb, 0x20, 0x5e, 0x8d, 0x07, 0x97, 0x23, 0x4a, 0x86, 0x28, 0xc7, 0x92, 0x21, 0x3f, 0x64,
-	0xda, 0xa6, 0x18, 0x51, 0x96, 0x65, 0x39, 0x76, 0x02, 0x92, 0x4b, 0x12, 0x36, 0x48, 0x22, 0x0b,
-	0xd0, 0x72, 0x72, 0xd9, 0x5a, 0x62, 0x87, 0xe4, 0x16, 0x81, 0x5d, 0x78, 0x67, 0x40, 0x19, 0xae,
-	0x7c, 0x40, 0x8e, 0x79, 0xdc, 0x52, 0xa9, 0x4a, 0xa5, 0x2a, 0xa7, 0x54, 0x2a, 0x57, 0x9f, 0x73,
-	0x4d, 0x2a, 0x95, 0x43, 0xfe, 0x22, 0x55, 0xc9, 0x21, 0x5f, 0x90, 0x9a, 0xc7, 0x3e, 0xb0, 0x58,
-	0x52, 0xa4, 0xa8, 0xaa, 0x1c, 0x92, 0xdb, 0x4c, 0xbf, 0xd0, 0xd3, 0xdd, 0xd3, 0x3d, 0xdd, 0x0b,
-	0x58, 0xe8, 0x3b, 0xa7, 0xf8, 0xc4, 0xa1, 0x26, 0x3e, 0xf2, 0x31, 0x21, 0xcb, 0x43, 0xdf, 0xa3,
-	0x1e, 0xca, 0x4b, 0xe8, 0x62, 0x88, 0x1e, 0x78, 0x36, 0xee, 0x4b, 0x74, 0xfd, 0xb7, 0x39, 0x58,
-	0x34, 0x3c, 0x6f, 0xb0, 0xee, 0x0d, 0x86, 0x1e, 0x71, 0x28, 0xd6, 0x39, 0xb3, 0x81, 0xbf, 0x1a,
-	0x61, 0x42, 0xd1, 0x2d, 0x28, 0xfa, 0x9e, 0x37, 0x30, 0x5d, 0x6b, 0x80, 0x6b, 0xca, 0x1d, 0xe5,
-	0x5e, 0xd1, 0x28, 0x30, 0xc0, 0xae, 0x35, 0xc0, 0xe8, 0x06, 0xe4, 0xfa, 0xd6, 0xd8, 0x1b, 0xd1,
-	0x9a, 0xca, 0x31, 0x72, 0x87, 0xbe, 0x03, 0x60, 0x8d, 0x6c, 0xc7, 0x33, 0x3d, 0xb7, 0x3f, 0xae,
-	0x65, 0xee, 0x28, 0xf7, 0x0a, 0x46, 0x91, 0x43, 0xf6, 0xdc, 0xfe, 0x18, 0x7d, 0x08, 0x65, 0x81,
-	0x1e, 0x38, 0x5f, 0x3b, 0xee, 0x51, 0x6d, 0xee, 0x8e, 0x72, 0xaf, 0xfa, 0x60, 0x61, 0x59, 0xea,
-	0xb7, 0xdc, 0x60, 0xc8, 0x1d, 0x8e, 0x33, 0x4a, 0x56, 0xb4, 0x61, 0x72, 0x4f, 0x1d, 0x1b, 0x4b,
-	0xb9, 0xb3, 0x42, 0x2e, 0x87, 0x70, 0xb9, 0x6f, 0xc1, 0x5c, 0x6f, 0x44, 0xa8, 0x37, 0x30, 0x0f,
-	0x2c, 0x82, 0xcd, 0x91, 0xdf, 0xaf, 0x65, 0xb9, 0x5e, 0x15, 0x01, 0x5e, 0xb3, 0x08, 0xde, 0xf7,
-	0xfb, 0xe8, 0x21, 0xcc, 0x1e, 0x3a, 0x7d, 0x5c, 0xcb, 0xdd, 0x51, 0xee, 0x95, 0x1e, 0x2c, 0x86,
-	0xbf, 0xab, 0xbb, 0x3d, 0xcf, 0xc6, 0xf6, 0xa6, 0xd3, 0xc7, 0x7b, 0x23, 0x3a, 0x1c, 0xd1, 0x35,
-	0xb5, 0xa6, 0x6c, 0xcf, 0x18, 0x9c, 0x1a, 0xad, 0x42, 0x8e, 0x50, 0x1f, 0x5b, 0x83, 0x5a, 0x9e,
-	0xf3, 0x5d, 0x0f, 0xf9, 0x3a, 0x1c, 0x3c, 0xc1, 0x22, 0x49, 0xd1, 0xa7, 0x50, 0x20, 0xf8, 0x68,
-	0x80, 0x5d, 0x4a, 0x6a, 0xc0, 0xd9, 0x5e, 0x8d, 0xd8, 0x04, 0x22, 0xe5, 0x07, 0x43, 0x1e, 0xf4,
-	0x18, 0x72, 0x43, 0x1f, 0x13, 0x4c, 0x6b, 0x05, 0x6e, 0xa4, 0xd7, 0x26, 0x95, 0x75, 0xdc, 0xa3,
-	0xbd, 0x21, 0x75, 0x3c, 0x97, 0xb4, 0x39, 0xd5, 0xb6, 0x62, 0x48, 0x7a, 0xf4, 0x08, 0x0a, 0x96,
-	0x7d, 0x6a, 0xb9, 0x3d, 0x6c, 0xd7, 0x8a, 0xfc, 0x97, 0x6b, 0x67, 0xf1, 0x6e, 0x2b, 0x46, 0x48,
-	0x8b, 0x3e, 0x81, 0x32, 0x3b, 0xae, 0xe9, 0x71, 0x85, 0x48, 0xad, 0x74, 0x27, 0x73, 0xbe, 0x91,
-	0x8c, 0xd2, 0x61, 0xb8, 0x26, 0xe8, 0x7b, 0x50, 0x15, 0x47, 0x0f, 0x05, 0x94, 0xb9, 0x80, 0x74,
-	0x6b, 0x19, 0x15, 0x12, 0xdb, 0x11, 0xa4, 0xc3, 0x9c, 0x3c, 0x7a, 0xc8, 0x5e, 0xe1, 0xec, 0xe7,
-	0x5a, 0xcd, 0xa8, 0x4a, 0xa6, 0x40, 0xcc, 0x47, 0x50, 0x71, 0x06, 0xd6, 0x51, 0x74, 0x88, 0x2a,
-	0x17, 0x12, 0x45, 0x58, 0x93, 0x61, 0x25, 0x73, 0xd9, 0x89, 0x36, 0x64, 0xad, 0x00, 0x39, 0xc1,
-	0xb4, 0x56, 0x84, 0xbc, 0x27, 0xec, 0x53, 0xff, 0x53, 0x16, 0xb4, 0xa7, 0xf8, 0x60, 0xf2, 0x66,
-	0x68, 0x90, 0x61, 0x11, 0x26, 0xee, 0x04, 0x5b, 0x26, 0xc2, 0x5e, 0x4d, 0x86, 0xfd, 0x64, 0xf4,
-	0x66, 0x92, 0xd1, 0xfb, 0x1e, 0x20, 0xeb, 0x99, 0xe5, 0x50, 0x93, 0x50, 0xcb, 0xa7, 0x26, 0x71,
-	0x8e, 0x5c, 0xab, 0x5f, 0x2b, 0x73, 0x32, 0x8d, 0x63, 0x3a, 0x0c, 0xd1, 0xe1, 0xf0, 0x30, 0x86,
-	0x67, 0x5f, 0x30, 0x86, 0xb3, 0x2f, 0x16, 0xc3, 0xb9, 0x2b, 0xc5, 0x70, 0xfe, 0x0a, 0x31, 0x5c,
-	0xb8, 0x42, 0x0c, 0x17, 0xaf, 0x1a, 0xc3, 0x70, 0xb5, 0x18, 0x2e, 0xbd, 0x8c, 0x18, 0xae, 0x5c,
-	0x2d, 0x86, 0xff, 0x9d, 0x81, 0x5a, 0xdb, 0xf2, 0xa9, 0xd3, 0x73, 0x86, 0x96, 0x4b, 0x2f, 0x91,
-	0xe5, 0x17, 0xa1, 0xe0, 0xd8, 0xd8, 0xa5, 0x0e, 0x1d, 0xcb, 0x3c, 0x1f, 0xee, 0xd1, 0xeb, 0x50,
-	0x26, 0x3d, 0x1f, 0x63, 0xd7, 0x24, 0xc7, 0x96, 0x8f, 0x65, 0x54, 0x97, 0x04, 0xac, 0xc3, 0x40,
-	0x31, 0xf7, 0xcf, 0x5e, 0xc8, 0xfd, 0x33, 0xa9, 0xee, 0xcf, 0x3e, 0xc7, 0xfd, 0x33, 0xe7, 0xb8,
-	0x3f, 0x77, 0x55, 0xf7, 0xe7, 0xaf, 0xe6, 0xfe, 0xc2, 0xcb, 0x70, 0x7f, 0xf1, 0xc2, 0xee, 0x8f,
-	0x39, 0xfd, 0xdb, 0x2c, 0xdc, 0xea, 0xfa, 0x56, 0xef, 0xe4, 0x45, 0xaa, 0xfb, 0x1b, 0x50, 0x15,
-	0xe9, 0x8c, 0x32, 0x09, 0xa6, 0x63, 0x4b, 0xef, 0x8b, 0xe2, 0xcd, 0xc5, 0x36, 0x6d, 0x46, 0x25,
-	0xb2, 0x5a, 0x48, 0x95, 0x11, 0x54, 0x1c, 0x1a, 0x50, 0xfd, 0x97, 0xd2, 0x55, 0xe1, 0x4a, 0xe9,
-	0x2a, 0x77, 0x85, 0x74, 0x95, 0xff, 0x7f, 0xc9, 0xbd, 0x52, 0xba, 0xfa, 0xa3, 0x02, 0x88, 0x07,
-	0xcf, 0x25, 0x02, 0xf6, 0x26, 0x14, 0x12, 0xa1, 0x9a, 0xa7, 0x32, 0xfe, 0xee, 0xcb, 0xf8, 0xcb,
-	0x70, 0xb7, 0xdc, 0x0c, 0xb5, 0xda, 0x70, 0x7c, 0xdc, 0xa3, 0xd1, 0xb9, 0xc2, 0xd0, 0x7b, 0x13,
-	0x2a, 0xcf, 0xf0, 0x01, 0xf1, 0x7a, 0x27, 0x98, 0xf2, 0x97, 0x24, 0x8b, 0xdc, 0xe2, 0xf6, 0x8c,
-	0x51, 0x0e, 0xc1, 0xfb, 0x7e, 0x3f, 0xd2, 0xbd, 0xfe, 0x17, 0x15, 0xe6, 0xa7, 0x1c, 0x85, 0x3e,
-	0x80, 0x22, 0x77, 0x2d, 0x1d, 0x0f, 0x85, 0xbe, 0xd5, 0x64, 0x4c, 0x08, 0xf2, 0xee, 0x78, 0x88,
-	0x8d, 0xc2, 0xa1, 0x5c, 0xb1, 0x94, 0xcb, 0xd6, 0x43, 0x8b, 0x1e, 0x07, 0x29, 0x37, 0xd8, 0xa3,
-	0x77, 0x40, 0xb3, 0x1d, 0x62, 0x1d, 0xf4, 0xb1, 0x39, 0xb0, 0x5c, 0xe7, 0x10, 0x13, 0x11, 0xa9,
-	0x05, 0x63, 0x4e, 0xc2, 0x77, 0x24, 0x18, 0xdd, 0x05, 0x95, 0xac, 0xca, 0x33, 0xcf, 0x47, 0xee,
-	0x5c, 0xdd, 0x1f, 0xf6, 0x3d, 0xcb, 0xde, 0x9e, 0x31, 0x54, 0xb2, 0x8a, 0xde, 0x82, 0xcc, 0x51,
-	0x6f, 0x28, 0x6f, 0x26, 0x0a, 0xa9, 0xb6, 0xd6, 0xdb, 0x21, 0x19, 0x23, 0x40, 0x2b, 0x90, 0xb5,
-	0xbe, 0x19, 0xf9, 0x78, 0x2a, 0x15, 0x37, 0x18, 0x74, 0xad, 0xef, 0x1d, 0x84, 0xf4, 0x82, 0x10,
-	0xdd, 0x87, 0x9c, 0xd5, 0x77, 0xf6, 0x3a, 0x9d, 0xa9, 0x17, 0x73, 0x83, 0x83, 0x43, 0x7a, 0x49,
-	0x16, 0xb3, 0xe6, 0xef, 0x66, 0xe1, 0x5a, 0x4a, 0xd8, 0xa1, 0x27, 0x50, 0xe0, 0x6d, 0x4b, 0xcf,
-	0xeb, 0x4b, 0x73, 0xbe, 0x96, 0x1e, 0xa6, 0x6d, 0x49, 0x65, 0x84, 0xf4, 0xe8, 0x6d, 0x98, 0x63,
-	0x46, 0x64, 0xa1, 0x63, 0x0e, 0x7d, 0x7c, 0xe8, 0x7c, 0x2d, 0x6d, 0x5b, 0x0d, 0xc0, 0x6d, 0x0e,
-	0x45, 0x77, 0xa1, 0x32, 0xec, 0x5b, 0xe3, 0xbe, 0x43, 0xa8, 0x08, 0x34, 0x99, 0xd1, 0x02, 0x20,
-	0x0f, 0xb6, 0xf7, 0x00, 0xb1, 0x1f, 0x36, 0x27, 0x29, 0x4b, 0x9c, 0x52, 0x63, 0x98, 0x76, 0x9c,
-	0xfa, 0x1d, 0xd0, 0x82, 0x5b, 0x66, 0x8f, 0x7c, 0x8b, 0xc5, 0x38, 0xb7, 0x78, 0xc5, 0x08, 0x6e,
-	0xdf, 0x86, 0x04, 0xb3, 0x0b, 0x19, 0xaa, 0x49, 0x46, 0x87, 0x4c, 0x4d, 0xe0, 0x27, 0x3d, 0xe3,
-	0x42, 0x76, 0x38, 0x4d, 0x74, 0x08, 0xb1, 0x4f, 0x0d, 0x93, 0xc2, 0x79, 0x61, 0x92, 0xbd, 0x50,
-	0x98, 0xe4, 0x2e, 0x1c, 0x26, 0xf9, 0xcb, 0x87, 0x49, 0xf1, 0xb2, 0x61, 0xf2, 0x4b, 0x15, 0xb4,
-	0xe4, 0x15, 0x9e, 0xb8, 0x3c, 0xca, 0x05, 0x2e, 0x4f, 0xf6, 0x3c, 0xab, 0xa8, 0x17, 0xb2, 0x4a,
-	0xe6, 0xc2, 0x56, 0x99, 0xbd, 0xbc, 0x55, 0x72, 0x97, 0xb5, 0xca, 0x3f, 0x33, 0x50, 0x8a, 0xa5,
-	0x5b, 0x76, 0xe8, 0x9e, 0x35, 0xa4, 0x23, 0x1f, 0x9b, 0x8e, 0x4b, 0xb1, 0x7f, 0x6a, 0x89, 0xcb,
-	0x53, 0x31, 0xe6, 0x24, 0xbc, 0x29, 0xc1, 0x68, 0x01, 0xb2, 0xcf, 0x1c, 0x5b, 0x66, 0x9d, 0xac,
-	0x21, 0x36, 0xac, 0xcf, 0x3f, 0xc6, 0xce, 0xd1, 0x31, 0xe5, 0x07, 0xcd, 0x1a, 0x72, 0x97, 0x76,
-	0xa3, 0x66, 0x53, 0x6f, 0x54, 0x63, 0x3a, 0xa6, 0xb3, 0x89, 0x64, 0xc8, 0x15, 0x3e, 0x27, 0x9e,
-	0x1f, 0x42, 0x49, 0x14, 0x18, 0x96, 0x33, 0x7b, 0xb2, 0x36, 0x5f, 0x9b, 0x64, 0x5f, 0x67, 0x28,
-	0x03, 0x9c, 0x70, 0x9d, 0xea, 0xef, 0xfc, 0x79, 0xfe, 0x2e, 0x5c, 0xc8, 0xdf, 0xc5, 0x0b, 0xfb,
-	0x1b, 0x2e, 0xef, 0xef, 0xd2, 0x65, 0xfd, 0xfd, 0xd7, 0x0c, 0x14, 0x02, 0x3d, 0x79, 0x13, 0xda,
-	0xeb, 0x61, 0x42, 0xcc, 0x13, 0x3c, 0x96, 0xf1, 0x5f, 0x14, 0x90, 0xcf, 0xf1, 0x98, 0xb9, 0x92,
-	0xe0, 0x9e, 0x8f, 0xc3, 0x91, 0x8d, 0xd8, 0xb1, 0x9c, 0x47, 0x30, 0x21, 0x8e, 0xe7, 0x9a, 0xd4,
-	0x3b, 0xc1, 0xae, 0xcc, 0x64, 0x65, 0x09, 0xec, 0x32, 0x18, 0x63, 0xf6, 0xf1, 0x11, 0xcb, 0x5d,
-	0x22, 0x23, 0xca, 0x1d, 0xbb, 0x71, 0xd8, 0xb5, 0x87, 0x9e, 0xe3, 0x52, 0x19, 0x00, 0xe1, 0x9e,
-	0xf1, 0x1c, 0x8c, 0x58, 0xb9, 0x94, 0xb3, 0x18, 0xb9, 0x43, 0xf7, 0x40, 0x3b, 0xf4, 0xfc, 0x1e,
-	0x36, 0xd9, 0xbd, 0x34, 0x09, 0x1d, 0xcb, 0x81, 0x4c, 0xc1, 0xa8, 0x72, 0x78, 0xdb, 0xa2, 0xc7,
-	0x1d, 0x06, 0x45, 0x1f, 0x43, 0x61, 0x80, 0xa9, 0x65, 0x5b, 0xd4, 0x92, 0x2f, 0xf1, 0xdb, 0x53,
-	0xee, 0x59, 0xde, 0x91, 0x14, 0xba, 0x4b, 0xfd, 0xb1, 0x11, 0x32, 0xa0, 0x1a, 0xe4, 0xa9, 0x75,
-	0x74, 0xe4, 0xb8, 0x47, 0xdc, 0xb5, 0xec, 0x49, 0x20, 0xb6, 0xe8, 0x3e, 0x5c, 0xeb, 0x79, 0x2e,
-	0xe5, 0x29, 0xd9, 0x21, 0xfc, 0x75, 0xcc, 0x4e, 0x56, 0xe4, 0x54, 0x48, 0xa2, 0x36, 0x22, 0x0c,
-	0x5a, 0x82, 0xec, 0xd0, 0xf7, 0xbe, 0x1e, 0x4b, 0x9f, 0x46, 0x4f, 0x9b, 0x36, 0x83, 0xae, 0x7b,
-	0xee, 0xa1, 0x73, 0x64, 0x08, 0x92, 0xc5, 0x8f, 0xa1, 0x32, 0xa1, 0x11, 0xd2, 0x20, 0x13, 0xf9,
-	0x83, 0x2d, 0xd9, 0x55, 0x3b, 0xb5, 0xfa, 0x23, 0x2c, 0x1d, 0x21, 0x36, 0x4f, 0xd4, 0xc7, 0x4a,
-	0xfd, 0x2b, 0x28, 0x86, 0x01, 0x85, 0xee, 0x40, 0xa9, 0xe7, 0x63, 0xde, 0x6f, 0x59, 0x7d, 0x22,
-	0x05, 0xc4, 0x41, 0x31, 0x0b, 0xab, 0x13, 0x16, 0x0e, 0xf5, 0xcd, 0x3c, 0x57, 0xdf, 0xfa, 0x4f,
-	0x60, 0x2e, 0x11, 0x99, 0xac, 0xb5, 0xb3, 0x7a, 0x3d, 0x6f, 0xe4, 0xd2, 0xf8, 0x6b, 0xab, 0x24,
-	0x61, 0xbc, 0xaa, 0xdd, 0x86, 0x60, 0xcb, 0x83, 0x4d, 0xfc, 0x3c, 0x48, 0x10, 0x8b, 0xb6, 0x37,
-	0xa1, 0xca, 0x0c, 0x69, 0x39, 0x2e, 0xf6, 0xe3, 0xa5, 0xb4, 0x12, 0x42, 0x99, 0x9c, 0xfa, 0xcf,
-	0x15, 0x28, 0xc7, 0xa3, 0xfc, 0x45, 0x83, 0xf8, 0x25, 0xc6, 0x67, 0xfd, 0x29, 0x94, 0x62, 0x76,
-	0x4a, 0x99, 0xf6, 0x2c, 0x42, 0x61, 0x44, 0xb0, 0xcf, 0x4f, 0x25, 0xdf, 0x68, 0xc1, 0x9e, 0xe1,
-	0x86, 0x16, 0x21, 0xcf, 0x3c, 0x3f, 0x68, 0x87, 0xc2, 0x7d, 0xfd, 0x29, 0x94, 0xe3, 0xef, 0x71,
-	0xb4, 0x3a, 0xf5, 0xa4, 0x79, 0x25, 0xf1, 0x70, 0x4f, 0x79, 0xcb, 0x20, 0x98, 0x1d, 0xf9, 0x7d,
-	0x52, 0x53, 0xef, 0x64, 0xee, 0x15, 0x0d, 0xbe, 0xae, 0xff, 0x39, 0x03, 0x73, 0x89, 0x36, 0x23,
-	0xca, 0xe7, 0x4a, 0x7a, 0x3e, 0x57, 0x27, 0xf2, 0xf9, 0x02, 0x64, 0x6d, 0x3c, 0xa4, 0xc7, 0x32,
-	0xcd, 0x8b, 0x0d, 0x7a, 0x15, 0x8a, 0x87, 0xbe, 0x35, 0xc0, 0xbe, 0x45, 0x45, 0xfd, 0xca, 0x1a,
-	0x11, 0x80, 0xe5, 0x65, 0xd1, 0x25, 0x8a, 0xbc, 0x9c, 0x4d, 0xe4, 0x65, 0x3e, 0xcb, 0x95, 0x79,
-	0xd9, 0x0a, 0xd7, 0x2c, 0xdd, 0x08, 0xae, 0x03, 0x87, 0x72, 0xb9, 0x39, 0x2e, 0x57, 0xb4, 0x96,
-	0x6b, 0x02, 0x16, 0x11, 0x7d, 0x35, 0xb2, 0xfa, 0x0e, 0x1d, 0xf3, 0x9c, 0x14, 0x10, 0xfd, 0x50,
-	0xc0, 0x58, 0x0d, 0x12, 0x44, 0x87, 0x3e, 0xeb, 0x11, 0xdc, 0xde, 0x98, 0x27, 0xf8, 0xac, 0x21,
-	0x9a, 0xd7, 0xcd, 0x00, 0xca, 0x14, 0x15, 0x8d, 0xaa, 0x50, 0xb4, 0x90, 0x50, 0xf4, 0x0b, 0x86,
-	0x93, 0x8a, 0x9e, 0x86, 0x6b, 0xa6, 0x83, 0xe0, 0x0a, 0x14, 0x2d, 0x0a, 0x1d, 0x38, 0x30, 0xa6,
-	0xa8, 0x20, 0x0a, 0x14, 0x2d, 0xc7, 0x88, 0x02, 0x45, 0xdf, 0x03, 0x74, 0x82, 0xc7, 0x26, 0xb7,
-	0x5c, 0x54, 0x87, 0x59, 0x2e, 0x51, 0x0c, 0xed, 0x04, 0x8f, 0x37, 0x19, 0x22, 0x28, 0xc4, 0xf5,
-	0xcf, 0xe0, 0xda, 0xfe, 0xd0, 0xb6, 0x28, 0x6e, 0xf1, 0x91, 0x7a, 0xac, 0xff, 0x11, 0xc3, 0x7d,
-	0xd6, 0xe3, 0xc8, 0xc7, 0x8d, 0x00, 0x34, 0xed, 0xb3, 0xc6, 0xf1, 0xf5, 0x9f, 0x2a, 0x81, 0x30,
-	0x11, 0x4f, 0x17, 0x12, 0xf6, 0x16, 0xcc, 0x59, 0xb6, 0x2d, 0xdb, 0x39, 0x33, 0x16, 0x6c, 0x15,
-	0xcb, 0xb6, 0x45, 0xe8, 0xee, 0xfb, 0x7d, 0xc2, 0x8e, 0xe5, 0xe3, 0x81, 0x77, 0x8a, 0x27, 0x48,
-	0x33, 0x9c, 0x54, 0x13, 0x98, 0x88, 0xba, 0x8e, 0x61, 0xbe, 0xe5, 0x90, 0xcb, 0x4c, 0x9f, 0x26,
-	0x94, 0x54, 0xa7, 0x4f, 0x6c, 0xf5, 0xa8, 0x73, 0x1a, 0x0c, 0x9e, 0xe4, 0xae, 0xfe, 0x7d, 0x40,
-	0xf1, 0x9f, 0x21, 0x43, 0xcf, 0x25, 0xec, 0x11, 0x9e, 0x75, 0x28, 0x1e, 0xb0, 0x24, 0xca, 0xaa,
-	0x48, 0xe4, 0x7b, 0x41, 0xd7, 0x74, 0x0f, 0x3d, 0x43, 0x50, 0xd4, 0x57, 0x60, 0xbe, 0x43, 0xbd,
-	0xe1, 0x94, 0x9e, 0x67, 0xda, 0xab, 0xfe, 0x6d, 0x11, 0x20, 0x92, 0x73, 0xbe, 0x6d, 0x5f, 0x81,
-	0x3c, 0x3f, 0x70, 0x78, 0xa2, 0x1c, 0xdb, 0x36, 0xed, 0x49, 0x4b, 0x54, 0x12, 0x96, 0x78, 0x02,
-	0x25, 0xe2, 0x8d, 0x58, 0xc9, 0xe4, 0xdd, 0xe4, 0x22, 0x0f, 0xe0, 0x9b, 0x89, 0x43, 0x74, 0x38,
-	0x05, 0x6f, 0x27, 0x81, 0x84, 0x6b, 0xf4, 0x3e, 0xe4, 0x08, 0xb5, 0xe8, 0x88, 0x70, 0x43, 0x55,
-	0x63, 0xaf, 0x0b, 0xc9, 0xc6, 0x91, 0x86, 0x24, 0x62, 0xf9, 0x97, 0x4f, 0xa1, 0xb1, 0x6d, 0x5a,
-	0x94, 0xc7, 0x68, 0xc6, 0x28, 0x4a, 0x48, 0x83, 0xb2, 0x46, 0x1b, 0xbb, 0xb6, 0x40, 0x96, 0x38,
-	0x32, 0xcf, 0xf7, 0x0d, 0xfe, 0xe9, 0x67, 0xc4, 0x43, 0x8d, 0x23, 0x91, 0xe0, 0x94, 0x90, 0x06,
-	0x65, 0xe5, 0xd8, 0xc6, 0xd4, 0x72, 0xfa, 0xa4, 0x76, 0x5d, 0x94, 0x63, 0xb9, 0x65, 0xb9, 0x07,
-	0xfb, 0xbe, 0xe7, 0xcb, 0x02, 0x2c, 0x36, 0x4c, 0x1c, 0x5f, 0xf0, 0x4b, 0x5b, 0xbb, 0x21, 0x92,
-	0x0f, 0x87, 0xb0, 0xeb, 0x89, 0x5a, 0x50, 0xe5, 0xf6, 0xea, 0x05, 0xe3, 0x2d, 0xf9, 0xbe, 0xbe,
-	0x1b, 0x1e, 0xef, 0xec, 0x4f, 0x5b, 0xdb, 0x33, 0x46, 0xc5, 0x8f, 0x63, 0xd1, 0xfb, 0x90, 0x79,
-	0x86, 0x0f, 0x6a, 0xd5, 0xc4, 0x8c, 0x20, 0x39, 0xf9, 0x67, 0x6f, 0xbc, 0x67, 0xf8, 0x00, 0xe9,
-	0x50, 0x1a, 0x46, 0x03, 0xd5, 0xda, 0x35, 0xce, 0xf6, 0x7a, 0x54, 0x65, 0xcf, 0x18, 0xb6, 0x6e,
-	0xcf, 0x18, 0x71, 0x3e, 0xb4, 0x07, 0x73, 0x62, 0x6a, 0x11, 0x1d, 0x42, 0xb4, 0x62, 0x6f, 0x84,
-	0xa2, 0xce, 0x19, 0xe1, 0x6d, 0xcf, 0x18, 0x55, 0x3a, 0x81, 0x46, 0xab, 0x90, 0xe5, 0x10, 0xd9,
-	0x38, 0xdc, 0x9a, 0x14, 0x93, 0xe4, 0x16, 0xb4, 0xe8, 0x83, 0xc4, 0xd7, 0xad, 0x64, 0x0d, 0x62,
-	0x41, 0xcd, 0xae, 0x14, 0x1f, 0x97, 0x29, 0xe1, 0xb0, 0xed, 0x5d, 0x39, 0x57, 0x49, 0x3e, 0x9b,
-	0xd9, 0x43, 0x9e, 0xb1, 0x48, 0x72, 0x31, 0x53, 0xf9, 0x30, 0x36, 0x99, 0x2b, 0x27, 0x07, 0x7a,
-	0x12, 0x11, 0x63, 0x8a, 0x46, 0x72, 0x4f, 0xc2, 0x09, 0x97, 0x8f, 0xc9, 0xa8, 0x4f, 0x49, 0x6d,
-	0x2e, 0x71, 0x83, 0x23, 0x25, 0x83, 0xf9, 0x96, 0x21, 0x28, 0xd1, 0x43, 0x39, 0x5c, 0x0b, 0x38,
-	0x35, 0xce, 0x39, 0xad, 0xa9, 0x98, 0xa9, 0x05, 0x5c, 0x9f, 0x46, 0x53, 0xb1, 0x80, 0x71, 0x3e,
-	0x39, 0x54, 0x8b, 0x69, 0x1c, 0x8e, 0xc3, 0x02, 0xfe, 0xc7, 0xc1, 0x38, 0x2c, 0xe0, 0x5e, 0x48,
-	0x28, 0xcc, 0xfb, 0x15, 0xc1, 0x2b, 0xa6, 0x61, 0x01, 0xe7, 0xbb, 0x30, 0x1f, 0x74, 0x2a, 0x66,
-	0xdf, 0xeb, 0x89, 0x51, 0xc1, 0x2b, 0x62, 0xac, 0x10, 0x20, 0x5a, 0x12, 0x8e, 0x96, 0xe1, 0xda,
-	0x81, 0xd5, 0x3b, 0x19, 0x0d, 0x4d, 0x42, 0x3d, 0x9f, 0xfd, 0xde, 0x88, 0x60, 0xbb, 0x76, 0x93,
-	0x27, 0xc3, 0x79, 0x81, 0xea, 0x08, 0xcc, 0x3e, 0xc1, 0xf6, 0x5a, 0x11, 0xf2, 0xbe, 0xf0, 0x3c,
-	0x6b, 0x1f, 0x84, 0x6e, 0xf5, 0x4f, 0xa0, 0x3a, 0xe9, 0x63, 0xf4, 0x36, 0xcc, 0x3a, 0xee, 0xa1,
-	0x37, 0x95, 0x27, 0x63, 0x56, 0xe6, 0x04, 0x4f, 0xd4, 0x9a, 0x52, 0xff, 0x97, 0x02, 0x10, 0x21,
-	0xd2, 0x3f, 0x8b, 0xc5, 0x92, 0x89, 0x7a, 0x5e, 0x32, 0xc9, 0x4c, 0x26, 0x93, 0x45, 0x28, 0x4c,
-	0x4c, 0x4b, 0x32, 0x46, 0xb8, 0x47, 0x0f, 0xc2, 0x8c, 0x26, 0x9e, 0x1c, 0x8b, 0x29, 0x5a, 0x2e,
-	0x27, 0xd2, 0x5a, 0x98, 0x63, 0x72, 0xb1, 0x1c, 0x53, 0x5f, 0x86, 0x9c, 0xa0, 0x43, 0x00, 0xb9,
-	0xc6, 0x7a, 0xb7, 0xf9, 0x85, 0xae, 0xcd, 0xa0, 0x32, 0x14, 0x36, 0x9b, 0xbb, 0xcd, 0xce, 0xb6,
-	0xbe, 0xa1, 0x29, 0x0c, 0xb3, 0xd9, 0x68, 0xb6, 0xf4, 0x0d, 0x4d, 0xad, 0xff, 0x41, 0x81, 0x42,
-	0x10, 0x35, 0xc1, 0xb0, 0x21, 0x5e, 0xba, 0x82, 0xfd, 0x4b, 0x3a, 0x78, 0x2e, 0x71, 0x70, 0x04,
-	0xb3, 0xc4, 0xf9, 0x06, 0x4b, 0x83, 0xf0, 0x35, 0xa3, 0x0f, 0x63, 0x45, 0x3c, 0x63, 0xc3, 0x7d,
-	0xfd, 0xef, 0x2a, 0x94, 0xe3, 0xb1, 0x3a, 0x3d, 0xde, 0x52, 0x2e, 0x3c, 0xde, 0x2a, 0x9c, 0x31,
-	0xde, 0x8a, 0xeb, 0xab, 0x9e, 0xa1, 0x6f, 0x26, 0xa6, 0xef, 0xbb, 0x30, 0x1f, 0x0a, 0x0e, 0x15,
-	0x17, 0x2f, 0x73, 0x2d, 0x40, 0x84, 0x41, 0xfe, 0x10, 0x6e, 0x4c, 0xaa, 0x12, 0x72, 0x88, 0x52,
-	0xb1, 0x10, 0x57, 0x27, 0xe4, 0xe2, 0x0d, 0xad, 0xb8, 0xc1, 0xbc, 0x1d, 0xe1, 0x76, 0xc9, 0xb0,
-	0x86, 0x96, 0x03, 0xd7, 0x19, 0x2c, 0xe1, 0xa1, 0xdc, 0x79, 0x1e, 0xca, 0x4f, 0x78, 0xa8, 0xfe,
-	0x33, 0x05, 0x20, 0xba, 0xc3, 0x17, 0x9f, 0x84, 0xdc, 0x8e, 0xc6, 0x18, 0x4c, 0x29, 0x85, 0x4b,
-	0x0d, 0x26, 0x16, 0xd3, 0x2a, 0x5d, 0x22, 0x68, 0xea, 0xbf, 0x52, 0xe1, 0x7a, 0x63, 0x44, 0xbd,
-	0xa9, 0xc2, 0x13, 0xfb, 0xa4, 0xa1, 0x5c, 0xe1, 0x13, 0x9c, 0x7a, 0x85, 0x4f, 0x70, 0x99, 0xcb,
-	0x7d, 0xd2, 0x48, 0xf9, 0x28, 0x31, 0x7b, 0xf9, 0x8f, 0x12, 0xf1, 0xef, 0x09, 0xbf, 0x50, 0x61,
-	0x8e, 0x19, 0x27, 0x56, 0x03, 0xff, 0xe7, 0x07, 0x85, 0x4b, 0x1f, 0xc9, 0x86, 0x31, 0xfa, 0x06,
-	0x81, 0x16, 0x40, 0xdb, 0xd0, 0x37, 0x1b, 0xfb, 0xad, 0xae, 0xb9, 0xd9, 0x6c, 0xe9, 0xdd, 0x1f,
-	0xb5, 0x59, 0x32, 0xcc, 0x43, 0x66, 0xa7, 0xfd, 0x50, 0x53, 0xd8, 0x62, 0x6f, 0x6b, 0x4b, 0x53,
-	0x97, 0x76, 0xe1, 0x7a, 0xea, 0xbc, 0x1d, 0xdd, 0x85, 0xdb, 0x81, 0x80, 0x8e, 0xbe, 0xb5, 0xa3,
-	0xef, 0x76, 0xf5, 0x0d, 0x2e, 0xca, 0x6c, 0x1b, 0x7b, 0xdd, 0xbd, 0xf5, 0xbd, 0x96, 0x36, 0x83,
-	0x34, 0x28, 0x6f, 0xb7, 0x3a, 0x11, 0x44, 0x59, 0xba, 0x9f, 0x98, 0xf7, 0xcb, 0xa9, 0x5f, 0x11,
-	0xb2, 0xcd, 0xdd, 0x0d, 0xfd, 0x4b, 0x6d, 0x06, 0x55, 0xa0, 0xd8, 0x6d, 0xee, 0xe8, 0x9d, 0x6e,
-	0x63, 0xa7, 0xad, 0x29, 0x4b, 0x3a, 0xcc, 0x25, 0x46, 0x86, 0xe8, 0x06, 0xa0, 0xe6, 0x4e, 0x63,
-	0x4b, 0x37, 0x3b, 0xfb, 0x9b, 0x9b, 0xcd, 0x2f, 0xcd, 0x80, 0x73, 0x11, 0x6e, 0x4c, 0xc0, 0xe3,
-	0x62, 0x3e, 0x0a, 0x8a, 0x5f, 0x78, 0x80, 0x98, 0x05, 0x62, 0x1a, 0x17, 0x60, 0xd6, 0xe8, 0x32,
-	0x0e, 0x66, 0x82, 0x8e, 0xd1, 0xd5, 0xd4, 0xa5, 0x7d, 0x28, 0xc5, 0xfe, 0xa9, 0x84, 0x10, 0x54,
-	0x03, 0xbe, 0x9d, 0xe6, 0x97, 0xcd, 0xdd, 0x2d, 0x6d, 0x86, 0x69, 0xb4, 0xb1, 0xdf, 0x68, 0x99,
-	0xeb, 0xdb, 0x8d, 0xdd, 0x5d, 0xbd, 0x65, 0x36, 0xb6, 0xf4, 0xdd, 0xae, 0xa6, 0x30, 0x8d, 0x26,
-	0xe1, 0xad, 0xae, 0x6e, 0xec, 0x36, 0xba, 0xba, 0xa6, 0x2e, 0xfd, 0x4d, 0x81, 0xeb, 0xa9, 0xd7,
-	0x92, 0x5b, 0xed, 0xc1, 0xa3, 0x87, 0xe6, 0x87, 0x0f, 0x56, 0xda, 0xe6, 0xea, 0x8a, 0xb4, 0x63,
-	0x08, 0x79, 0xb4, 0xa2, 0x29, 0x68, 0x1e, 0x2a, 0x1c, 0xf2, 0xdd, 0x95, 0xc7, 0x82, 0x48, 0x4d,
-	0x80, 0x1e, 0xad, 0x68, 0x19, 0x74, 0x13, 0xae, 0xb7, 0xf7, 0x8c, 0xae, 0xd1, 0x68, 0x76, 0xcd,
-	0x09, 0x91, 0xb3, 0x67, 0xa0, 0x1e, 0xad, 0x68, 0x59, 0xa6, 0xf5, 0x24, 0x2a, 0xfc, 0x91, 0xdc,
-	0x59, 0xb8, 0x47, 0x2b, 0x5a, 0x7e, 0xe9, 0x37, 0x0a, 0x94, 0xe3, 0x6d, 0x06, 0xba, 0x06, 0x73,
-	0xfa, 0x96, 0xa1, 0x77, 0x3a, 0x66, 0xa7, 0xdb, 0x30, 0xba, 0xc2, 0x56, 0xf3, 0x50, 0x91, 0x40,
-	0x59, 0x83, 0x95, 0x18, 0x48, 0xdf, 0xdd, 0x60, 0x54, 0x6a, 0x8c, 0x75, 0x7d, 0x6f, 0xa7, 0xdd,
-	0xd2, 0xbb, 0xba, 0x96, 0x89, 0xd1, 0xc9, 0x22, 0x3d, 0xcb, 0xbc, 0x11, 0x48, 0x5b, 0xdb, 0x33,
-	0xba, 0xfa, 0x86, 0x96, 0x45, 0x35, 0x58, 0x90, 0xb0, 0x56, 0x73, 0xa7, 0xd9, 0x35, 0x0d, 0xbd,
-	0xb1, 0xce, 0xca, 0x7b, 0x6e, 0xe9, 0x33, 0xd0, 0x92, 0xed, 0x13, 0x3b, 0x51, 0xa0, 0xe4, 0xde,
-	0xbe, 0xb1, 0xae, 0x9b, 0xec, 0x2e, 0x98, 0x4f, 0xf5, 0x35, 0x11, 0x51, 0x29, 0xb8, 0xce, 0xc6,
-	0xe7, 0x9a, 0xf2, 0xe0, 0xd7, 0x59, 0xc8, 0xc9, 0x04, 0xd3, 0x85, 0x1a, 0xff, 0xcf, 0x4e, 0x4a,
-	0x13, 0x82, 0x2e, 0xd2, 0xa2, 0x2c, 0xa6, 0xb5, 0xa8, 0xe8, 0x07, 0x2c, 0x64, 0x2d, 0x9f, 0x86,
-	0x7d, 0x09, 0x3a, 0xbb, 0x57, 0x49, 0x97, 0xd0, 0x86, 0x1b, 0x5c, 0xc2, 0x74, 0xa5, 0x78, 0x7e,
-	0xfb, 0x92, 0x2e, 0xf1, 0x0b, 0xb8, 0xc9, 0x25, 0xa6, 0x75, 0x2a, 0xe8, 0x42, 0x8d, 0x4c, 0xba,
-	0xdc, 0x0d, 0xd0, 0x22, 0xb9, 0x52, 0xdc, 0x79, 0x0d, 0x4d, 0xba, 0x94, 0x06, 0x94, 0xe3, 0xc3,
-	0x14, 0x14, 0x55, 0x91, 0x94, 0x19, 0xcb, 0x73, 0x44, 0x88, 0x6c, 0x31, 0x25, 0x62, 0x62, 0xb2,
-	0x92, 0x2e, 0x42, 0x07, 0x88, 0x86, 0x12, 0x28, 0xaa, 0x85, 0x53, 0x03, 0x91, 0xc5, 0x5b, 0xa9,
-	0x38, 0x39, 0xc5, 0xf8, 0x84, 0x3d, 0xb7, 0x83, 0xd1, 0x04, 0x8a, 0x3f, 0x7b, 0x13, 0xf3, 0x8a,
-	0x54, 0x2d, 0xd6, 0x36, 0x7f, 0x7c, 0xf7, 0xc8, 0xa1, 0xc7, 0xa3, 0x83, 0xe5, 0x9e, 0x37, 0xb8,
-	0x2f, 0x09, 0xee, 0x07, 0x83, 0xc5, 0x00, 0xf0, 0x7b, 0xb5, 0xd2, 0x72, 0x4e, 0xf1, 0xe7, 0x62,
-	0x5a, 0x4c, 0xbd, 0x7f, 0xa8, 0x55, 0xb9, 0x7f, 0xf2, 0x84, 0x03, 0x0e, 0x72, 0x9c, 0x65, 0xf5,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x46, 0xfb, 0x9b, 0x2f, 0x75, 0x2a, 0x00, 0x00,
+	// 3245 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x49, 0x73, 0x1b, 0xc7,
+	0xf5, 0xe7, 0x60, 0xc7, 0xc3, 0x36, 0x6c, 0x52, 0x32, 0x44, 0xf9, 0xff, 0x17, 0x0d, 0x79, 0x91,
+	0x69, 0x9b, 0x62, 0x44, 0x59, 0x96, 0xe5, 0xd8, 0x09, 0x48, 0x0e, 0x49, 0x58, 0x20, 0x89, 0x0c,
+	0x40, 0xc9, 0xc9, 0x65, 0x6a, 0x88, 0x69, 0x52, 0x13, 0x02, 0x33, 0xf0, 0x74, 0x83, 0x32, 0x5c,
+	0x39, 0xe6, 0x90, 0x63, 0x96, 0x43, 0xaa, 0x52, 0xa9, 0xca, 0x21, 0x39, 0xa5, 0xf2, 0x39, 0x72,
+	0x48, 0x2a, 0x95, 0x83, 0xaf, 0x39, 0xe7, 0x90, 0xaa, 0xe4, 0x33, 0xa4, 0x7a, 0x99, 0x05, 0x03,
+	0x90, 0x02, 0x45, 0x55, 0xe5, 0x90, 0xdc, 0xa6, 0xdf, 0x86, 0xd7, 0xaf, 0x7f, 0xfd, 0xba, 0xdf,
+	0x6b, 0xc0, 0x62, 0xcf, 0x3e, 0xc3, 0xa7, 0x36, 0x35, 0xf0, 0x89, 0x87, 0x09, 0x59, 0x1d, 0x78,
+	0x2e, 0x75, 0x51, 0x56, 0x52, 0x97, 0x02, 0x76, 0xdf, 0xb5, 0x70, 0x4f, 0xb2, 0x6b, 0x7f, 0xcc,
+	0xc0, 0x92, 0xee, 0xba, 0xfd, 0x4d, 0xb7, 0x3f, 0x70, 0x89, 0x4d, 0xb1, 0xc6, 0x95, 0x75, 0xfc,
+	0xe5, 0x10, 0x13, 0x8a, 0x6e, 0x42, 0xde, 0x73, 0xdd, 0xbe, 0xe1, 0x98, 0x7d, 0x5c, 0x55, 0x96,
+	0x95, 0x3b, 0x79, 0x3d, 0xc7, 0x08, 0xfb, 0x66, 0x1f, 0xa3, 0xeb, 0x90, 0xe9, 0x99, 0x23, 0x77,
+	0x48, 0xab, 0x09, 0xce, 0x91, 0x23, 0xf4, 0x7f, 0x00, 0xe6, 0xd0, 0xb2, 0x5d, 0xc3, 0x75, 0x7a,
+	0xa3, 0x6a, 0x72, 0x59, 0xb9, 0x93, 0xd3, 0xf3, 0x9c, 0x72, 0xe0, 0xf4, 0x46, 0xe8, 0x23, 0x28,
+	0x0a, 0x76, 0xdf, 0xfe, 0xca, 0x76, 0x4e, 0xaa, 0x95, 0x65, 0xe5, 0x4e, 0xf9, 0xde, 0xe2, 0xaa,
+	0xf4, 0x6f, 0xb5, 0xce, 0x98, 0x7b, 0x9c, 0xa7, 0x17, 0xcc, 0x70, 0xc0, 0xec, 0x9e, 0xd9, 0x16,
+	0x96, 0x76, 0x53, 0xc2, 0x2e, 0xa7, 0x70, 0xbb, 0x6f, 0x43, 0xa5, 0x3b, 0x24, 0xd4, 0xed, 0x1b,
+	0x47, 0x26, 0xc1, 0xc6, 0xd0, 0xeb, 0x55, 0xd3, 0xdc, 0xaf, 0x92, 0x20, 0x6f, 0x98, 0x04, 0x1f,
+	0x7a, 0x3d, 0x74, 0x1f, 0x52, 0xc7, 0x76, 0x0f, 0x57, 0x33, 0xcb, 0xca, 0x9d, 0xc2, 0xbd, 0xa5,
+	0xe0, 0x77, 0x35, 0xa7, 0xeb, 0x5a, 0xd8, 0xda, 0xb6, 0x7b, 0xf8, 0x60, 0x48, 0x07, 0x43, 0xba,
+	0x91, 0xa8, 0x2a, 0xbb, 0x73, 0x3a, 0x97, 0x46, 0xeb, 0x90, 0x21, 0xd4, 0xc3, 0x66, 0xbf, 0x9a,
+	0xe5, 0x7a, 0xd7, 0x02, 0xbd, 0x36, 0x27, 0x8f, 0xa9, 0x48, 0x51, 0xf4, 0x19, 0xe4, 0x08, 0x3e,
+	0xe9, 0x63, 0x87, 0x92, 0x2a, 0x70, 0xb5, 0xd7, 0x43, 0x35, 0xc1, 0x98, 0xf2, 0x83, 0x81, 0x0e,
+	0x7a, 0x08, 0x99, 0x81, 0x87, 0x09, 0xa6, 0xd5, 0x1c, 0x0f, 0xd2, 0xff, 0x8f, 0x3b, 0x6b, 0x3b,
+	0x27, 0x07, 0x03, 0x6a, 0xbb, 0x0e, 0x69, 0x71, 0xa9, 0x5d, 0x45, 0x97, 0xf2, 0xe8, 0x01, 0xe4,
+	0x4c, 0xeb, 0xcc, 0x74, 0xba, 0xd8, 0xaa, 0xe6, 0xf9, 0x2f, 0x57, 0xcf, 0xd3, 0xdd, 0x55, 0xf4,
+	0x40, 0x16, 0x7d, 0x0a, 0x45, 0x36, 0x5d, 0xc3, 0xe5, 0x0e, 0x91, 0x6a, 0x61, 0x39, 0x79, 0x71,
+	0x90, 0xf4, 0xc2, 0x71, 0xf0, 0x4d, 0xd0, 0xb7, 0xa1, 0x2c, 0xa6, 0x1e, 0x18, 0x28, 0x2e, 0x27,
+	0xcf, 0x8d, 0x96, 0x5e, 0x22, 0x91, 0x11, 0x41, 0x1a, 0x54, 0xe4, 0xd4, 0x03, 0xf5, 0xd2, 0x72,
+	0xf2, 0x45, 0x51, 0xd3, 0xcb, 0x52, 0xc9, 0x37, 0xf3, 0x31, 0x94, 0xec, 0xbe, 0x79, 0x12, 0x4e,
+	0xa2, 0xcc, 0x8d, 0x84, 0x08, 0x6b, 0x30, 0xae, 0x54, 0x2e, 0xda, 0xe1, 0x80, 0xa0, 0xdb, 0x50,
+	0x3a, 0x33, 0x7b, 0xb6, 0x65, 0x52, 0x2c, 0x50, 0xa6, 0x72, 0x94, 0x15, 0x7d, 0x22, 0x03, 0xda,
+	0x46, 0x0e, 0x32, 0xc2, 0xf2, 0x46, 0x1e, 0xb2, 0xae, 0x08, 0x62, 0xed, 0xef, 0x69, 0x50, 0x9f,
+	0xe2, 0xa3, 0xf1, 0xed, 0xa3, 0x42, 0x92, 0xc1, 0x50, 0x6c, 0x1c, 0xf6, 0x19, 0xdb, 0x1b, 0x89,
+	0xf8, 0xde, 0x18, 0x87, 0x78, 0x32, 0x0e, 0xf1, 0xf7, 0x01, 0x99, 0xcf, 0x4d, 0x9b, 0x1a, 0x84,
+	0x9a, 0x1e, 0x35, 0x88, 0x7d, 0xe2, 0x98, 0xbd, 0x6a, 0x91, 0x8b, 0xa9, 0x9c, 0xd3, 0x66, 0x8c,
+	0x36, 0xa7, 0x07, 0x40, 0x4f, 0xbd, 0x24, 0xd0, 0xd3, 0x2f, 0x07, 0xf4, 0xcc, 0x95, 0x80, 0x9e,
+	0xbd, 0x02, 0xd0, 0x73, 0x57, 0x00, 0x7a, 0xfe, 0xaa, 0x40, 0x87, 0xab, 0x01, 0xbd, 0xf0, 0x2a,
+	0x80, 0x5e, 0x7a, 0x79, 0xa0, 0x97, 0x67, 0x05, 0xfa, 0x2f, 0x53, 0x50, 0x6d, 0x99, 0x1e, 0xb5,
+	0xbb, 0xf6, 0xc0, 0x74, 0xe8, 0x25, 0xce, 0x8b, 0x25, 0xc8, 0xd9, 0x16, 0x76, 0xa8, 0x4d, 0x47,
+	0xf2, 0xc4, 0x08, 0xc6, 0xe8, 0x0d, 0x28, 0x92, 0xae, 0x87, 0xb1, 0x63, 0x90, 0x67, 0xa6, 0x87,
+	0x25, 0xf4, 0x0b, 0x82, 0xd6, 0x66, 0xa4, 0x08, 0x46, 0x52, 0x33, 0x61, 0x64, 0x6e, 0x2a, 0x46,
+	0xd2, 0x2f, 0xc0, 0xc8, 0xdc, 0x05, 0x18, 0xc9, 0x5c, 0x15, 0x23, 0xd9, 0xab, 0x61, 0x24, 0xf7,
+	0x2a, 0x30, 0x92, 0x7f, 0x79, 0x8c, 0xc0, 0x14, 0x8c, 0x44, 0x90, 0xf1, 0xb7, 0x34, 0xdc, 0xec,
+	0x78, 0x66, 0xf7, 0xf4, 0x65, 0x2e, 0x13, 0x6f, 0x42, 0x59, 0x24, 0x46, 0xca, 0x2c, 0x18, 0xb6,
+	0x25, 0x21, 0x22, 0xee, 0x0a, 0xdc, 0x6c, 0xc3, 0x62, 0x52, 0x22, 0x3f, 0x06, 0x52, 0x49, 0x21,
+	0xc5, 0xa9, 0xbe, 0xd4, 0x7f, 0x28, 0xf1, 0xe5, 0xae, 0x94, 0xf8, 0x32, 0x57, 0x48, 0x7c, 0xd9,
+	0xff, 0x9d, 0xf0, 0x2f, 0x0d, 0xea, 0xca, 0xac, 0x89, 0xef, 0x1b, 0x05, 0x10, 0x47, 0xd8, 0x25,
+	0x50, 0x7d, 0x03, 0x72, 0x31, 0x3c, 0x67, 0xa9, 0x04, 0xe9, 0x5d, 0x09, 0xd2, 0x24, 0x5f, 0xbb,
+	0x1b, 0x81, 0xeb, 0x5b, 0xb6, 0x87, 0xbb, 0x34, 0x9c, 0x7c, 0x80, 0xcf, 0xb7, 0xa0, 0xf4, 0x1c,
+	0x1f, 0x11, 0xb7, 0x7b, 0x8a, 0x29, 0xbf, 0xdd, 0x32, 0x78, 0xe7, 0x77, 0xe7, 0xf4, 0x62, 0x40,
+	0x66, 0xd7, 0xdb, 0x89, 0x09, 0xa6, 0x2f, 0x9a, 0x60, 0xed, 0xcf, 0x09, 0x98, 0x9f, 0x58, 0x72,
+	0xf4, 0x21, 0xe4, 0x39, 0x48, 0xe8, 0x68, 0x20, 0x26, 0x55, 0x8e, 0xa3, 0x4b, 0x88, 0x77, 0x46,
+	0x03, 0xac, 0xe7, 0x8e, 0xe5, 0x17, 0xcb, 0xf0, 0xec, 0x7b, 0x60, 0xd2, 0x67, 0x7e, 0x86, 0xf7,
+	0xc7, 0xe8, 0x5d, 0x50, 0x2d, 0x9b, 0x98, 0x47, 0x3d, 0x6c, 0xf4, 0x4d, 0xc7, 0x3e, 0xc6, 0x44,
+	0x60, 0x3e, 0xa7, 0x57, 0x24, 0x7d, 0x4f, 0x92, 0xd1, 0x6d, 0x48, 0x90, 0x75, 0x19, 0x98, 0xf9,
+	0x10, 0x18, 0xeb, 0x87, 0x83, 0x9e, 0x6b, 0x5a, 0xbb, 0x73, 0x7a, 0x82, 0xac, 0xa3, 0xb7, 0x21,
+	0x79, 0xd2, 0x1d, 0xc8, 0x3d, 0x8e, 0x02, 0xa9, 0x9d, 0xcd, 0x56, 0x20, 0xc6, 0x04, 0xd0, 0x1a,
+	0xa4, 0xcd, 0xaf, 0x87, 0x1e, 0x9e, 0xc8, 0xfc, 0x75, 0x46, 0xdd, 0xe8, 0xb9, 0x47, 0x81, 0xbc,
+	0x10, 0x44, 0x77, 0x21, 0x63, 0xf6, 0xec, 0x83, 0x76, 0x7b, 0xe2, 0xaa, 0x5f, 0xe7, 0xe4, 0x40,
+	0x5e, 0x8a, 0x45, 0xa2, 0xf9, 0xbb, 0x14, 0x2c, 0x4c, 0x01, 0x30, 0x7a, 0x04, 0x39, 0x5e, 0x6f,
+	0x75, 0xdd, 0x5e, 0x55, 0x89, 0x6d, 0xf4, 0x31, 0xf9, 0x96, 0x94, 0xd2, 0x03, 0x79, 0xf4, 0x0e,
+	0x54, 0x58, 0x10, 0x19, 0xbe, 0x8c, 0x81, 0x87, 0x8f, 0xed, 0xaf, 0x64, 0x6c, 0xcb, 0x3e, 0xb9,
+	0xc5, 0xa9, 0x6c, 0xe5, 0x07, 0x3d, 0x73, 0xd4, 0xb3, 0x09, 0x15, 0x68, 0x94, 0xb9, 0xd1, 0x27,
+	0x72, 0x44, 0xbe, 0x0f, 0x88, 0xfd, 0xb0, 0x31, 0x2e, 0x59, 0xe0, 0x92, 0x2a, 0xe3, 0xb4, 0xa2,
+	0xd2, 0xef, 0x82, 0xea, 0xef, 0x57, 0x6b, 0xe8, 0x99, 0x6c, 0x23, 0xf0, 0x88, 0x97, 0x74, 0x7f,
+	0x1f, 0x6f, 0x49, 0x32, 0xdb, 0xda, 0x81, 0x9b, 0x64, 0x78, 0xcc, 0xdc, 0x04, 0x3e, 0xd3, 0x73,
+	0xb6, 0x76, 0x9b, 0xcb, 0x84, 0x93, 0x10, 0xe3, 0xa9, 0x30, 0xc9, 0x5d, 0x04, 0x93, 0xf4, 0x4c,
+	0x30, 0xc9, 0xcc, 0x0c, 0x93, 0xec, 0xe5, 0x61, 0x92, 0xbf, 0x2c, 0x4c, 0x7e, 0x91, 0x00, 0x35,
+	0xbe, 0xcf, 0xc7, 0x36, 0x8f, 0x32, 0xc3, 0xe6, 0x49, 0x5f, 0x14, 0x95, 0xc4, 0x4c, 0x51, 0x49,
+	0xce, 0x1c, 0x95, 0xd4, 0xe5, 0xa3, 0x92, 0xb9, 0x6c, 0x54, 0xfe, 0x99, 0x84, 0x42, 0x24, 0x71,
+	0xb3, 0x49, 0x77, 0xcd, 0x01, 0x1d, 0x7a, 0xd8, 0xb0, 0x1d, 0x8a, 0xbd, 0x33, 0x53, 0x6c, 0x9e,
+	0x92, 0x5e, 0x91, 0xf4, 0x86, 0x24, 0xa3, 0x45, 0x48, 0x3f, 0xb7, 0x2d, 0x99, 0x75, 0xd2, 0xba,
+	0x18, 0xb0, 0x06, 0xc5, 0x33, 0x6c, 0x9f, 0x3c, 0xa3, 0x7c, 0xa2, 0x69, 0x5d, 0x8e, 0xa6, 0xed,
+	0xa8, 0xd4, 0xd4, 0x1d, 0x55, 0x9f, 0xc4, 0x74, 0x3a, 0x96, 0x0c, 0xb9, 0xc3, 0x17, 0xe0, 0xf9,
+	0x3e, 0x14, 0xc4, 0x51, 0xc5, 0x72, 0x66, 0x57, 0x9e, 0xf2, 0x0b, 0xe3, 0xea, 0x9b, 0x8c, 0xa5,
+	0x83, 0x1d, 0x7c, 0x4f, 0x5d, 0xef, 0xec, 0x45, 0xeb, 0x9d, 0x9b, 0x69, 0xbd, 0xf3, 0x33, 0xaf,
+	0x37, 0x5c, 0x7e, 0xbd, 0x0b, 0x97, 0x5d, 0xef, 0xbf, 0x24, 0x21, 0xe7, 0xfb, 0xc9, 0x0b, 0xe3,
+	0x6e, 0x17, 0x13, 0x62, 0x9c, 0xe2, 0x91, 0xc4, 0x7f, 0x5e, 0x50, 0x1e, 0xe3, 0x11, 0x5b, 0x4a,
+	0x82, 0xbb, 0x1e, 0x0e, 0x7a, 0x4d, 0x62, 0xc4, 0x72, 0x1e, 0xc1, 0x84, 0xd8, 0xae, 0x63, 0x50,
+	0xf7, 0x14, 0x3b, 0x32, 0x93, 0x15, 0x25, 0xb1, 0xc3, 0x68, 0x4c, 0xd9, 0xc3, 0x27, 0x2c, 0x77,
+	0x89, 0x8c, 0x28, 0x47, 0x6c, 0xc7, 0x61, 0xc7, 0x1a, 0xb8, 0xb6, 0x43, 0x25, 0x00, 0x82, 0x31,
+	0xd3, 0x39, 0x1a, 0xb2, 0x33, 0x55, 0x36, 0x91, 0xe4, 0x08, 0xdd, 0x01, 0xf5, 0xd8, 0xf5, 0xba,
+	0xd8, 0x60, 0xfb, 0xd2, 0x20, 0x74, 0x24, 0x3b, 0x49, 0x39, 0xbd, 0xcc, 0xe9, 0x2d, 0x93, 0x3e,
+	0x6b, 0x33, 0x2a, 0xfa, 0x04, 0x72, 0x7d, 0x4c, 0x4d, 0xcb, 0xa4, 0xa6, 0xbc, 0xf8, 0xdf, 0x9a,
+	0x58, 0x9e, 0xd5, 0x3d, 0x29, 0xa1, 0x39, 0xd4, 0x1b, 0xe9, 0x81, 0x02, 0xaa, 0x42, 0x96, 0x9a,
+	0x27, 0x27, 0xac, 0x3f, 0x96, 0x93, 0xf7, 0x06, 0x31, 0x44, 0x77, 0x61, 0xa1, 0xeb, 0x3a, 0x94,
+	0xa7, 0x64, 0x9b, 0xf0, 0x7b, 0x36, 0x9b, 0x59, 0x9e, 0x4b, 0x21, 0xc9, 0xda, 0x0a, 0x39, 0x68,
+	0x05, 0xd2, 0x03, 0xcf, 0xfd, 0x6a, 0x24, 0xd7, 0x34, 0xbc, 0x24, 0xb5, 0x18, 0x75, 0xd3, 0x75,
+	0x8e, 0xed, 0x13, 0x5d, 0x88, 0x2c, 0x7d, 0x02, 0xa5, 0x31, 0x8f, 0x58, 0x07, 0x23, 0x5c, 0x0f,
+	0xf6, 0xc9, 0xb6, 0xda, 0x99, 0xd9, 0x1b, 0x62, 0xb9, 0x10, 0x62, 0xf0, 0x28, 0xf1, 0x50, 0xa9,
+	0x7d, 0x09, 0xf9, 0x00, 0x50, 0x68, 0x19, 0x0a, 0x5d, 0x0f, 0xf3, 0xf2, 0xce, 0xec, 0x11, 0x69,
+	0x20, 0x4a, 0x8a, 0x44, 0x38, 0x31, 0x16, 0xe1, 0xc0, 0xdf, 0xe4, 0x0b, 0xfd, 0xad, 0xfd, 0x08,
+	0x2a, 0x31, 0x64, 0xb2, 0x4a, 0xd2, 0xec, 0x76, 0xdd, 0xa1, 0x43, 0xa3, 0x57, 0xb2, 0x82, 0xa4,
+	0xf1, 0x53, 0xed, 0x16, 0xf8, 0x43, 0x0e, 0x36, 0xf1, 0xf3, 0x20, 0x49, 0x0c, 0x6d, 0x6f, 0x41,
+	0x99, 0x05, 0xd2, 0xb4, 0x1d, 0xec, 0x45, 0x8f, 0xd2, 0x52, 0x40, 0x65, 0x76, 0x6a, 0x3f, 0x53,
+	0xa0, 0x18, 0x45, 0xf9, 0xcb, 0x82, 0xf8, 0x15, 0xe2, 0xb3, 0xf6, 0x14, 0x0a, 0x91, 0x38, 0x4d,
+	0xe9, 0x40, 0x2d, 0x41, 0x6e, 0x48, 0xb0, 0xc7, 0x67, 0x25, 0xef, 0x68, 0xfe, 0x98, 0xf1, 0x06,
+	0x26, 0x21, 0xcf, 0x5d, 0xcf, 0x2f, 0xac, 0x82, 0x71, 0xed, 0x29, 0x14, 0xa3, 0x37, 0x7b, 0xb4,
+	0x3e, 0x71, 0xa5, 0x79, 0x2d, 0x56, 0x02, 0x4c, 0xb9, 0xcb, 0x20, 0x48, 0x0d, 0xbd, 0x1e, 0xa9,
+	0x26, 0x96, 0x93, 0x77, 0xf2, 0x3a, 0xff, 0xae, 0xfd, 0x29, 0x09, 0x95, 0x58, 0xc1, 0x12, 0xe6,
+	0x73, 0x65, 0x7a, 0x3e, 0x4f, 0x8c, 0xe5, 0xf3, 0x45, 0x48, 0x5b, 0x78, 0x40, 0x9f, 0xc9, 0x34,
+	0x2f, 0x06, 0xe8, 0x75, 0xc8, 0x1f, 0x7b, 0x66, 0x1f, 0x7b, 0x26, 0x15, 0xe7, 0x57, 0x5a, 0x0f,
+	0x09, 0x2c, 0x2f, 0x8b, 0x7a, 0x53, 0xe4, 0xe5, 0x74, 0x2c, 0x2f, 0xf3, 0x26, 0xb4, 0xcc, 0xcb,
+	0x66, 0xf0, 0xcd, 0xd2, 0x8d, 0xd0, 0x3a, 0xb2, 0x29, 0xb7, 0x9b, 0xe1, 0x76, 0x45, 0x91, 0xba,
+	0x21, 0x68, 0xa1, 0xd0, 0x97, 0x43, 0xb3, 0xc7, 0x9a, 0x1d, 0x85, 0x88, 0xd0, 0xf7, 0x04, 0x8d,
+	0x9d, 0x41, 0x42, 0xe8, 0xd8, 0x63, 0x85, 0x84, 0xd3, 0x1d, 0xf1, 0x04, 0x9f, 0xd6, 0x45, 0x19,
+	0xbc, 0xed, 0x53, 0x99, 0xa3, 0xa2, 0xe4, 0x15, 0x8e, 0xe6, 0x62, 0x8e, 0x3e, 0x61, 0x3c, 0xe9,
+	0xe8, 0x59, 0xf0, 0xcd, 0xab, 0x00, 0xae, 0xe5, 0x3b, 0x9a, 0x17, 0x3e, 0x70, 0x62, 0xc4, 0x51,
+	0x21, 0xe4, 0x3b, 0x5a, 0x8c, 0x08, 0xf9, 0x8e, 0xbe, 0x0f, 0xe8, 0x14, 0x8f, 0x0c, 0x1e, 0xb9,
+	0xf0, 0x1c, 0x66, 0xb9, 0x44, 0xd1, 0xd5, 0x53, 0x3c, 0xda, 0x66, 0x0c, 0xff, 0x20, 0xae, 0x7d,
+	0x0e, 0x0b, 0x87, 0x03, 0x56, 0x66, 0x34, 0xf9, 0x5b, 0x40, 0xa4, 0x48, 0x12, 0xaf, 0x12, 0xac,
+	0x10, 0x92, 0x97, 0x1b, 0x41, 0x68, 0x58, 0xe7, 0xbd, 0x23, 0xd4, 0x7e, 0xa2, 0xf8, 0xc6, 0x04,
+	0x9e, 0x66, 0x32, 0xf6, 0x36, 0x54, 0x4c, 0xcb, 0x92, 0x85, 0xa1, 0x11, 0x01, 0x5b, 0xc9, 0xb4,
+	0x2c, 0x01, 0xdd, 0x43, 0xaf, 0x47, 0xd8, 0xb4, 0x3c, 0xdc, 0x77, 0xcf, 0xf0, 0x98, 0x68, 0x92,
+	0x8b, 0xaa, 0x82, 0x13, 0x4a, 0xd7, 0x7e, 0xac, 0xc0, 0x7c, 0xd3, 0x26, 0x97, 0xe9, 0x76, 0x8d,
+	0x79, 0x99, 0x98, 0x9c, 0xb2, 0xd9, 0xa5, 0xf6, 0x99, 0xdf, 0xe8, 0x92, 0x23, 0x96, 0x40, 0x06,
+	0x9e, 0xfb, 0x43, 0xdc, 0xa5, 0x4c, 0x4b, 0xec, 0xf9, 0xbc, 0xa4, 0x34, 0xac, 0xda, 0x77, 0x00,
+	0x45, 0xbd, 0x20, 0x03, 0xd7, 0x21, 0xec, 0x92, 0x9e, 0xb6, 0x29, 0xee, 0xb3, 0x24, 0xcb, 0x4e,
+	0x99, 0x10, 0x1b, 0x42, 0xae, 0xe1, 0x1c, 0xbb, 0xba, 0x90, 0xa8, 0xad, 0xc1, 0x7c, 0x9b, 0xba,
+	0x83, 0x89, 0x69, 0x9c, 0x1b, 0xcf, 0xda, 0x6f, 0x01, 0x20, 0xb4, 0x73, 0x71, 0xec, 0x5f, 0x83,
+	0x2c, 0x8f, 0x47, 0x30, 0xe1, 0x0c, 0x1b, 0x36, 0xac, 0xf1, 0x40, 0x95, 0x62, 0x81, 0x7a, 0x04,
+	0x05, 0xe2, 0x0e, 0xbd, 0xae, 0xac, 0x36, 0x97, 0x38, 0xc0, 0x6f, 0xc4, 0x26, 0xd1, 0xe6, 0x12,
+	0xbc, 0xdc, 0x04, 0x12, 0x7c, 0xa3, 0x0f, 0x58, 0xcf, 0xc6, 0xa4, 0x43, 0xc2, 0xe3, 0x58, 0xbe,
+	0x77, 0x2d, 0xae, 0xc6, 0x99, 0xba, 0x14, 0x62, 0xe1, 0xe5, 0x9d, 0x73, 0x6c, 0x19, 0x26, 0xe5,
+	0x18, 0x4e, 0xea, 0x79, 0x49, 0xa9, 0x53, 0x56, 0xad, 0x63, 0xc7, 0x12, 0xcc, 0x02, 0x67, 0x66,
+	0xf9, 0xb8, 0xce, 0xdf, 0xb4, 0x86, 0x1c, 0x8a, 0x9c, 0x89, 0x84, 0xa6, 0xa4, 0xd4, 0x29, 0x3b,
+	0xae, 0x2d, 0x4c, 0x4d, 0xbb, 0x47, 0xaa, 0xd7, 0xc4, 0x71, 0x2d, 0x87, 0x2c, 0x37, 0x61, 0xcf,
+	0x73, 0x3d, 0x79, 0x40, 0x8b, 0x01, 0x33, 0xc7, 0x3f, 0xf8, 0xa6, 0xae, 0x5e, 0x17, 0xc9, 0x89,
+	0x53, 0xd8, 0xf6, 0x45, 0x4d, 0x28, 0xf3, 0x78, 0x75, 0xfd, 0x46, 0x9a, 0xbc, 0x7f, 0xdf, 0x0e,
+	0xa6, 0x77, 0xfe, 0x9b, 0xdd, 0xee, 0x9c, 0x5e, 0xf2, 0xa2, 0x5c, 0xf4, 0x01, 0x24, 0x9f, 0xe3,
+	0xa3, 0x6a, 0x39, 0xd6, 0x68, 0x88, 0xbf, 0x56, 0xb0, 0x3b, 0xe0, 0x73, 0x7c, 0x84, 0x34, 0x28,
+	0x0c, 0xc2, 0xfe, 0x6e, 0x75, 0x81, 0xab, 0xbd, 0x11, 0x9e, 0xc2, 0xe7, 0xf4, 0x7e, 0x77, 0xe7,
+	0xf4, 0xa8, 0x1e, 0x3a, 0x80, 0x8a, 0x68, 0x7d, 0x84, 0x93, 0x10, 0xa5, 0xda, 0x9b, 0x81, 0xa9,
+	0x0b, 0x9a, 0x85, 0xbb, 0x73, 0x7a, 0x99, 0x8e, 0xb1, 0xd1, 0x3a, 0xa4, 0x39, 0x45, 0x16, 0x16,
+	0x37, 0xc7, 0xcd, 0xc4, 0xb5, 0x85, 0x2c, 0xfa, 0x30, 0xf6, 0x6c, 0x17, 0x3f, 0xa3, 0x18, 0xa8,
+	0xd9, 0x96, 0xe2, 0x8d, 0x39, 0x25, 0x68, 0xeb, 0xbd, 0x27, 0x9b, 0x33, 0xf1, 0x6b, 0x35, 0xbb,
+	0xe8, 0x33, 0x15, 0x29, 0xce, 0x85, 0xd0, 0x47, 0x91, 0x1e, 0x60, 0x31, 0xde, 0x3a, 0x94, 0x8c,
+	0x88, 0x52, 0x20, 0x8c, 0x1e, 0x05, 0xbd, 0x34, 0x0f, 0x93, 0x61, 0x8f, 0x92, 0x6a, 0x25, 0xb6,
+	0x83, 0x43, 0x27, 0xfd, 0x4e, 0x9a, 0x2e, 0x24, 0xd1, 0x7d, 0xd9, 0xc6, 0xf3, 0x35, 0xd5, 0xe5,
+	0xe4, 0x54, 0x4f, 0x45, 0xf7, 0xce, 0xd7, 0xfa, 0x2c, 0xec, 0xbf, 0xf9, 0x8a, 0xf3, 0xf1, 0xf6,
+	0x5d, 0xc4, 0xe3, 0xa0, 0xf1, 0xe6, 0xeb, 0x3f, 0xf4, 0x1b, 0x6f, 0xbe, 0xf6, 0x62, 0xcc, 0x61,
+	0x5e, 0xcf, 0x08, 0x5d, 0xd1, 0x77, 0xf3, 0x35, 0xdf, 0x83, 0x79, 0xbf, 0x92, 0x31, 0x7a, 0x6e,
+	0x57, 0xb4, 0x12, 0x5e, 0x13, 0x6d, 0x07, 0x9f, 0xd1, 0x94, 0x74, 0xb4, 0x0a, 0x0b, 0x47, 0x66,
+	0xf7, 0x74, 0x38, 0x30, 0x08, 0x75, 0x3d, 0xf6, 0x7b, 0x43, 0x82, 0xad, 0xea, 0x0d, 0x9e, 0x2b,
+	0xe7, 0x05, 0xab, 0x2d, 0x38, 0x87, 0x04, 0x5b, 0xe8, 0x31, 0x2c, 0xc8, 0xf6, 0x16, 0x2b, 0x04,
+	0x9e, 0x9b, 0x9e, 0x63, 0x3b, 0x27, 0xa4, 0x7a, 0x33, 0xd6, 0xda, 0x7c, 0x12, 0xc8, 0x3c, 0x15,
+	0x22, 0x3a, 0x3a, 0x8b, 0x93, 0x48, 0x2c, 0x07, 0xbf, 0x1e, 0xcb, 0xc1, 0xac, 0x23, 0xe8, 0x09,
+	0x94, 0xb1, 0x52, 0x46, 0xc4, 0xa1, 0xf6, 0x29, 0x94, 0xc7, 0xf1, 0x84, 0xde, 0x81, 0x94, 0xed,
+	0x1c, 0xbb, 0x13, 0x39, 0x39, 0xb2, 0xa2, 0x5c, 0xe0, 0x51, 0xa2, 0xaa, 0xd4, 0xfe, 0xa5, 0x00,
+	0x84, 0x8c, 0xe9, 0xcf, 0x86, 0x91, 0xc4, 0x95, 0xb8, 0x28, 0x71, 0x25, 0xc7, 0x13, 0xd7, 0x12,
+	0xe4, 0xc6, 0x3a, 0x37, 0x49, 0x3d, 0x18, 0xa3, 0x7b, 0x41, 0xf6, 0x14, 0xd7, 0x9f, 0xa5, 0x29,
+	0x5e, 0xae, 0xc6, 0x52, 0x68, 0x90, 0xcf, 0x32, 0x91, 0x7c, 0x56, 0x5b, 0x85, 0x8c, 0x90, 0x43,
+	0x00, 0x99, 0xfa, 0x66, 0xa7, 0xf1, 0x44, 0x53, 0xe7, 0x50, 0x11, 0x72, 0xdb, 0x8d, 0xfd, 0x46,
+	0x7b, 0x57, 0xdb, 0x52, 0x15, 0xc6, 0xd9, 0xae, 0x37, 0x9a, 0xda, 0x96, 0x9a, 0xa8, 0xfd, 0x41,
+	0x81, 0x9c, 0x8f, 0x50, 0xbf, 0xf1, 0x11, 0x3d, 0x45, 0xfd, 0xf1, 0x2b, 0x9a, 0x78, 0x26, 0x36,
+	0x71, 0x04, 0x29, 0x62, 0x7f, 0x8d, 0x65, 0x40, 0xf8, 0x37, 0x93, 0x0f, 0x70, 0x29, 0xae, 0xd4,
+	0xc1, 0xb8, 0xf6, 0x4d, 0x02, 0x8a, 0xd1, 0x7d, 0x31, 0xd9, 0x6a, 0x53, 0x66, 0x6e, 0xb5, 0xe5,
+	0xce, 0x69, 0xb5, 0x45, 0xfd, 0x4d, 0x9c, 0xe3, 0x6f, 0x32, 0xe2, 0xef, 0x7b, 0x30, 0x1f, 0x18,
+	0x0e, 0x1c, 0x17, 0x37, 0x06, 0xd5, 0x67, 0x04, 0x1b, 0xea, 0x3e, 0x5c, 0x1f, 0x77, 0x25, 0xd0,
+	0x10, 0xc7, 0xd2, 0x62, 0xd4, 0x9d, 0x40, 0x8b, 0x17, 0xd7, 0x22, 0x5b, 0xf0, 0xd2, 0x88, 0xc7,
+	0x25, 0xa9, 0x17, 0x25, 0x71, 0x93, 0xd1, 0x62, 0x2b, 0x94, 0xb9, 0x68, 0x85, 0xb2, 0x63, 0x2b,
+	0x54, 0xfb, 0xa9, 0x02, 0x10, 0xe6, 0x8b, 0xd9, 0xbb, 0x32, 0xb7, 0xc2, 0x96, 0x0a, 0x73, 0x4a,
+	0xe1, 0x56, 0xfd, 0xee, 0xc9, 0xa4, 0x4b, 0x97, 0x00, 0x4d, 0xed, 0x57, 0x09, 0xb8, 0x56, 0x1f,
+	0x52, 0x77, 0xe2, 0x90, 0x8b, 0x3c, 0xd4, 0x28, 0x57, 0x78, 0x7d, 0x4c, 0x5c, 0xe1, 0xf5, 0x31,
+	0x79, 0xb9, 0x87, 0x9a, 0x29, 0x4f, 0x2d, 0xa9, 0xcb, 0x3f, 0xb5, 0x44, 0x1f, 0x40, 0x7e, 0x9e,
+	0x80, 0x0a, 0x0b, 0x4e, 0xe4, 0xbc, 0xfd, 0xaf, 0x6f, 0x5a, 0xae, 0x7c, 0x2c, 0x8b, 0xd7, 0xf0,
+	0x3d, 0x04, 0x2d, 0x82, 0xba, 0xa5, 0x6d, 0xd7, 0x0f, 0x9b, 0x1d, 0x63, 0xbb, 0xd1, 0xd4, 0x3a,
+	0xdf, 0x6f, 0xb1, 0x64, 0x98, 0x85, 0xe4, 0x5e, 0xeb, 0xbe, 0xaa, 0xb0, 0x8f, 0x83, 0x9d, 0x1d,
+	0x35, 0xb1, 0xb2, 0x0f, 0xd7, 0xa6, 0xf6, 0xfe, 0xd1, 0x6d, 0xb8, 0xe5, 0x1b, 0x68, 0x6b, 0x3b,
+	0x7b, 0xda, 0x7e, 0x47, 0xdb, 0xe2, 0xa6, 0x8c, 0x96, 0x7e, 0xd0, 0x39, 0xd8, 0x3c, 0x68, 0xaa,
+	0x73, 0x48, 0x85, 0xe2, 0x6e, 0xb3, 0x1d, 0x52, 0x94, 0x95, 0xbb, 0xb1, 0xb7, 0x07, 0xd9, 0x81,
+	0xcc, 0x43, 0xba, 0xb1, 0xbf, 0xa5, 0x7d, 0xa1, 0xce, 0xa1, 0x12, 0xe4, 0x3b, 0x8d, 0x3d, 0xad,
+	0xdd, 0xa9, 0xef, 0xb5, 0x54, 0x65, 0x45, 0x83, 0x4a, 0xac, 0x7d, 0x89, 0xae, 0x03, 0x6a, 0xec,
+	0xd5, 0x77, 0x34, 0xa3, 0x7d, 0xb8, 0xbd, 0xdd, 0xf8, 0xc2, 0xf0, 0x35, 0x97, 0xe0, 0xfa, 0x18,
+	0x3d, 0x6a, 0xe6, 0x63, 0xff, 0xf0, 0x0b, 0x26, 0x10, 0x89, 0x40, 0xc4, 0xe3, 0x1c, 0xa4, 0xf4,
+	0xce, 0x5e, 0x4b, 0x84, 0xa0, 0xad, 0x77, 0xd4, 0xc4, 0xca, 0x21, 0x14, 0x22, 0x7f, 0xf7, 0x42,
+	0x08, 0xca, 0xbe, 0xde, 0x5e, 0xe3, 0x8b, 0xc6, 0xfe, 0x8e, 0x3a, 0xc7, 0x3c, 0xda, 0x3a, 0xac,
+	0x37, 0x8d, 0xcd, 0xdd, 0xfa, 0xfe, 0xbe, 0xd6, 0x34, 0xea, 0x3b, 0xda, 0x7e, 0x47, 0x55, 0x98,
+	0x47, 0xe3, 0xf4, 0x66, 0x47, 0xd3, 0xf7, 0xeb, 0x1d, 0x4d, 0x4d, 0xac, 0xfc, 0x55, 0x81, 0x6b,
+	0x53, 0xb7, 0x25, 0x8f, 0xda, 0xbd, 0x07, 0xf7, 0x8d, 0x8f, 0xee, 0xad, 0xb5, 0x8c, 0xf5, 0x35,
+	0x75, 0x6e, 0x9c, 0xf2, 0x60, 0x4d, 0x55, 0xd0, 0x3c, 0x94, 0x38, 0xe5, 0x5b, 0x6b, 0x0f, 0x85,
+	0x50, 0x22, 0x46, 0x7a, 0xb0, 0xa6, 0x26, 0xd1, 0x0d, 0xb8, 0xd6, 0x3a, 0xd0, 0x3b, 0x7a, 0xbd,
+	0xd1, 0x31, 0xc6, 0x4c, 0xa6, 0xce, 0x61, 0x3d, 0x58, 0x53, 0xd3, 0xcc, 0xeb, 0x71, 0x56, 0xf0,
+	0x23, 0x99, 0xf3, 0x78, 0x0f, 0xd6, 0xd4, 0xec, 0xca, 0x6f, 0x14, 0x28, 0x46, 0x4b, 0x1a, 0xb4,
+	0x00, 0x15, 0x6d, 0x47, 0xd7, 0xda, 0x6d, 0xa3, 0xdd, 0xa9, 0xeb, 0x1d, 0x11, 0xab, 0x79, 0x28,
+	0x49, 0xa2, 0x3c, 0x83, 0x95, 0x08, 0x49, 0xdb, 0xdf, 0x62, 0x52, 0x89, 0x88, 0xea, 0xe6, 0xc1,
+	0x5e, 0xab, 0xa9, 0x75, 0x34, 0x35, 0x19, 0x91, 0x93, 0x87, 0x74, 0x8a, 0xad, 0x86, 0x6f, 0x6d,
+	0xe3, 0x40, 0xef, 0x68, 0x5b, 0x6a, 0x1a, 0x55, 0x61, 0x51, 0xd2, 0x9a, 0x8d, 0xbd, 0x46, 0xc7,
+	0xd0, 0xb5, 0xfa, 0x26, 0x3b, 0xde, 0x33, 0x2b, 0x9f, 0x83, 0x1a, 0x2f, 0xd5, 0xd8, 0x8c, 0x7c,
+	0x27, 0x0f, 0x0e, 0xf5, 0x4d, 0xcd, 0x60, 0x7b, 0xc1, 0x78, 0xaa, 0x6d, 0xa8, 0x73, 0xe7, 0xf0,
+	0xda, 0x5b, 0x8f, 0x55, 0xe5, 0xde, 0xaf, 0xd3, 0x90, 0x91, 0x09, 0xa6, 0x03, 0x55, 0xfe, 0x9f,
+	0xa6, 0x29, 0x05, 0x0f, 0x9a, 0xa5, 0x1c, 0x5a, 0x9a, 0x56, 0x0e, 0xa3, 0xef, 0x32, 0xc8, 0x9a,
+	0x1e, 0x0d, 0x6a, 0x20, 0x74, 0x7e, 0x5d, 0x34, 0xdd, 0x42, 0x0b, 0xae, 0x73, 0x0b, 0x93, 0x27,
+	0xc5, 0x8b, 0x4b, 0xa5, 0xe9, 0x16, 0x9f, 0xc0, 0x0d, 0x6e, 0x71, 0x5a, 0x55, 0x84, 0x66, 0x2a,
+	0x9a, 0xa6, 0xdb, 0xdd, 0x02, 0x35, 0xb4, 0x2b, 0xcd, 0x5d, 0x54, 0x3c, 0x4d, 0xb7, 0x52, 0x87,
+	0x62, 0xb4, 0xb1, 0x83, 0xc2, 0x53, 0x64, 0x4a, 0xbf, 0xe7, 0x05, 0x26, 0x44, 0xb6, 0x98, 0x30,
+	0x31, 0xd6, 0xe5, 0x99, 0x6e, 0x42, 0x03, 0x08, 0x1b, 0x20, 0x28, 0x3c, 0x0b, 0x27, 0x7a, 0x33,
+	0x4b, 0x37, 0xa7, 0xf2, 0x64, 0xc7, 0xe4, 0x53, 0x80, 0xb0, 0x0d, 0x82, 0xa2, 0xd7, 0xde, 0x58,
+	0x6f, 0x64, 0xaa, 0x17, 0x1b, 0xdb, 0x3f, 0xb8, 0x7d, 0x62, 0xd3, 0x67, 0xc3, 0xa3, 0xd5, 0xae,
+	0xdb, 0xbf, 0x2b, 0x05, 0xee, 0xfa, 0x4d, 0x4e, 0x9f, 0xf0, 0xfb, 0x44, 0xa9, 0x69, 0x9f, 0xe1,
+	0xc7, 0xa2, 0x73, 0x4d, 0xdd, 0x7f, 0x24, 0xca, 0x72, 0xfc, 0xe8, 0x11, 0x27, 0x1c, 0x65, 0xb8,
+	0xca, 0xfa, 0xbf, 0x07, 0x00, 0x67, 0x18, 0x06, 0x3f, 0xba, 0x2b, 0x00, 0x00,
 }
@@ -1356,98 +1356,104 @@ func (s *ingressServer) PathPrefix() string {
 }
 
 var twirpFileDescriptor2 = []byte{
-	// 1485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x36, 0xf5, 0xad, 0x51, 0x24, 0x2b, 0x6b, 0x39, 0x61, 0xfc, 0x81, 0xd7, 0x50, 0xf2, 0x22,
-	0x8e, 0xf3, 0x42, 0xb1, 0x15, 0xc7, 0x6f, 0x1b, 0x20, 0x40, 0x2d, 0x5b, 0x8e, 0x08, 0xdb, 0x92,
-	0xb0, 0x92, 0x53, 0xb4, 0x17, 0x82, 0x16, 0x37, 0x36, 0x11, 0x89, 0x54, 0xc9, 0x95, 0x1b, 0xa3,
-	0xd7, 0x1e, 0xf2, 0x03, 0x7a, 0xef, 0xa1, 0xed, 0x25, 0x97, 0xa2, 0x7f, 0xab, 0xe8, 0xb9, 0xe7,
-	0x62, 0x3f, 0x44, 0x93, 0x12, 0x15, 0xd8, 0x69, 0x51, 0xe4, 0xa6, 0x9d, 0x67, 0x66, 0xf8, 0xec,
-	0xce, 0xec, 0x33, 0x0b, 0xc1, 0x62, 0xdf, 0xba, 0x20, 0x6f, 0x2c, 0xaa, 0x5b, 0xf6, 0x99, 0x4b,
-	0x3c, 0xaf, 0x32, 0x74, 0x1d, 0xea, 0xa0, 0xb4, 0x34, 0x2f, 0x95, 0xc6, 0xf8, 0xc0, 0x31, 0x49,
-	0x5f, 0xc2, 0xe5, 0xdf, 0x12, 0x50, 0xda, 0x73, 0x89, 0x41, 0x89, 0x26, 0xc2, 0x30, 0xf9, 0x66,
-	0x44, 0x3c, 0x8a, 0xb6, 0x01, 0x2c, 0x7b, 0x38, 0xa2, 0x3a, 0xbd, 0x1c, 0x12, 0x55, 0x59, 0x53,
-	0xd6, 0x0b, 0xd5, 0xc5, 0x8a, 0xcc, 0x51, 0x91, 0xce, 0x1a, 0xf3, 0xc0, 0x59, 0xee, 0xd8, 0xbd,
-	0x1c, 0x12, 0x54, 0x84, 0xf8, 0xc8, 0xed, 0xab, 0xd9, 0x35, 0x65, 0x3d, 0x8b, 0xd9, 0x4f, 0x84,
-	0x20, 0x61, 0x1b, 0x03, 0xa2, 0xc6, 0xb8, 0x89, 0xff, 0x46, 0xcb, 0x90, 0x75, 0x1d, 0x67, 0xa0,
-	0x73, 0x20, 0xce, 0x81, 0x0c, 0x33, 0x34, 0x19, 0xb8, 0x05, 0xa5, 0xa1, 0xe1, 0x52, 0xab, 0x67,
-	0x0d, 0x0d, 0x9b, 0xea, 0x96, 0x49, 0x6c, 0x6a, 0xd1, 0x4b, 0x35, 0xc1, 0xfd, 0x16, 0x02, 0x98,
-	0x26, 0x21, 0xf4, 0x08, 0x8a, 0xc1, 0x10, 0x9e, 0x36, 0xc9, 0xdd, 0xe7, 0x03, 0xf6, 0xa8, 0xec,
-	0x03, 0x42, 0x0d, 0xd3, 0xa0, 0x86, 0x0a, 0x53, 0xd9, 0x8f, 0x25, 0x84, 0xb6, 0x00, 0x9d, 0x5e,
-	0x0e, 0x0d, 0xcf, 0xd3, 0xa9, 0x6b, 0xd8, 0x5e, 0xcf, 0x31, 0x2d, 0xfb, 0x4c, 0xcd, 0xac, 0x29,
-	0xeb, 0x99, 0x5a, 0x4c, 0x55, 0xf0, 0x6d, 0x81, 0x76, 0xaf, 0x40, 0x54, 0x05, 0x44, 0x6c, 0xe3,
-	0xb4, 0x4f, 0x42, 0x21, 0x39, 0x16, 0xd2, 0x98, 0xc3, 0xb7, 0x05, 0x16, 0x08, 0x78, 0xa7, 0x28,
-	0xa8, 0x0a, 0x49, 0x63, 0x64, 0x5a, 0x8e, 0x9a, 0x5a, 0x53, 0xd6, 0x73, 0xd5, 0x95, 0xc9, 0xb3,
-	0xde, 0x65, 0x60, 0x6b, 0x48, 0x2d, 0xc7, 0xf6, 0xb0, 0x70, 0x65, 0x31, 0x17, 0x96, 0x49, 0x1c,
-	0x35, 0x1d, 0x1d, 0xf3, 0x8a, 0x81, 0x7e, 0x0c, 0x77, 0x45, 0xab, 0x90, 0x16, 0xdf, 0x37, 0xd5,
-	0x5b, 0x9c, 0x90, 0x82, 0xc7, 0x86, 0x77, 0x8a, 0x52, 0x5b, 0x84, 0x05, 0x7d, 0x9a, 0x7b, 0x0d,
-	0x20, 0x23, 0xcd, 0x66, 0xf9, 0x0f, 0x05, 0x16, 0x22, 0x48, 0xf9, 0xa5, 0x56, 0x02, 0xa5, 0xfe,
-	0x1f, 0xa4, 0x3c, 0x67, 0xe4, 0xf6, 0x44, 0x03, 0x14, 0xaa, 0x25, 0x9f, 0x62, 0xd7, 0x35, 0x7a,
-	0x6f, 0x3a, 0x1c, 0xc3, 0xd2, 0x07, 0xbd, 0x80, 0xd4, 0xd0, 0x25, 0x1e, 0xa1, 0xbc, 0x2b, 0x0a,
-	0xd5, 0xfb, 0x91, 0x87, 0x50, 0xb7, 0x05, 0xa9, 0x36, 0x77, 0x6d, 0xcc, 0x61, 0x19, 0x84, 0xbe,
-	0x80, 0xb4, 0x23, 0xb8, 0xf0, 0x6e, 0xc9, 0x55, 0x1f, 0x7c, 0x30, 0x5e, 0xf2, 0x6e, 0xcc, 0xe1,
-	0x71, 0x58, 0x0d, 0x41, 0x91, 0x48, 0x54, 0x97, 0xb6, 0xe0, 0x76, 0x83, 0xe7, 0xf9, 0x6f, 0x6c,
-	0x97, 0x7f, 0xef, 0x6f, 0x6c, 0x37, 0x14, 0x7f, 0xcd, 0xed, 0xbe, 0x57, 0x60, 0xf9, 0x03, 0xa7,
-	0x85, 0xb6, 0x21, 0xc7, 0x9b, 0x4f, 0xef, 0x39, 0x26, 0xe9, 0x49, 0x65, 0x58, 0xf0, 0xbf, 0xcc,
-	0x63, 0xf6, 0x18, 0x84, 0xc1, 0xf0, 0x7f, 0x23, 0x15, 0xd2, 0xa7, 0x16, 0x75, 0x0d, 0x2a, 0x4e,
-	0x26, 0x8f, 0xc7, 0x4b, 0xf4, 0x1f, 0xc8, 0x99, 0x96, 0xc7, 0x1b, 0xce, 0xa4, 0x6f, 0xf9, 0x49,
-	0x64, 0x30, 0x48, 0xd3, 0x3e, 0x7d, 0x8b, 0x96, 0x20, 0xd3, 0x3b, 0x37, 0x6c, 0x9b, 0xf4, 0xc5,
-	0x3e, 0xf3, 0xd8, 0x5f, 0x97, 0x7f, 0xbc, 0x22, 0x1b, 0xb5, 0x57, 0x46, 0x96, 0x77, 0xfd, 0x0c,
-	0xb2, 0x3c, 0x46, 0x92, 0xbd, 0xf0, 0x7f, 0xa3, 0x55, 0x80, 0xd7, 0xae, 0x31, 0x20, 0xba, 0xcf,
-	0x57, 0xc1, 0x59, 0x6e, 0xc1, 0x8c, 0xf1, 0x63, 0x48, 0xf5, 0x8d, 0x4b, 0xe2, 0x7a, 0x6a, 0x7c,
-	0x2d, 0xbe, 0x9e, 0x9b, 0xcc, 0x77, 0xc4, 0x30, 0x2c, 0x5d, 0xca, 0xbf, 0x26, 0x21, 0xe7, 0xab,
-	0xe5, 0x6b, 0x76, 0xfd, 0x40, 0x0a, 0xb4, 0x6e, 0x99, 0xb2, 0x77, 0xb2, 0xd2, 0xa2, 0x99, 0x91,
-	0x72, 0xb9, 0x0a, 0xe0, 0x51, 0x97, 0x18, 0x03, 0xfd, 0x0d, 0xb9, 0x94, 0x7a, 0x99, 0x15, 0x96,
-	0x43, 0x72, 0x39, 0xd6, 0xdc, 0xc4, 0x95, 0xe6, 0x86, 0xb5, 0x3b, 0x79, 0x4d, 0xed, 0x8e, 0xd6,
-	0xb9, 0xfc, 0xcd, 0x75, 0x6e, 0xfe, 0x93, 0xd0, 0xb9, 0xd0, 0x90, 0xc9, 0x5c, 0x73, 0xc8, 0x64,
-	0x6f, 0x36, 0x64, 0xe0, 0x66, 0x43, 0xa6, 0x30, 0x7b, 0xc8, 0x2c, 0x41, 0xc6, 0x25, 0x23, 0xde,
-	0xf3, 0x62, 0x4e, 0x60, 0x7f, 0x8d, 0x1e, 0x43, 0xd2, 0xa3, 0xac, 0x13, 0x6f, 0xf1, 0xdd, 0x4f,
-	0x55, 0xb2, 0xc3, 0x40, 0x2c, 0x7c, 0x82, 0xf2, 0x5e, 0xfc, 0x38, 0x79, 0xff, 0x33, 0x0e, 0xb7,
-	0x82, 0x99, 0xd1, 0x36, 0xa4, 0x58, 0xee, 0x91, 0x27, 0xef, 0xcf, 0x4a, 0x24, 0x81, 0x4a, 0x87,
-	0xfb, 0x60, 0xe9, 0x8b, 0x4a, 0x90, 0x24, 0xae, 0xeb, 0xb8, 0xb2, 0x95, 0xc5, 0x02, 0x55, 0xc6,
-	0x95, 0x8c, 0xf3, 0xbd, 0xa8, 0x81, 0x54, 0xc3, 0x11, 0xe5, 0x75, 0x94, 0xdb, 0x11, 0x55, 0xac,
-	0x8c, 0xbb, 0x25, 0x11, 0xe5, 0xcf, 0x7b, 0x45, 0xfa, 0x8b, 0x4e, 0xb9, 0x0b, 0x69, 0x5e, 0x75,
-	0xcb, 0x94, 0x2f, 0x80, 0x14, 0x5b, 0x6a, 0xa6, 0xb8, 0x44, 0x86, 0x4b, 0x89, 0xa9, 0x1b, 0x94,
-	0xf7, 0x51, 0x9c, 0x5d, 0x22, 0x6e, 0xd9, 0xa5, 0xe8, 0x1e, 0x64, 0x88, 0x6d, 0x0a, 0x30, 0xc3,
-	0xc1, 0x34, 0x5f, 0xef, 0x52, 0x16, 0x39, 0x1a, 0x9a, 0x86, 0x8c, 0x04, 0x11, 0x29, 0x2d, 0xbb,
-	0x94, 0xe9, 0x97, 0x4b, 0x84, 0xa0, 0xb3, 0xaf, 0x8a, 0x0e, 0x82, 0xb1, 0x49, 0x33, 0xd1, 0x06,
-	0xa4, 0x28, 0x13, 0x7f, 0x4f, 0x4d, 0x71, 0xb9, 0x40, 0xe1, 0x99, 0xc0, 0x54, 0x01, 0x4b, 0x8f,
-	0xf2, 0x77, 0x90, 0x12, 0xc7, 0x88, 0x16, 0xe1, 0x76, 0xbd, 0xb9, 0xdf, 0x6e, 0x69, 0xcd, 0xae,
-	0xae, 0x35, 0x77, 0xf7, 0xba, 0xda, 0xab, 0x7a, 0x71, 0x0e, 0xdd, 0x01, 0xe4, 0x9b, 0x6b, 0x27,
-	0x07, 0x07, 0x75, 0xac, 0x35, 0x5f, 0x16, 0x15, 0x74, 0x17, 0x16, 0x7c, 0x7b, 0xfb, 0xa4, 0x76,
-	0xa4, 0x75, 0x1a, 0x0c, 0x88, 0x21, 0x04, 0x05, 0x1f, 0xa8, 0x63, 0xdc, 0xc2, 0xc5, 0x78, 0x28,
-	0xf7, 0x5e, 0xeb, 0xb8, 0x7d, 0x54, 0xef, 0xd6, 0x8b, 0x89, 0xf2, 0x4f, 0x0a, 0xcc, 0x4f, 0x94,
-	0x81, 0xdd, 0xa2, 0x81, 0x35, 0x20, 0x57, 0xaf, 0xc0, 0x2c, 0xce, 0x30, 0x03, 0x57, 0x8c, 0x87,
-	0x30, 0x6f, 0x5c, 0x10, 0xd7, 0x38, 0x23, 0x7a, 0x58, 0xdc, 0x0b, 0xd2, 0x5c, 0x93, 0x1a, 0x5f,
-	0x82, 0xe4, 0xb7, 0x96, 0x49, 0xcf, 0x79, 0xd5, 0xf3, 0x58, 0x2c, 0xd0, 0x1d, 0x48, 0x9d, 0x13,
-	0xeb, 0xec, 0x9c, 0x4a, 0x59, 0x97, 0x2b, 0xb4, 0x02, 0x42, 0x6c, 0x79, 0xc2, 0x64, 0x40, 0x7d,
-	0x99, 0xa1, 0xfc, 0xc3, 0x98, 0xe5, 0x55, 0xf1, 0xff, 0x21, 0x96, 0xc1, 0x41, 0x13, 0x0f, 0x0f,
-	0x1a, 0x56, 0x65, 0xcf, 0x18, 0x0c, 0xfb, 0x72, 0x26, 0x08, 0xc2, 0x20, 0x4c, 0x6c, 0x28, 0x94,
-	0x7f, 0x4e, 0x40, 0xe9, 0x84, 0x37, 0xc5, 0xc4, 0x43, 0xfa, 0x23, 0x04, 0xff, 0x13, 0x7c, 0x1f,
-	0x67, 0x67, 0x4b, 0xd7, 0xce, 0x87, 0xdf, 0xc7, 0x6c, 0x10, 0x4c, 0x4d, 0x0e, 0x31, 0x08, 0xa2,
-	0x86, 0x07, 0x48, 0xd1, 0xfa, 0x64, 0x1e, 0xc9, 0xe2, 0xd5, 0x1e, 0x9b, 0x52, 0xd1, 0xe9, 0x3d,
-	0x5f, 0x47, 0x5c, 0xdb, 0x80, 0x8e, 0x2c, 0x8f, 0x4e, 0xf4, 0x48, 0xa8, 0xe0, 0xca, 0x44, 0xc1,
-	0xc3, 0x0d, 0x14, 0x9b, 0x68, 0xa0, 0xf2, 0x2e, 0x2c, 0x84, 0x32, 0x7a, 0x43, 0xc7, 0xf6, 0x08,
-	0xda, 0x80, 0xa4, 0x45, 0xc9, 0x80, 0x69, 0x36, 0x13, 0x9d, 0xd2, 0xf4, 0xf8, 0x7f, 0xed, 0x60,
-	0xe1, 0x52, 0x7e, 0x06, 0xa5, 0x7d, 0xd2, 0x27, 0x37, 0x6c, 0xdd, 0x8d, 0x17, 0xfe, 0x9c, 0xe0,
-	0xf7, 0x11, 0x15, 0x00, 0x70, 0xf7, 0xb8, 0xad, 0x6b, 0xcd, 0xf6, 0x49, 0xb7, 0x38, 0xc7, 0xd6,
-	0x5f, 0x36, 0xb4, 0xf1, 0x5a, 0x41, 0x79, 0xc8, 0x9e, 0xe0, 0x23, 0xb9, 0x8c, 0x6d, 0x68, 0xb0,
-	0x34, 0xfb, 0x55, 0xcf, 0x84, 0xae, 0xd5, 0x3e, 0xe9, 0xe8, 0x9d, 0x6e, 0x1d, 0xd7, 0x5b, 0xfa,
-	0xe7, 0x3b, 0x87, 0xb5, 0x76, 0xa7, 0x38, 0x87, 0x16, 0x60, 0x9e, 0xdb, 0x8f, 0x5b, 0xcd, 0x96,
-	0xbe, 0xb3, 0x7d, 0x58, 0xeb, 0x14, 0x95, 0x8d, 0xef, 0xe3, 0x7e, 0xae, 0x88, 0x27, 0x33, 0x5a,
-	0x01, 0xb5, 0x51, 0xdd, 0xd9, 0xd6, 0xff, 0x5f, 0xdd, 0x6c, 0xeb, 0x4f, 0x37, 0x0f, 0xda, 0x1d,
-	0xfd, 0xa9, 0x7e, 0xb4, 0xfb, 0x55, 0x1d, 0xb3, 0x8c, 0xab, 0x70, 0x8f, 0xa3, 0x5b, 0x9b, 0x9f,
-	0x4d, 0xc3, 0x8a, 0x1f, 0xfc, 0x6c, 0x7b, 0xb3, 0xad, 0x57, 0x9f, 0x31, 0xb4, 0x3a, 0x46, 0x63,
-	0x68, 0x19, 0xee, 0x4e, 0xa6, 0xde, 0x12, 0x68, 0x31, 0xee, 0x87, 0x06, 0x33, 0x8f, 0xd1, 0x04,
-	0x5a, 0x87, 0x07, 0xb3, 0x58, 0xe9, 0x0d, 0xed, 0x65, 0x43, 0x3f, 0x6e, 0x75, 0xb5, 0x56, 0xb3,
-	0x98, 0x44, 0x8f, 0xe0, 0xbf, 0x33, 0x19, 0x86, 0x5c, 0x53, 0x7e, 0xd2, 0x08, 0xb6, 0x21, 0xcf,
-	0x34, 0x7a, 0x08, 0xf7, 0x67, 0x30, 0x0f, 0x39, 0x66, 0xfc, 0x94, 0x11, 0xbb, 0x08, 0x79, 0x66,
-	0xab, 0xbf, 0xc4, 0x20, 0x2d, 0xcb, 0x80, 0xf6, 0x21, 0x1f, 0xfa, 0x5f, 0x01, 0xad, 0xfa, 0x1d,
-	0x18, 0xf5, 0x7f, 0xc3, 0x52, 0x64, 0x83, 0xb2, 0x2c, 0x21, 0x51, 0x0d, 0x64, 0x89, 0x12, 0xdb,
-	0x19, 0x59, 0x1a, 0x90, 0x0b, 0x5c, 0x11, 0xb4, 0xec, 0x3b, 0x4d, 0x5f, 0xc5, 0xa5, 0x95, 0x68,
-	0x50, 0xde, 0xaa, 0x7d, 0xc8, 0x87, 0x6e, 0x4a, 0x80, 0x4f, 0xd4, 0x0d, 0x8a, 0xe6, 0x53, 0x3b,
-	0xf8, 0xfa, 0xfe, 0x99, 0x45, 0xcf, 0x47, 0xa7, 0x95, 0x9e, 0x33, 0x78, 0x22, 0x3d, 0x9e, 0xf0,
-	0x3f, 0x64, 0x7a, 0x4e, 0x7f, 0x6c, 0x78, 0x1f, 0xcb, 0x1f, 0x59, 0x17, 0xe4, 0xd0, 0xa2, 0x95,
-	0x36, 0x83, 0x7e, 0x8f, 0x15, 0xe4, 0xfa, 0xf9, 0x73, 0x6e, 0x38, 0x4d, 0xf1, 0x90, 0xa7, 0x7f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x22, 0x5c, 0x1b, 0x0e, 0xfb, 0x11, 0x00, 0x00,
+	// 1569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0xe2, 0xda,
+	0x15, 0x8e, 0xf9, 0xcd, 0x21, 0x10, 0x72, 0x21, 0x6f, 0xfc, 0x48, 0xa2, 0x46, 0xe4, 0x55, 0x2f,
+	0x2f, 0xaf, 0xe2, 0x25, 0x4c, 0x26, 0x6d, 0x9f, 0x34, 0x52, 0x43, 0x42, 0x06, 0x2b, 0x09, 0xa0,
+	0x0b, 0x99, 0x51, 0xbb, 0xb1, 0x1c, 0x7c, 0x27, 0x71, 0x07, 0x6c, 0x6a, 0x5f, 0x32, 0x83, 0xba,
+	0xed, 0xa2, 0x7f, 0x40, 0x77, 0x5d, 0x74, 0xd1, 0x76, 0x33, 0x7f, 0x5a, 0xd5, 0x6d, 0xbb, 0xae,
+	0xee, 0x0f, 0x1c, 0x1b, 0xcc, 0x28, 0x99, 0x56, 0xd5, 0xec, 0x7c, 0xcf, 0x77, 0xce, 0xf1, 0x39,
+	0xf7, 0x7e, 0xf7, 0x3b, 0x06, 0xd8, 0x18, 0x5a, 0xf7, 0xe4, 0x9d, 0x45, 0x75, 0xcb, 0xbe, 0x75,
+	0x89, 0xe7, 0xd5, 0xc6, 0xae, 0x43, 0x1d, 0x94, 0x96, 0xe6, 0x4a, 0x79, 0x86, 0x8f, 0x1c, 0x93,
+	0x0c, 0x25, 0x5c, 0xfd, 0x57, 0x02, 0xca, 0xa7, 0x2e, 0x31, 0x28, 0xd1, 0x44, 0x18, 0x26, 0xbf,
+	0x9b, 0x10, 0x8f, 0xa2, 0x23, 0x00, 0xcb, 0x1e, 0x4f, 0xa8, 0x4e, 0xa7, 0x63, 0xa2, 0x2a, 0x3b,
+	0xca, 0x5e, 0xa1, 0xbe, 0x51, 0x93, 0x39, 0x6a, 0xd2, 0x59, 0x63, 0x1e, 0x38, 0xcb, 0x1d, 0xfb,
+	0xd3, 0x31, 0x41, 0x45, 0x88, 0x4f, 0xdc, 0xa1, 0x9a, 0xdd, 0x51, 0xf6, 0xb2, 0x98, 0x3d, 0x22,
+	0x04, 0x09, 0xdb, 0x18, 0x11, 0x35, 0xc6, 0x4d, 0xfc, 0x19, 0x6d, 0x42, 0xd6, 0x75, 0x9c, 0x91,
+	0xce, 0x81, 0x38, 0x07, 0x32, 0xcc, 0xd0, 0x66, 0xe0, 0x21, 0x94, 0xc7, 0x86, 0x4b, 0xad, 0x81,
+	0x35, 0x36, 0x6c, 0xaa, 0x5b, 0x26, 0xb1, 0xa9, 0x45, 0xa7, 0x6a, 0x82, 0xfb, 0x95, 0x02, 0x98,
+	0x26, 0x21, 0xf4, 0x1d, 0x14, 0x83, 0x21, 0x3c, 0x6d, 0x92, 0xbb, 0xaf, 0x05, 0xec, 0x51, 0xd9,
+	0x47, 0x84, 0x1a, 0xa6, 0x41, 0x0d, 0x15, 0x16, 0xb2, 0x5f, 0x49, 0x08, 0x1d, 0x02, 0xba, 0x99,
+	0x8e, 0x0d, 0xcf, 0xd3, 0xa9, 0x6b, 0xd8, 0xde, 0xc0, 0x31, 0x2d, 0xfb, 0x56, 0xcd, 0xec, 0x28,
+	0x7b, 0x99, 0x46, 0x4c, 0x55, 0xf0, 0xba, 0x40, 0xfb, 0x0f, 0x20, 0xaa, 0x03, 0x22, 0xb6, 0x71,
+	0x33, 0x24, 0xa1, 0x90, 0x1c, 0x0b, 0x69, 0xad, 0xe0, 0x75, 0x81, 0x05, 0x02, 0xfe, 0xa8, 0x28,
+	0xa8, 0x0e, 0x49, 0x63, 0x62, 0x5a, 0x8e, 0x9a, 0xda, 0x51, 0xf6, 0x72, 0xf5, 0xad, 0xf9, 0xbd,
+	0x3e, 0x61, 0x60, 0x67, 0x4c, 0x2d, 0xc7, 0xf6, 0xb0, 0x70, 0x65, 0x31, 0xf7, 0x96, 0x49, 0x1c,
+	0x35, 0x1d, 0x1d, 0xf3, 0x9a, 0x81, 0x7e, 0x0c, 0x77, 0x45, 0xdb, 0x90, 0x16, 0xef, 0x37, 0xd5,
+	0x55, 0x5e, 0x90, 0x82, 0x67, 0x06, 0x56, 0xc6, 0x2e, 0xe4, 0xef, 0x8d, 0xa1, 0x65, 0x1a, 0x94,
+	0xe8, 0x8e, 0x3d, 0x9c, 0xaa, 0x79, 0xe6, 0x84, 0x57, 0x67, 0xc6, 0x8e, 0x3d, 0x9c, 0xa2, 0x6d,
+	0x80, 0xb1, 0xeb, 0xfc, 0x96, 0x0c, 0xd8, 0xf9, 0xa8, 0x05, 0xbe, 0x77, 0x59, 0x69, 0xd1, 0xcc,
+	0xc6, 0x06, 0x94, 0xf4, 0xc5, 0xfe, 0x1b, 0x00, 0x19, 0x69, 0x36, 0xab, 0xff, 0x54, 0xa0, 0x14,
+	0xd1, 0x98, 0x4f, 0x17, 0x25, 0x40, 0x97, 0x9f, 0x41, 0xca, 0x73, 0x26, 0xee, 0x40, 0x90, 0xa8,
+	0x50, 0x2f, 0xfb, 0x6d, 0xf6, 0x5d, 0x63, 0xf0, 0xae, 0xc7, 0x31, 0x2c, 0x7d, 0xd0, 0x4b, 0x48,
+	0x8d, 0x5d, 0xe2, 0x11, 0xca, 0x99, 0x55, 0xa8, 0xef, 0x46, 0x6e, 0x64, 0xd3, 0x16, 0x45, 0x75,
+	0xb9, 0x6b, 0x6b, 0x05, 0xcb, 0x20, 0xf4, 0x2b, 0x48, 0x3b, 0xa2, 0x16, 0xce, 0xb8, 0x5c, 0xfd,
+	0x9b, 0x4f, 0xc6, 0xcb, 0xba, 0x5b, 0x2b, 0x78, 0x16, 0xd6, 0x40, 0x50, 0x24, 0x12, 0xd5, 0xa5,
+	0x2d, 0xd8, 0x6e, 0xf0, 0x4c, 0xfe, 0x1f, 0xed, 0xf2, 0xf7, 0xfd, 0x17, 0xed, 0x86, 0xe2, 0x1f,
+	0xd9, 0xee, 0x47, 0x05, 0x36, 0x3f, 0xb1, 0x5b, 0xe8, 0x08, 0x72, 0x9c, 0xc0, 0xfa, 0xc0, 0x31,
+	0xc9, 0x40, 0xaa, 0x4b, 0xc9, 0x7f, 0x33, 0x8f, 0x39, 0x65, 0x10, 0x06, 0xc3, 0x7f, 0x46, 0x2a,
+	0xa4, 0x6f, 0x2c, 0xea, 0x1a, 0x54, 0xec, 0x4c, 0x1e, 0xcf, 0x96, 0xe8, 0x27, 0x90, 0x33, 0x2d,
+	0x8f, 0x13, 0xce, 0xa4, 0x1f, 0xf8, 0x4e, 0x64, 0x30, 0x48, 0xd3, 0x19, 0xfd, 0x80, 0x2a, 0x90,
+	0x19, 0xdc, 0x19, 0xb6, 0x4d, 0x86, 0xa2, 0xcf, 0x3c, 0xf6, 0xd7, 0xd5, 0xbf, 0x3c, 0x14, 0x1b,
+	0xd5, 0x2b, 0x2b, 0x96, 0xdf, 0x9c, 0x25, 0xc5, 0xf2, 0x18, 0x59, 0xec, 0xbd, 0xff, 0xcc, 0xae,
+	0xc8, 0x5b, 0xd7, 0x18, 0x11, 0xdd, 0xaf, 0x57, 0xc1, 0x59, 0x6e, 0xc1, 0xac, 0xe2, 0xef, 0x21,
+	0x35, 0x34, 0xa6, 0xc4, 0xf5, 0xd4, 0xf8, 0x4e, 0x7c, 0x2f, 0x37, 0x9f, 0xef, 0x92, 0x61, 0x58,
+	0xba, 0x54, 0xff, 0x9c, 0x82, 0x9c, 0xaf, 0xb8, 0x6f, 0xd9, 0x15, 0x06, 0x29, 0xf2, 0xec, 0xfa,
+	0x09, 0xee, 0x64, 0xa5, 0x45, 0x33, 0x23, 0x25, 0x77, 0x1b, 0xc0, 0xa3, 0x2e, 0x31, 0x46, 0xfa,
+	0x3b, 0x32, 0x95, 0x9a, 0x9b, 0x15, 0x96, 0x0b, 0x32, 0x9d, 0xe9, 0x76, 0xe2, 0x41, 0xb7, 0xc3,
+	0xfa, 0x9f, 0x7c, 0xa4, 0xfe, 0x47, 0x6b, 0x65, 0xfe, 0xe9, 0x5a, 0xb9, 0xf6, 0x45, 0x68, 0x65,
+	0x68, 0x50, 0x65, 0x1e, 0x39, 0xa8, 0xb2, 0x4f, 0x1b, 0x54, 0xf0, 0xb4, 0x41, 0x55, 0x58, 0x3e,
+	0xa8, 0x2a, 0x90, 0x71, 0xc9, 0x84, 0x73, 0x5e, 0xcc, 0x1a, 0xec, 0xaf, 0xd1, 0xf7, 0x90, 0xf4,
+	0x28, 0x63, 0xe2, 0x2a, 0xef, 0x7e, 0xe1, 0x24, 0x7b, 0x0c, 0xc4, 0xc2, 0x27, 0x38, 0x22, 0x8a,
+	0x11, 0x23, 0xe2, 0x02, 0x4a, 0x72, 0x1a, 0x58, 0x8e, 0xad, 0xbf, 0x37, 0x5c, 0xdb, 0xb2, 0x6f,
+	0x3d, 0x75, 0x9d, 0x13, 0xb9, 0xf2, 0x40, 0x64, 0xdf, 0xe7, 0x8d, 0x70, 0xc1, 0xe8, 0x7e, 0xde,
+	0xe4, 0xcd, 0x8d, 0x12, 0xf4, 0x19, 0xa3, 0xe4, 0xdf, 0x71, 0x58, 0x0d, 0x76, 0x81, 0x8e, 0x20,
+	0xc5, 0xfa, 0x98, 0x78, 0xf2, 0xae, 0x6e, 0x45, 0x36, 0x5b, 0xeb, 0x71, 0x1f, 0x2c, 0x7d, 0x51,
+	0x19, 0x92, 0xc4, 0x75, 0x1d, 0x57, 0x5e, 0x1b, 0xb1, 0x40, 0xb5, 0x19, 0x6b, 0xe2, 0x7c, 0xdf,
+	0xd4, 0x40, 0xaa, 0xf1, 0x84, 0x72, 0xce, 0xc8, 0xad, 0x13, 0x8c, 0xa9, 0xcd, 0x98, 0x99, 0x88,
+	0xf2, 0xe7, 0xbc, 0x94, 0xfe, 0x82, 0x95, 0xcf, 0x20, 0xcd, 0x19, 0x66, 0x99, 0xf2, 0x8b, 0x25,
+	0xc5, 0x96, 0x9a, 0x29, 0x2e, 0xac, 0xe1, 0x52, 0x62, 0xea, 0x06, 0xe5, 0x9c, 0x8d, 0xe3, 0xac,
+	0xb4, 0x9c, 0x50, 0xf4, 0x35, 0x64, 0x88, 0x6d, 0x0a, 0x30, 0xc3, 0xc1, 0x34, 0x5f, 0x9f, 0x50,
+	0x16, 0x39, 0x19, 0xb3, 0x51, 0xcd, 0x41, 0x10, 0x91, 0xd2, 0x72, 0x42, 0x99, 0x56, 0xba, 0x44,
+	0x0c, 0x0f, 0xf6, 0x56, 0xc1, 0x56, 0x98, 0x99, 0x34, 0x13, 0xed, 0x43, 0x8a, 0xb2, 0x41, 0xe3,
+	0xa9, 0x29, 0x7e, 0xa2, 0x28, 0x3c, 0x7f, 0x98, 0x02, 0x61, 0xe9, 0x51, 0xfd, 0x3d, 0xa4, 0xc4,
+	0x36, 0xa2, 0x0d, 0x58, 0x6f, 0xb6, 0xcf, 0xba, 0x1d, 0xad, 0xdd, 0xd7, 0xb5, 0xf6, 0xc9, 0x69,
+	0x5f, 0x7b, 0xdd, 0x2c, 0xae, 0xa0, 0xaf, 0x00, 0xf9, 0xe6, 0xc6, 0xf5, 0xf9, 0x79, 0x13, 0x6b,
+	0xed, 0x57, 0x45, 0x05, 0x3d, 0x83, 0x92, 0x6f, 0xef, 0x5e, 0x37, 0x2e, 0xb5, 0x5e, 0x8b, 0x01,
+	0x31, 0x84, 0xa0, 0xe0, 0x03, 0x4d, 0x8c, 0x3b, 0xb8, 0x18, 0x0f, 0xe5, 0x3e, 0xed, 0x5c, 0x75,
+	0x2f, 0x9b, 0xfd, 0x66, 0x31, 0x51, 0xfd, 0xab, 0x02, 0x6b, 0x73, 0xc7, 0xc0, 0x6e, 0xec, 0xc8,
+	0x1a, 0x91, 0x87, 0xaf, 0xd6, 0x2c, 0xce, 0x30, 0x03, 0x57, 0xa7, 0x6f, 0x61, 0xcd, 0xb8, 0x27,
+	0xae, 0x71, 0x4b, 0xf4, 0xf0, 0x20, 0x29, 0x48, 0x73, 0x43, 0x58, 0x19, 0x17, 0xde, 0x5b, 0x26,
+	0xbd, 0xe3, 0xa7, 0x9e, 0xc7, 0x62, 0x81, 0xbe, 0x82, 0xd4, 0x1d, 0xb1, 0x6e, 0xef, 0xa8, 0x1c,
+	0x21, 0x72, 0x85, 0xb6, 0x40, 0x08, 0x3b, 0x4f, 0x98, 0x0c, 0x28, 0x3d, 0x33, 0x54, 0xff, 0x34,
+	0xab, 0xf2, 0xe1, 0xf0, 0xff, 0x47, 0x55, 0x06, 0x87, 0x5a, 0x3c, 0x3c, 0xd4, 0xd8, 0x29, 0x7b,
+	0xc6, 0x68, 0x3c, 0x94, 0xf3, 0x47, 0x14, 0x0c, 0xc2, 0xc4, 0x06, 0x50, 0xf5, 0x6f, 0x09, 0x28,
+	0x5f, 0x73, 0x52, 0xcc, 0x7d, 0xf8, 0x7f, 0xc6, 0x70, 0xf9, 0x02, 0xbf, 0xe7, 0xb3, 0xcb, 0x65,
+	0xf2, 0xf8, 0xd3, 0xdf, 0xf3, 0x6c, 0xe8, 0x2c, 0x4c, 0x29, 0x31, 0x74, 0xa2, 0x06, 0x15, 0x48,
+	0x81, 0xfc, 0x62, 0x3e, 0xea, 0xc5, 0xaf, 0x8c, 0x58, 0x50, 0xb1, 0xb9, 0x8a, 0x2e, 0xf6, 0xfc,
+	0x18, 0x71, 0x75, 0x00, 0x5d, 0x5a, 0x1e, 0x9d, 0xe3, 0x48, 0xe8, 0xc0, 0x95, 0xb9, 0x03, 0x0f,
+	0x13, 0x28, 0x36, 0x4f, 0xa0, 0xb0, 0xe0, 0xc7, 0xe7, 0x04, 0xbf, 0x7a, 0x02, 0xa5, 0xd0, 0x0b,
+	0xbd, 0xb1, 0x63, 0x7b, 0x04, 0xed, 0x43, 0xd2, 0xa2, 0x64, 0xc4, 0x24, 0x9d, 0x69, 0x52, 0x79,
+	0xf1, 0x4b, 0xe4, 0xad, 0x83, 0x85, 0x4b, 0xf5, 0x05, 0x94, 0xcf, 0xc8, 0x90, 0x3c, 0x91, 0xd9,
+	0xfb, 0x2f, 0xfd, 0x31, 0xc2, 0xaf, 0x2b, 0x2a, 0x00, 0xe0, 0xfe, 0x55, 0x57, 0xd7, 0xda, 0xdd,
+	0xeb, 0x7e, 0x71, 0x85, 0xad, 0xdf, 0xb4, 0xb4, 0xd9, 0x5a, 0x41, 0x79, 0xc8, 0x5e, 0xe3, 0x4b,
+	0xb9, 0x8c, 0xed, 0x6b, 0x50, 0x59, 0xfe, 0x03, 0x83, 0xe9, 0x60, 0xa7, 0x7b, 0xdd, 0xd3, 0x7b,
+	0xfd, 0x26, 0x6e, 0x76, 0xf4, 0x5f, 0x1e, 0x5f, 0x34, 0xba, 0xbd, 0xe2, 0x0a, 0x2a, 0xc1, 0x1a,
+	0xb7, 0x5f, 0x75, 0xda, 0x1d, 0xfd, 0xf8, 0xe8, 0xa2, 0xd1, 0x2b, 0x2a, 0xfb, 0x7f, 0x88, 0x43,
+	0x25, 0x78, 0xd8, 0x73, 0xb9, 0xb6, 0x40, 0x6d, 0xd5, 0x8f, 0x8f, 0xf4, 0x9f, 0xd7, 0x0f, 0xba,
+	0xfa, 0xf3, 0x83, 0xf3, 0x6e, 0x4f, 0x7f, 0xae, 0x5f, 0x9e, 0xfc, 0xba, 0x89, 0x59, 0xc6, 0x6d,
+	0xf8, 0x9a, 0xa3, 0x87, 0x07, 0xbf, 0x58, 0x84, 0x15, 0x3f, 0xf8, 0xc5, 0xd1, 0x41, 0x57, 0xaf,
+	0xbf, 0x60, 0x68, 0x7d, 0x86, 0xc6, 0xd0, 0x26, 0x3c, 0x9b, 0x4f, 0x7d, 0x28, 0xd0, 0x62, 0xdc,
+	0x0f, 0x0d, 0x66, 0x9e, 0xa1, 0x09, 0xb4, 0x07, 0xdf, 0x2c, 0xab, 0x4a, 0x6f, 0x69, 0xaf, 0x5a,
+	0xfa, 0x55, 0xa7, 0xaf, 0x75, 0xda, 0xc5, 0x24, 0xfa, 0x0e, 0x7e, 0xba, 0xb4, 0xc2, 0x90, 0x6b,
+	0xca, 0x4f, 0x1a, 0x51, 0x6d, 0xc8, 0x33, 0x8d, 0xbe, 0x85, 0xdd, 0x25, 0x95, 0x87, 0x1c, 0x33,
+	0x7e, 0xca, 0x88, 0x2e, 0x42, 0x9e, 0xd9, 0xfa, 0xdf, 0x63, 0x90, 0x96, 0xc7, 0x80, 0xce, 0x20,
+	0x1f, 0xfa, 0x9b, 0x04, 0x6d, 0xfb, 0x0c, 0x8c, 0xfa, 0xfb, 0xa4, 0x12, 0x49, 0x50, 0x96, 0x25,
+	0xa4, 0xb9, 0x81, 0x2c, 0x51, 0x5a, 0xbc, 0x24, 0x4b, 0x0b, 0x72, 0x81, 0x2b, 0x82, 0x36, 0x7d,
+	0xa7, 0xc5, 0x9b, 0x5a, 0xd9, 0x8a, 0x06, 0xe5, 0xad, 0x3a, 0x83, 0x7c, 0xe8, 0xa6, 0x04, 0xea,
+	0x89, 0xba, 0x41, 0xd1, 0xf5, 0x34, 0xce, 0x7f, 0xb3, 0x7b, 0x6b, 0xd1, 0xbb, 0xc9, 0x4d, 0x6d,
+	0xe0, 0x8c, 0x7e, 0x90, 0x1e, 0x3f, 0xf0, 0xff, 0x97, 0x06, 0xce, 0x70, 0x66, 0xf8, 0x18, 0xcb,
+	0x5f, 0x5a, 0xf7, 0xe4, 0xc2, 0xa2, 0xb5, 0x2e, 0x83, 0xfe, 0x11, 0x2b, 0xc8, 0xf5, 0x8f, 0x3f,
+	0x72, 0xc3, 0x4d, 0x8a, 0x87, 0x3c, 0xff, 0xcf, 0x00, 0xe9, 0xa0, 0x33, 0xb5, 0xca, 0x12, 0x00,
+	0x00,
 }
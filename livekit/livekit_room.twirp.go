@@ -68,6 +68,16 @@ type RoomService interface {
 	// or call this method again with `stop` set to true. A participant can be forwarded to multiple rooms. The destination room will be
 	// created if it does not exist.
 	ForwardParticipant(context.Context, *ForwardParticipantRequest) (*ForwardParticipantResponse, error)
+
+	// Move a connected participant to another room, without the client rejoining. Requires `roomAdmin` for the room
+	// and `destinationRoom` set to the destination room. The participant keeps its identity, metadata, attributes and
+	// published tracks. The destination room will be created if it does not exist.
+	MoveParticipant(context.Context, *MoveParticipantRequest) (*MoveParticipantResponse, error)
+
+	// Returns a recent composite snapshot of an active room, rendered by the server. Requires `roomList` permission.
+	// Previews are cached and rate limited per room. When a new capture is not allowed yet, the most recent preview
+	// is returned, or a ResourceExhausted error if none is available.
+	RequestRoomPreview(context.Context, *RoomPreviewRequest) (*RoomPreviewResponse, error)
 }
 
 // ===========================
@@ -76,7 +86,7 @@ type RoomService interface {
 
 type roomServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [12]string
+	urls        [14]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -104,7 +114,7 @@ func NewRoomServiceProtobufClient(baseURL string, client HTTPClient, opts ...twi
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "livekit", "RoomService")
-	urls := [12]string{
+	urls := [14]string{
 		serviceURL + "CreateRoom",
 		serviceURL + "ListRooms",
 		serviceURL + "DeleteRoom",
@@ -117,6 +127,8 @@ func NewRoomServiceProtobufClient(baseURL string, client HTTPClient, opts ...twi
 		serviceURL + "SendData",
 		serviceURL + "UpdateRoomMetadata",
 		serviceURL + "ForwardParticipant",
+		serviceURL + "MoveParticipant",
+		serviceURL + "RequestRoomPreview",
 	}
 
 	return &roomServiceProtobufClient{
@@ -679,13 +691,105 @@ func (c *roomServiceProtobufClient) callForwardParticipant(ctx context.Context,
 	return out, nil
 }
 
+func (c *roomServiceProtobufClient) MoveParticipant(ctx context.Context, in *MoveParticipantRequest) (*MoveParticipantResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomService")
+	ctx = ctxsetters.WithMethodName(ctx, "MoveParticipant")
+	caller := c.callMoveParticipant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MoveParticipantRequest) (*MoveParticipantResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveParticipantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveParticipantRequest) when calling interceptor")
+					}
+					return c.callMoveParticipant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MoveParticipantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MoveParticipantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomServiceProtobufClient) callMoveParticipant(ctx context.Context, in *MoveParticipantRequest) (*MoveParticipantResponse, error) {
+	out := new(MoveParticipantResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomServiceProtobufClient) RequestRoomPreview(ctx context.Context, in *RoomPreviewRequest) (*RoomPreviewResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomService")
+	ctx = ctxsetters.WithMethodName(ctx, "RequestRoomPreview")
+	caller := c.callRequestRoomPreview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RoomPreviewRequest) (*RoomPreviewResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RoomPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RoomPreviewRequest) when calling interceptor")
+					}
+					return c.callRequestRoomPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RoomPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RoomPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomServiceProtobufClient) callRequestRoomPreview(ctx context.Context, in *RoomPreviewRequest) (*RoomPreviewResponse, error) {
+	out := new(RoomPreviewResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // =======================
 // RoomService JSON Client
 // =======================
 
 type roomServiceJSONClient struct {
 	client      HTTPClient
-	urls        [12]string
+	urls        [14]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -713,7 +817,7 @@ func NewRoomServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.C
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "livekit", "RoomService")
-	urls := [12]string{
+	urls := [14]string{
 		serviceURL + "CreateRoom",
 		serviceURL + "ListRooms",
 		serviceURL + "DeleteRoom",
@@ -726,6 +830,8 @@ func NewRoomServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.C
 		serviceURL + "SendData",
 		serviceURL + "UpdateRoomMetadata",
 		serviceURL + "ForwardParticipant",
+		serviceURL + "MoveParticipant",
+		serviceURL + "RequestRoomPreview",
 	}
 
 	return &roomServiceJSONClient{
@@ -1288,6 +1394,98 @@ func (c *roomServiceJSONClient) callForwardParticipant(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *roomServiceJSONClient) MoveParticipant(ctx context.Context, in *MoveParticipantRequest) (*MoveParticipantResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomService")
+	ctx = ctxsetters.WithMethodName(ctx, "MoveParticipant")
+	caller := c.callMoveParticipant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MoveParticipantRequest) (*MoveParticipantResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveParticipantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveParticipantRequest) when calling interceptor")
+					}
+					return c.callMoveParticipant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MoveParticipantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MoveParticipantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomServiceJSONClient) callMoveParticipant(ctx context.Context, in *MoveParticipantRequest) (*MoveParticipantResponse, error) {
+	out := new(MoveParticipantResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomServiceJSONClient) RequestRoomPreview(ctx context.Context, in *RoomPreviewRequest) (*RoomPreviewResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomService")
+	ctx = ctxsetters.WithMethodName(ctx, "RequestRoomPreview")
+	caller := c.callRequestRoomPreview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RoomPreviewRequest) (*RoomPreviewResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RoomPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RoomPreviewRequest) when calling interceptor")
+					}
+					return c.callRequestRoomPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RoomPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RoomPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomServiceJSONClient) callRequestRoomPreview(ctx context.Context, in *RoomPreviewRequest) (*RoomPreviewResponse, error) {
+	out := new(RoomPreviewResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ==========================
 // RoomService Server Handler
 // ==========================
@@ -1421,6 +1619,12 @@ func (s *roomServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Reques
 	case "ForwardParticipant":
 		s.serveForwardParticipant(ctx, resp, req)
 		return
+	case "MoveParticipant":
+		s.serveMoveParticipant(ctx, resp, req)
+		return
+	case "RequestRoomPreview":
+		s.serveRequestRoomPreview(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -3588,6 +3792,366 @@ func (s *roomServiceServer) serveForwardParticipantProtobuf(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *roomServiceServer) serveMoveParticipant(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMoveParticipantJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMoveParticipantProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomServiceServer) serveMoveParticipantJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MoveParticipant")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(MoveParticipantRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomService.MoveParticipant
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MoveParticipantRequest) (*MoveParticipantResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveParticipantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveParticipantRequest) when calling interceptor")
+					}
+					return s.RoomService.MoveParticipant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MoveParticipantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MoveParticipantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *MoveParticipantResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MoveParticipantResponse and nil error while calling MoveParticipant. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomServiceServer) serveMoveParticipantProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MoveParticipant")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(MoveParticipantRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomService.MoveParticipant
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MoveParticipantRequest) (*MoveParticipantResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveParticipantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveParticipantRequest) when calling interceptor")
+					}
+					return s.RoomService.MoveParticipant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MoveParticipantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MoveParticipantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *MoveParticipantResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MoveParticipantResponse and nil error while calling MoveParticipant. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomServiceServer) serveRequestRoomPreview(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRequestRoomPreviewJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRequestRoomPreviewProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomServiceServer) serveRequestRoomPreviewJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RequestRoomPreview")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RoomPreviewRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomService.RequestRoomPreview
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RoomPreviewRequest) (*RoomPreviewResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RoomPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RoomPreviewRequest) when calling interceptor")
+					}
+					return s.RoomService.RequestRoomPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RoomPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RoomPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RoomPreviewResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RoomPreviewResponse and nil error while calling RequestRoomPreview. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomServiceServer) serveRequestRoomPreviewProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RequestRoomPreview")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RoomPreviewRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomService.RequestRoomPreview
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RoomPreviewRequest) (*RoomPreviewResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RoomPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RoomPreviewRequest) when calling interceptor")
+					}
+					return s.RoomService.RequestRoomPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RoomPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RoomPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RoomPreviewResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RoomPreviewResponse and nil error while calling RequestRoomPreview. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *roomServiceServer) ServiceDescriptor() ([]byte, int) {
 	return twirpFileDescriptor3, 0
 }
@@ -3604,89 +4168,117 @@ func (s *roomServiceServer) PathPrefix() string {
 }
 
 var twirpFileDescriptor3 = []byte{
-	// 1339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x5b, 0x6f, 0xdc, 0x44,
-	0x14, 0x66, 0xaf, 0x59, 0x9f, 0xcd, 0x6d, 0xa7, 0x29, 0x75, 0x9c, 0xb6, 0xa4, 0x0e, 0x88, 0x2d,
-	0xa5, 0x29, 0x2c, 0x42, 0xad, 0x22, 0x6e, 0x49, 0x93, 0x96, 0xa8, 0xad, 0xb4, 0x38, 0x45, 0x5c,
-	0x24, 0x64, 0xbc, 0xeb, 0x69, 0x3a, 0xca, 0xfa, 0x82, 0x67, 0x1c, 0xba, 0x6f, 0x3c, 0xf6, 0xb7,
-	0xf0, 0x0f, 0xf8, 0x01, 0xbc, 0xf1, 0xc8, 0xaf, 0xe0, 0x3f, 0x20, 0xa1, 0xb9, 0xac, 0x3d, 0xde,
-	0xf5, 0x6e, 0x4b, 0xc4, 0x03, 0x6f, 0x9e, 0x73, 0xbe, 0x39, 0xf7, 0x73, 0xe6, 0x18, 0xd0, 0x88,
-	0x9c, 0xe3, 0x33, 0xc2, 0xdc, 0x24, 0x8a, 0x82, 0xdd, 0x38, 0x89, 0x58, 0x84, 0x96, 0x14, 0xcd,
-	0xda, 0x98, 0x30, 0x83, 0xc8, 0xc7, 0x23, 0x2a, 0xd9, 0x39, 0x15, 0x9f, 0x26, 0x98, 0x4e, 0xa8,
-	0x57, 0x27, 0x54, 0xef, 0x14, 0x87, 0xcc, 0xf5, 0x09, 0x8d, 0x3d, 0x36, 0x7c, 0x2e, 0xb9, 0xf6,
-	0xdf, 0x35, 0xe8, 0xdc, 0x4f, 0xb0, 0xc7, 0xb0, 0x13, 0x45, 0x81, 0x83, 0x7f, 0x4a, 0x31, 0x65,
-	0x08, 0x41, 0x3d, 0xf4, 0x02, 0x6c, 0x56, 0xb6, 0x2b, 0x5d, 0xc3, 0x11, 0xdf, 0xe8, 0x2d, 0x68,
-	0x73, 0x53, 0xdc, 0x38, 0xc1, 0x14, 0x33, 0x73, 0x59, 0xb0, 0x80, 0x93, 0xfa, 0x82, 0x82, 0x76,
-	0x60, 0x05, 0x07, 0x31, 0x1b, 0xbb, 0x8c, 0x04, 0x38, 0x4a, 0x99, 0x59, 0xdd, 0xae, 0x74, 0x57,
-	0x9c, 0x65, 0x41, 0x7c, 0x2a, 0x69, 0xe8, 0x16, 0x74, 0x7c, 0x1c, 0x7b, 0x09, 0x4b, 0x13, 0x9c,
-	0x01, 0x41, 0x00, 0xd7, 0x33, 0xc6, 0x04, 0x7c, 0x13, 0xd6, 0x03, 0xef, 0x85, 0xcb, 0xa9, 0x64,
-	0x48, 0x62, 0x2f, 0x64, 0xd4, 0xac, 0x09, 0xec, 0x5a, 0xe0, 0xbd, 0xe8, 0x6b, 0x64, 0x74, 0x05,
-	0x96, 0xc2, 0xc8, 0xc7, 0x2e, 0xf1, 0xcd, 0xba, 0xb0, 0xac, 0xc9, 0x8f, 0xc7, 0x3e, 0xb2, 0xa0,
-	0x15, 0x60, 0xe6, 0xf9, 0x1e, 0xf3, 0xcc, 0x86, 0xe0, 0x64, 0x67, 0x74, 0x0b, 0x9a, 0x32, 0x54,
-	0x66, 0x73, 0xbb, 0xd2, 0x6d, 0xf7, 0x2e, 0xed, 0xaa, 0x58, 0xed, 0xf2, 0x60, 0x1c, 0x09, 0x96,
-	0xa3, 0x20, 0xe8, 0x3d, 0xe8, 0x04, 0x24, 0x74, 0xe3, 0x91, 0x37, 0x8e, 0x52, 0xe6, 0xfa, 0x78,
-	0xe4, 0x8d, 0xcd, 0x25, 0x65, 0x0d, 0x09, 0xfb, 0x92, 0x7e, 0xc8, 0xc9, 0x02, 0xcb, 0x0d, 0x2f,
-	0x60, 0x5b, 0xb9, 0xe5, 0x3a, 0xf6, 0x06, 0x2c, 0xd3, 0x71, 0x38, 0x74, 0x29, 0x4b, 0xb0, 0x17,
-	0x50, 0xd3, 0xd8, 0xae, 0x74, 0x5b, 0x4e, 0x9b, 0xd3, 0x4e, 0x24, 0x09, 0xbd, 0x03, 0xab, 0x09,
-	0xe6, 0xc2, 0x5c, 0x1c, 0x7a, 0x83, 0x11, 0xf6, 0xcd, 0x15, 0x01, 0x5a, 0x91, 0xd4, 0x23, 0x49,
-	0x44, 0x3d, 0x68, 0x8a, 0x1c, 0x53, 0x73, 0x75, 0xbb, 0xd6, 0x6d, 0xf7, 0xac, 0x82, 0x3b, 0xfb,
-	0x9c, 0x75, 0xa8, 0xb2, 0xef, 0x28, 0xa4, 0xfd, 0x5b, 0x05, 0x20, 0x77, 0x16, 0xdd, 0x85, 0x3a,
-	0xcf, 0xa8, 0x48, 0x7c, 0xbb, 0xb7, 0x53, 0x10, 0x70, 0x3f, 0x0a, 0xe2, 0x88, 0x12, 0x86, 0x55,
-	0x60, 0x64, 0xad, 0x38, 0xe2, 0x02, 0xfa, 0x02, 0xda, 0x5a, 0x9a, 0x44, 0x96, 0xda, 0xbd, 0xeb,
-	0xd9, 0xfd, 0xfd, 0x94, 0x45, 0x5a, 0xbe, 0x94, 0x04, 0xfd, 0x0a, 0xfa, 0x00, 0x9a, 0x2c, 0xf1,
-	0x86, 0x67, 0x54, 0xd4, 0x4d, 0xbb, 0x67, 0x16, 0x2e, 0x3f, 0xe5, 0xac, 0x49, 0x46, 0x24, 0xce,
-	0x7e, 0x08, 0x46, 0xe6, 0x18, 0xda, 0x03, 0x98, 0x94, 0x36, 0xa6, 0x66, 0xe5, 0x95, 0x01, 0xd0,
-	0xd0, 0x76, 0x17, 0xd6, 0x1f, 0x13, 0xca, 0x38, 0x68, 0xe2, 0x16, 0xda, 0x80, 0x06, 0x2f, 0x7b,
-	0x29, 0xca, 0x70, 0xe4, 0xc1, 0xbe, 0x07, 0x1d, 0x0d, 0x49, 0xe3, 0x28, 0xa4, 0x18, 0xed, 0x40,
-	0x83, 0xc7, 0x60, 0xa2, 0x75, 0xa5, 0xa0, 0xd5, 0x91, 0x3c, 0xfb, 0x5d, 0xe8, 0x1c, 0xe2, 0x11,
-	0x9e, 0xe9, 0xb3, 0x2c, 0xdc, 0x86, 0x8c, 0xa4, 0xbd, 0x01, 0x48, 0x07, 0x4a, 0x1d, 0xf6, 0x6d,
-	0xb8, 0xc2, 0x15, 0xeb, 0x35, 0xbf, 0x48, 0xc8, 0xb7, 0x60, 0xce, 0xc2, 0x95, 0xb9, 0x9f, 0xc0,
-	0x72, 0xa1, 0xa3, 0xa4, 0xd5, 0x79, 0xb8, 0xb5, 0x4b, 0xc7, 0xe1, 0xb3, 0xc8, 0x29, 0xa0, 0xed,
-	0x63, 0xb8, 0xc2, 0x0d, 0xd3, 0x41, 0x3e, 0x0e, 0x19, 0x61, 0xe3, 0x32, 0x43, 0x78, 0xfb, 0x11,
-	0xc5, 0x17, 0x79, 0x35, 0x9c, 0xec, 0x6c, 0x6f, 0xc1, 0xa6, 0x83, 0x83, 0xe8, 0x1c, 0x6b, 0xc2,
-	0x32, 0x87, 0xc7, 0xb0, 0xf1, 0x24, 0x95, 0x41, 0x10, 0xb9, 0x5f, 0xe0, 0xed, 0x22, 0x25, 0x68,
-	0x0b, 0x0c, 0x51, 0x2e, 0x2e, 0x25, 0xbe, 0x28, 0x4b, 0xc3, 0x69, 0x09, 0xc2, 0x09, 0xf1, 0x79,
-	0x92, 0x83, 0x94, 0x61, 0x39, 0x33, 0x5a, 0x8e, 0x3c, 0xd8, 0xfb, 0x70, 0x79, 0x4a, 0xb5, 0x8a,
-	0x5c, 0x17, 0x1a, 0xe2, 0xaa, 0x6a, 0x0f, 0x94, 0x85, 0x4c, 0xc0, 0x44, 0xb0, 0x24, 0xc0, 0xfe,
-	0xa3, 0x0a, 0xe6, 0xd7, 0xb1, 0xef, 0xb1, 0xa2, 0x6f, 0x17, 0x73, 0x41, 0x1f, 0x61, 0xb5, 0xa9,
-	0x11, 0xf6, 0x19, 0x40, 0x8c, 0x93, 0x80, 0x50, 0x4a, 0xa2, 0x50, 0xb8, 0xa1, 0xb7, 0x9d, 0xa6,
-	0xbc, 0x9f, 0xa1, 0x1c, 0xed, 0x46, 0x36, 0xe9, 0x1b, 0xda, 0xa4, 0xff, 0x0a, 0xc0, 0x63, 0x2c,
-	0x21, 0x83, 0x94, 0x61, 0x3e, 0x1a, 0x79, 0x79, 0x7c, 0x98, 0xc9, 0x9c, 0xe7, 0xd6, 0xee, 0x7e,
-	0x76, 0xe7, 0x28, 0x64, 0xc9, 0xd8, 0xd1, 0x84, 0x58, 0x9f, 0xc2, 0xda, 0x14, 0x1b, 0xad, 0x43,
-	0xed, 0x0c, 0x8f, 0x55, 0x10, 0xf8, 0x27, 0xcf, 0xc6, 0xb9, 0x37, 0x4a, 0xb1, 0x0a, 0x80, 0x3c,
-	0xec, 0x55, 0xef, 0x55, 0xec, 0x3f, 0x2b, 0x60, 0x49, 0xbd, 0x27, 0xe9, 0x80, 0x0e, 0x13, 0x12,
-	0x33, 0x12, 0x85, 0xf4, 0xa2, 0x01, 0xbd, 0x06, 0x90, 0xd5, 0x04, 0x7f, 0x51, 0x78, 0x83, 0x1b,
-	0x93, 0xa2, 0xa0, 0xe8, 0x2a, 0x18, 0x54, 0xaa, 0x19, 0x60, 0x55, 0x19, 0x39, 0x01, 0x1d, 0x03,
-	0xd2, 0x1a, 0xc2, 0x55, 0x33, 0xab, 0x31, 0x35, 0x70, 0xb4, 0xf8, 0x88, 0xe2, 0xa0, 0x4e, 0x27,
-	0x9e, 0x26, 0xd9, 0xd7, 0x60, 0xab, 0xd4, 0x2b, 0xd5, 0x02, 0x2f, 0xab, 0xb0, 0x76, 0x82, 0x43,
-	0xff, 0xd0, 0x63, 0xde, 0x22, 0x57, 0x11, 0xd4, 0x45, 0x6d, 0x70, 0x37, 0x97, 0x1d, 0xf1, 0x8d,
-	0xde, 0x87, 0xfa, 0x19, 0x09, 0x65, 0xc5, 0xaf, 0x6a, 0xcd, 0xcd, 0x65, 0xf5, 0xbd, 0xe1, 0x19,
-	0x66, 0xbb, 0x8f, 0x48, 0xe8, 0x3b, 0x02, 0x85, 0x6e, 0xc3, 0xba, 0x8f, 0x29, 0x23, 0xa1, 0xc7,
-	0x2d, 0x90, 0x61, 0xa9, 0xf3, 0xb0, 0x1c, 0x54, 0xcd, 0x8a, 0xb3, 0xa6, 0xf1, 0x44, 0x80, 0x3e,
-	0x86, 0x37, 0x75, 0xb8, 0x8a, 0x2b, 0x51, 0xc5, 0x62, 0x38, 0x97, 0x35, 0xee, 0x71, 0xc6, 0x44,
-	0x9b, 0xd0, 0x60, 0x51, 0x4c, 0x86, 0xb2, 0xd8, 0xbe, 0x7c, 0xc3, 0x91, 0xc7, 0x97, 0x95, 0x8a,
-	0x98, 0xb6, 0x51, 0x38, 0xc4, 0xe2, 0x41, 0x5d, 0x76, 0xe4, 0xe1, 0xa0, 0x05, 0x4d, 0x57, 0x40,
-	0x6c, 0x04, 0xeb, 0x79, 0x24, 0x54, 0x78, 0x1e, 0xc1, 0xa6, 0x8c, 0x1e, 0x6f, 0xd4, 0x27, 0xaa,
-	0x21, 0x5e, 0x51, 0x12, 0x59, 0x1f, 0x55, 0x8b, 0x7d, 0x64, 0xff, 0x52, 0x83, 0x8e, 0x7c, 0xe4,
-	0xc2, 0x67, 0xe4, 0x34, 0x4d, 0x84, 0xed, 0xa5, 0x7b, 0xd0, 0xc5, 0xd7, 0x9c, 0xda, 0xbf, 0x58,
-	0x73, 0xea, 0xe5, 0x6b, 0x4e, 0xbe, 0xb1, 0x34, 0xfe, 0xd7, 0x1b, 0x4b, 0xbe, 0x8a, 0xc0, 0x6b,
-	0xaf, 0x22, 0xe7, 0xb0, 0xf9, 0x20, 0x4a, 0x7e, 0xf6, 0x12, 0xff, 0x3f, 0x98, 0x99, 0x37, 0x8b,
-	0x15, 0x2d, 0xee, 0xca, 0xd9, 0xa9, 0x57, 0x33, 0x37, 0xc6, 0xbe, 0x0a, 0x56, 0x99, 0x5e, 0x59,
-	0x65, 0xbd, 0xdf, 0x97, 0xa0, 0xcd, 0x61, 0x27, 0x38, 0x39, 0x27, 0x43, 0x8c, 0xee, 0x02, 0xe4,
-	0xfb, 0x32, 0xca, 0xfd, 0x9a, 0x59, 0xa2, 0xad, 0xe2, 0x1e, 0x80, 0x0e, 0xc0, 0xc8, 0x56, 0x07,
-	0xb4, 0x99, 0xf1, 0xa6, 0x17, 0x0f, 0xcb, 0x2a, 0x63, 0xa9, 0x07, 0xe8, 0x08, 0x20, 0xdf, 0x0d,
-	0x34, 0xe5, 0x33, 0x9b, 0x85, 0xb5, 0x55, 0xca, 0x53, 0x62, 0xbe, 0x91, 0xfb, 0x4e, 0xa1, 0xb2,
-	0xb6, 0x0b, 0x6a, 0x4b, 0xf6, 0x0c, 0xeb, 0xc6, 0x02, 0x84, 0x12, 0xfc, 0x18, 0x56, 0x1f, 0x62,
-	0x9d, 0xa5, 0x89, 0x9d, 0xb3, 0x35, 0x58, 0x73, 0x17, 0x0f, 0xf4, 0x1d, 0x74, 0x66, 0xf6, 0x83,
-	0xd7, 0x10, 0x68, 0xe7, 0x88, 0x79, 0xdb, 0x05, 0x3a, 0x01, 0xc4, 0x9f, 0xf8, 0x7e, 0x3a, 0x18,
-	0x11, 0xfa, 0x1c, 0xfb, 0x62, 0x20, 0xa3, 0x6b, 0xd9, 0xcd, 0xb2, 0xd5, 0xc3, 0xba, 0x3e, 0x8f,
-	0xad, 0x84, 0xf6, 0xa1, 0x33, 0xf3, 0x38, 0xa2, 0x1b, 0xaf, 0x7c, 0x38, 0x17, 0x44, 0xe0, 0x47,
-	0xb8, 0x54, 0xf2, 0x40, 0xa0, 0x9d, 0x29, 0x99, 0x65, 0x8f, 0xa2, 0xf5, 0xf6, 0x62, 0x90, 0xb2,
-	0xf9, 0x73, 0x68, 0x4d, 0x06, 0x2b, 0xca, 0xed, 0x98, 0x7a, 0x75, 0xac, 0xcd, 0x12, 0x8e, 0x12,
-	0xf0, 0x10, 0xd0, 0xec, 0x14, 0x46, 0xf6, 0x94, 0xf2, 0x92, 0x11, 0x3d, 0xdd, 0x1f, 0x3f, 0x00,
-	0x9a, 0x6d, 0x43, 0x4d, 0xd0, 0xdc, 0xd9, 0x60, 0xed, 0x2c, 0xc4, 0x48, 0x3b, 0x0f, 0x1e, 0x7c,
-	0xbf, 0x73, 0x4a, 0xd8, 0xf3, 0x74, 0xb0, 0x3b, 0x8c, 0x82, 0x3b, 0xea, 0xc2, 0x1d, 0xf1, 0x13,
-	0x3c, 0x8c, 0x46, 0x13, 0xc2, 0xaf, 0xd5, 0x95, 0xc7, 0xe4, 0x1c, 0x3f, 0xe2, 0xb9, 0xe0, 0xac,
-	0xbf, 0xaa, 0xab, 0xea, 0xbc, 0xb7, 0x27, 0x08, 0x83, 0xa6, 0xb8, 0xf2, 0xd1, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa8, 0x0c, 0xfd, 0x0c, 0xa0, 0x0f, 0x00, 0x00,
+	// 1791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x6d, 0x6f, 0x1b, 0xc7,
+	0x11, 0x0e, 0x29, 0x92, 0x22, 0x87, 0x2f, 0x12, 0xd7, 0xb2, 0x7d, 0x3a, 0xc9, 0xb1, 0x7c, 0x4a,
+	0x5b, 0xb9, 0x69, 0xe4, 0x56, 0x45, 0x90, 0x40, 0xe8, 0x9b, 0x64, 0x2b, 0x8e, 0x22, 0x0b, 0x56,
+	0x4f, 0x36, 0xd2, 0x16, 0x28, 0xae, 0x2b, 0xde, 0x84, 0xda, 0x8a, 0xf7, 0xd2, 0xbb, 0x3d, 0x4a,
+	0xfc, 0x5c, 0xa0, 0xc8, 0x6f, 0xe9, 0x1f, 0x28, 0xfa, 0xb1, 0xe8, 0xd7, 0x7e, 0xec, 0xaf, 0x28,
+	0xfa, 0x23, 0x8a, 0x7d, 0xe1, 0xf1, 0xee, 0x78, 0xa2, 0x53, 0xb7, 0x06, 0xf2, 0x8d, 0x3b, 0xf3,
+	0xdc, 0xec, 0xec, 0xb3, 0x33, 0xb3, 0x33, 0x04, 0x32, 0x62, 0x63, 0xbc, 0x62, 0xdc, 0x89, 0x82,
+	0xc0, 0xdb, 0x0d, 0xa3, 0x80, 0x07, 0x64, 0x59, 0xcb, 0xcc, 0xb5, 0xa9, 0xd2, 0x0b, 0x5c, 0x1c,
+	0xc5, 0x4a, 0x3d, 0x93, 0xe2, 0x30, 0xc2, 0x78, 0x2a, 0xdd, 0x9c, 0x4a, 0xe9, 0x10, 0x7d, 0xee,
+	0xb8, 0x2c, 0x0e, 0x29, 0x1f, 0x5c, 0x2a, 0xad, 0xf5, 0xb7, 0x1a, 0xf4, 0x9f, 0x46, 0x48, 0x39,
+	0xda, 0x41, 0xe0, 0xd9, 0xf8, 0x87, 0x04, 0x63, 0x4e, 0x08, 0xd4, 0x7c, 0xea, 0xa1, 0x51, 0xd9,
+	0xaa, 0xec, 0xb4, 0x6c, 0xf9, 0x9b, 0x3c, 0x84, 0xb6, 0x70, 0xc5, 0x09, 0x23, 0x8c, 0x91, 0x1b,
+	0x1d, 0xa9, 0x02, 0x21, 0x3a, 0x93, 0x12, 0xb2, 0x0d, 0x5d, 0xf4, 0x42, 0x3e, 0x71, 0x38, 0xf3,
+	0x30, 0x48, 0xb8, 0x51, 0xdd, 0xaa, 0xec, 0x74, 0xed, 0x8e, 0x14, 0xbe, 0x52, 0x32, 0xf2, 0x21,
+	0xf4, 0x5d, 0x0c, 0x69, 0xc4, 0x93, 0x08, 0x53, 0x20, 0x48, 0xe0, 0x6a, 0xaa, 0x98, 0x82, 0x1f,
+	0xc3, 0xaa, 0x47, 0x6f, 0x1c, 0x21, 0x65, 0x03, 0x16, 0x52, 0x9f, 0xc7, 0xc6, 0x92, 0xc4, 0xae,
+	0x78, 0xf4, 0xe6, 0x2c, 0x23, 0x26, 0xf7, 0x61, 0xd9, 0x0f, 0x5c, 0x74, 0x98, 0x6b, 0xd4, 0xa4,
+	0x67, 0x0d, 0xb1, 0x3c, 0x76, 0x89, 0x09, 0x4d, 0x0f, 0x39, 0x75, 0x29, 0xa7, 0x46, 0x5d, 0x6a,
+	0xd2, 0x35, 0xf9, 0x10, 0x1a, 0x8a, 0x2a, 0xa3, 0xb1, 0x55, 0xd9, 0x69, 0xef, 0xdd, 0xd9, 0xd5,
+	0x5c, 0xed, 0x0a, 0x32, 0x8e, 0xa4, 0xca, 0xd6, 0x10, 0xf2, 0x7d, 0xe8, 0x7b, 0xcc, 0x77, 0xc2,
+	0x11, 0x9d, 0x04, 0x09, 0x77, 0x5c, 0x1c, 0xd1, 0x89, 0xb1, 0xac, 0xbd, 0x61, 0xfe, 0x99, 0x92,
+	0x3f, 0x13, 0x62, 0x89, 0x15, 0x8e, 0xe7, 0xb0, 0xcd, 0x99, 0xe7, 0x59, 0xec, 0x23, 0xe8, 0xc4,
+	0x13, 0x7f, 0xe0, 0xc4, 0x3c, 0x42, 0xea, 0xc5, 0x46, 0x6b, 0xab, 0xb2, 0xd3, 0xb4, 0xdb, 0x42,
+	0x76, 0xae, 0x44, 0xe4, 0x3b, 0xd0, 0x8b, 0x50, 0x18, 0x73, 0xd0, 0xa7, 0x17, 0x23, 0x74, 0x8d,
+	0xae, 0x04, 0x75, 0x95, 0xf4, 0x48, 0x09, 0xc9, 0x1e, 0x34, 0xe4, 0x1d, 0xc7, 0x46, 0x6f, 0x6b,
+	0x69, 0xa7, 0xbd, 0x67, 0xe6, 0x8e, 0x73, 0x20, 0x54, 0xcf, 0xf4, 0xed, 0xdb, 0x1a, 0x29, 0x2e,
+	0x6d, 0x4c, 0x47, 0xcc, 0xa5, 0x1c, 0x9d, 0xc0, 0x1f, 0x4d, 0x8c, 0x15, 0x69, 0xb9, 0x33, 0x15,
+	0xbe, 0xf4, 0x47, 0x13, 0xf2, 0x00, 0x20, 0x8c, 0x82, 0xdf, 0xe3, 0x80, 0x0b, 0x7e, 0x57, 0x25,
+	0x8b, 0x2d, 0x2d, 0x39, 0x76, 0xad, 0xbf, 0x56, 0x00, 0x66, 0x84, 0x91, 0x4f, 0xa0, 0x26, 0xa2,
+	0x42, 0x06, 0x4f, 0x7b, 0x6f, 0x3b, 0xe7, 0xc4, 0xd3, 0xc0, 0x0b, 0x83, 0x98, 0x71, 0xd4, 0xe4,
+	0xaa, 0x78, 0xb3, 0xe5, 0x07, 0xe4, 0x17, 0xd0, 0xce, 0x5c, 0xb5, 0xbc, 0xe9, 0xf6, 0xde, 0xfb,
+	0xe9, 0xf7, 0x07, 0x09, 0x0f, 0x32, 0x77, 0xae, 0x2d, 0x64, 0x3f, 0x21, 0x3f, 0x84, 0x06, 0x8f,
+	0xe8, 0xe0, 0x2a, 0x96, 0xb1, 0xd7, 0xde, 0x33, 0x72, 0x1f, 0xbf, 0x12, 0xaa, 0xe9, 0xad, 0x2a,
+	0x9c, 0xf5, 0x1c, 0x5a, 0x29, 0x39, 0x64, 0x1f, 0x60, 0x9a, 0x1e, 0x18, 0x1b, 0x95, 0x37, 0x92,
+	0x98, 0x41, 0x5b, 0xcf, 0x61, 0xf5, 0x05, 0x8b, 0xb9, 0x00, 0x4d, 0x8f, 0x45, 0xd6, 0xa0, 0x2e,
+	0x52, 0x47, 0x99, 0x6a, 0xd9, 0x6a, 0x51, 0x60, 0xb3, 0x5a, 0x64, 0xf3, 0x53, 0xe8, 0x67, 0x0c,
+	0xc5, 0x61, 0xe0, 0xc7, 0x48, 0xb6, 0xa1, 0x2e, 0x28, 0x9a, 0x3a, 0xd5, 0xcd, 0x39, 0x65, 0x2b,
+	0x9d, 0xf5, 0x3d, 0xe8, 0x3f, 0xc3, 0x11, 0xce, 0xa5, 0x72, 0x7a, 0x1b, 0x2d, 0x45, 0xb4, 0xb5,
+	0x06, 0x24, 0x0b, 0x54, 0x7b, 0x58, 0x1f, 0xc1, 0x7d, 0xb1, 0x71, 0x36, 0xad, 0x16, 0x19, 0xf9,
+	0x15, 0x18, 0xf3, 0x70, 0xed, 0xee, 0x4f, 0xa0, 0x93, 0x4b, 0x5a, 0xe5, 0xf5, 0xec, 0x36, 0x32,
+	0x1f, 0x1d, 0xfb, 0x5f, 0x05, 0x76, 0x0e, 0x6d, 0x1d, 0xc3, 0x7d, 0xe1, 0x58, 0x16, 0xe4, 0xa2,
+	0xcf, 0x19, 0x9f, 0x94, 0x39, 0x22, 0x32, 0x9c, 0x69, 0xbd, 0x66, 0x33, 0x5d, 0x5b, 0x1b, 0xb0,
+	0x6e, 0xa3, 0x17, 0x8c, 0x31, 0x63, 0x2c, 0x3d, 0xf0, 0x04, 0xd6, 0x4e, 0x13, 0x45, 0x82, 0x0c,
+	0x8d, 0x05, 0xa7, 0x5d, 0xb4, 0x09, 0xd9, 0x80, 0x96, 0x8c, 0x26, 0x27, 0x66, 0xae, 0x8c, 0xda,
+	0x96, 0xdd, 0x94, 0x82, 0x73, 0xe6, 0x8a, 0x18, 0xf0, 0x12, 0x8e, 0xaa, 0x2c, 0x35, 0x6d, 0xb5,
+	0xb0, 0x0e, 0xe0, 0x6e, 0x61, 0x6b, 0xcd, 0xdc, 0x0e, 0xd4, 0xe5, 0xa7, 0x3a, 0x7b, 0x48, 0x4a,
+	0x99, 0x84, 0x49, 0xb2, 0x14, 0xc0, 0xfa, 0x47, 0x15, 0x8c, 0xd7, 0xa1, 0xc8, 0xd1, 0xdc, 0xd9,
+	0xde, 0xee, 0x08, 0xd9, 0x2a, 0xb9, 0x54, 0xa8, 0x92, 0x3f, 0x03, 0x08, 0x31, 0xf2, 0x58, 0x1c,
+	0xb3, 0xc0, 0x37, 0x6a, 0x85, 0xac, 0xcc, 0x6c, 0x7e, 0x96, 0xa2, 0xec, 0xcc, 0x17, 0xe9, 0x63,
+	0x52, 0xcf, 0x3c, 0x26, 0xbf, 0x04, 0xa0, 0x9c, 0x47, 0xec, 0x22, 0xe1, 0x28, 0xaa, 0xaf, 0x08,
+	0x8f, 0x1f, 0xa5, 0x36, 0x6f, 0x3b, 0xd6, 0xee, 0x41, 0xfa, 0xcd, 0x91, 0xcf, 0xa3, 0x89, 0x9d,
+	0x31, 0x62, 0xfe, 0x14, 0x56, 0x0a, 0x6a, 0xb2, 0x0a, 0x4b, 0x57, 0x38, 0xd1, 0x24, 0x88, 0x9f,
+	0xe2, 0x36, 0xc6, 0x74, 0x94, 0xa0, 0x26, 0x40, 0x2d, 0xf6, 0xab, 0x9f, 0x56, 0xac, 0x7f, 0x56,
+	0xc0, 0x54, 0xfb, 0x9e, 0x27, 0x17, 0xf1, 0x20, 0x62, 0x21, 0x67, 0x81, 0x1f, 0xbf, 0x2d, 0xa1,
+	0x0f, 0x00, 0xd2, 0x98, 0x10, 0x8f, 0x96, 0xc8, 0xff, 0xd6, 0x34, 0x28, 0x62, 0xb2, 0x09, 0xad,
+	0x58, 0x6d, 0x73, 0x81, 0x3a, 0x32, 0x66, 0x02, 0x72, 0x0c, 0x24, 0x93, 0x10, 0x8e, 0x2e, 0x69,
+	0xf5, 0x42, 0x3d, 0xca, 0xf0, 0x23, 0x83, 0x23, 0xb6, 0xfb, 0x61, 0x51, 0x64, 0x3d, 0x80, 0x8d,
+	0xd2, 0x53, 0xe9, 0x14, 0xf8, 0xba, 0x0a, 0x2b, 0xe7, 0xe8, 0xbb, 0xcf, 0x28, 0xa7, 0x8b, 0x8e,
+	0x4a, 0xa0, 0x26, 0x63, 0x43, 0x1c, 0xb3, 0x63, 0xcb, 0xdf, 0xe4, 0x07, 0x50, 0xbb, 0x62, 0xbe,
+	0x8a, 0xf8, 0x5e, 0x26, 0xb9, 0x85, 0xad, 0x33, 0x3a, 0xb8, 0x42, 0xbe, 0x7b, 0xc2, 0x7c, 0xd7,
+	0x96, 0x28, 0xf2, 0x11, 0xac, 0xba, 0x18, 0x73, 0xe6, 0x53, 0xe1, 0x81, 0xa2, 0xa5, 0x26, 0x68,
+	0x39, 0xac, 0x1a, 0x15, 0x7b, 0x25, 0xa3, 0x93, 0x04, 0x7d, 0x0c, 0xf7, 0xb2, 0x70, 0xcd, 0x2b,
+	0xd3, 0xc1, 0xd2, 0xb2, 0xef, 0x66, 0xb4, 0xc7, 0xa9, 0x92, 0xac, 0x43, 0x9d, 0x07, 0x21, 0x1b,
+	0xa8, 0x60, 0xfb, 0xfc, 0x3d, 0x5b, 0x2d, 0xbf, 0xae, 0x54, 0x64, 0x31, 0x0e, 0xfc, 0x01, 0xca,
+	0x37, 0xbb, 0x63, 0xab, 0xc5, 0x61, 0x13, 0x1a, 0x8e, 0x84, 0x58, 0x04, 0x56, 0x67, 0x4c, 0x68,
+	0x7a, 0x4e, 0x60, 0x5d, 0xb1, 0x27, 0x12, 0xf5, 0x54, 0x27, 0xc4, 0x1b, 0x42, 0x22, 0xcd, 0xa3,
+	0x6a, 0x3e, 0x8f, 0xac, 0x3f, 0xd6, 0xa0, 0xaf, 0xde, 0x40, 0xff, 0x2b, 0x36, 0x4c, 0x22, 0xe9,
+	0x7b, 0x69, 0xab, 0xf5, 0xf6, 0x9d, 0xd4, 0xd2, 0x7f, 0xd1, 0x49, 0xd5, 0xca, 0x3b, 0xa9, 0x59,
+	0x53, 0x54, 0xff, 0x56, 0x37, 0x45, 0xb3, 0x6e, 0x07, 0xbe, 0x71, 0xb7, 0xf3, 0x05, 0xdc, 0xb9,
+	0xc6, 0x8b, 0xcb, 0x20, 0x10, 0x79, 0x39, 0xf4, 0x99, 0x3f, 0x74, 0x44, 0x81, 0x68, 0x6f, 0x55,
+	0x72, 0x06, 0xbe, 0x54, 0x98, 0x73, 0x05, 0x39, 0xc1, 0x89, 0xdd, 0xbf, 0x2e, 0x8a, 0xc8, 0xc7,
+	0xd0, 0xd3, 0xdd, 0x98, 0x33, 0x08, 0x5c, 0x1c, 0xc4, 0x46, 0x47, 0xfa, 0xd1, 0x4b, 0xcd, 0x3c,
+	0x15, 0x62, 0xbb, 0xab, 0x51, 0x72, 0x15, 0x5b, 0xaf, 0xa1, 0x3f, 0x67, 0x9e, 0xdc, 0x85, 0xc6,
+	0x15, 0x4e, 0x44, 0x3b, 0xa0, 0xc2, 0xa0, 0x7e, 0x85, 0x93, 0x63, 0x57, 0x34, 0xb5, 0x34, 0x64,
+	0xd2, 0x45, 0x15, 0x4c, 0x0d, 0x1a, 0xb2, 0x13, 0x9c, 0x7c, 0x51, 0x6b, 0x2e, 0xad, 0xd6, 0x6c,
+	0x10, 0xca, 0x18, 0x07, 0x11, 0x72, 0x6b, 0x0c, 0xeb, 0x9f, 0x05, 0xd1, 0x35, 0x8d, 0xdc, 0xff,
+	0xc3, 0x6b, 0xf0, 0x38, 0x9f, 0xab, 0xf2, 0x5b, 0xf5, 0x2a, 0x64, 0xf3, 0x54, 0xd0, 0x6c, 0x6d,
+	0x82, 0x59, 0xb6, 0xaf, 0xce, 0x9f, 0xbf, 0x57, 0xe1, 0xde, 0x69, 0xf1, 0xf5, 0x7d, 0xd7, 0x3e,
+	0xfd, 0xcf, 0x0f, 0xd6, 0xcb, 0xdc, 0xe3, 0xa4, 0xca, 0xee, 0x93, 0xf4, 0xfb, 0xf2, 0xf3, 0xbc,
+	0xcb, 0xa7, 0xe9, 0x35, 0xdc, 0x3f, 0x2d, 0x6f, 0x61, 0xc8, 0x7e, 0xbe, 0x65, 0xae, 0x14, 0xba,
+	0xde, 0x62, 0x9f, 0x95, 0x05, 0x5b, 0x7f, 0xa9, 0x00, 0xb1, 0xd5, 0xf8, 0x36, 0x66, 0x78, 0xbd,
+	0xe8, 0x62, 0xd6, 0xa0, 0x7e, 0xcd, 0x5c, 0x7e, 0xa9, 0x0b, 0x91, 0x5a, 0x90, 0x7b, 0xd0, 0xb8,
+	0x44, 0x36, 0xbc, 0x9c, 0x96, 0x1d, 0xbd, 0x22, 0x8f, 0xa1, 0x2e, 0x33, 0x42, 0x52, 0xdf, 0xcb,
+	0x14, 0x90, 0x63, 0x8f, 0x0e, 0x51, 0x65, 0x85, 0x42, 0x90, 0x4d, 0x00, 0x51, 0x13, 0xe8, 0x10,
+	0x1d, 0x4f, 0x15, 0x9c, 0xae, 0xdd, 0xf4, 0xe8, 0xcd, 0xc1, 0x10, 0x4f, 0x63, 0xb1, 0x41, 0x12,
+	0x8e, 0x02, 0xea, 0xca, 0xf9, 0xac, 0x69, 0xeb, 0x95, 0xf5, 0xa7, 0x2a, 0xdc, 0xc9, 0x79, 0xae,
+	0xd9, 0xb8, 0x07, 0x75, 0x26, 0xb6, 0x90, 0xbe, 0x77, 0x44, 0xf5, 0x97, 0x4b, 0x42, 0x60, 0x29,
+	0x89, 0x46, 0x8a, 0xd8, 0xcf, 0xdf, 0xb3, 0xc5, 0x82, 0x7c, 0x00, 0xbd, 0x24, 0x1a, 0x39, 0x78,
+	0x13, 0xb2, 0x08, 0x63, 0x87, 0xaa, 0x43, 0x2c, 0xd9, 0x9d, 0x24, 0x1a, 0x1d, 0x29, 0xe1, 0x01,
+	0x17, 0xad, 0x9d, 0xc7, 0x3c, 0x74, 0xf8, 0x24, 0x44, 0x3d, 0x58, 0x36, 0x85, 0xe0, 0xd5, 0x24,
+	0xc4, 0x19, 0x2b, 0xf5, 0x72, 0x56, 0x1a, 0x39, 0x56, 0x1e, 0x42, 0x7b, 0x40, 0x43, 0x51, 0x94,
+	0x5d, 0xb1, 0xdb, 0xb2, 0xdc, 0x0d, 0xa6, 0xa2, 0x03, 0x4e, 0xbe, 0x0b, 0x2b, 0x3e, 0xde, 0x70,
+	0x47, 0x8b, 0x04, 0xa8, 0x29, 0x41, 0x5d, 0x21, 0x7e, 0xaa, 0xa4, 0x07, 0xfc, 0xb0, 0x05, 0xcb,
+	0xa1, 0x3a, 0xf8, 0xde, 0xbf, 0x9b, 0xd0, 0x16, 0x44, 0x9c, 0x63, 0x34, 0x66, 0x03, 0x24, 0x9f,
+	0x00, 0xcc, 0x86, 0x79, 0x32, 0x2b, 0x68, 0x73, 0x13, 0xbe, 0x99, 0x9f, 0x20, 0xc8, 0x21, 0xb4,
+	0xd2, 0xa1, 0x83, 0xac, 0xa7, 0xba, 0xe2, 0x44, 0x63, 0x9a, 0x65, 0x2a, 0xcd, 0xfe, 0x11, 0xc0,
+	0x6c, 0xaa, 0xc8, 0x6c, 0x3e, 0x37, 0x93, 0x98, 0x1b, 0xa5, 0x3a, 0x6d, 0xe6, 0x4b, 0x35, 0x48,
+	0xe5, 0xde, 0xa4, 0xad, 0xdc, 0xb6, 0x25, 0x13, 0x8a, 0xf9, 0x68, 0x01, 0x42, 0x1b, 0x7e, 0x01,
+	0xbd, 0xe7, 0x98, 0x55, 0x65, 0xcc, 0xde, 0x32, 0x6f, 0x98, 0xb7, 0xa6, 0x12, 0xf9, 0x35, 0xf4,
+	0xe7, 0x26, 0x8b, 0x6f, 0x60, 0xd0, 0x9a, 0x21, 0x6e, 0x9b, 0x4b, 0xc8, 0x39, 0x10, 0x31, 0x1c,
+	0x9c, 0x25, 0x17, 0x23, 0x16, 0x5f, 0xa2, 0x2b, 0x5b, 0x39, 0xf2, 0x60, 0x56, 0x81, 0x4a, 0x86,
+	0x16, 0xf3, 0xfd, 0xdb, 0xd4, 0xda, 0xe8, 0x19, 0xf4, 0xe7, 0xda, 0x6a, 0xf2, 0xe8, 0x8d, 0x2d,
+	0xf7, 0x02, 0x06, 0x7e, 0x07, 0x77, 0x4a, 0x5a, 0x4b, 0xb2, 0x5d, 0xb0, 0x59, 0xd6, 0x4e, 0x9b,
+	0x1f, 0x2c, 0x06, 0x69, 0x9f, 0x7f, 0x0e, 0xcd, 0x69, 0x4b, 0x46, 0x66, 0x7e, 0x14, 0xfa, 0x55,
+	0x73, 0xbd, 0x44, 0xa3, 0x0d, 0x3c, 0x07, 0x32, 0xdf, 0xbf, 0x11, 0xab, 0xb0, 0x79, 0x49, 0x73,
+	0x57, 0xcc, 0x8f, 0xdf, 0x02, 0x99, 0x7f, 0xe6, 0x32, 0x86, 0x6e, 0x7d, 0x7b, 0xcd, 0xed, 0x85,
+	0x18, 0xed, 0xe7, 0x2b, 0x58, 0x29, 0x54, 0x78, 0xf2, 0xf0, 0x0d, 0x0f, 0x8e, 0xb9, 0x75, 0x3b,
+	0x40, 0x5b, 0x7d, 0x09, 0x44, 0x83, 0x33, 0xc5, 0x92, 0x6c, 0xe4, 0x63, 0x34, 0x57, 0xfc, 0xcd,
+	0xcd, 0x72, 0xa5, 0x32, 0x78, 0xf8, 0xd9, 0x6f, 0xb6, 0x87, 0x8c, 0x5f, 0x26, 0x17, 0xbb, 0x83,
+	0xc0, 0x7b, 0xa2, 0x91, 0x4f, 0xe4, 0x1f, 0x89, 0x83, 0x60, 0x34, 0x15, 0xfc, 0xb9, 0xda, 0x7d,
+	0xc1, 0xc6, 0x78, 0x22, 0x42, 0x46, 0xa8, 0xfe, 0x55, 0xed, 0xe9, 0xf5, 0xfe, 0xbe, 0x14, 0x5c,
+	0x34, 0xe4, 0x27, 0x3f, 0xfe, 0xcf, 0x00, 0x0b, 0xf5, 0x9d, 0xa3, 0xe4, 0x14, 0x00, 0x00,
 }
@@ -49,6 +49,19 @@ type SIP interface {
 
 	DeleteSIPTrunk(context.Context, *DeleteSIPTrunkRequest) (*SIPTrunkInfo, error)
 
+	// Replace auth credentials of a trunk. Previous credentials stay valid until the overlap window ends,
+	// so calls and carriers that still use them are not dropped. Fails while a previous rotation is in its
+	// overlap window, complete or roll it back first.
+	RotateSIPTrunkCredentials(context.Context, *RotateSIPTrunkCredentialsRequest) (*SIPTrunkCredentialRotation, error)
+
+	GetSIPTrunkCredentialRotation(context.Context, *GetSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error)
+
+	// Restore previous credentials of a trunk. Only allowed while the overlap window is active.
+	RollbackSIPTrunkCredentialRotation(context.Context, *RollbackSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error)
+
+	// End the overlap window early, previous credentials of the trunk stop being accepted.
+	CompleteSIPTrunkCredentialRotation(context.Context, *CompleteSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error)
+
 	CreateSIPDispatchRule(context.Context, *CreateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error)
 
 	UpdateSIPDispatchRule(context.Context, *UpdateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error)
@@ -68,7 +81,7 @@ type SIP interface {
 
 type sIPProtobufClient struct {
 	client      HTTPClient
-	urls        [16]string
+	urls        [20]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -96,7 +109,7 @@ func NewSIPProtobufClient(baseURL string, client HTTPClient, opts ...twirp.Clien
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "livekit", "SIP")
-	urls := [16]string{
+	urls := [20]string{
 		serviceURL + "ListSIPTrunk",
 		serviceURL + "CreateSIPInboundTrunk",
 		serviceURL + "CreateSIPOutboundTrunk",
@@ -107,6 +120,10 @@ func NewSIPProtobufClient(baseURL string, client HTTPClient, opts ...twirp.Clien
 		serviceURL + "ListSIPInboundTrunk",
 		serviceURL + "ListSIPOutboundTrunk",
 		serviceURL + "DeleteSIPTrunk",
+		serviceURL + "RotateSIPTrunkCredentials",
+		serviceURL + "GetSIPTrunkCredentialRotation",
+		serviceURL + "RollbackSIPTrunkCredentialRotation",
+		serviceURL + "CompleteSIPTrunkCredentialRotation",
 		serviceURL + "CreateSIPDispatchRule",
 		serviceURL + "UpdateSIPDispatchRule",
 		serviceURL + "ListSIPDispatchRule",
@@ -583,6 +600,190 @@ func (c *sIPProtobufClient) callDeleteSIPTrunk(ctx context.Context, in *DeleteSI
 	return out, nil
 }
 
+func (c *sIPProtobufClient) RotateSIPTrunkCredentials(ctx context.Context, in *RotateSIPTrunkCredentialsRequest) (*SIPTrunkCredentialRotation, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "SIP")
+	ctx = ctxsetters.WithMethodName(ctx, "RotateSIPTrunkCredentials")
+	caller := c.callRotateSIPTrunkCredentials
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RotateSIPTrunkCredentialsRequest) (*SIPTrunkCredentialRotation, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RotateSIPTrunkCredentialsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RotateSIPTrunkCredentialsRequest) when calling interceptor")
+					}
+					return c.callRotateSIPTrunkCredentials(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sIPProtobufClient) callRotateSIPTrunkCredentials(ctx context.Context, in *RotateSIPTrunkCredentialsRequest) (*SIPTrunkCredentialRotation, error) {
+	out := new(SIPTrunkCredentialRotation)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sIPProtobufClient) GetSIPTrunkCredentialRotation(ctx context.Context, in *GetSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "SIP")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSIPTrunkCredentialRotation")
+	caller := c.callGetSIPTrunkCredentialRotation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSIPTrunkCredentialRotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSIPTrunkCredentialRotationRequest) when calling interceptor")
+					}
+					return c.callGetSIPTrunkCredentialRotation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sIPProtobufClient) callGetSIPTrunkCredentialRotation(ctx context.Context, in *GetSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+	out := new(SIPTrunkCredentialRotation)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sIPProtobufClient) RollbackSIPTrunkCredentialRotation(ctx context.Context, in *RollbackSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "SIP")
+	ctx = ctxsetters.WithMethodName(ctx, "RollbackSIPTrunkCredentialRotation")
+	caller := c.callRollbackSIPTrunkCredentialRotation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RollbackSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RollbackSIPTrunkCredentialRotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RollbackSIPTrunkCredentialRotationRequest) when calling interceptor")
+					}
+					return c.callRollbackSIPTrunkCredentialRotation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sIPProtobufClient) callRollbackSIPTrunkCredentialRotation(ctx context.Context, in *RollbackSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+	out := new(SIPTrunkCredentialRotation)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sIPProtobufClient) CompleteSIPTrunkCredentialRotation(ctx context.Context, in *CompleteSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "SIP")
+	ctx = ctxsetters.WithMethodName(ctx, "CompleteSIPTrunkCredentialRotation")
+	caller := c.callCompleteSIPTrunkCredentialRotation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CompleteSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CompleteSIPTrunkCredentialRotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CompleteSIPTrunkCredentialRotationRequest) when calling interceptor")
+					}
+					return c.callCompleteSIPTrunkCredentialRotation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sIPProtobufClient) callCompleteSIPTrunkCredentialRotation(ctx context.Context, in *CompleteSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+	out := new(SIPTrunkCredentialRotation)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 func (c *sIPProtobufClient) CreateSIPDispatchRule(ctx context.Context, in *CreateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "livekit")
 	ctx = ctxsetters.WithServiceName(ctx, "SIP")
@@ -614,7 +815,7 @@ func (c *sIPProtobufClient) CreateSIPDispatchRule(ctx context.Context, in *Creat
 
 func (c *sIPProtobufClient) callCreateSIPDispatchRule(ctx context.Context, in *CreateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
 	out := new(SIPDispatchRuleInfo)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -660,7 +861,7 @@ func (c *sIPProtobufClient) UpdateSIPDispatchRule(ctx context.Context, in *Updat
 
 func (c *sIPProtobufClient) callUpdateSIPDispatchRule(ctx context.Context, in *UpdateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
 	out := new(SIPDispatchRuleInfo)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -706,7 +907,7 @@ func (c *sIPProtobufClient) ListSIPDispatchRule(ctx context.Context, in *ListSIP
 
 func (c *sIPProtobufClient) callListSIPDispatchRule(ctx context.Context, in *ListSIPDispatchRuleRequest) (*ListSIPDispatchRuleResponse, error) {
 	out := new(ListSIPDispatchRuleResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -752,7 +953,7 @@ func (c *sIPProtobufClient) DeleteSIPDispatchRule(ctx context.Context, in *Delet
 
 func (c *sIPProtobufClient) callDeleteSIPDispatchRule(ctx context.Context, in *DeleteSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
 	out := new(SIPDispatchRuleInfo)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -798,7 +999,7 @@ func (c *sIPProtobufClient) CreateSIPParticipant(ctx context.Context, in *Create
 
 func (c *sIPProtobufClient) callCreateSIPParticipant(ctx context.Context, in *CreateSIPParticipantRequest) (*SIPParticipantInfo, error) {
 	out := new(SIPParticipantInfo)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -844,7 +1045,7 @@ func (c *sIPProtobufClient) TransferSIPParticipant(ctx context.Context, in *Tran
 
 func (c *sIPProtobufClient) callTransferSIPParticipant(ctx context.Context, in *TransferSIPParticipantRequest) (*google_protobuf2.Empty, error) {
 	out := new(google_protobuf2.Empty)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -865,7 +1066,7 @@ func (c *sIPProtobufClient) callTransferSIPParticipant(ctx context.Context, in *
 
 type sIPJSONClient struct {
 	client      HTTPClient
-	urls        [16]string
+	urls        [20]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -893,7 +1094,7 @@ func NewSIPJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOpt
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "livekit", "SIP")
-	urls := [16]string{
+	urls := [20]string{
 		serviceURL + "ListSIPTrunk",
 		serviceURL + "CreateSIPInboundTrunk",
 		serviceURL + "CreateSIPOutboundTrunk",
@@ -904,6 +1105,10 @@ func NewSIPJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOpt
 		serviceURL + "ListSIPInboundTrunk",
 		serviceURL + "ListSIPOutboundTrunk",
 		serviceURL + "DeleteSIPTrunk",
+		serviceURL + "RotateSIPTrunkCredentials",
+		serviceURL + "GetSIPTrunkCredentialRotation",
+		serviceURL + "RollbackSIPTrunkCredentialRotation",
+		serviceURL + "CompleteSIPTrunkCredentialRotation",
 		serviceURL + "CreateSIPDispatchRule",
 		serviceURL + "UpdateSIPDispatchRule",
 		serviceURL + "ListSIPDispatchRule",
@@ -1380,26 +1585,26 @@ func (c *sIPJSONClient) callDeleteSIPTrunk(ctx context.Context, in *DeleteSIPTru
 	return out, nil
 }
 
-func (c *sIPJSONClient) CreateSIPDispatchRule(ctx context.Context, in *CreateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
+func (c *sIPJSONClient) RotateSIPTrunkCredentials(ctx context.Context, in *RotateSIPTrunkCredentialsRequest) (*SIPTrunkCredentialRotation, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "livekit")
 	ctx = ctxsetters.WithServiceName(ctx, "SIP")
-	ctx = ctxsetters.WithMethodName(ctx, "CreateSIPDispatchRule")
-	caller := c.callCreateSIPDispatchRule
+	ctx = ctxsetters.WithMethodName(ctx, "RotateSIPTrunkCredentials")
+	caller := c.callRotateSIPTrunkCredentials
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CreateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
+		caller = func(ctx context.Context, req *RotateSIPTrunkCredentialsRequest) (*SIPTrunkCredentialRotation, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateSIPDispatchRuleRequest)
+					typedReq, ok := req.(*RotateSIPTrunkCredentialsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateSIPDispatchRuleRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RotateSIPTrunkCredentialsRequest) when calling interceptor")
 					}
-					return c.callCreateSIPDispatchRule(ctx, typedReq)
+					return c.callRotateSIPTrunkCredentials(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*SIPDispatchRuleInfo)
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SIPDispatchRuleInfo) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1409,8 +1614,8 @@ func (c *sIPJSONClient) CreateSIPDispatchRule(ctx context.Context, in *CreateSIP
 	return caller(ctx, in)
 }
 
-func (c *sIPJSONClient) callCreateSIPDispatchRule(ctx context.Context, in *CreateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
-	out := new(SIPDispatchRuleInfo)
+func (c *sIPJSONClient) callRotateSIPTrunkCredentials(ctx context.Context, in *RotateSIPTrunkCredentialsRequest) (*SIPTrunkCredentialRotation, error) {
+	out := new(SIPTrunkCredentialRotation)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -1426,26 +1631,26 @@ func (c *sIPJSONClient) callCreateSIPDispatchRule(ctx context.Context, in *Creat
 	return out, nil
 }
 
-func (c *sIPJSONClient) UpdateSIPDispatchRule(ctx context.Context, in *UpdateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
+func (c *sIPJSONClient) GetSIPTrunkCredentialRotation(ctx context.Context, in *GetSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "livekit")
 	ctx = ctxsetters.WithServiceName(ctx, "SIP")
-	ctx = ctxsetters.WithMethodName(ctx, "UpdateSIPDispatchRule")
-	caller := c.callUpdateSIPDispatchRule
+	ctx = ctxsetters.WithMethodName(ctx, "GetSIPTrunkCredentialRotation")
+	caller := c.callGetSIPTrunkCredentialRotation
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UpdateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
+		caller = func(ctx context.Context, req *GetSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpdateSIPDispatchRuleRequest)
+					typedReq, ok := req.(*GetSIPTrunkCredentialRotationRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpdateSIPDispatchRuleRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetSIPTrunkCredentialRotationRequest) when calling interceptor")
 					}
-					return c.callUpdateSIPDispatchRule(ctx, typedReq)
+					return c.callGetSIPTrunkCredentialRotation(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*SIPDispatchRuleInfo)
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SIPDispatchRuleInfo) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1455,8 +1660,8 @@ func (c *sIPJSONClient) UpdateSIPDispatchRule(ctx context.Context, in *UpdateSIP
 	return caller(ctx, in)
 }
 
-func (c *sIPJSONClient) callUpdateSIPDispatchRule(ctx context.Context, in *UpdateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
-	out := new(SIPDispatchRuleInfo)
+func (c *sIPJSONClient) callGetSIPTrunkCredentialRotation(ctx context.Context, in *GetSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+	out := new(SIPTrunkCredentialRotation)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -1472,26 +1677,26 @@ func (c *sIPJSONClient) callUpdateSIPDispatchRule(ctx context.Context, in *Updat
 	return out, nil
 }
 
-func (c *sIPJSONClient) ListSIPDispatchRule(ctx context.Context, in *ListSIPDispatchRuleRequest) (*ListSIPDispatchRuleResponse, error) {
+func (c *sIPJSONClient) RollbackSIPTrunkCredentialRotation(ctx context.Context, in *RollbackSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "livekit")
 	ctx = ctxsetters.WithServiceName(ctx, "SIP")
-	ctx = ctxsetters.WithMethodName(ctx, "ListSIPDispatchRule")
-	caller := c.callListSIPDispatchRule
+	ctx = ctxsetters.WithMethodName(ctx, "RollbackSIPTrunkCredentialRotation")
+	caller := c.callRollbackSIPTrunkCredentialRotation
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ListSIPDispatchRuleRequest) (*ListSIPDispatchRuleResponse, error) {
+		caller = func(ctx context.Context, req *RollbackSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListSIPDispatchRuleRequest)
+					typedReq, ok := req.(*RollbackSIPTrunkCredentialRotationRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListSIPDispatchRuleRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RollbackSIPTrunkCredentialRotationRequest) when calling interceptor")
 					}
-					return c.callListSIPDispatchRule(ctx, typedReq)
+					return c.callRollbackSIPTrunkCredentialRotation(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListSIPDispatchRuleResponse)
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListSIPDispatchRuleResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1501,8 +1706,8 @@ func (c *sIPJSONClient) ListSIPDispatchRule(ctx context.Context, in *ListSIPDisp
 	return caller(ctx, in)
 }
 
-func (c *sIPJSONClient) callListSIPDispatchRule(ctx context.Context, in *ListSIPDispatchRuleRequest) (*ListSIPDispatchRuleResponse, error) {
-	out := new(ListSIPDispatchRuleResponse)
+func (c *sIPJSONClient) callRollbackSIPTrunkCredentialRotation(ctx context.Context, in *RollbackSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+	out := new(SIPTrunkCredentialRotation)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -1518,26 +1723,26 @@ func (c *sIPJSONClient) callListSIPDispatchRule(ctx context.Context, in *ListSIP
 	return out, nil
 }
 
-func (c *sIPJSONClient) DeleteSIPDispatchRule(ctx context.Context, in *DeleteSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
+func (c *sIPJSONClient) CompleteSIPTrunkCredentialRotation(ctx context.Context, in *CompleteSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "livekit")
 	ctx = ctxsetters.WithServiceName(ctx, "SIP")
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteSIPDispatchRule")
-	caller := c.callDeleteSIPDispatchRule
+	ctx = ctxsetters.WithMethodName(ctx, "CompleteSIPTrunkCredentialRotation")
+	caller := c.callCompleteSIPTrunkCredentialRotation
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *DeleteSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
+		caller = func(ctx context.Context, req *CompleteSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteSIPDispatchRuleRequest)
+					typedReq, ok := req.(*CompleteSIPTrunkCredentialRotationRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteSIPDispatchRuleRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CompleteSIPTrunkCredentialRotationRequest) when calling interceptor")
 					}
-					return c.callDeleteSIPDispatchRule(ctx, typedReq)
+					return c.callCompleteSIPTrunkCredentialRotation(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*SIPDispatchRuleInfo)
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SIPDispatchRuleInfo) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1547,8 +1752,8 @@ func (c *sIPJSONClient) DeleteSIPDispatchRule(ctx context.Context, in *DeleteSIP
 	return caller(ctx, in)
 }
 
-func (c *sIPJSONClient) callDeleteSIPDispatchRule(ctx context.Context, in *DeleteSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
-	out := new(SIPDispatchRuleInfo)
+func (c *sIPJSONClient) callCompleteSIPTrunkCredentialRotation(ctx context.Context, in *CompleteSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+	out := new(SIPTrunkCredentialRotation)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -1564,26 +1769,26 @@ func (c *sIPJSONClient) callDeleteSIPDispatchRule(ctx context.Context, in *Delet
 	return out, nil
 }
 
-func (c *sIPJSONClient) CreateSIPParticipant(ctx context.Context, in *CreateSIPParticipantRequest) (*SIPParticipantInfo, error) {
+func (c *sIPJSONClient) CreateSIPDispatchRule(ctx context.Context, in *CreateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "livekit")
 	ctx = ctxsetters.WithServiceName(ctx, "SIP")
-	ctx = ctxsetters.WithMethodName(ctx, "CreateSIPParticipant")
-	caller := c.callCreateSIPParticipant
+	ctx = ctxsetters.WithMethodName(ctx, "CreateSIPDispatchRule")
+	caller := c.callCreateSIPDispatchRule
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CreateSIPParticipantRequest) (*SIPParticipantInfo, error) {
+		caller = func(ctx context.Context, req *CreateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateSIPParticipantRequest)
+					typedReq, ok := req.(*CreateSIPDispatchRuleRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateSIPParticipantRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CreateSIPDispatchRuleRequest) when calling interceptor")
 					}
-					return c.callCreateSIPParticipant(ctx, typedReq)
+					return c.callCreateSIPDispatchRule(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*SIPParticipantInfo)
+				typedResp, ok := resp.(*SIPDispatchRuleInfo)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SIPParticipantInfo) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPDispatchRuleInfo) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1593,8 +1798,8 @@ func (c *sIPJSONClient) CreateSIPParticipant(ctx context.Context, in *CreateSIPP
 	return caller(ctx, in)
 }
 
-func (c *sIPJSONClient) callCreateSIPParticipant(ctx context.Context, in *CreateSIPParticipantRequest) (*SIPParticipantInfo, error) {
-	out := new(SIPParticipantInfo)
+func (c *sIPJSONClient) callCreateSIPDispatchRule(ctx context.Context, in *CreateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
+	out := new(SIPDispatchRuleInfo)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -1610,26 +1815,26 @@ func (c *sIPJSONClient) callCreateSIPParticipant(ctx context.Context, in *Create
 	return out, nil
 }
 
-func (c *sIPJSONClient) TransferSIPParticipant(ctx context.Context, in *TransferSIPParticipantRequest) (*google_protobuf2.Empty, error) {
+func (c *sIPJSONClient) UpdateSIPDispatchRule(ctx context.Context, in *UpdateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "livekit")
 	ctx = ctxsetters.WithServiceName(ctx, "SIP")
-	ctx = ctxsetters.WithMethodName(ctx, "TransferSIPParticipant")
-	caller := c.callTransferSIPParticipant
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateSIPDispatchRule")
+	caller := c.callUpdateSIPDispatchRule
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *TransferSIPParticipantRequest) (*google_protobuf2.Empty, error) {
+		caller = func(ctx context.Context, req *UpdateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*TransferSIPParticipantRequest)
+					typedReq, ok := req.(*UpdateSIPDispatchRuleRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*TransferSIPParticipantRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateSIPDispatchRuleRequest) when calling interceptor")
 					}
-					return c.callTransferSIPParticipant(ctx, typedReq)
+					return c.callUpdateSIPDispatchRule(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*google_protobuf2.Empty)
+				typedResp, ok := resp.(*SIPDispatchRuleInfo)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf2.Empty) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPDispatchRuleInfo) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1639,8 +1844,8 @@ func (c *sIPJSONClient) TransferSIPParticipant(ctx context.Context, in *Transfer
 	return caller(ctx, in)
 }
 
-func (c *sIPJSONClient) callTransferSIPParticipant(ctx context.Context, in *TransferSIPParticipantRequest) (*google_protobuf2.Empty, error) {
-	out := new(google_protobuf2.Empty)
+func (c *sIPJSONClient) callUpdateSIPDispatchRule(ctx context.Context, in *UpdateSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
+	out := new(SIPDispatchRuleInfo)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -1656,22 +1861,206 @@ func (c *sIPJSONClient) callTransferSIPParticipant(ctx context.Context, in *Tran
 	return out, nil
 }
 
-// ==================
-// SIP Server Handler
-// ==================
-
-type sIPServer struct {
-	SIP
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
-}
-
-// NewSIPServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func (c *sIPJSONClient) ListSIPDispatchRule(ctx context.Context, in *ListSIPDispatchRuleRequest) (*ListSIPDispatchRuleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "SIP")
+	ctx = ctxsetters.WithMethodName(ctx, "ListSIPDispatchRule")
+	caller := c.callListSIPDispatchRule
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListSIPDispatchRuleRequest) (*ListSIPDispatchRuleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSIPDispatchRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSIPDispatchRuleRequest) when calling interceptor")
+					}
+					return c.callListSIPDispatchRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSIPDispatchRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSIPDispatchRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sIPJSONClient) callListSIPDispatchRule(ctx context.Context, in *ListSIPDispatchRuleRequest) (*ListSIPDispatchRuleResponse, error) {
+	out := new(ListSIPDispatchRuleResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sIPJSONClient) DeleteSIPDispatchRule(ctx context.Context, in *DeleteSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "SIP")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteSIPDispatchRule")
+	caller := c.callDeleteSIPDispatchRule
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteSIPDispatchRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteSIPDispatchRuleRequest) when calling interceptor")
+					}
+					return c.callDeleteSIPDispatchRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SIPDispatchRuleInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPDispatchRuleInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sIPJSONClient) callDeleteSIPDispatchRule(ctx context.Context, in *DeleteSIPDispatchRuleRequest) (*SIPDispatchRuleInfo, error) {
+	out := new(SIPDispatchRuleInfo)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sIPJSONClient) CreateSIPParticipant(ctx context.Context, in *CreateSIPParticipantRequest) (*SIPParticipantInfo, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "SIP")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateSIPParticipant")
+	caller := c.callCreateSIPParticipant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateSIPParticipantRequest) (*SIPParticipantInfo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateSIPParticipantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateSIPParticipantRequest) when calling interceptor")
+					}
+					return c.callCreateSIPParticipant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SIPParticipantInfo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPParticipantInfo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sIPJSONClient) callCreateSIPParticipant(ctx context.Context, in *CreateSIPParticipantRequest) (*SIPParticipantInfo, error) {
+	out := new(SIPParticipantInfo)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *sIPJSONClient) TransferSIPParticipant(ctx context.Context, in *TransferSIPParticipantRequest) (*google_protobuf2.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "livekit")
+	ctx = ctxsetters.WithServiceName(ctx, "SIP")
+	ctx = ctxsetters.WithMethodName(ctx, "TransferSIPParticipant")
+	caller := c.callTransferSIPParticipant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TransferSIPParticipantRequest) (*google_protobuf2.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TransferSIPParticipantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TransferSIPParticipantRequest) when calling interceptor")
+					}
+					return c.callTransferSIPParticipant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf2.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf2.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *sIPJSONClient) callTransferSIPParticipant(ctx context.Context, in *TransferSIPParticipantRequest) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==================
+// SIP Server Handler
+// ==================
+
+type sIPServer struct {
+	SIP
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewSIPServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
 func NewSIPServer(svc SIP, opts ...interface{}) TwirpServer {
 	serverOpts := newServerOpts(opts)
 
@@ -1783,6 +2172,18 @@ func (s *sIPServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 	case "DeleteSIPTrunk":
 		s.serveDeleteSIPTrunk(ctx, resp, req)
 		return
+	case "RotateSIPTrunkCredentials":
+		s.serveRotateSIPTrunkCredentials(ctx, resp, req)
+		return
+	case "GetSIPTrunkCredentialRotation":
+		s.serveGetSIPTrunkCredentialRotation(ctx, resp, req)
+		return
+	case "RollbackSIPTrunkCredentialRotation":
+		s.serveRollbackSIPTrunkCredentialRotation(ctx, resp, req)
+		return
+	case "CompleteSIPTrunkCredentialRotation":
+		s.serveCompleteSIPTrunkCredentialRotation(ctx, resp, req)
+		return
 	case "CreateSIPDispatchRule":
 		s.serveCreateSIPDispatchRule(ctx, resp, req)
 		return
@@ -3608,6 +4009,726 @@ func (s *sIPServer) serveDeleteSIPTrunkProtobuf(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *sIPServer) serveRotateSIPTrunkCredentials(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRotateSIPTrunkCredentialsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRotateSIPTrunkCredentialsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sIPServer) serveRotateSIPTrunkCredentialsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RotateSIPTrunkCredentials")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RotateSIPTrunkCredentialsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SIP.RotateSIPTrunkCredentials
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RotateSIPTrunkCredentialsRequest) (*SIPTrunkCredentialRotation, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RotateSIPTrunkCredentialsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RotateSIPTrunkCredentialsRequest) when calling interceptor")
+					}
+					return s.SIP.RotateSIPTrunkCredentials(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SIPTrunkCredentialRotation
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SIPTrunkCredentialRotation and nil error while calling RotateSIPTrunkCredentials. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sIPServer) serveRotateSIPTrunkCredentialsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RotateSIPTrunkCredentials")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RotateSIPTrunkCredentialsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SIP.RotateSIPTrunkCredentials
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RotateSIPTrunkCredentialsRequest) (*SIPTrunkCredentialRotation, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RotateSIPTrunkCredentialsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RotateSIPTrunkCredentialsRequest) when calling interceptor")
+					}
+					return s.SIP.RotateSIPTrunkCredentials(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SIPTrunkCredentialRotation
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SIPTrunkCredentialRotation and nil error while calling RotateSIPTrunkCredentials. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sIPServer) serveGetSIPTrunkCredentialRotation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetSIPTrunkCredentialRotationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetSIPTrunkCredentialRotationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sIPServer) serveGetSIPTrunkCredentialRotationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSIPTrunkCredentialRotation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetSIPTrunkCredentialRotationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SIP.GetSIPTrunkCredentialRotation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSIPTrunkCredentialRotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSIPTrunkCredentialRotationRequest) when calling interceptor")
+					}
+					return s.SIP.GetSIPTrunkCredentialRotation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SIPTrunkCredentialRotation
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SIPTrunkCredentialRotation and nil error while calling GetSIPTrunkCredentialRotation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sIPServer) serveGetSIPTrunkCredentialRotationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSIPTrunkCredentialRotation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetSIPTrunkCredentialRotationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SIP.GetSIPTrunkCredentialRotation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSIPTrunkCredentialRotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSIPTrunkCredentialRotationRequest) when calling interceptor")
+					}
+					return s.SIP.GetSIPTrunkCredentialRotation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SIPTrunkCredentialRotation
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SIPTrunkCredentialRotation and nil error while calling GetSIPTrunkCredentialRotation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sIPServer) serveRollbackSIPTrunkCredentialRotation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRollbackSIPTrunkCredentialRotationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRollbackSIPTrunkCredentialRotationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sIPServer) serveRollbackSIPTrunkCredentialRotationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RollbackSIPTrunkCredentialRotation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RollbackSIPTrunkCredentialRotationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SIP.RollbackSIPTrunkCredentialRotation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RollbackSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RollbackSIPTrunkCredentialRotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RollbackSIPTrunkCredentialRotationRequest) when calling interceptor")
+					}
+					return s.SIP.RollbackSIPTrunkCredentialRotation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SIPTrunkCredentialRotation
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SIPTrunkCredentialRotation and nil error while calling RollbackSIPTrunkCredentialRotation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sIPServer) serveRollbackSIPTrunkCredentialRotationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RollbackSIPTrunkCredentialRotation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RollbackSIPTrunkCredentialRotationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SIP.RollbackSIPTrunkCredentialRotation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RollbackSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RollbackSIPTrunkCredentialRotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RollbackSIPTrunkCredentialRotationRequest) when calling interceptor")
+					}
+					return s.SIP.RollbackSIPTrunkCredentialRotation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SIPTrunkCredentialRotation
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SIPTrunkCredentialRotation and nil error while calling RollbackSIPTrunkCredentialRotation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sIPServer) serveCompleteSIPTrunkCredentialRotation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCompleteSIPTrunkCredentialRotationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCompleteSIPTrunkCredentialRotationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *sIPServer) serveCompleteSIPTrunkCredentialRotationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CompleteSIPTrunkCredentialRotation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CompleteSIPTrunkCredentialRotationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SIP.CompleteSIPTrunkCredentialRotation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CompleteSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CompleteSIPTrunkCredentialRotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CompleteSIPTrunkCredentialRotationRequest) when calling interceptor")
+					}
+					return s.SIP.CompleteSIPTrunkCredentialRotation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SIPTrunkCredentialRotation
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SIPTrunkCredentialRotation and nil error while calling CompleteSIPTrunkCredentialRotation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *sIPServer) serveCompleteSIPTrunkCredentialRotationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CompleteSIPTrunkCredentialRotation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CompleteSIPTrunkCredentialRotationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SIP.CompleteSIPTrunkCredentialRotation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CompleteSIPTrunkCredentialRotationRequest) (*SIPTrunkCredentialRotation, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CompleteSIPTrunkCredentialRotationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CompleteSIPTrunkCredentialRotationRequest) when calling interceptor")
+					}
+					return s.SIP.CompleteSIPTrunkCredentialRotation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SIPTrunkCredentialRotation)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SIPTrunkCredentialRotation) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SIPTrunkCredentialRotation
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SIPTrunkCredentialRotation and nil error while calling CompleteSIPTrunkCredentialRotation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *sIPServer) serveCreateSIPDispatchRule(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
@@ -4704,270 +5825,298 @@ func (s *sIPServer) PathPrefix() string {
 }
 
 var twirpFileDescriptor4 = []byte{
-	// 4228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x59, 0x8c, 0xdc, 0x5a,
-	0x5a, 0x4e, 0x95, 0xab, 0xbb, 0xab, 0xff, 0xea, 0xc5, 0x7d, 0xba, 0xd3, 0xa9, 0x54, 0xd2, 0x49,
-	0x6e, 0xe5, 0xde, 0x9b, 0xa4, 0xef, 0xd0, 0xb9, 0xd3, 0x11, 0xc3, 0xbd, 0x61, 0x36, 0x77, 0xd9,
-	0xdd, 0x6d, 0x52, 0x6d, 0xd7, 0x75, 0xb9, 0x92, 0xf4, 0x68, 0xc0, 0x38, 0x65, 0x77, 0xc7, 0x93,
-	0xaa, 0x72, 0x61, 0xbb, 0x92, 0x1b, 0x04, 0x0f, 0xf0, 0x74, 0x79, 0x19, 0xcd, 0xb0, 0x83, 0xc4,
-	0x26, 0x36, 0x09, 0x04, 0x12, 0x12, 0xcb, 0x1b, 0x02, 0x89, 0x07, 0x40, 0xe2, 0x15, 0x90, 0x58,
-	0x04, 0xc3, 0x2a, 0x1e, 0xe0, 0x85, 0xf5, 0x0d, 0x9d, 0xc5, 0x6b, 0xb9, 0xaa, 0xab, 0x93, 0x3b,
-	0x12, 0x62, 0xde, 0xec, 0xff, 0xfc, 0xe7, 0x3f, 0xff, 0x39, 0xe7, 0xfb, 0x97, 0xf3, 0xfb, 0x18,
-	0xd6, 0x7a, 0xce, 0x73, 0xfb, 0x99, 0x13, 0x18, 0xbe, 0x33, 0xdc, 0x19, 0x7a, 0x6e, 0xe0, 0xa2,
-	0x05, 0x46, 0xaa, 0x5d, 0x3b, 0x75, 0xdd, 0xd3, 0x9e, 0x7d, 0x97, 0x90, 0x9f, 0x8c, 0x4e, 0xee,
-	0x5a, 0x23, 0xcf, 0x0c, 0x1c, 0x77, 0x40, 0x19, 0x6b, 0x57, 0xb2, 0xed, 0x76, 0x7f, 0x18, 0xbc,
-	0x64, 0x8d, 0x1b, 0xa1, 0xe0, 0xbe, 0x6b, 0xd9, 0x3d, 0x9f, 0x51, 0x51, 0x48, 0xf5, 0x5c, 0xb7,
-	0x4f, 0x69, 0x75, 0x15, 0x16, 0xdb, 0x72, 0xab, 0x1d, 0x98, 0xc1, 0xc8, 0x47, 0xdb, 0x50, 0xea,
-	0xba, 0x96, 0x5d, 0x2d, 0xdc, 0x28, 0xdc, 0x5e, 0xd9, 0xdd, 0xdc, 0x61, 0xfc, 0x3b, 0x11, 0x47,
-	0xc3, 0xb5, 0x6c, 0x8d, 0xf0, 0xa0, 0x4d, 0x98, 0xf7, 0x09, 0xad, 0x5a, 0xbc, 0x51, 0xb8, 0xbd,
-	0xa8, 0xb1, 0xb7, 0xfa, 0x5f, 0x71, 0x70, 0xb1, 0xe1, 0xd9, 0x66, 0x60, 0xb7, 0xe5, 0x96, 0xee,
-	0x8d, 0x06, 0xcf, 0x34, 0xfb, 0xbb, 0x46, 0xb6, 0x1f, 0xa0, 0x77, 0x60, 0xcd, 0x19, 0x3c, 0x71,
-	0x47, 0x03, 0xcb, 0x30, 0x2d, 0xcb, 0xb3, 0x7d, 0xdf, 0xf6, 0xab, 0x85, 0x1b, 0xdc, 0xed, 0x45,
-	0x8d, 0x67, 0x0d, 0x42, 0x48, 0x47, 0x77, 0x80, 0x77, 0x47, 0x41, 0x8a, 0x9b, 0x0d, 0xb4, 0x1a,
-	0xd2, 0x19, 0x33, 0xba, 0x05, 0x11, 0xc9, 0x18, 0x8c, 0xfa, 0x4f, 0x6c, 0xaf, 0xca, 0x11, 0xce,
-	0x95, 0x90, 0xac, 0x10, 0x2a, 0xfa, 0x14, 0x5c, 0x0c, 0x15, 0xa0, 0x7c, 0xbe, 0xe1, 0xd9, 0xa7,
-	0xf6, 0x87, 0xd5, 0x12, 0x56, 0x62, 0xaf, 0x58, 0x2d, 0x68, 0xeb, 0x8c, 0x81, 0xf6, 0xf0, 0x35,
-	0xdc, 0x8c, 0x07, 0xc8, 0xf4, 0xab, 0x2e, 0x12, 0xb5, 0x57, 0xd2, 0xdc, 0x58, 0xe9, 0x90, 0x71,
-	0xe4, 0xdb, 0xde, 0xc0, 0xec, 0xdb, 0xd5, 0x39, 0xaa, 0x34, 0xa3, 0x77, 0x18, 0x39, 0xc9, 0x3a,
-	0x34, 0x7d, 0xff, 0x85, 0xeb, 0x59, 0xd5, 0xf9, 0x14, 0x6b, 0x8b, 0x91, 0xf1, 0xba, 0x45, 0xf3,
-	0x8b, 0xc4, 0x2e, 0x10, 0xde, 0x68, 0x8d, 0x22, 0xb9, 0x49, 0xe6, 0x48, 0x70, 0x39, 0xcd, 0x1c,
-	0x49, 0x46, 0x50, 0x22, 0xc2, 0x80, 0xb4, 0x93, 0x67, 0x54, 0x83, 0x72, 0xdf, 0x0e, 0x4c, 0xcb,
-	0x0c, 0xcc, 0x6a, 0x85, 0xd0, 0xa3, 0xf7, 0xfb, 0xc5, 0x6a, 0xa1, 0xfe, 0x4b, 0x73, 0xb0, 0x14,
-	0xee, 0xac, 0x3c, 0x38, 0x71, 0xd1, 0x0d, 0x58, 0xf2, 0x9d, 0xa1, 0x11, 0x60, 0x82, 0xe1, 0x58,
-	0x04, 0x3c, 0x8b, 0x1a, 0xf8, 0xce, 0x90, 0xf2, 0x58, 0xe8, 0x1e, 0x94, 0x9e, 0x39, 0x03, 0xab,
-	0xba, 0x42, 0x60, 0x75, 0x3d, 0x09, 0xab, 0x48, 0xcc, 0x0e, 0x79, 0x7a, 0xe0, 0x0c, 0x2c, 0x8d,
-	0x30, 0xe7, 0xa3, 0xa5, 0x78, 0x0e, 0xb4, 0x70, 0x33, 0xa3, 0xa5, 0x94, 0x8b, 0x96, 0x7b, 0xb0,
-	0x18, 0x78, 0xe6, 0xc0, 0x1f, 0xba, 0x5e, 0x50, 0x5d, 0x26, 0xaa, 0x5f, 0x4c, 0xab, 0xce, 0x1a,
-	0xb5, 0x98, 0x6f, 0x32, 0xc4, 0xe6, 0xce, 0x0d, 0x31, 0x98, 0x19, 0x62, 0xf3, 0xb3, 0x43, 0x6c,
-	0xe1, 0x1c, 0x10, 0x2b, 0x9f, 0x07, 0x62, 0x8b, 0x67, 0x40, 0xac, 0x32, 0x01, 0x62, 0x4b, 0x69,
-	0x88, 0xd5, 0x45, 0x58, 0x8c, 0x90, 0x80, 0x78, 0x58, 0xd2, 0xb5, 0x8e, 0xf2, 0xc0, 0x68, 0x4a,
-	0x07, 0x42, 0xe3, 0x98, 0xbf, 0x80, 0xd6, 0x60, 0x99, 0x52, 0x64, 0x65, 0x4f, 0xed, 0x28, 0x22,
-	0x5f, 0x40, 0x08, 0x56, 0x28, 0x49, 0xed, 0xe8, 0x94, 0x56, 0x24, 0x40, 0xd5, 0xe0, 0x6a, 0xe4,
-	0x87, 0x64, 0x3a, 0xdf, 0x94, 0x3b, 0xda, 0x85, 0x39, 0x82, 0x59, 0x02, 0xd8, 0xca, 0xee, 0xd5,
-	0xe4, 0xde, 0x26, 0xf9, 0x31, 0x3a, 0x35, 0xca, 0x5a, 0xff, 0xdd, 0x02, 0x5c, 0xed, 0x0c, 0xad,
-	0xc9, 0x42, 0xcf, 0x36, 0x86, 0xf7, 0x60, 0xc1, 0xb3, 0x87, 0x3d, 0xb3, 0x6b, 0x13, 0x7f, 0x76,
-	0xc6, 0xc0, 0x87, 0x17, 0xb4, 0x90, 0x1d, 0xbd, 0x07, 0xf3, 0x23, 0x32, 0x36, 0x81, 0x76, 0x65,
-	0xf7, 0xda, 0xa4, 0x8e, 0x54, 0xc3, 0xc3, 0x0b, 0x1a, 0xe3, 0xdf, 0x2b, 0xc3, 0xbc, 0xd9, 0xc5,
-	0xb1, 0xa3, 0xfe, 0x07, 0x65, 0x58, 0xcf, 0x19, 0x66, 0x06, 0xbd, 0xc3, 0x8d, 0x2c, 0x4e, 0xd8,
-	0x48, 0x2e, 0xbd, 0x91, 0xa8, 0x0a, 0x0b, 0x21, 0x92, 0x89, 0x7b, 0xd5, 0xc2, 0x57, 0x8c, 0x1f,
-	0xb3, 0xd7, 0x73, 0x5f, 0xd8, 0x49, 0xcb, 0x9e, 0xa3, 0x96, 0xcd, 0x1a, 0x62, 0xcb, 0xbe, 0x05,
-	0xab, 0x21, 0x73, 0x28, 0x6e, 0x9e, 0x1a, 0x06, 0x23, 0x87, 0x86, 0x71, 0x13, 0x96, 0xcd, 0x51,
-	0xf0, 0x34, 0xeb, 0x21, 0x97, 0x30, 0x31, 0x82, 0x6e, 0xc8, 0x94, 0xf1, 0x8c, 0x84, 0x29, 0x82,
-	0x6c, 0x03, 0x16, 0x9e, 0xda, 0xa6, 0x15, 0xba, 0xf9, 0xca, 0xee, 0x9d, 0x69, 0x3b, 0xb4, 0x73,
-	0x48, 0x79, 0xa5, 0x41, 0xe0, 0xbd, 0xd4, 0xc2, 0x9e, 0xc8, 0x81, 0x8b, 0xec, 0xd1, 0x08, 0x5c,
-	0xc3, 0x0c, 0x02, 0xcf, 0x79, 0x32, 0x0a, 0x6c, 0x6a, 0xd6, 0x95, 0xdd, 0x6f, 0x9e, 0x45, 0xa4,
-	0xee, 0x0a, 0x51, 0x3f, 0x2a, 0x7e, 0xfd, 0xe9, 0x78, 0x0b, 0x1e, 0x2a, 0x96, 0x8f, 0x47, 0x0b,
-	0xb5, 0x5f, 0x99, 0x61, 0xa8, 0x58, 0x8e, 0xee, 0xa6, 0x66, 0xb2, 0x6e, 0x8e, 0xb7, 0xa0, 0x3d,
-	0xec, 0xa6, 0xba, 0xbd, 0x91, 0x65, 0x47, 0x83, 0xac, 0x12, 0xcf, 0x78, 0x39, 0x39, 0x08, 0xe5,
-	0x56, 0x87, 0x18, 0x71, 0x3e, 0xf6, 0x60, 0xa4, 0x47, 0x42, 0x86, 0xe7, 0x0c, 0x4e, 0x9d, 0xc1,
-	0xa9, 0x11, 0x38, 0x7d, 0xdb, 0x1d, 0x05, 0xc4, 0x39, 0x54, 0x76, 0x2f, 0xef, 0xd0, 0x94, 0x66,
-	0x27, 0x4c, 0x69, 0x76, 0x44, 0x96, 0xf2, 0x68, 0x2b, 0xac, 0x87, 0x4e, 0x3b, 0x20, 0x09, 0xd6,
-	0xfa, 0xe6, 0x87, 0x46, 0xd7, 0xec, 0xf5, 0x8c, 0x30, 0x2f, 0x22, 0xae, 0x64, 0xaa, 0x94, 0xd5,
-	0xbe, 0xf9, 0x61, 0xc3, 0xec, 0xf5, 0x42, 0x02, 0x86, 0xc3, 0x33, 0xcf, 0xf1, 0x87, 0x86, 0x3d,
-	0x30, 0x9f, 0xf4, 0x6c, 0x8b, 0xb8, 0xf9, 0xb2, 0xb6, 0x44, 0x88, 0x12, 0xa5, 0xa1, 0x7d, 0xe0,
-	0xfb, 0xb6, 0xe5, 0x98, 0x86, 0x3d, 0xe8, 0x7a, 0x2f, 0xc9, 0xa4, 0xaa, 0x3c, 0x99, 0xf4, 0x95,
-	0xe4, 0xa4, 0x8f, 0x30, 0x8f, 0x14, 0xb1, 0x68, 0xab, 0xfd, 0x34, 0xa1, 0x76, 0x1f, 0x96, 0x92,
-	0x0b, 0x8c, 0x78, 0xe0, 0x9e, 0xd9, 0x2f, 0x99, 0xa5, 0xe1, 0x47, 0xb4, 0x01, 0x73, 0xcf, 0xcd,
-	0xde, 0x28, 0xb4, 0x31, 0xfa, 0x72, 0xbf, 0xf8, 0x5e, 0xa1, 0xb6, 0x0f, 0xd5, 0x49, 0x98, 0x38,
-	0xaf, 0x9c, 0x49, 0x1b, 0x7e, 0x1e, 0x39, 0xf5, 0x2f, 0x73, 0x70, 0x31, 0xd7, 0xe9, 0xa0, 0x6f,
-	0x8a, 0xcd, 0x9e, 0xfa, 0xd5, 0xf5, 0x68, 0x91, 0x9a, 0x8e, 0x1f, 0x50, 0xae, 0xd8, 0x17, 0x7c,
-	0x3e, 0xcf, 0x17, 0x14, 0x27, 0x77, 0x1c, 0x77, 0x10, 0x9f, 0x1e, 0x77, 0x10, 0xdc, 0xe4, 0xfe,
-	0x59, 0xaf, 0x71, 0x3b, 0xeb, 0x35, 0x48, 0x2e, 0x70, 0x78, 0x21, 0xed, 0x37, 0x3e, 0x2a, 0x14,
-	0x22, 0xce, 0xc8, 0x75, 0x90, 0xc4, 0xee, 0xb0, 0x90, 0x76, 0x1e, 0x98, 0xf3, 0x12, 0xf3, 0x94,
-	0x24, 0x2c, 0x1f, 0x16, 0xa9, 0xaf, 0xc4, 0x0d, 0xd7, 0x13, 0xee, 0x92, 0x78, 0xa7, 0x43, 0x2e,
-	0x76, 0x98, 0x1f, 0x15, 0x0a, 0x7b, 0x3c, 0xac, 0x18, 0x29, 0x75, 0x62, 0x4a, 0x38, 0xec, 0xde,
-	0x02, 0xcc, 0x19, 0xa4, 0xa9, 0x02, 0x8b, 0x46, 0x14, 0x36, 0x75, 0xd8, 0x8a, 0x82, 0x9d, 0xca,
-	0x62, 0x70, 0x2a, 0x30, 0xdd, 0x4b, 0x47, 0xbb, 0xad, 0x24, 0x74, 0x53, 0x1d, 0x92, 0xe1, 0xee,
-	0xf7, 0x0b, 0xb0, 0x15, 0x85, 0xbb, 0x5c, 0xb1, 0x67, 0xc7, 0x8d, 0xf7, 0xb3, 0xf1, 0x6e, 0xfa,
-	0xd0, 0xc9, 0x80, 0xf7, 0x7e, 0x26, 0xe0, 0x5d, 0x9f, 0xd8, 0x73, 0x4a, 0xc4, 0xfb, 0xea, 0x02,
-	0x6c, 0xe4, 0x0d, 0xf4, 0xf5, 0x09, 0x79, 0x61, 0xf2, 0x49, 0x53, 0xca, 0xf0, 0x35, 0x9d, 0x4b,
-	0xce, 0xcd, 0x98, 0x4b, 0x26, 0x22, 0xe8, 0x7c, 0x3a, 0x82, 0x7e, 0x7c, 0xb1, 0x4e, 0xcc, 0xc6,
-	0xba, 0xed, 0xa9, 0xbb, 0x33, 0x21, 0xd8, 0x7d, 0x69, 0x7a, 0xb0, 0xfb, 0xd4, 0x4c, 0x32, 0x67,
-	0x8c, 0x76, 0x5f, 0x9a, 0x14, 0xed, 0x2a, 0xb3, 0x8c, 0xf5, 0xda, 0xe1, 0x6e, 0xe9, 0xbc, 0xe1,
-	0x2e, 0x2f, 0x7c, 0x2c, 0x7f, 0x83, 0x87, 0x8f, 0xef, 0xe7, 0x60, 0x33, 0xdf, 0x84, 0xd1, 0x56,
-	0x6c, 0x43, 0x05, 0xe6, 0x8a, 0x43, 0x02, 0x76, 0xa1, 0xef, 0x25, 0x0d, 0xa9, 0x38, 0xc5, 0x90,
-	0x0e, 0x0b, 0x09, 0x53, 0xc2, 0x3d, 0x13, 0x81, 0x89, 0x9b, 0x21, 0x30, 0x4d, 0x08, 0x0c, 0xc5,
-	0x99, 0x03, 0x03, 0x77, 0x56, 0x60, 0x28, 0x4d, 0x0b, 0x0c, 0x73, 0xe9, 0xc0, 0x00, 0x50, 0x0e,
-	0xe3, 0xe3, 0xde, 0x12, 0x80, 0x11, 0xcd, 0xec, 0x35, 0x42, 0xc6, 0x67, 0xe0, 0xf2, 0x81, 0x1d,
-	0xbc, 0xea, 0x39, 0xa6, 0xde, 0x82, 0x5a, 0x5e, 0x77, 0x7f, 0xe8, 0x0e, 0x7c, 0xfb, 0x95, 0x0e,
-	0x57, 0x9f, 0x0d, 0x25, 0xbe, 0x5a, 0xa4, 0xa9, 0x6b, 0x70, 0x25, 0xb7, 0x3f, 0x53, 0xe9, 0x95,
-	0x22, 0xe0, 0x1e, 0xac, 0x63, 0x74, 0x64, 0x4b, 0x59, 0xb7, 0xa0, 0x34, 0x34, 0x4f, 0xed, 0xb1,
-	0x14, 0xa7, 0x65, 0x9e, 0x3a, 0x03, 0x9a, 0x6c, 0x12, 0x06, 0x72, 0x10, 0x3d, 0x80, 0x8d, 0xb4,
-	0x0c, 0xa6, 0xd0, 0x3b, 0x30, 0xe7, 0x04, 0x76, 0x9f, 0xd6, 0xc0, 0x2a, 0x59, 0x1c, 0x47, 0x8a,
-	0x10, 0x1e, 0x22, 0xe8, 0x7b, 0xa0, 0xc6, 0x04, 0xe5, 0x6d, 0x59, 0xa8, 0x13, 0x77, 0x86, 0x4e,
-	0xe8, 0x0a, 0xb6, 0x21, 0xba, 0x8a, 0x61, 0xfd, 0xad, 0x1c, 0xd0, 0x35, 0xf4, 0x93, 0x41, 0xa7,
-	0x98, 0x0a, 0x3a, 0xf5, 0x0f, 0xe0, 0x4a, 0xee, 0xe8, 0xf1, 0x8e, 0x27, 0x67, 0x73, 0xc6, 0x8e,
-	0x13, 0xd6, 0xfa, 0xf7, 0x46, 0x22, 0x73, 0xb7, 0xfc, 0xeb, 0x3d, 0xa3, 0x36, 0x5c, 0xcd, 0x1f,
-	0x3e, 0x46, 0x4c, 0x72, 0x4a, 0x67, 0x21, 0x86, 0xce, 0xe9, 0x7d, 0xb8, 0x28, 0xda, 0x3d, 0x7b,
-	0xbc, 0xfc, 0x79, 0x36, 0x80, 0xf7, 0x49, 0x52, 0x2d, 0x3a, 0xfe, 0xd0, 0x0c, 0xba, 0x4f, 0xb5,
-	0x51, 0xcf, 0x16, 0x1d, 0xcf, 0xee, 0x06, 0x78, 0x7e, 0x9e, 0xeb, 0xf6, 0x89, 0x11, 0xb3, 0x7e,
-	0x65, 0x4c, 0x50, 0x70, 0x9c, 0xe7, 0x81, 0x1b, 0x3a, 0x03, 0xe6, 0x64, 0xf1, 0x63, 0x5d, 0x81,
-	0xcb, 0x19, 0x39, 0xf2, 0xc0, 0x72, 0x9e, 0x3b, 0xd6, 0xc8, 0xec, 0xa1, 0xeb, 0x50, 0x21, 0xb2,
-	0x86, 0x9e, 0x7d, 0xe2, 0x7c, 0x18, 0x6a, 0x81, 0x49, 0x2d, 0x42, 0xc9, 0x91, 0xf7, 0x74, 0x4c,
-	0x2f, 0x7c, 0x8a, 0xb2, 0xed, 0x57, 0x90, 0x85, 0xae, 0xc2, 0xa2, 0x67, 0x0e, 0x2c, 0xb7, 0xef,
-	0x7c, 0x37, 0xdd, 0xd8, 0xb2, 0x16, 0x13, 0xea, 0x3f, 0x57, 0x84, 0xd5, 0xcc, 0x50, 0x48, 0x83,
-	0x0d, 0x8b, 0xbd, 0x1b, 0xde, 0xa8, 0x67, 0x1b, 0x16, 0x59, 0x14, 0x66, 0x7b, 0xa9, 0x22, 0xc8,
-	0xf8, 0xd2, 0x1d, 0x5e, 0xd0, 0x90, 0x35, 0xbe, 0xa0, 0xdf, 0x01, 0xd5, 0xb4, 0x4c, 0x27, 0x5a,
-	0x20, 0x96, 0xa5, 0xd6, 0x27, 0xc9, 0x8d, 0x97, 0xf2, 0xf0, 0x82, 0xb6, 0x69, 0xe5, 0x2f, 0xf2,
-	0x98, 0xce, 0x5d, 0xb2, 0x60, 0x79, 0x85, 0x9b, 0xf1, 0x65, 0xcd, 0xea, 0x4c, 0xa9, 0x7b, 0xf3,
-	0x50, 0xc2, 0xa2, 0xea, 0x7f, 0x54, 0x4a, 0x14, 0xb6, 0x92, 0xbd, 0x43, 0xa0, 0x09, 0xb0, 0x9c,
-	0x1a, 0x9c, 0x94, 0x77, 0x33, 0x16, 0x99, 0x9e, 0xd1, 0x89, 0xab, 0x2d, 0x25, 0x47, 0x44, 0xef,
-	0xd2, 0xb1, 0xd8, 0x1a, 0x57, 0x27, 0xf5, 0x24, 0xf5, 0x4c, 0xc2, 0x89, 0xae, 0x27, 0x4d, 0xb0,
-	0x18, 0x15, 0x3b, 0x63, 0x33, 0xdc, 0x81, 0xb5, 0xa7, 0x8e, 0x65, 0x1b, 0xc3, 0xa7, 0xee, 0xc0,
-	0x4e, 0xd6, 0xe9, 0xcb, 0x84, 0x71, 0x15, 0x37, 0xb6, 0x70, 0x1b, 0x2b, 0xbf, 0xbe, 0x33, 0x5e,
-	0x11, 0x9d, 0x8f, 0xc4, 0x66, 0xab, 0xa2, 0x9b, 0x2c, 0xb2, 0x92, 0x20, 0x4d, 0xb5, 0x22, 0x39,
-	0xf0, 0xb5, 0x44, 0x60, 0x9d, 0x8b, 0xda, 0xe2, 0x8c, 0xfd, 0x18, 0x20, 0x91, 0xad, 0x2e, 0x64,
-	0xea, 0x25, 0xd3, 0x56, 0x79, 0x27, 0x93, 0x47, 0x11, 0xc1, 0x09, 0x61, 0xe8, 0x66, 0x6c, 0x1b,
-	0xbe, 0x1d, 0xd0, 0xe4, 0x9b, 0x32, 0x31, 0xfb, 0xf0, 0xed, 0x00, 0x7d, 0x8e, 0x31, 0x75, 0xdd,
-	0xc1, 0x89, 0x73, 0x4a, 0x8a, 0xa8, 0x95, 0xdd, 0x5a, 0xa4, 0x80, 0xe6, 0xba, 0xfd, 0x06, 0x69,
-	0x62, 0x15, 0x8b, 0x58, 0x00, 0x25, 0xd7, 0x3e, 0x03, 0xab, 0xaf, 0x91, 0xd0, 0xd5, 0xff, 0x24,
-	0x59, 0xcf, 0xcc, 0xc3, 0xd2, 0x5d, 0xd8, 0xc0, 0x4e, 0x2b, 0x63, 0x2c, 0xa1, 0xf3, 0x5a, 0xf3,
-	0x9d, 0x61, 0x0a, 0x49, 0x67, 0x95, 0x37, 0xb3, 0xb0, 0x9b, 0xb9, 0xbc, 0x99, 0xec, 0x38, 0xe5,
-	0xb0, 0xf7, 0xaf, 0x25, 0x52, 0xde, 0xcc, 0x0e, 0x73, 0xfe, 0x69, 0x7c, 0x82, 0x19, 0x40, 0x71,
-	0xba, 0x01, 0x30, 0xf0, 0xa7, 0xe2, 0x0f, 0x97, 0x89, 0x3f, 0xdb, 0x79, 0xc0, 0x2f, 0x11, 0xcf,
-	0x37, 0x06, 0xfa, 0x9c, 0xcf, 0x00, 0x0b, 0xb9, 0x9f, 0x01, 0xc2, 0xa3, 0xe9, 0xdc, 0x84, 0xa3,
-	0xe9, 0x7c, 0xe6, 0x68, 0xda, 0x4c, 0x01, 0xbd, 0x4c, 0x80, 0xfe, 0x89, 0x69, 0x3b, 0x93, 0xc5,
-	0x77, 0x0a, 0xdb, 0xd7, 0xd3, 0xd8, 0x5e, 0x4c, 0xf9, 0x7d, 0x8c, 0xeb, 0x6f, 0x4d, 0xe3, 0x1a,
-	0xce, 0xc2, 0x75, 0x12, 0xd3, 0xe3, 0x55, 0xb9, 0xca, 0x8c, 0x55, 0xb9, 0xa5, 0x57, 0x38, 0x56,
-	0xbd, 0xa6, 0x01, 0xfd, 0x69, 0x71, 0x2c, 0x36, 0xb2, 0x83, 0xcc, 0xbb, 0xe9, 0x9c, 0x64, 0xe2,
-	0x89, 0x23, 0x06, 0xca, 0xf9, 0x30, 0x17, 0x1e, 0x26, 0x38, 0x76, 0x4a, 0xca, 0x3d, 0x4c, 0x94,
-	0x58, 0x8d, 0x2a, 0x79, 0x98, 0x40, 0x4a, 0x0a, 0x0b, 0x73, 0x04, 0x0b, 0x3b, 0xd3, 0x8d, 0x6d,
-	0x1a, 0x1a, 0x5e, 0x73, 0x09, 0x27, 0x9c, 0x4e, 0xbe, 0x5c, 0x88, 0x92, 0xdd, 0x3c, 0xbf, 0x34,
-	0x73, 0x6a, 0xb8, 0x0d, 0x6b, 0x59, 0xab, 0x0f, 0x53, 0xc4, 0xd5, 0x54, 0xf0, 0xb6, 0xfc, 0xb4,
-	0x19, 0x17, 0xd3, 0x66, 0x9c, 0x48, 0x7f, 0xd3, 0xfa, 0xcc, 0x90, 0xfe, 0x8e, 0x05, 0x5b, 0x96,
-	0x2a, 0xaa, 0x70, 0x35, 0x4a, 0x15, 0x3f, 0x0e, 0xe7, 0x5b, 0xff, 0x4a, 0x09, 0xd6, 0x12, 0xb9,
-	0x29, 0xb3, 0xa7, 0x1a, 0x94, 0x9f, 0xba, 0x7e, 0x90, 0x4c, 0x1e, 0xc3, 0xf7, 0x74, 0x61, 0xaa,
-	0x38, 0x63, 0x61, 0x6a, 0xac, 0xfc, 0xc4, 0xcd, 0x52, 0x7e, 0x2a, 0xe5, 0x94, 0x9f, 0x4e, 0x27,
-	0x15, 0x8e, 0x28, 0x2a, 0xef, 0xe5, 0x65, 0xdc, 0x74, 0x56, 0xe7, 0xac, 0x1a, 0x9d, 0x4e, 0xaa,
-	0x1a, 0xcd, 0x9f, 0x39, 0xd0, 0xb9, 0x4a, 0x46, 0xff, 0xe7, 0x4a, 0x2d, 0x3f, 0xbf, 0x08, 0x57,
-	0xa2, 0x3c, 0xa6, 0x65, 0x7a, 0x81, 0xd3, 0x75, 0x86, 0xe6, 0x20, 0x98, 0xbd, 0x80, 0xfb, 0x6e,
-	0x78, 0x6e, 0xde, 0xc8, 0x78, 0xf1, 0xb1, 0xa5, 0x62, 0x87, 0x66, 0x74, 0x0d, 0x2a, 0x58, 0x26,
-	0xf9, 0x3a, 0x13, 0xb8, 0x4c, 0xa7, 0x45, 0xdf, 0x19, 0xe2, 0x4c, 0x56, 0x77, 0xd1, 0x16, 0x60,
-	0xf9, 0x61, 0x28, 0x5c, 0x8d, 0x9a, 0x59, 0x10, 0x4c, 0x9d, 0x76, 0xb8, 0xcc, 0x69, 0xe7, 0x93,
-	0xb0, 0x31, 0x8c, 0x67, 0x61, 0x38, 0x96, 0x3d, 0x08, 0x9c, 0xe0, 0x25, 0x43, 0xd7, 0x7a, 0xa2,
-	0x4d, 0x66, 0x4d, 0xe8, 0x0e, 0xf0, 0xc9, 0x2e, 0x89, 0x82, 0xe9, 0x6a, 0x82, 0x9e, 0x27, 0x3d,
-	0xf2, 0xa3, 0xe5, 0x31, 0xe9, 0x47, 0x61, 0x64, 0x7d, 0x0e, 0x9b, 0xc9, 0x2e, 0x09, 0x0c, 0xd3,
-	0x82, 0xea, 0xe7, 0xc6, 0xd3, 0xc9, 0xf1, 0x6d, 0xd8, 0x49, 0x90, 0xb2, 0x78, 0xbe, 0x38, 0xcc,
-	0x6b, 0xc3, 0x19, 0x80, 0x15, 0xf4, 0x4f, 0xc2, 0x0c, 0x00, 0x3f, 0xa3, 0x5b, 0xb0, 0x3c, 0xec,
-	0x99, 0x2f, 0x0d, 0xcf, 0x19, 0x9c, 0x06, 0xee, 0x80, 0x56, 0x9a, 0x68, 0x7e, 0xbd, 0x84, 0x1b,
-	0x34, 0x46, 0xc7, 0xc6, 0x49, 0x18, 0x2d, 0xc7, 0xec, 0x11, 0x46, 0xf6, 0xe1, 0x0b, 0x13, 0x45,
-	0x46, 0xcb, 0x4f, 0x5c, 0x20, 0x3f, 0x71, 0x79, 0x10, 0xd7, 0x91, 0x79, 0x32, 0xed, 0x4f, 0xce,
-	0x34, 0xed, 0xfc, 0x72, 0x72, 0x4e, 0xd9, 0x75, 0xed, 0xff, 0xd3, 0x57, 0xc6, 0x95, 0x19, 0xf3,
-	0x19, 0x74, 0xfe, 0x7c, 0x06, 0xed, 0xc0, 0xfa, 0x0b, 0xd3, 0x09, 0x8c, 0xd1, 0x20, 0x70, 0x7a,
-	0x86, 0x39, 0xf0, 0x5f, 0xd8, 0x9e, 0x6d, 0x55, 0xd7, 0xc9, 0x90, 0x6b, 0xb8, 0xa9, 0x83, 0x5b,
-	0x04, 0xd6, 0x50, 0x3b, 0x84, 0xda, 0x64, 0xec, 0x9d, 0xcb, 0x63, 0xbd, 0x46, 0x81, 0xba, 0xfe,
-	0xab, 0x05, 0x40, 0x69, 0x84, 0x90, 0xb4, 0xfd, 0x2d, 0x58, 0x49, 0x1b, 0x3b, 0x93, 0xb6, 0x9c,
-	0x32, 0xf3, 0x89, 0x3e, 0xa1, 0x38, 0xd9, 0x27, 0x4c, 0xf5, 0x31, 0x49, 0xff, 0xe5, 0x84, 0x81,
-	0x2b, 0xf4, 0x5f, 0xb2, 0x55, 0xff, 0xbd, 0x22, 0x6c, 0x91, 0xc0, 0x78, 0x62, 0x7b, 0xf9, 0x5e,
-	0x75, 0x92, 0x46, 0x85, 0x19, 0x35, 0x2a, 0x66, 0x34, 0xba, 0x0e, 0x95, 0x80, 0x0d, 0x88, 0x3d,
-	0x2a, 0x55, 0x18, 0x42, 0x92, 0xee, 0x8e, 0x1b, 0x74, 0x29, 0xc7, 0xa0, 0x8f, 0x62, 0x23, 0xcd,
-	0xc6, 0xd7, 0xa9, 0xd3, 0xc9, 0x37, 0xd3, 0xd7, 0xda, 0xf0, 0xaf, 0x95, 0xa1, 0xd2, 0x96, 0x5b,
-	0x64, 0x41, 0xf1, 0x4e, 0x5f, 0x82, 0x85, 0x70, 0xb9, 0x69, 0xff, 0xf9, 0x2e, 0x59, 0x6b, 0x74,
-	0x19, 0xca, 0x51, 0x6c, 0xa2, 0x52, 0x16, 0x58, 0x4a, 0x86, 0x6e, 0x03, 0x3f, 0x96, 0x1a, 0xf1,
-	0xf4, 0x2a, 0x57, 0x3a, 0xb3, 0x43, 0x9b, 0x30, 0xef, 0xd9, 0xa7, 0xd8, 0xa4, 0xd6, 0xa8, 0x70,
-	0xfa, 0x36, 0x1d, 0x05, 0x97, 0x60, 0x81, 0x34, 0x46, 0x08, 0x98, 0xc7, 0xaf, 0x53, 0xe0, 0x36,
-	0x37, 0x79, 0x73, 0x4f, 0x26, 0x06, 0x09, 0x44, 0x36, 0xe2, 0x6e, 0xd2, 0xc6, 0xc3, 0x45, 0x79,
-	0x85, 0xa0, 0xb0, 0x0d, 0xe5, 0x13, 0xcf, 0xed, 0x1b, 0x23, 0xcf, 0x21, 0xbe, 0xbf, 0xb2, 0xbb,
-	0x9a, 0x94, 0xdc, 0xf1, 0x1c, 0x6d, 0x01, 0x33, 0x74, 0x3c, 0x07, 0xbd, 0x0d, 0xf3, 0x81, 0x4b,
-	0x38, 0x17, 0xf2, 0x39, 0xe7, 0x02, 0x17, 0xf3, 0xbd, 0x01, 0xd0, 0x25, 0x2e, 0xdc, 0x32, 0x4c,
-	0x7a, 0xd6, 0xe3, 0x48, 0x44, 0x59, 0x64, 0x54, 0x21, 0xc0, 0x2c, 0x7e, 0x60, 0x7a, 0x8c, 0x05,
-	0x62, 0x16, 0x46, 0x15, 0x02, 0xb4, 0x05, 0x65, 0x7b, 0x60, 0x51, 0x86, 0x4a, 0xc4, 0xb0, 0x40,
-	0x68, 0x42, 0x80, 0x3e, 0x0b, 0x3c, 0xf3, 0x8e, 0xc6, 0x89, 0x6d, 0x06, 0x23, 0xcf, 0xa6, 0xd7,
-	0x57, 0x56, 0x12, 0xb9, 0x7d, 0x5b, 0x6e, 0xed, 0xd3, 0x36, 0x6d, 0x95, 0x31, 0xb3, 0x77, 0x1f,
-	0x7d, 0x1e, 0x56, 0xa8, 0x9b, 0x26, 0xf5, 0x3d, 0xbc, 0xd3, 0x39, 0xf7, 0x52, 0x88, 0x57, 0x0e,
-	0x19, 0xb4, 0xe5, 0x6e, 0xf2, 0x15, 0x7d, 0x0b, 0x54, 0x88, 0x04, 0x76, 0xa9, 0xb5, 0x3c, 0x7e,
-	0x05, 0x16, 0x77, 0xa7, 0xd7, 0x60, 0x35, 0xe8, 0x46, 0xcf, 0xa8, 0x0e, 0xcb, 0xf1, 0xfa, 0x18,
-	0x03, 0xbf, 0xba, 0x89, 0xa7, 0xa7, 0x55, 0xa2, 0xe5, 0x51, 0x08, 0x4f, 0xbc, 0x40, 0x98, 0xe7,
-	0x12, 0xe5, 0x89, 0xd6, 0x47, 0xf1, 0xb1, 0xd7, 0x09, 0x57, 0x08, 0x73, 0x54, 0x09, 0xc7, 0x22,
-	0x5b, 0x20, 0xc5, 0x47, 0xfb, 0xe4, 0x24, 0xd3, 0x75, 0x07, 0x03, 0xbb, 0x1b, 0x18, 0x9e, 0x6d,
-	0xfa, 0xd1, 0x91, 0x37, 0x9e, 0xa5, 0x18, 0x71, 0x68, 0x84, 0x41, 0xe3, 0xad, 0x0c, 0x05, 0x1b,
-	0xa5, 0xed, 0x79, 0xae, 0x47, 0x62, 0xfe, 0xa2, 0x46, 0x5f, 0xd0, 0xa7, 0x81, 0x4f, 0x4c, 0xdf,
-	0x20, 0xd7, 0x80, 0xd7, 0x09, 0x2e, 0xd0, 0xf8, 0x35, 0x60, 0x6d, 0x25, 0x9e, 0x7f, 0xc3, 0xb5,
-	0x88, 0x7f, 0x32, 0x47, 0x96, 0xe3, 0x92, 0x7e, 0x5d, 0x92, 0x29, 0x2e, 0x6a, 0x40, 0x48, 0xb8,
-	0xbd, 0x8b, 0x73, 0xb0, 0xb1, 0xf0, 0x76, 0x91, 0xe6, 0x60, 0xd9, 0x13, 0xf9, 0xc7, 0x16, 0x91,
-	0xea, 0x3f, 0x50, 0x80, 0x79, 0x8a, 0x65, 0x9c, 0x2d, 0xe1, 0xd3, 0x0a, 0xeb, 0x47, 0x9e, 0x31,
-	0x0d, 0x9f, 0x83, 0xc2, 0xcf, 0xfb, 0xf8, 0x19, 0xad, 0x40, 0xd1, 0x19, 0x32, 0x57, 0x50, 0x74,
-	0x86, 0x98, 0x87, 0x1c, 0x8d, 0xb0, 0x07, 0x58, 0xd6, 0xc8, 0xf3, 0x2b, 0x7d, 0xcc, 0xdf, 0xfe,
-	0xb7, 0x25, 0x58, 0x4e, 0x5d, 0xa3, 0x46, 0x9b, 0x24, 0xe4, 0x19, 0x6d, 0x5d, 0xd0, 0x3b, 0x6d,
-	0xa3, 0xa3, 0x3c, 0x50, 0xd4, 0x47, 0x0a, 0x7f, 0x01, 0x5d, 0x24, 0x67, 0xb8, 0x90, 0xae, 0x6b,
-	0xc7, 0xb2, 0x72, 0xc0, 0x5b, 0xe8, 0x52, 0x8a, 0x5d, 0x93, 0x95, 0x03, 0x4c, 0xff, 0xcd, 0x02,
-	0x7a, 0x03, 0xae, 0x26, 0x1a, 0x1a, 0x42, 0xb3, 0x69, 0xc8, 0x6d, 0x63, 0x5f, 0xd5, 0x1e, 0x09,
-	0x9a, 0x28, 0x89, 0xfc, 0x6f, 0x15, 0xd0, 0x66, 0x4a, 0xe4, 0x07, 0x1d, 0xa9, 0x23, 0x89, 0xfc,
-	0x6f, 0x17, 0xd0, 0x0d, 0xb8, 0x92, 0xa0, 0xb7, 0xa5, 0x76, 0x5b, 0x56, 0x15, 0xa3, 0xa5, 0xa9,
-	0x07, 0x9a, 0xd4, 0x6e, 0xf3, 0xbf, 0x53, 0x40, 0x88, 0x68, 0x1d, 0x72, 0xa8, 0x0f, 0xf8, 0x3f,
-	0x2c, 0xa0, 0x2a, 0xa9, 0xb1, 0x85, 0x34, 0xa1, 0xd1, 0x90, 0x5a, 0xba, 0x24, 0xf2, 0x7f, 0x9c,
-	0x55, 0xe5, 0x48, 0x7d, 0x28, 0x89, 0x46, 0x4b, 0xd2, 0x8e, 0x04, 0x45, 0x52, 0xf4, 0xe6, 0x31,
-	0xff, 0x6b, 0xc5, 0x5c, 0x16, 0x5d, 0x3a, 0x6a, 0xa9, 0x9a, 0xa0, 0xc9, 0xcd, 0x63, 0xfe, 0xd7,
-	0x8b, 0xe8, 0x32, 0xb9, 0xb0, 0x11, 0x2d, 0x4c, 0x5b, 0xc2, 0x1a, 0x3d, 0x3e, 0xe6, 0x7f, 0xa3,
-	0x88, 0xae, 0x90, 0xef, 0xc6, 0x61, 0xd3, 0x9e, 0x20, 0x1a, 0x9a, 0xf4, 0x41, 0x47, 0x6a, 0xeb,
-	0xfc, 0x57, 0x38, 0x74, 0x15, 0x2e, 0xa5, 0x16, 0x54, 0xe8, 0xe8, 0x87, 0xaa, 0x26, 0x7f, 0x41,
-	0x12, 0xf9, 0xaf, 0x72, 0x99, 0xb9, 0xb6, 0x84, 0xe3, 0x23, 0x49, 0xd1, 0x49, 0x77, 0x59, 0x93,
-	0x44, 0xfe, 0x07, 0xb9, 0xcc, 0xb8, 0xfb, 0xaa, 0xb6, 0x27, 0x8b, 0xa2, 0xa4, 0xf0, 0x3f, 0xc4,
-	0x65, 0xa6, 0xac, 0xa8, 0xfa, 0x3e, 0xb9, 0x68, 0xfa, 0xc3, 0x1c, 0xaa, 0xc3, 0x56, 0x72, 0x3e,
-	0x92, 0x7e, 0xa8, 0x8a, 0x98, 0xc1, 0x10, 0x9a, 0x4d, 0xf5, 0x91, 0x24, 0xf2, 0x3f, 0xc2, 0xa1,
-	0x6b, 0xe4, 0x7b, 0x4c, 0xa2, 0x37, 0x5b, 0x34, 0x61, 0xaf, 0x29, 0xf1, 0x3f, 0xca, 0xa1, 0x9b,
-	0x70, 0x2d, 0xa9, 0x1a, 0x9e, 0xac, 0x81, 0x95, 0x8f, 0xb5, 0xfb, 0x31, 0x0e, 0x5d, 0x87, 0x5a,
-	0x72, 0xff, 0xe9, 0xb4, 0x0d, 0x5d, 0x3e, 0x92, 0xd4, 0x8e, 0xce, 0xff, 0x78, 0x56, 0xc7, 0x86,
-	0xaa, 0xec, 0x37, 0xe5, 0x86, 0xce, 0xff, 0x04, 0x87, 0x36, 0xc8, 0x47, 0x95, 0xb0, 0xe5, 0x40,
-	0x55, 0x24, 0xfe, 0x27, 0x39, 0x74, 0x1b, 0x6e, 0xe6, 0x08, 0x94, 0x14, 0x5d, 0xd6, 0x8f, 0x0d,
-	0x5d, 0x55, 0x8d, 0xa6, 0xa0, 0x1d, 0x48, 0xfc, 0x4f, 0x71, 0xe8, 0x4d, 0xb8, 0x9e, 0xc3, 0xd9,
-	0xd1, 0x64, 0xca, 0xa6, 0x2a, 0x07, 0xfc, 0x4f, 0x73, 0xe8, 0x6d, 0x78, 0x23, 0xb5, 0xfc, 0xed,
-	0x4e, 0xab, 0xa5, 0x6a, 0xba, 0x24, 0x1a, 0x47, 0x92, 0x28, 0x0b, 0x86, 0x7e, 0xdc, 0x92, 0xf8,
-	0x9f, 0xe1, 0xd0, 0x5d, 0xd8, 0x1e, 0x97, 0x26, 0x89, 0x86, 0x26, 0x28, 0x07, 0x12, 0x59, 0x9d,
-	0xb6, 0xa0, 0xcb, 0xed, 0x7d, 0x99, 0x2c, 0xcf, 0xcf, 0x72, 0x68, 0x0b, 0xaa, 0x99, 0x4d, 0x97,
-	0x1e, 0xeb, 0x92, 0x82, 0xb1, 0xca, 0xff, 0x42, 0x76, 0x07, 0xa2, 0xa6, 0x78, 0xf1, 0x7e, 0x31,
-	0xcb, 0x23, 0x2b, 0xba, 0xa4, 0x3d, 0x14, 0x9a, 0x44, 0xfd, 0x3d, 0x4d, 0x96, 0xf6, 0xf9, 0x5f,
-	0xe6, 0xd0, 0x2d, 0xa8, 0x27, 0xed, 0x2e, 0xc6, 0x24, 0x86, 0xd2, 0x43, 0x41, 0x6e, 0x12, 0x7d,
-	0xfe, 0x86, 0x43, 0xef, 0xc2, 0x3b, 0x59, 0x83, 0xd3, 0x35, 0x41, 0x69, 0x0b, 0x0d, 0x1d, 0x8f,
-	0x2b, 0xaa, 0x12, 0xdd, 0x64, 0xe9, 0xb1, 0xdc, 0xd6, 0xdb, 0xfc, 0xdf, 0x66, 0x67, 0xd0, 0x54,
-	0xd5, 0x96, 0x21, 0x4a, 0xba, 0xd4, 0xc0, 0x66, 0xf3, 0xb5, 0x6c, 0x33, 0x56, 0xea, 0x48, 0x50,
-	0x8e, 0x8d, 0x43, 0xb5, 0xd5, 0xe6, 0xff, 0x2e, 0xab, 0xbc, 0x20, 0x8a, 0xd8, 0x38, 0x0d, 0x59,
-	0x69, 0xa8, 0x47, 0xad, 0xa6, 0xa4, 0x4b, 0xfc, 0xdf, 0x67, 0xb1, 0x2b, 0x1c, 0xed, 0xc9, 0x07,
-	0x1d, 0xb5, 0xd3, 0xe6, 0xff, 0x21, 0xdb, 0xb4, 0xd7, 0x69, 0x1f, 0x1b, 0x87, 0x92, 0x26, 0xf1,
-	0xff, 0x98, 0x95, 0x1c, 0x61, 0x4a, 0xd2, 0x8e, 0x64, 0x45, 0xc0, 0xca, 0xfd, 0x53, 0x16, 0x9c,
-	0x69, 0xf0, 0x52, 0x41, 0xff, 0xcc, 0xa1, 0xb7, 0xe0, 0x46, 0x76, 0x7d, 0x15, 0xa1, 0x69, 0xb4,
-	0x25, 0xed, 0xa1, 0xa4, 0x19, 0x92, 0xa6, 0xa9, 0x1a, 0xff, 0xef, 0x59, 0x0c, 0x63, 0x59, 0x32,
-	0x9e, 0x02, 0xb6, 0x44, 0x49, 0xe4, 0xff, 0x83, 0xcb, 0xb1, 0xef, 0x03, 0x41, 0x97, 0x1e, 0x09,
-	0xc7, 0xfc, 0x7f, 0x66, 0x35, 0xc1, 0xb2, 0xe5, 0x86, 0x94, 0xda, 0x9c, 0xff, 0xca, 0x0e, 0xc1,
-	0x7a, 0x47, 0x66, 0xf2, 0xdf, 0x59, 0x55, 0x1f, 0x4a, 0x1a, 0x01, 0x0b, 0x81, 0x5d, 0x08, 0x58,
-	0xfe, 0x7f, 0xb8, 0xac, 0x9f, 0x92, 0xda, 0x6d, 0xe1, 0x40, 0x4a, 0x98, 0xc5, 0xf7, 0x95, 0x32,
-	0x80, 0x3f, 0x68, 0xaa, 0x7b, 0x42, 0x93, 0xae, 0xaf, 0xf4, 0x50, 0xd2, 0x8e, 0x1f, 0x91, 0xc5,
-	0xf9, 0xf3, 0x52, 0xc6, 0xfc, 0x19, 0x9f, 0x28, 0x35, 0x9a, 0xb2, 0x22, 0xf1, 0x7f, 0x51, 0x42,
-	0x3b, 0x70, 0x27, 0xa7, 0x3d, 0x85, 0x22, 0x43, 0x50, 0x98, 0xbc, 0xbf, 0x2c, 0x65, 0x66, 0xc0,
-	0xf8, 0x33, 0x5e, 0xe5, 0xaf, 0x4b, 0xdb, 0xcf, 0xd8, 0x7f, 0x1a, 0x61, 0xd5, 0x8e, 0xc5, 0x1b,
-	0x02, 0x55, 0x3c, 0x4f, 0xec, 0x62, 0xd4, 0x38, 0xde, 0xc4, 0xf4, 0x8e, 0xd8, 0xe2, 0x0b, 0xe3,
-	0x64, 0xbd, 0xd1, 0xe2, 0x8b, 0x39, 0xe4, 0x66, 0x9b, 0xe7, 0xb6, 0x15, 0xe0, 0xb3, 0x47, 0x72,
-	0x84, 0x60, 0x05, 0xb3, 0x2a, 0xaa, 0x71, 0x28, 0x09, 0xa2, 0xa4, 0xb5, 0xe9, 0xfd, 0x7d, 0x4c,
-	0x7b, 0x1c, 0x91, 0x0a, 0x68, 0x9d, 0x7a, 0x27, 0x6c, 0x46, 0x21, 0xb1, 0xb8, 0xed, 0x12, 0x65,
-	0x33, 0xa7, 0x5d, 0xb4, 0x45, 0x57, 0x92, 0xfa, 0x13, 0x49, 0x69, 0x68, 0xc7, 0x2d, 0xdd, 0x10,
-	0xe5, 0x36, 0x99, 0xf2, 0x05, 0x74, 0x85, 0x06, 0x80, 0x74, 0x33, 0xf1, 0xc3, 0x7c, 0x21, 0xbf,
-	0x2f, 0xf3, 0x11, 0x7c, 0x71, 0xfb, 0x05, 0x51, 0x2c, 0x4e, 0xf1, 0xc8, 0x44, 0x1b, 0x61, 0x3c,
-	0xc5, 0xb6, 0x86, 0xc3, 0xed, 0x05, 0x54, 0x83, 0x4d, 0x4c, 0x6e, 0x09, 0x9a, 0x2e, 0x37, 0xe4,
-	0x96, 0xa0, 0xe8, 0xc6, 0xb7, 0xa9, 0xb2, 0x22, 0x89, 0x7c, 0x01, 0xad, 0x00, 0xe0, 0x36, 0xec,
-	0x07, 0x1e, 0x4a, 0x7c, 0x11, 0x6d, 0x00, 0x8f, 0xdf, 0x45, 0xb9, 0xdd, 0x50, 0x15, 0x85, 0x9a,
-	0x3b, 0x87, 0x96, 0x61, 0x11, 0x53, 0xa9, 0x51, 0x94, 0xb6, 0xef, 0x00, 0xc4, 0x89, 0x2d, 0x2a,
-	0x43, 0x49, 0xc1, 0xfe, 0x99, 0xac, 0xd4, 0x03, 0x4d, 0x6e, 0xb7, 0x0c, 0x49, 0xc1, 0xd3, 0x13,
-	0xf9, 0xc2, 0xf6, 0x3e, 0x59, 0xe4, 0x54, 0x16, 0x8b, 0x56, 0xa1, 0xd2, 0x6e, 0x88, 0x89, 0xf4,
-	0x81, 0x11, 0xe2, 0xff, 0x23, 0x78, 0x58, 0xc2, 0x84, 0xf8, 0xef, 0x88, 0xdd, 0x3f, 0xab, 0x00,
-	0xd7, 0x96, 0x5b, 0xa8, 0x05, 0x4b, 0xc9, 0x8b, 0x29, 0xe8, 0x6a, 0xea, 0xfb, 0x44, 0xe6, 0xfe,
-	0x42, 0x6d, 0x6b, 0x42, 0x2b, 0x2d, 0x80, 0xd7, 0xb9, 0x8f, 0x8a, 0x05, 0xf4, 0xc5, 0xc4, 0xbf,
-	0x5f, 0xc9, 0x4b, 0x1f, 0xe8, 0xad, 0xf1, 0x72, 0x50, 0xce, 0x1d, 0x96, 0xda, 0xd4, 0x5b, 0x23,
-	0xc8, 0x80, 0xcd, 0xfc, 0x4b, 0xae, 0xe8, 0xed, 0x71, 0xf1, 0x79, 0x37, 0x4a, 0x6a, 0xd3, 0xaf,
-	0x70, 0x60, 0xf5, 0x73, 0xff, 0xee, 0x48, 0xa8, 0x3f, 0xed, 0xef, 0x8f, 0xb3, 0xd5, 0xcf, 0xbf,
-	0x4c, 0x9b, 0x50, 0x7f, 0xea, 0x6d, 0xdb, 0xb3, 0xd4, 0xff, 0x76, 0x40, 0xe3, 0x57, 0xb2, 0x50,
-	0x7c, 0x93, 0x61, 0xe2, 0x75, 0xaf, 0xda, 0xcd, 0xa9, 0x3c, 0xec, 0x13, 0xc7, 0x77, 0xc2, 0x7a,
-	0xce, 0xfd, 0x2a, 0x94, 0xed, 0x9b, 0xab, 0xf9, 0x9b, 0xd3, 0x99, 0xe2, 0x11, 0x72, 0xae, 0x18,
-	0x25, 0x46, 0x98, 0x7c, 0xfd, 0x29, 0x31, 0xc2, 0xb4, 0x5b, 0x4a, 0xdd, 0xe8, 0x2e, 0x56, 0x7a,
-	0x12, 0x63, 0xbd, 0x73, 0x67, 0xf1, 0xd6, 0x19, 0x5c, 0x6c, 0x90, 0x03, 0x58, 0x49, 0x5f, 0x01,
-	0x42, 0xf1, 0xb7, 0xec, 0xdc, 0xbb, 0x41, 0xb5, 0xfc, 0xbb, 0x5f, 0x29, 0x73, 0x4a, 0xdd, 0x89,
-	0x79, 0x6b, 0xa6, 0x3b, 0x0a, 0xb5, 0xa9, 0x5f, 0xa1, 0x52, 0x68, 0x9f, 0x20, 0x7d, 0xda, 0xdd,
-	0x80, 0x33, 0xa4, 0xc7, 0x7b, 0x99, 0x92, 0x3d, 0xb6, 0x97, 0x79, 0x92, 0xdf, 0x9c, 0xce, 0xc4,
-	0x96, 0xf9, 0x8b, 0x89, 0x9b, 0x56, 0x13, 0xf4, 0x9f, 0xf6, 0x79, 0xed, 0x0c, 0xfd, 0x8f, 0x61,
-	0x23, 0xaf, 0x72, 0x9d, 0x40, 0xca, 0x94, 0xc2, 0x76, 0x2d, 0x55, 0xb4, 0xcd, 0x96, 0x35, 0x1f,
-	0xc3, 0x66, 0x7e, 0xbd, 0x2d, 0xe1, 0x08, 0xa6, 0x16, 0xe4, 0x6a, 0x9b, 0x63, 0xe5, 0x67, 0xa9,
-	0x3f, 0x0c, 0x5e, 0xee, 0xed, 0x7f, 0xe1, 0xe6, 0xa9, 0x13, 0x3c, 0x1d, 0x3d, 0xd9, 0xe9, 0xba,
-	0xfd, 0xbb, 0x4c, 0x16, 0xfd, 0x45, 0xb8, 0xeb, 0xf6, 0x42, 0xc2, 0xaf, 0x14, 0x97, 0x9b, 0xce,
-	0x73, 0xfb, 0x81, 0x13, 0xec, 0xb4, 0x70, 0xd3, 0xbf, 0x14, 0x57, 0xd8, 0xfb, 0xfd, 0xfb, 0x84,
-	0xf0, 0x64, 0x9e, 0x74, 0xb9, 0xf7, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x11, 0x06, 0xab, 0x2d,
-	0xa1, 0x3c, 0x00, 0x00,
+	// 4683 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x59, 0x6c, 0x1b, 0x49,
+	0x76, 0x26, 0x9b, 0x92, 0xc8, 0x47, 0x89, 0x6a, 0x95, 0x64, 0x99, 0xa6, 0x2d, 0xdb, 0x4b, 0x7b,
+	0xd6, 0xb6, 0x66, 0x57, 0x9e, 0x95, 0x91, 0xcd, 0xac, 0x33, 0x3b, 0x9b, 0x16, 0xd9, 0x92, 0x3a,
+	0xa6, 0xba, 0x39, 0xc5, 0xa6, 0x3d, 0x5a, 0x6c, 0xd2, 0x69, 0xb3, 0xdb, 0x72, 0x8f, 0x29, 0x36,
+	0xd3, 0x6c, 0xda, 0xe3, 0x00, 0xf9, 0xd8, 0x7c, 0x04, 0x93, 0x9f, 0x60, 0x73, 0xdf, 0x17, 0x72,
+	0x01, 0x09, 0x36, 0x40, 0x90, 0x63, 0x91, 0x9f, 0x20, 0xf9, 0x0b, 0x02, 0xe4, 0x2b, 0x40, 0x10,
+	0x6c, 0x0e, 0xe4, 0x4e, 0x90, 0x8f, 0xe4, 0x27, 0xe7, 0x5f, 0x50, 0xd5, 0xd5, 0x77, 0xf3, 0x90,
+	0xed, 0x45, 0x82, 0xfd, 0x63, 0xbf, 0x7a, 0xf5, 0xea, 0x55, 0xd5, 0xbb, 0xea, 0xd5, 0x2b, 0xc2,
+	0x5a, 0xdf, 0x7a, 0x66, 0x3e, 0xb5, 0x5c, 0x6d, 0x64, 0x0d, 0x77, 0x86, 0x8e, 0xed, 0xda, 0x68,
+	0x89, 0x81, 0x6a, 0x57, 0x4e, 0x6c, 0xfb, 0xa4, 0x6f, 0xde, 0xa1, 0xe0, 0x47, 0xe3, 0xc7, 0x77,
+	0x8c, 0xb1, 0xa3, 0xbb, 0x96, 0x3d, 0xf0, 0x10, 0x6b, 0x97, 0x92, 0xed, 0xe6, 0xe9, 0xd0, 0x7d,
+	0xc1, 0x1a, 0x37, 0x7c, 0xc2, 0xa7, 0xb6, 0x61, 0xf6, 0x47, 0x0c, 0x8a, 0x7c, 0xa8, 0x63, 0xdb,
+	0xa7, 0x1e, 0xac, 0xae, 0x40, 0xa9, 0x23, 0xb5, 0x3b, 0xae, 0xee, 0x8e, 0x47, 0x68, 0x1b, 0x0a,
+	0x3d, 0xdb, 0x30, 0xab, 0xb9, 0x6b, 0xb9, 0x5b, 0x95, 0xdd, 0xcd, 0x1d, 0x86, 0xbf, 0x13, 0x60,
+	0x34, 0x6c, 0xc3, 0xc4, 0x14, 0x07, 0x6d, 0xc2, 0xe2, 0x88, 0xc2, 0xaa, 0xf9, 0x6b, 0xb9, 0x5b,
+	0x25, 0xcc, 0xbe, 0xea, 0x7f, 0xc9, 0xc1, 0xf9, 0x86, 0x63, 0xea, 0xae, 0xd9, 0x91, 0xda, 0xaa,
+	0x33, 0x1e, 0x3c, 0xc5, 0xe6, 0x77, 0x8c, 0xcd, 0x91, 0x8b, 0xde, 0x84, 0x35, 0x6b, 0xf0, 0xc8,
+	0x1e, 0x0f, 0x0c, 0x4d, 0x37, 0x0c, 0xc7, 0x1c, 0x8d, 0xcc, 0x51, 0x35, 0x77, 0x8d, 0xbb, 0x55,
+	0xc2, 0x3c, 0x6b, 0x10, 0x7c, 0x38, 0xba, 0x0d, 0xbc, 0x3d, 0x76, 0x63, 0xd8, 0x6c, 0xa0, 0x55,
+	0x1f, 0xce, 0x90, 0xd1, 0x4d, 0x08, 0x40, 0xda, 0x60, 0x7c, 0xfa, 0xc8, 0x74, 0xaa, 0x1c, 0xc5,
+	0xac, 0xf8, 0x60, 0x99, 0x42, 0xd1, 0xa7, 0xe1, 0xbc, 0x35, 0x88, 0xe2, 0x8d, 0x34, 0xc7, 0x3c,
+	0x31, 0x3f, 0xac, 0x16, 0x08, 0x13, 0x7b, 0xf9, 0x6a, 0x0e, 0xaf, 0x5b, 0x83, 0x48, 0x8f, 0x11,
+	0x26, 0xcd, 0x64, 0x80, 0x44, 0xbf, 0x6a, 0x89, 0xb2, 0x5d, 0x89, 0x63, 0x13, 0xa6, 0x7d, 0xc4,
+	0xf1, 0xc8, 0x74, 0x06, 0xfa, 0xa9, 0x59, 0x5d, 0xf0, 0x98, 0x66, 0xf0, 0x2e, 0x03, 0x47, 0x51,
+	0x87, 0xfa, 0x68, 0xf4, 0xdc, 0x76, 0x8c, 0xea, 0x62, 0x0c, 0xb5, 0xcd, 0xc0, 0x64, 0xdd, 0x82,
+	0xf9, 0x05, 0x64, 0x97, 0x28, 0x6e, 0xb0, 0x46, 0x01, 0xdd, 0x28, 0x72, 0x40, 0xb8, 0x18, 0x47,
+	0x0e, 0x28, 0x23, 0x28, 0x50, 0x62, 0x40, 0xdb, 0xe9, 0x6f, 0x54, 0x83, 0xe2, 0xa9, 0xe9, 0xea,
+	0x86, 0xee, 0xea, 0xd5, 0x32, 0x85, 0x07, 0xdf, 0xf7, 0xf2, 0xd5, 0x5c, 0xfd, 0x97, 0x17, 0x60,
+	0xd9, 0xdf, 0x59, 0x69, 0xf0, 0xd8, 0x46, 0xd7, 0x60, 0x79, 0x64, 0x0d, 0x35, 0x97, 0x00, 0x34,
+	0xcb, 0xa0, 0xc2, 0x53, 0xc2, 0x30, 0xb2, 0x86, 0x1e, 0x8e, 0x81, 0xee, 0x42, 0xe1, 0xa9, 0x35,
+	0x30, 0xaa, 0x15, 0x2a, 0x56, 0x57, 0xa3, 0x62, 0x15, 0x90, 0xd9, 0xa1, 0xbf, 0xee, 0x5b, 0x03,
+	0x03, 0x53, 0xe4, 0x6c, 0x69, 0xc9, 0x9f, 0x41, 0x5a, 0xb8, 0xb9, 0xa5, 0xa5, 0x90, 0x29, 0x2d,
+	0x77, 0xa1, 0xe4, 0x3a, 0xfa, 0x60, 0x34, 0xb4, 0x1d, 0xb7, 0xba, 0x42, 0x59, 0x3f, 0x1f, 0x67,
+	0x9d, 0x35, 0xe2, 0x10, 0x6f, 0xb2, 0x88, 0x2d, 0x9c, 0x59, 0xc4, 0x60, 0x6e, 0x11, 0x5b, 0x9c,
+	0x5f, 0xc4, 0x96, 0xce, 0x20, 0x62, 0xc5, 0xb3, 0x88, 0x58, 0x69, 0x86, 0x88, 0x95, 0x27, 0x88,
+	0xd8, 0x72, 0x5c, 0xc4, 0xea, 0x4d, 0x28, 0x05, 0x92, 0x80, 0x78, 0x58, 0x56, 0x71, 0x57, 0xbe,
+	0xaf, 0xb5, 0xc4, 0x03, 0xa1, 0x71, 0xcc, 0x9f, 0x43, 0x6b, 0xb0, 0xe2, 0x41, 0x24, 0x79, 0x4f,
+	0xe9, 0xca, 0x4d, 0x3e, 0x87, 0x10, 0x54, 0x3c, 0x90, 0xd2, 0x55, 0x3d, 0x58, 0x9e, 0x0a, 0xea,
+	0x73, 0xb8, 0x1c, 0xd8, 0x21, 0xc9, 0x9b, 0x6f, 0xcc, 0x1c, 0xed, 0xc2, 0x02, 0x95, 0x59, 0x2a,
+	0xb0, 0xe5, 0xdd, 0xcb, 0xd1, 0xbd, 0x8d, 0xe2, 0x13, 0xe9, 0xc4, 0x1e, 0x2a, 0xba, 0x0e, 0x2b,
+	0xcf, 0xf4, 0xbe, 0x65, 0xe8, 0xae, 0xa9, 0xd9, 0x83, 0xfe, 0x0b, 0x6a, 0x92, 0x8a, 0x78, 0xd9,
+	0x07, 0x2a, 0x83, 0xfe, 0x8b, 0xfa, 0xef, 0xe5, 0xe0, 0x72, 0x77, 0x68, 0x4c, 0x1e, 0x79, 0xb6,
+	0xc6, 0xbc, 0x0d, 0x4b, 0x8e, 0x39, 0xec, 0xeb, 0x3d, 0xb3, 0x9a, 0x9f, 0xcd, 0xdd, 0xe1, 0x39,
+	0xec, 0xa3, 0xa3, 0xb7, 0x61, 0x71, 0x4c, 0xc7, 0xa6, 0xf2, 0x5f, 0xde, 0xbd, 0x32, 0xa9, 0xa3,
+	0xc7, 0xe1, 0xe1, 0x39, 0xcc, 0xf0, 0xf7, 0x8a, 0xb0, 0xa8, 0xf7, 0x88, 0x83, 0xa9, 0x7f, 0x0f,
+	0xc0, 0x7a, 0xc6, 0x30, 0x73, 0xf0, 0xed, 0xef, 0x76, 0x7e, 0xc2, 0x6e, 0x73, 0xf1, 0xdd, 0x46,
+	0x55, 0x58, 0xf2, 0xc5, 0x9d, 0xda, 0x60, 0xec, 0x7f, 0x12, 0x21, 0xd3, 0xfb, 0x7d, 0xfb, 0xb9,
+	0x19, 0x55, 0xff, 0x05, 0x4f, 0xfd, 0x59, 0x43, 0xa8, 0xfe, 0x37, 0x61, 0xd5, 0x47, 0xf6, 0xc9,
+	0x2d, 0x7a, 0xda, 0xc3, 0xc0, 0xbe, 0xf6, 0x5c, 0x87, 0x15, 0x7d, 0xec, 0x3e, 0x49, 0x9a, 0xd1,
+	0x65, 0x02, 0x0c, 0xe4, 0xdb, 0x47, 0x4a, 0x98, 0x4f, 0x8a, 0x14, 0xc8, 0x75, 0x03, 0x96, 0x9e,
+	0x98, 0xba, 0xe1, 0xfb, 0x82, 0xf2, 0xee, 0xed, 0x69, 0x3b, 0xb4, 0x73, 0xe8, 0xe1, 0x8a, 0x03,
+	0xd7, 0x79, 0x81, 0xfd, 0x9e, 0xc8, 0x82, 0xf3, 0xec, 0xa7, 0xe6, 0xda, 0x9a, 0xee, 0xba, 0x8e,
+	0xf5, 0x68, 0xec, 0x9a, 0x9e, 0xee, 0x97, 0x77, 0xbf, 0x61, 0x1e, 0x92, 0xaa, 0x2d, 0x04, 0xfd,
+	0x3c, 0xf2, 0xeb, 0x4f, 0xd2, 0x2d, 0x64, 0xa8, 0x90, 0x3e, 0x19, 0xcd, 0xe7, 0xbe, 0x32, 0xc7,
+	0x50, 0x21, 0x1d, 0xd5, 0x8e, 0xcd, 0x64, 0x5d, 0x4f, 0xb7, 0xa0, 0x3d, 0x62, 0xcb, 0x7a, 0xfd,
+	0xb1, 0x61, 0x06, 0x83, 0xac, 0x52, 0xf3, 0x79, 0x31, 0x3a, 0x88, 0x87, 0xad, 0x0c, 0x89, 0xc4,
+	0x8d, 0x70, 0x85, 0xf5, 0x88, 0xd0, 0x70, 0xac, 0xc1, 0x89, 0x35, 0x38, 0xd1, 0x5c, 0xeb, 0xd4,
+	0xb4, 0xc7, 0x2e, 0xb5, 0x20, 0xe5, 0xdd, 0x8b, 0x3b, 0x5e, 0xdc, 0xb3, 0xe3, 0xc7, 0x3d, 0x3b,
+	0x4d, 0x16, 0x17, 0xe1, 0x0a, 0xeb, 0xa1, 0x7a, 0x1d, 0x90, 0x08, 0x6b, 0xa7, 0xfa, 0x87, 0x5a,
+	0x4f, 0xef, 0xf7, 0x35, 0x3f, 0x78, 0xaa, 0x2e, 0xcf, 0xa2, 0xb2, 0x7a, 0xaa, 0x7f, 0xd8, 0xd0,
+	0xfb, 0x7d, 0x1f, 0x40, 0xc4, 0xe1, 0xa9, 0x63, 0x8d, 0x86, 0x9a, 0x39, 0xd0, 0x1f, 0xf5, 0x4d,
+	0x83, 0xfa, 0x82, 0x22, 0x5e, 0xa6, 0x40, 0xd1, 0x83, 0xa1, 0x7d, 0xe0, 0x4f, 0x4d, 0xc3, 0xd2,
+	0x35, 0x73, 0xd0, 0x73, 0x5e, 0xd0, 0x49, 0x55, 0x79, 0x3a, 0xe9, 0x4b, 0xd1, 0x49, 0x1f, 0x11,
+	0x1c, 0x31, 0x40, 0xc1, 0xab, 0xa7, 0x71, 0x00, 0x52, 0x61, 0xbd, 0xe7, 0x98, 0x86, 0x39, 0x70,
+	0x2d, 0xbd, 0xaf, 0x39, 0xb6, 0xeb, 0x71, 0xbd, 0x46, 0xb9, 0xbe, 0x9e, 0xf2, 0x9c, 0x8d, 0x00,
+	0x17, 0x33, 0x54, 0x8c, 0x7a, 0x29, 0x18, 0xba, 0x0f, 0xeb, 0xcc, 0x42, 0x59, 0xf6, 0x40, 0x7b,
+	0xae, 0x3b, 0x03, 0x6b, 0x70, 0x32, 0xaa, 0x22, 0xba, 0xf5, 0xb5, 0x80, 0xea, 0x83, 0x00, 0xe7,
+	0xa1, 0x87, 0x82, 0xd1, 0xb3, 0x24, 0x68, 0x84, 0xb6, 0x00, 0x86, 0x8e, 0xfd, 0x81, 0xd9, 0x73,
+	0x89, 0x0d, 0x58, 0xa7, 0xba, 0x51, 0x62, 0x10, 0xc9, 0xa8, 0xdd, 0x83, 0xe5, 0xa8, 0x88, 0x20,
+	0x1e, 0xb8, 0xa7, 0xe6, 0x0b, 0x66, 0x2b, 0xc8, 0x4f, 0xb4, 0x01, 0x0b, 0xcf, 0xf4, 0xfe, 0xd8,
+	0xb7, 0x12, 0xde, 0xc7, 0xbd, 0xfc, 0xdb, 0xb9, 0xda, 0x3e, 0x54, 0x27, 0x49, 0xf5, 0x59, 0xe9,
+	0x4c, 0x12, 0xd9, 0xb3, 0xd0, 0xa9, 0x7f, 0x1f, 0x07, 0xe7, 0x33, 0xcd, 0x26, 0xfa, 0x64, 0x68,
+	0xb8, 0x3c, 0xf7, 0xb1, 0x1e, 0xac, 0x62, 0xcb, 0x1a, 0xb9, 0x1e, 0x56, 0x68, 0xcd, 0xbe, 0x39,
+	0xcb, 0x9a, 0xe5, 0x27, 0x77, 0x4c, 0x9b, 0xb8, 0x77, 0xd2, 0x26, 0x8e, 0x9b, 0xdc, 0x3f, 0x69,
+	0xf7, 0x6e, 0x25, 0xed, 0x1e, 0x0d, 0x79, 0x0e, 0xcf, 0xc5, 0x2d, 0xdf, 0x47, 0xb9, 0x1c, 0xba,
+	0x95, 0x34, 0x7e, 0x34, 0x7e, 0x3d, 0xcc, 0xc5, 0xcd, 0x1f, 0xc1, 0xbc, 0x00, 0x85, 0x30, 0xfa,
+	0x38, 0xcc, 0x7b, 0xd6, 0x9e, 0x34, 0x5c, 0x8d, 0x18, 0x7c, 0x6a, 0x5f, 0x0f, 0xb9, 0xd0, 0xe4,
+	0x7f, 0x94, 0xcb, 0xed, 0xf1, 0x50, 0xd1, 0x62, 0xec, 0x84, 0x10, 0x7f, 0xd8, 0xbd, 0x25, 0x58,
+	0xd0, 0x68, 0x53, 0x19, 0x4a, 0x5a, 0x10, 0x1d, 0xbc, 0x80, 0xad, 0xc0, 0xa7, 0x2b, 0x63, 0x37,
+	0xdc, 0x16, 0xdf, 0xb5, 0xde, 0x8d, 0x3b, 0xf5, 0xad, 0xa8, 0xc6, 0xc4, 0x3a, 0x9c, 0xd9, 0xab,
+	0xff, 0x41, 0x0e, 0xb6, 0x02, 0xaf, 0x9e, 0x39, 0xf6, 0x6c, 0xf7, 0xf8, 0x99, 0xa4, 0x5b, 0x9f,
+	0xce, 0x5f, 0xd4, 0xaf, 0x7f, 0x26, 0xe1, 0xd7, 0xaf, 0x4e, 0xec, 0x39, 0xc5, 0xb1, 0xff, 0x49,
+	0x11, 0x36, 0xb2, 0x06, 0xfa, 0xda, 0x78, 0x76, 0x3f, 0x10, 0xf7, 0xc2, 0x6b, 0xff, 0x33, 0x1e,
+	0x57, 0x2f, 0xcc, 0x19, 0x57, 0x47, 0x02, 0x85, 0xc5, 0x78, 0xa0, 0xf0, 0xfa, 0x5c, 0x7a, 0x33,
+	0xe9, 0xd2, 0xb7, 0xa7, 0xee, 0xce, 0x04, 0x9f, 0xfe, 0xc1, 0x74, 0x9f, 0xfe, 0xe9, 0xb9, 0x68,
+	0xce, 0xe9, 0xd4, 0x3f, 0x98, 0xe4, 0xd4, 0xcb, 0xf3, 0x8c, 0xf5, 0xca, 0x5e, 0x7d, 0xf9, 0xac,
+	0x5e, 0x3d, 0xcb, 0x4b, 0xae, 0xbc, 0x3e, 0x2f, 0x59, 0xf9, 0x9a, 0x78, 0xc9, 0xd5, 0xd7, 0xe0,
+	0x25, 0xf9, 0xaf, 0x67, 0x2f, 0xf9, 0xdd, 0x1c, 0x6c, 0x66, 0x1b, 0x21, 0xb4, 0x15, 0x5a, 0x81,
+	0x1c, 0xf3, 0x38, 0x3e, 0x80, 0x78, 0x8a, 0xb7, 0xa3, 0xa6, 0x20, 0x3f, 0xc5, 0x14, 0x1c, 0xe6,
+	0x22, 0xc6, 0x80, 0xf4, 0x8c, 0xf8, 0x5f, 0x6e, 0x0e, 0xff, 0x3b, 0xc1, 0xff, 0xe5, 0xe7, 0xf6,
+	0x7f, 0xdc, 0x2c, 0xff, 0x57, 0x98, 0xe6, 0xff, 0x16, 0xe2, 0xfe, 0x0f, 0xa0, 0xe8, 0x87, 0x01,
+	0x7b, 0xcb, 0x00, 0x5a, 0x30, 0xb3, 0x57, 0xf0, 0x8c, 0x9f, 0x85, 0x8b, 0x07, 0xa6, 0xfb, 0xb2,
+	0x07, 0xce, 0x7a, 0x1b, 0x6a, 0x59, 0xdd, 0x47, 0x43, 0x7b, 0x30, 0x32, 0x5f, 0xe6, 0xa8, 0x5c,
+	0x7f, 0xd7, 0xa7, 0xf8, 0x72, 0xbe, 0xb2, 0x8e, 0xe1, 0x52, 0x66, 0x7f, 0xc6, 0xd2, 0xcb, 0x38,
+	0xfa, 0xfa, 0x1e, 0xac, 0x13, 0xe9, 0x48, 0x26, 0x26, 0x6f, 0x42, 0x61, 0xa8, 0x9f, 0x98, 0xa9,
+	0x48, 0xae, 0xad, 0x9f, 0x58, 0x03, 0xcf, 0x5e, 0x50, 0x04, 0x9a, 0x56, 0x38, 0x80, 0x8d, 0x38,
+	0x0d, 0xc6, 0xd0, 0x9b, 0xb0, 0x60, 0xb9, 0xe6, 0xa9, 0x97, 0xd1, 0x2c, 0x27, 0xe5, 0x38, 0x60,
+	0x84, 0xe2, 0x50, 0x42, 0x3f, 0x99, 0x83, 0x1a, 0xa3, 0x94, 0xb5, 0x67, 0x3e, 0x53, 0xdc, 0x0c,
+	0xa6, 0xd0, 0x25, 0x28, 0xf9, 0xcb, 0xe8, 0xa7, 0x53, 0x8b, 0xae, 0xb7, 0x88, 0xa3, 0xa8, 0xdf,
+	0xcc, 0xc7, 0xfd, 0x66, 0xdc, 0x40, 0x15, 0x12, 0x06, 0xaa, 0xfe, 0x1e, 0x5c, 0xca, 0x64, 0x2e,
+	0x94, 0x88, 0xe8, 0x6c, 0x67, 0x48, 0x04, 0x45, 0xad, 0xff, 0x54, 0x2e, 0xa0, 0x99, 0x29, 0x13,
+	0xff, 0xc7, 0x33, 0xee, 0xc0, 0xe5, 0x6c, 0xee, 0x42, 0x89, 0x8b, 0x4e, 0x79, 0x96, 0xc4, 0x79,
+	0x73, 0xfe, 0x0c, 0x9c, 0x6f, 0x9a, 0x7d, 0x33, 0x9d, 0x0c, 0x9f, 0xad, 0x00, 0xfb, 0xf4, 0xec,
+	0xd1, 0xb4, 0x46, 0x43, 0xdd, 0xed, 0x3d, 0xc1, 0xe3, 0xbe, 0xd9, 0xb4, 0x1c, 0xb3, 0xe7, 0x92,
+	0xe9, 0x93, 0x04, 0x3e, 0x35, 0x02, 0xac, 0x5f, 0x91, 0x00, 0x64, 0x12, 0xe9, 0xf0, 0xc0, 0x0d,
+	0xad, 0x01, 0x33, 0xd2, 0xe4, 0x67, 0x5d, 0x86, 0x8b, 0x09, 0x3a, 0xd2, 0xc0, 0xb0, 0x9e, 0x59,
+	0xc6, 0x58, 0xef, 0xa3, 0xab, 0x50, 0xa6, 0xb4, 0x86, 0x8e, 0xf9, 0xd8, 0xfa, 0xd0, 0xe7, 0x82,
+	0x80, 0xda, 0x14, 0x92, 0x41, 0xef, 0x49, 0x8a, 0x2f, 0x72, 0x5c, 0x36, 0xcd, 0x97, 0xa0, 0x85,
+	0x2e, 0x43, 0xc9, 0xd1, 0x07, 0x86, 0x7d, 0x6a, 0x7d, 0xa7, 0xb7, 0xef, 0x45, 0x1c, 0x02, 0xea,
+	0x3f, 0x9f, 0x87, 0xd5, 0xc4, 0x50, 0x08, 0xc3, 0x86, 0xc1, 0xbe, 0x35, 0x67, 0xdc, 0x37, 0x35,
+	0x83, 0x2e, 0x4a, 0x35, 0x97, 0xce, 0x76, 0xa5, 0x97, 0xee, 0xf0, 0x1c, 0x46, 0x46, 0x7a, 0x41,
+	0xbf, 0x0d, 0xaa, 0x71, 0x9a, 0x56, 0xb0, 0x40, 0x2c, 0x4e, 0xaf, 0x4f, 0xa2, 0x1b, 0x2e, 0xe5,
+	0xe1, 0x39, 0xbc, 0x69, 0x64, 0x2f, 0x72, 0x8a, 0xe7, 0x1e, 0x5d, 0xb0, 0xac, 0x0c, 0x5d, 0x7a,
+	0x59, 0x93, 0x3c, 0x7b, 0xd0, 0xbd, 0x45, 0x28, 0x10, 0x52, 0xf5, 0x7f, 0x2d, 0x44, 0xd2, 0x9c,
+	0xd1, 0xde, 0xbe, 0xa0, 0x09, 0xb0, 0x12, 0x1b, 0x9c, 0x26, 0xfb, 0x13, 0x1a, 0x1b, 0x9f, 0xd1,
+	0x63, 0x1b, 0x2f, 0x47, 0x47, 0x44, 0x6f, 0x79, 0x63, 0xb1, 0x35, 0xae, 0x4e, 0xea, 0x49, 0xb3,
+	0xdb, 0x14, 0x13, 0x5d, 0x8d, 0x6a, 0x68, 0x3e, 0x48, 0x7d, 0x87, 0x5a, 0xba, 0x03, 0x6b, 0x4f,
+	0x2c, 0xc3, 0xd4, 0x86, 0x4f, 0xec, 0x81, 0x19, 0xbd, 0xb5, 0x29, 0x52, 0xc4, 0x55, 0xd2, 0xd8,
+	0x26, 0x6d, 0x2c, 0x19, 0xff, 0x66, 0x3a, 0x3f, 0xbe, 0x18, 0x90, 0x4d, 0xe6, 0xc8, 0x37, 0x99,
+	0x67, 0xa6, 0x2a, 0xee, 0x71, 0x45, 0xbe, 0xd1, 0x95, 0x88, 0x63, 0x5e, 0x08, 0xda, 0x02, 0x18,
+	0x3a, 0x06, 0x88, 0xc4, 0xeb, 0x4b, 0x89, 0xc4, 0xd8, 0xb4, 0x55, 0xde, 0x49, 0xc4, 0x61, 0x94,
+	0x70, 0x84, 0x18, 0xba, 0x1e, 0xea, 0xc6, 0xc8, 0x74, 0xbd, 0xe3, 0x87, 0x87, 0xc4, 0xf4, 0x63,
+	0x64, 0xba, 0xe8, 0x73, 0x0c, 0xa9, 0x67, 0x0f, 0x1e, 0x5b, 0x27, 0x34, 0xa5, 0x1e, 0x0d, 0x3c,
+	0xb1, 0x6d, 0x9f, 0x36, 0x68, 0x13, 0x4b, 0x4d, 0x85, 0x04, 0x3c, 0x70, 0xfa, 0x20, 0x5b, 0x4e,
+	0x1f, 0x64, 0x6b, 0x9f, 0x85, 0xd5, 0x57, 0x88, 0x1a, 0xeb, 0x7f, 0x1c, 0xcd, 0x6e, 0x67, 0x09,
+	0xdc, 0x1d, 0xd8, 0x20, 0x96, 0x2d, 0xa1, 0x51, 0xbe, 0x85, 0x5b, 0x1b, 0x59, 0xc3, 0x98, 0xb8,
+	0xcd, 0x4a, 0x76, 0x27, 0x65, 0x73, 0xee, 0x64, 0x77, 0xb4, 0xe3, 0x94, 0x33, 0xf1, 0x57, 0x17,
+	0x60, 0x3d, 0x81, 0x4d, 0x86, 0x39, 0xfb, 0x34, 0x3e, 0xc1, 0xb4, 0x24, 0x3f, 0x5d, 0x4b, 0x98,
+	0x86, 0xc4, 0x7c, 0x18, 0x97, 0xf0, 0x61, 0xdb, 0x59, 0xda, 0x51, 0xa0, 0x7b, 0x99, 0xd2, 0x8c,
+	0x8c, 0x9b, 0xa3, 0xa5, 0xcc, 0x9b, 0x23, 0xff, 0x04, 0xbf, 0x30, 0xe1, 0x04, 0xbf, 0x98, 0x38,
+	0xc1, 0xb7, 0x62, 0xda, 0x50, 0xa4, 0xda, 0xf0, 0x89, 0x69, 0x3b, 0x93, 0x54, 0x82, 0x98, 0x02,
+	0x5c, 0x8d, 0x2b, 0x40, 0x29, 0xe6, 0x1c, 0x88, 0xf0, 0x7f, 0x53, 0x5c, 0xf8, 0x61, 0x96, 0xf0,
+	0x27, 0x05, 0x3f, 0x9e, 0xa3, 0x2d, 0xcf, 0x99, 0xa3, 0x5d, 0x7e, 0x89, 0xd3, 0xe7, 0x84, 0x73,
+	0xe2, 0xca, 0x6b, 0x38, 0x27, 0x56, 0x92, 0xe7, 0xc4, 0x57, 0x54, 0xd6, 0x3f, 0xcd, 0xc3, 0xf9,
+	0x4c, 0x55, 0x40, 0x6f, 0xc5, 0x63, 0xa8, 0x89, 0x47, 0xa8, 0x50, 0x28, 0xcf, 0x26, 0xdf, 0xfe,
+	0xe9, 0x88, 0x63, 0xc7, 0xbe, 0xcc, 0xd3, 0x51, 0x81, 0xe5, 0x16, 0xa3, 0xa7, 0x23, 0x24, 0xc7,
+	0xe4, 0x6e, 0x81, 0xae, 0xea, 0xce, 0x74, 0xc5, 0x9e, 0x26, 0x79, 0xaf, 0xb8, 0x84, 0x13, 0x8e,
+	0x5b, 0x5f, 0x0e, 0x83, 0xf7, 0x2c, 0x1b, 0x38, 0x77, 0x28, 0xbb, 0x0d, 0x6b, 0x49, 0x0b, 0xe3,
+	0x87, 0xb4, 0xab, 0xb1, 0x68, 0xc2, 0x18, 0xa1, 0x4b, 0x29, 0xa7, 0x1a, 0xd9, 0x9d, 0xb9, 0xc3,
+	0xf9, 0x38, 0xbb, 0x73, 0x84, 0xf3, 0xa9, 0xe0, 0x80, 0x85, 0xb6, 0x0a, 0x5c, 0x0e, 0x42, 0xdb,
+	0xd7, 0xe1, 0x07, 0xea, 0x5f, 0x2a, 0xc0, 0x5a, 0x24, 0x96, 0x66, 0xaa, 0x5d, 0x83, 0xe2, 0x13,
+	0x7b, 0xe4, 0x46, 0x83, 0x5d, 0xff, 0x3b, 0x9e, 0x4a, 0xcc, 0xcf, 0x99, 0x4a, 0x4c, 0x25, 0x0c,
+	0xb9, 0x79, 0x12, 0x86, 0x85, 0x8c, 0x84, 0xe1, 0xc9, 0xa4, 0x54, 0x9f, 0x27, 0xb4, 0x77, 0xb3,
+	0x4e, 0x08, 0xde, 0xac, 0xce, 0x98, 0xe7, 0x3b, 0x99, 0x94, 0xe7, 0x5b, 0x9c, 0x39, 0xd0, 0x99,
+	0x92, 0x7c, 0xff, 0xef, 0x52, 0x4b, 0xbf, 0x50, 0x82, 0x4b, 0x41, 0xdc, 0xd5, 0xd6, 0x1d, 0xd7,
+	0xea, 0x59, 0x43, 0x7d, 0xe0, 0xce, 0x9f, 0x72, 0x7f, 0xcb, 0xcf, 0x13, 0x6c, 0x24, 0x1c, 0x4a,
+	0x6a, 0xa9, 0xfc, 0xdb, 0x80, 0x2b, 0x50, 0x26, 0x34, 0xe9, 0xb5, 0xa1, 0x6b, 0x33, 0x9e, 0x4a,
+	0x23, 0x6b, 0x48, 0x22, 0x6f, 0xd5, 0x26, 0x9a, 0x46, 0xda, 0x99, 0x57, 0x5e, 0x0d, 0x9a, 0x99,
+	0x3f, 0x8e, 0x9d, 0xce, 0xb8, 0xc4, 0xe9, 0xec, 0x53, 0xb0, 0x31, 0x0c, 0x67, 0xa1, 0x59, 0x34,
+	0x05, 0xe9, 0xbe, 0x60, 0xd2, 0xb5, 0x1e, 0x69, 0x93, 0x58, 0x13, 0xa9, 0xe2, 0x88, 0x76, 0x89,
+	0xa4, 0xb8, 0x57, 0x23, 0xf0, 0x2c, 0xea, 0x81, 0x99, 0x2d, 0xa6, 0xa8, 0x1f, 0xb1, 0x26, 0xf4,
+	0x0c, 0x36, 0xa3, 0x5d, 0x22, 0x32, 0xec, 0xa5, 0xc0, 0x3f, 0x97, 0x0e, 0x7f, 0xd3, 0xdb, 0xb0,
+	0x13, 0x01, 0x25, 0xe5, 0xf9, 0xfc, 0x30, 0xab, 0x8d, 0x04, 0x23, 0x86, 0x7b, 0xfa, 0xd8, 0x0f,
+	0x46, 0xc8, 0x6f, 0x74, 0x13, 0x56, 0x86, 0x7d, 0xfd, 0x85, 0x46, 0xae, 0x71, 0x5d, 0x7b, 0xe0,
+	0x65, 0xd6, 0xbc, 0xf3, 0xc0, 0x32, 0x69, 0xc0, 0x0c, 0x4e, 0x94, 0x93, 0x22, 0x1a, 0x96, 0xde,
+	0xa7, 0x88, 0xec, 0x46, 0x96, 0x00, 0x9b, 0x0c, 0x96, 0x1d, 0x43, 0x41, 0x76, 0x0c, 0x75, 0x3f,
+	0xcc, 0xfc, 0xf3, 0x74, 0xda, 0x9f, 0x9a, 0x6b, 0xda, 0xd9, 0x17, 0x00, 0x19, 0x89, 0xf2, 0xb5,
+	0xaf, 0xa7, 0xeb, 0xef, 0xca, 0x9c, 0xa1, 0x15, 0x7a, 0x89, 0xd0, 0x6a, 0x07, 0xd6, 0x9f, 0xeb,
+	0x96, 0xab, 0x8d, 0x07, 0xae, 0xd5, 0xd7, 0xf4, 0xc1, 0xe8, 0xb9, 0xe9, 0x98, 0xde, 0x25, 0x73,
+	0x11, 0xaf, 0x91, 0xa6, 0x2e, 0x69, 0x11, 0x58, 0x43, 0xed, 0x10, 0x6a, 0x93, 0x65, 0xef, 0x4c,
+	0x16, 0xeb, 0x15, 0x12, 0xf2, 0xf5, 0x5f, 0xcb, 0x01, 0x8a, 0x4b, 0x08, 0x3d, 0x41, 0xbc, 0x01,
+	0x95, 0xb8, 0xb2, 0x33, 0x6a, 0x2b, 0x31, 0x35, 0x9f, 0x68, 0x13, 0xf2, 0x93, 0x6d, 0xc2, 0x54,
+	0x1b, 0x13, 0xb5, 0x5f, 0x61, 0x28, 0xc0, 0xec, 0x97, 0x64, 0xd4, 0x7f, 0x3f, 0x0f, 0x5b, 0xd4,
+	0x31, 0x3e, 0x36, 0x9d, 0x6c, 0xab, 0x3a, 0x89, 0xa3, 0xdc, 0x9c, 0x1c, 0xe5, 0x13, 0x1c, 0x5d,
+	0x85, 0xb2, 0xcb, 0x06, 0x24, 0x16, 0xd5, 0x63, 0x18, 0x7c, 0x90, 0x6a, 0xa7, 0x15, 0xba, 0x90,
+	0xa1, 0xd0, 0x47, 0xa1, 0x92, 0x26, 0xfd, 0xeb, 0xd4, 0xe9, 0x64, 0xab, 0xe9, 0x2b, 0x6d, 0xb8,
+	0x46, 0x8f, 0x8c, 0x89, 0xbb, 0xa5, 0x8c, 0xab, 0xc8, 0xdc, 0x3c, 0x91, 0x45, 0x3e, 0x1d, 0x59,
+	0xd4, 0x7f, 0x37, 0x07, 0xd7, 0xe8, 0xbd, 0x94, 0x99, 0x31, 0xce, 0xfc, 0xce, 0xef, 0x5d, 0x28,
+	0x87, 0xf7, 0x5c, 0xa3, 0xac, 0xd3, 0x75, 0x8a, 0x76, 0xb4, 0x03, 0xba, 0x0b, 0x4b, 0xf6, 0x33,
+	0xd3, 0xe9, 0xeb, 0xc3, 0x2a, 0x37, 0xcb, 0x70, 0xf8, 0x98, 0xf5, 0x43, 0xb8, 0xe1, 0x25, 0xee,
+	0x27, 0xdd, 0xbd, 0xcd, 0x9d, 0x01, 0x3d, 0x82, 0xdb, 0xd8, 0xee, 0xf7, 0x1f, 0xe9, 0xbd, 0xa7,
+	0xaf, 0x89, 0x5c, 0xc3, 0x3e, 0x1d, 0x46, 0xb3, 0xb1, 0xaf, 0x42, 0xee, 0xab, 0x79, 0xa8, 0x4d,
+	0xa6, 0x33, 0xc7, 0xee, 0xbc, 0x13, 0xab, 0xa0, 0xae, 0xec, 0xde, 0x88, 0x6e, 0x4c, 0x9a, 0xa2,
+	0x57, 0x81, 0xed, 0xd7, 0x59, 0x23, 0x05, 0x36, 0x86, 0x8e, 0xf9, 0xcc, 0xb2, 0xc7, 0x23, 0x2d,
+	0xba, 0xc9, 0xdc, 0x1c, 0x9b, 0xbc, 0xee, 0xf7, 0x8c, 0x00, 0x51, 0x1d, 0x56, 0x46, 0xae, 0xee,
+	0xb8, 0xa6, 0xa1, 0xe9, 0xae, 0x36, 0xf0, 0xee, 0xed, 0x39, 0x5c, 0x66, 0x40, 0xc1, 0x95, 0x49,
+	0x55, 0x1e, 0x62, 0xdb, 0xac, 0x99, 0x03, 0x63, 0xc4, 0x10, 0x17, 0x28, 0xe2, 0x2a, 0x6b, 0x11,
+	0x07, 0xc6, 0x88, 0x22, 0xdf, 0x83, 0x8b, 0x59, 0x1c, 0x12, 0xf5, 0xf0, 0x6a, 0x9d, 0x39, 0x7c,
+	0x21, 0x83, 0x91, 0xee, 0xc8, 0x34, 0xea, 0x5f, 0x2c, 0x41, 0x99, 0xac, 0x02, 0x31, 0x59, 0xc4,
+	0x96, 0x5e, 0x80, 0x25, 0xdf, 0xa0, 0x79, 0x0b, 0xb9, 0x48, 0x3e, 0x25, 0x03, 0x5d, 0x84, 0x62,
+	0xb0, 0xc4, 0x9e, 0x26, 0x2d, 0xb1, 0x33, 0x11, 0xba, 0x05, 0x7c, 0xea, 0xf0, 0xe1, 0x5d, 0xc4,
+	0x56, 0xe2, 0x47, 0x2b, 0x52, 0xcb, 0xee, 0x98, 0x27, 0x7e, 0xa1, 0x55, 0x09, 0xb3, 0xaf, 0xe9,
+	0x76, 0xf6, 0x02, 0x2c, 0xd1, 0xc6, 0xc0, 0xc6, 0x2e, 0x92, 0xcf, 0x29, 0x06, 0x7d, 0x61, 0xb2,
+	0xf9, 0x7c, 0x3c, 0x31, 0x0c, 0xf3, 0x6a, 0xb4, 0xee, 0xc4, 0x44, 0x83, 0x2d, 0xca, 0x4b, 0x84,
+	0x5d, 0xdb, 0x50, 0x7c, 0xec, 0xd8, 0xa7, 0xda, 0xd8, 0xb1, 0xe8, 0x0e, 0x94, 0x77, 0x57, 0xa3,
+	0x94, 0xbb, 0x8e, 0x85, 0x97, 0x08, 0x42, 0xd7, 0xb1, 0xd0, 0xc7, 0x61, 0xd1, 0xb5, 0x29, 0xe6,
+	0x52, 0x36, 0xe6, 0x82, 0x6b, 0x13, 0xbc, 0x8f, 0x01, 0xf4, 0x68, 0x90, 0x44, 0xe4, 0x86, 0x26,
+	0x76, 0x38, 0x1a, 0xb3, 0x95, 0x18, 0x54, 0x70, 0x09, 0x4a, 0x28, 0x5a, 0x55, 0x08, 0x51, 0x02,
+	0xd9, 0x42, 0x5b, 0x50, 0x34, 0x07, 0x86, 0x87, 0x50, 0x0e, 0x10, 0x96, 0x28, 0x4c, 0x70, 0xd1,
+	0xbb, 0xc0, 0xb3, 0xf8, 0x43, 0x7b, 0x6c, 0xea, 0xee, 0xd8, 0x31, 0xbd, 0xca, 0xc5, 0x4a, 0xe4,
+	0x70, 0xdd, 0x91, 0xda, 0xfb, 0x5e, 0x1b, 0x5e, 0x65, 0xc8, 0xec, 0x9b, 0x14, 0x60, 0x55, 0xbc,
+	0x40, 0x88, 0x66, 0xfc, 0xc9, 0x4e, 0x67, 0x94, 0x24, 0xd2, 0xb8, 0xc7, 0x47, 0xc0, 0x2b, 0xbd,
+	0xe8, 0x27, 0xfa, 0x46, 0x28, 0x53, 0x0a, 0x4c, 0x65, 0x8b, 0xe9, 0x27, 0x12, 0xa4, 0x3b, 0x53,
+	0x52, 0xe8, 0x05, 0xbf, 0x89, 0x5e, 0x85, 0xeb, 0x43, 0xd4, 0x65, 0xd3, 0xd3, 0xab, 0x60, 0x79,
+	0xe4, 0x0c, 0xdd, 0xbb, 0x90, 0xd6, 0xbd, 0x2b, 0x50, 0xf6, 0x57, 0x88, 0x60, 0x54, 0x29, 0x46,
+	0x89, 0x2d, 0x90, 0x4c, 0x8a, 0x2b, 0x48, 0x2a, 0xa1, 0x67, 0x0f, 0x06, 0x24, 0x09, 0xe0, 0x98,
+	0xfa, 0x28, 0xc8, 0x6f, 0x85, 0xb3, 0x6c, 0x06, 0x18, 0x98, 0x22, 0x60, 0xde, 0x48, 0x40, 0x88,
+	0xdb, 0x33, 0x1d, 0xc7, 0x76, 0x68, 0x54, 0x5d, 0xc2, 0xde, 0x07, 0x7a, 0x07, 0xf8, 0xc8, 0xf4,
+	0x35, 0xfa, 0x4c, 0x64, 0x9d, 0xca, 0x05, 0x4a, 0x3f, 0x13, 0xc1, 0x95, 0x70, 0xfe, 0xe4, 0xc9,
+	0x08, 0x89, 0x00, 0xf4, 0xb1, 0x61, 0xd9, 0xb4, 0x5f, 0x8f, 0x9e, 0xc5, 0x4a, 0x18, 0x28, 0x88,
+	0xb4, 0xf7, 0xc8, 0x29, 0x27, 0x15, 0x40, 0x9e, 0xf7, 0x4e, 0x39, 0xc9, 0x18, 0x31, 0x9e, 0xe9,
+	0xb8, 0x98, 0xcc, 0x98, 0xbd, 0xb6, 0x90, 0xb0, 0xfe, 0xbd, 0x39, 0x58, 0xf4, 0x44, 0x9d, 0x1c,
+	0x57, 0x88, 0x53, 0x67, 0xfd, 0xe8, 0x6f, 0x02, 0x23, 0x89, 0x08, 0xd6, 0x8f, 0xfe, 0x46, 0x15,
+	0xc8, 0x5b, 0x43, 0x66, 0x29, 0xf2, 0xd6, 0x90, 0xe0, 0xd0, 0xdc, 0x04, 0x31, 0x10, 0x2b, 0x98,
+	0xfe, 0x7e, 0xa9, 0xfa, 0xa7, 0xed, 0x7f, 0x5b, 0x86, 0x95, 0xd8, 0x2b, 0x1c, 0xb4, 0x49, 0x63,
+	0x4e, 0xad, 0xa3, 0x0a, 0x6a, 0xb7, 0xa3, 0x75, 0xe5, 0xfb, 0xb2, 0xf2, 0x50, 0xe6, 0xcf, 0xa1,
+	0xf3, 0xb0, 0x16, 0x81, 0xab, 0xf8, 0x58, 0x92, 0x0f, 0x78, 0x03, 0x5d, 0x88, 0xa1, 0x63, 0x49,
+	0x3e, 0x20, 0xf0, 0xdf, 0xca, 0xa1, 0x8f, 0xc1, 0xe5, 0x48, 0x43, 0x43, 0x68, 0xb5, 0x34, 0xa9,
+	0xa3, 0xed, 0x2b, 0xf8, 0xa1, 0x80, 0x9b, 0x62, 0x93, 0xff, 0xed, 0x1c, 0xda, 0x8c, 0x91, 0x7c,
+	0xaf, 0x2b, 0x76, 0xc5, 0x26, 0xff, 0x3b, 0x39, 0x74, 0x0d, 0x2e, 0x45, 0xe0, 0x1d, 0xb1, 0xd3,
+	0x91, 0x14, 0x59, 0x6b, 0x63, 0xe5, 0x00, 0x8b, 0x9d, 0x0e, 0xff, 0x15, 0x52, 0x9b, 0xbf, 0x12,
+	0xc1, 0x50, 0xee, 0xf3, 0x7f, 0x98, 0x43, 0x55, 0x58, 0x8f, 0xc0, 0x84, 0x46, 0x43, 0x6c, 0xab,
+	0x62, 0x93, 0xff, 0xa3, 0x24, 0x2b, 0x47, 0xca, 0x03, 0xb1, 0xa9, 0xb5, 0x45, 0x7c, 0x24, 0xc8,
+	0xa2, 0xac, 0xb6, 0x8e, 0xf9, 0x2f, 0xe7, 0x33, 0x51, 0x54, 0xf1, 0xa8, 0xad, 0x60, 0x01, 0x4b,
+	0xad, 0x63, 0xfe, 0xd7, 0xf3, 0xe8, 0x22, 0x6c, 0x44, 0x50, 0xba, 0x1d, 0x91, 0x70, 0xf4, 0xfe,
+	0x31, 0xff, 0x1b, 0x79, 0x74, 0x09, 0x36, 0x23, 0x4d, 0x7b, 0x42, 0x53, 0xc3, 0xe2, 0x7b, 0x5d,
+	0xb1, 0xa3, 0xf2, 0x5f, 0xe2, 0xd0, 0x65, 0xb8, 0x10, 0x5b, 0x50, 0xa1, 0xab, 0x1e, 0x2a, 0x58,
+	0xfa, 0xbc, 0xd8, 0xe4, 0xbf, 0x9f, 0x4b, 0xcc, 0xb5, 0x2d, 0x1c, 0x1f, 0x89, 0xb2, 0x4a, 0xbb,
+	0x4b, 0x58, 0x6c, 0xf2, 0x3f, 0xc0, 0x25, 0xc6, 0xdd, 0x57, 0xf0, 0x9e, 0xd4, 0x6c, 0x8a, 0x32,
+	0xff, 0x83, 0x5c, 0x62, 0xca, 0xb2, 0xa2, 0xee, 0xd3, 0x77, 0x0a, 0x3f, 0xc4, 0xa1, 0x3a, 0x6c,
+	0x45, 0xe7, 0x23, 0xaa, 0x87, 0x4a, 0x93, 0x20, 0x68, 0x42, 0xab, 0xa5, 0x3c, 0x14, 0x9b, 0xfc,
+	0x0f, 0x73, 0xe8, 0x0a, 0x5c, 0x8c, 0xe0, 0xd0, 0x46, 0xba, 0x68, 0xc2, 0x5e, 0x4b, 0xe4, 0x7f,
+	0x84, 0x43, 0xd7, 0xe1, 0x4a, 0x94, 0x35, 0x32, 0x59, 0x8d, 0x30, 0x1f, 0x72, 0xf7, 0xa3, 0x1c,
+	0xba, 0x0a, 0xb5, 0x08, 0x12, 0x9b, 0xb6, 0xa6, 0x4a, 0x47, 0xa2, 0xd2, 0x55, 0xf9, 0x1f, 0x4b,
+	0xf2, 0xd8, 0x50, 0xe4, 0xfd, 0x96, 0xd4, 0x50, 0xf9, 0x1f, 0xe7, 0xd0, 0x06, 0xac, 0x46, 0x5a,
+	0x0e, 0x14, 0x59, 0xe4, 0x7f, 0x82, 0x43, 0xb7, 0xe0, 0x7a, 0x06, 0x41, 0x51, 0x56, 0x25, 0xf5,
+	0x58, 0x53, 0x15, 0x45, 0x6b, 0x09, 0xf8, 0x40, 0xe4, 0x7f, 0x9a, 0x43, 0x37, 0xe0, 0x6a, 0x06,
+	0x66, 0x17, 0x4b, 0x1e, 0x9a, 0x22, 0x1f, 0xf0, 0x3f, 0xc3, 0xa1, 0x8f, 0xc3, 0xc7, 0x62, 0xcb,
+	0xdf, 0xe9, 0xb6, 0xdb, 0x0a, 0x56, 0xc5, 0xa6, 0x76, 0x24, 0x36, 0x25, 0x41, 0x53, 0x8f, 0xdb,
+	0x22, 0xff, 0xb3, 0x1c, 0xba, 0x03, 0xdb, 0x69, 0x6a, 0x62, 0x53, 0xc3, 0x82, 0x7c, 0x20, 0xd2,
+	0xd5, 0xe9, 0x08, 0xaa, 0xd4, 0xd9, 0x97, 0xe8, 0xf2, 0xfc, 0x1c, 0x87, 0xb6, 0xa0, 0x9a, 0xd8,
+	0x74, 0xf1, 0x7d, 0x55, 0x94, 0x89, 0xac, 0xf2, 0xbf, 0x98, 0xdc, 0x81, 0xa0, 0x29, 0x5c, 0xbc,
+	0x5f, 0x4a, 0xe2, 0x48, 0xb2, 0x2a, 0xe2, 0x07, 0x42, 0x8b, 0xb2, 0xbf, 0x87, 0x25, 0x71, 0x9f,
+	0xff, 0x15, 0x0e, 0xdd, 0x84, 0x7a, 0x54, 0xef, 0x42, 0x99, 0x24, 0xa2, 0xf4, 0x40, 0x90, 0x5a,
+	0x94, 0x9f, 0xbf, 0xe6, 0xd0, 0x5b, 0xf0, 0x66, 0x52, 0xe1, 0x54, 0x2c, 0xc8, 0x1d, 0xa1, 0xa1,
+	0x92, 0x71, 0x9b, 0x8a, 0xe8, 0x6d, 0xb2, 0xf8, 0xbe, 0xd4, 0x51, 0x3b, 0xfc, 0xdf, 0x24, 0x67,
+	0xd0, 0x52, 0x94, 0xb6, 0xd6, 0x14, 0x55, 0xb1, 0x41, 0xd4, 0xe6, 0x6f, 0x93, 0xcd, 0x84, 0xa9,
+	0x23, 0x41, 0x3e, 0xd6, 0x0e, 0x95, 0x76, 0x87, 0xff, 0xbb, 0x24, 0xf3, 0x42, 0xb3, 0x49, 0x94,
+	0x53, 0x93, 0xe4, 0x86, 0x72, 0xd4, 0x6e, 0x89, 0xaa, 0xc8, 0xff, 0x7d, 0x52, 0x76, 0x85, 0xa3,
+	0x3d, 0xe9, 0xa0, 0xab, 0x74, 0x3b, 0xfc, 0x3f, 0x24, 0x9b, 0xf6, 0xba, 0x9d, 0x63, 0xed, 0x50,
+	0xc4, 0x22, 0xff, 0x8f, 0x49, 0xca, 0x81, 0x4c, 0x89, 0xf8, 0x48, 0x92, 0x05, 0xc2, 0xdc, 0x3f,
+	0x25, 0x85, 0x33, 0x2e, 0xbc, 0x1e, 0xa1, 0x7f, 0xe6, 0xd0, 0x1b, 0x70, 0x2d, 0xb9, 0xbe, 0xb2,
+	0xd0, 0xd2, 0x3a, 0x22, 0x7e, 0x20, 0x62, 0x4d, 0xc4, 0x58, 0xc1, 0xfc, 0xbf, 0x27, 0x65, 0x98,
+	0xd0, 0x92, 0xc8, 0x14, 0x88, 0x26, 0x8a, 0x4d, 0xfe, 0x3f, 0xb8, 0x0c, 0xfd, 0x3e, 0x10, 0x54,
+	0xf1, 0xa1, 0x70, 0xcc, 0xff, 0x67, 0x92, 0x13, 0x42, 0x5b, 0x6a, 0x88, 0xb1, 0xcd, 0xf9, 0xaf,
+	0xe4, 0x10, 0xac, 0x77, 0xa0, 0x26, 0xff, 0x9d, 0x64, 0xf5, 0x81, 0x88, 0xa9, 0xb0, 0x50, 0xb1,
+	0xf3, 0x05, 0x96, 0xff, 0x1f, 0x2e, 0x69, 0xa7, 0xc4, 0x4e, 0x47, 0x38, 0x10, 0x23, 0x6a, 0xf1,
+	0xc5, 0x42, 0x42, 0xe0, 0x0f, 0x5a, 0xca, 0x9e, 0xd0, 0xf2, 0xd6, 0x57, 0x7c, 0x20, 0xe2, 0xe3,
+	0x87, 0x74, 0x71, 0xfe, 0xac, 0x90, 0x50, 0x7f, 0x86, 0xd7, 0x14, 0x1b, 0x2d, 0x49, 0x16, 0xf9,
+	0x3f, 0x2f, 0xa0, 0x1d, 0xb8, 0x9d, 0xd1, 0x1e, 0x93, 0x22, 0x4d, 0x90, 0x19, 0xbd, 0xbf, 0x28,
+	0x24, 0x66, 0xc0, 0xf0, 0x13, 0x56, 0xe5, 0xaf, 0x0a, 0xdb, 0x4f, 0xd9, 0x33, 0x3f, 0x3f, 0x6d,
+	0xce, 0xfc, 0x0d, 0x15, 0x55, 0x32, 0x4f, 0x62, 0x62, 0x94, 0xd0, 0xdf, 0x84, 0xf0, 0x6e, 0xb3,
+	0xcd, 0xe7, 0xd2, 0x60, 0xb5, 0xd1, 0xe6, 0xf3, 0x19, 0xe0, 0x56, 0x87, 0xe7, 0xb6, 0x65, 0xe0,
+	0x93, 0x39, 0x31, 0xf2, 0xae, 0x8b, 0xa0, 0xca, 0x8a, 0x76, 0x28, 0x0a, 0x4d, 0x11, 0x77, 0xbc,
+	0xe7, 0x5f, 0x04, 0xf6, 0x7e, 0x00, 0xca, 0xa1, 0x75, 0xcf, 0x3a, 0x11, 0x35, 0xf2, 0x81, 0xf9,
+	0x6d, 0x1b, 0x50, 0x3a, 0xdd, 0x84, 0xb6, 0xbc, 0x95, 0xf4, 0xec, 0x89, 0x28, 0x37, 0xf0, 0x71,
+	0x5b, 0xd5, 0x9a, 0x52, 0x87, 0x4e, 0xf9, 0x1c, 0xba, 0x04, 0x17, 0xd2, 0xcd, 0xd4, 0x0e, 0xf3,
+	0xb9, 0xec, 0xbe, 0xcc, 0x46, 0xf0, 0xf9, 0xed, 0xdf, 0xcc, 0x51, 0xff, 0x30, 0xe9, 0xd4, 0x86,
+	0xae, 0x79, 0xf2, 0xd0, 0xc0, 0x62, 0x93, 0x98, 0x48, 0xa1, 0xa5, 0x61, 0x45, 0x15, 0x54, 0x4f,
+	0x76, 0x64, 0x32, 0xfa, 0x75, 0xb8, 0x3a, 0x09, 0x43, 0x79, 0x20, 0xe2, 0x96, 0x40, 0x56, 0xf5,
+	0x06, 0x5c, 0x9b, 0x84, 0x14, 0xa8, 0x73, 0x1e, 0xdd, 0x84, 0xeb, 0x93, 0xb0, 0xb0, 0xd2, 0x6a,
+	0x89, 0x4d, 0x6d, 0x4f, 0x68, 0xdc, 0xe7, 0xb9, 0xed, 0xe7, 0xb0, 0x12, 0x8b, 0x5b, 0xe9, 0xf6,
+	0x34, 0xfc, 0x28, 0x80, 0x58, 0x08, 0x12, 0x24, 0x9c, 0x43, 0x35, 0xd8, 0x24, 0xe0, 0xb6, 0x80,
+	0x55, 0xa9, 0x21, 0xb5, 0x05, 0x59, 0xd5, 0xbe, 0x45, 0x91, 0x64, 0x91, 0x3c, 0xbf, 0xab, 0x00,
+	0x90, 0x36, 0x62, 0xbd, 0x1e, 0x90, 0xc1, 0x37, 0x80, 0x27, 0xdf, 0x4d, 0xa9, 0xd3, 0x50, 0x64,
+	0xd9, 0x33, 0x52, 0x1c, 0x5a, 0x81, 0x12, 0x81, 0x7a, 0xaa, 0x5c, 0xd8, 0xbe, 0x0d, 0x10, 0x46,
+	0xeb, 0xa8, 0x08, 0x05, 0xb6, 0x08, 0x6b, 0xb0, 0x72, 0x1f, 0x4b, 0x9d, 0xb6, 0x26, 0xca, 0x64,
+	0x53, 0x9a, 0x7c, 0x6e, 0x7b, 0x1f, 0x78, 0xc6, 0x63, 0x18, 0x8b, 0xaf, 0x42, 0xb9, 0xd3, 0x68,
+	0x46, 0x82, 0x1e, 0x06, 0x08, 0x1f, 0x05, 0xf2, 0xb0, 0x4c, 0x00, 0xe1, 0x93, 0xc0, 0xdd, 0xaf,
+	0xac, 0x02, 0xd7, 0x91, 0xda, 0xa8, 0x0d, 0xcb, 0xd1, 0xfa, 0x3d, 0x74, 0x39, 0x76, 0xeb, 0x99,
+	0x28, 0xd3, 0xaa, 0x6d, 0x4d, 0x68, 0xf5, 0xee, 0xcd, 0xea, 0xdc, 0x47, 0xf9, 0x1c, 0xfa, 0x42,
+	0xe4, 0xc1, 0x73, 0xb4, 0xf6, 0x0d, 0xbd, 0x91, 0xce, 0x22, 0x67, 0x54, 0xfa, 0xd5, 0xa6, 0x16,
+	0xcf, 0x21, 0x0d, 0x36, 0xb3, 0x9f, 0x3c, 0xa0, 0x8f, 0xa7, 0xc9, 0x67, 0xd5, 0xd5, 0xd5, 0xa6,
+	0x57, 0xaa, 0x11, 0xf6, 0x33, 0x5f, 0x2b, 0x46, 0xd8, 0x9f, 0xf6, 0x9a, 0x71, 0x36, 0xfb, 0xd9,
+	0xaf, 0x26, 0x22, 0xec, 0x4f, 0x7d, 0x56, 0x31, 0x8b, 0xfd, 0x6f, 0x05, 0x94, 0xae, 0x5c, 0x45,
+	0x61, 0xc1, 0xd6, 0xc4, 0xaa, 0xd8, 0xda, 0xf5, 0xa9, 0x38, 0xec, 0x66, 0xf4, 0xdb, 0x61, 0x3d,
+	0xa3, 0x0c, 0x15, 0x25, 0xfb, 0x66, 0x72, 0x7e, 0x63, 0x3a, 0x52, 0x38, 0x42, 0x46, 0xa5, 0x65,
+	0x64, 0x84, 0xc9, 0x45, 0xa2, 0xb5, 0x1b, 0xd3, 0x91, 0xd8, 0x08, 0xbd, 0xa0, 0x64, 0x35, 0x3e,
+	0x89, 0x54, 0xef, 0xcc, 0x59, 0xbc, 0x31, 0x03, 0x8b, 0x0d, 0x72, 0x00, 0x95, 0x78, 0xa5, 0x23,
+	0x0a, 0xab, 0x71, 0x32, 0x4b, 0x20, 0x6b, 0xd9, 0x25, 0xb2, 0xe8, 0x29, 0x5c, 0x9c, 0x98, 0xfa,
+	0x44, 0xb7, 0x23, 0x05, 0x21, 0xd3, 0xd3, 0xa3, 0xb5, 0x79, 0xde, 0x01, 0xa0, 0x11, 0x6c, 0x4d,
+	0x4d, 0x56, 0xa2, 0x4f, 0x26, 0xf6, 0x70, 0x7a, 0xda, 0x70, 0xbe, 0x41, 0xbf, 0x0b, 0xea, 0xb3,
+	0xf3, 0x9a, 0x68, 0x37, 0x32, 0xd5, 0x39, 0x93, 0xa0, 0x73, 0x0f, 0x3f, 0x3b, 0x0f, 0x1a, 0x19,
+	0x7e, 0xee, 0xa4, 0xe9, 0x7c, 0xc3, 0x47, 0xcd, 0x65, 0xac, 0xb4, 0xf3, 0x8d, 0xb9, 0x4a, 0xed,
+	0x6a, 0x53, 0x8b, 0x13, 0x62, 0xd6, 0x6c, 0x02, 0xf5, 0x69, 0xd5, 0x6b, 0x33, 0xa8, 0x87, 0xba,
+	0x1a, 0xa3, 0x9d, 0xd2, 0xd5, 0x2c, 0xca, 0x37, 0xa6, 0x23, 0x31, 0x35, 0xfa, 0x42, 0xa4, 0x60,
+	0x78, 0x02, 0xff, 0xd3, 0xaa, 0x2e, 0x66, 0xf0, 0x7f, 0x0c, 0x1b, 0x59, 0x17, 0x9a, 0x11, 0x4b,
+	0x30, 0xe5, 0xbe, 0xb3, 0x16, 0xbb, 0xcb, 0x4b, 0xde, 0x76, 0xbd, 0x0f, 0x9b, 0xd9, 0xd7, 0x30,
+	0x11, 0x43, 0x3f, 0xf5, 0x9e, 0xa6, 0xb6, 0x99, 0xba, 0x5c, 0x10, 0xc9, 0x5f, 0x9a, 0xec, 0xed,
+	0x7f, 0xfe, 0xfa, 0x89, 0xe5, 0x3e, 0x19, 0x3f, 0xda, 0xe9, 0xd9, 0xa7, 0x77, 0x18, 0x2d, 0xef,
+	0x7f, 0x4f, 0x7a, 0x76, 0xdf, 0x07, 0xfc, 0x6a, 0x7e, 0xa5, 0x65, 0x3d, 0x33, 0xef, 0x93, 0x2a,
+	0x1b, 0xd2, 0xf4, 0x2f, 0xf9, 0x0a, 0xfb, 0xbe, 0x77, 0x8f, 0x02, 0x1e, 0x2d, 0xd2, 0x2e, 0x77,
+	0xff, 0x77, 0x00, 0x49, 0x0a, 0x84, 0x5d, 0x76, 0x45, 0x00, 0x00,
 }
@@ -37,9 +37,10 @@ const (
 
 type WebhookEvent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// one of room_started, room_finished, participant_joined, participant_left,
-	// track_published, track_unpublished, egress_started, egress_updated, egress_ended,
-	// ingress_started, ingress_ended
+	// one of room_started, room_finished, participant_joined, participant_left, participant_reconnected,
+	// participant_moved, track_published, track_unpublished, track_muted, track_unmuted, egress_started,
+	// egress_updated, egress_ended, ingress_started, ingress_ended, sip_call_started, sip_call_ended,
+	// sip_call_failed, agent_job_started, agent_job_ended
 	Event string `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	Room  *Room  `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
 	// set when event is participant_* or track_*
@@ -52,10 +53,40 @@ type WebhookEvent struct {
 	Track *TrackInfo `protobuf:"bytes,8,opt,name=track,proto3" json:"track,omitempty"`
 	// unique event uuid
 	Id string `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`
-	// timestamp in seconds
+	// timestamp in seconds, see created_at_ms for more precision
 	CreatedAt int64 `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Deprecated: Marked as deprecated in livekit_webhook.proto.
-	NumDropped    int32 `protobuf:"varint,11,opt,name=num_dropped,json=numDropped,proto3" json:"num_dropped,omitempty"`
+	NumDropped int32 `protobuf:"varint,11,opt,name=num_dropped,json=numDropped,proto3" json:"num_dropped,omitempty"`
+	// set when event is participant_reconnected
+	ParticipantReconnect *ParticipantReconnect `protobuf:"bytes,12,opt,name=participant_reconnect,json=participantReconnect,proto3" json:"participant_reconnect,omitempty"`
+	// project the event belongs to, empty on single tenant deployments
+	ProjectId string `protobuf:"bytes,13,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// region and node which emitted the event, when set by the notifier
+	Region string `protobuf:"bytes,26,opt,name=region,proto3" json:"region,omitempty"`
+	NodeId string `protobuf:"bytes,27,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// timestamp in milliseconds, same time as created_at with more precision
+	CreatedAtMs int64 `protobuf:"varint,14,opt,name=created_at_ms,json=createdAtMs,proto3" json:"created_at_ms,omitempty"`
+	// set when event is participant_moved
+	ParticipantMove *ParticipantMove `protobuf:"bytes,15,opt,name=participant_move,json=participantMove,proto3" json:"participant_move,omitempty"`
+	// set when event is track_muted or track_unmuted
+	TrackMuteChange *TrackMuteChange `protobuf:"bytes,16,opt,name=track_mute_change,json=trackMuteChange,proto3" json:"track_mute_change,omitempty"`
+	// set when event is sip_call_*. sip_call_ended is sent for calls that were answered,
+	// sip_call_failed for calls that ended with an error or were never answered
+	SipCall *SIPCallInfo `protobuf:"bytes,17,opt,name=sip_call,json=sipCall,proto3" json:"sip_call,omitempty"`
+	// set when event is agent_job_*, with the dispatch and the termination reason of ended jobs
+	AgentJob *Job `protobuf:"bytes,18,opt,name=agent_job,json=agentJob,proto3" json:"agent_job,omitempty"`
+	// subject of the event, set together with the legacy fields above which it duplicates
+	//
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*WebhookEvent_RoomPayload
+	//	*WebhookEvent_ParticipantPayload
+	//	*WebhookEvent_TrackPayload
+	//	*WebhookEvent_EgressPayload
+	//	*WebhookEvent_IngressPayload
+	//	*WebhookEvent_SipPayload
+	//	*WebhookEvent_AgentPayload
+	Payload       isWebhookEvent_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -154,6 +185,529 @@ func (x *WebhookEvent) GetNumDropped() int32 {
 	return 0
 }
 
+func (x *WebhookEvent) GetParticipantReconnect() *ParticipantReconnect {
+	if x != nil {
+		return x.ParticipantReconnect
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetCreatedAtMs() int64 {
+	if x != nil {
+		return x.CreatedAtMs
+	}
+	return 0
+}
+
+func (x *WebhookEvent) GetParticipantMove() *ParticipantMove {
+	if x != nil {
+		return x.ParticipantMove
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetTrackMuteChange() *TrackMuteChange {
+	if x != nil {
+		return x.TrackMuteChange
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetSipCall() *SIPCallInfo {
+	if x != nil {
+		return x.SipCall
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetAgentJob() *Job {
+	if x != nil {
+		return x.AgentJob
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetPayload() isWebhookEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetRoomPayload() *Room {
+	if x != nil {
+		if x, ok := x.Payload.(*WebhookEvent_RoomPayload); ok {
+			return x.RoomPayload
+		}
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetParticipantPayload() *ParticipantWebhookPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*WebhookEvent_ParticipantPayload); ok {
+			return x.ParticipantPayload
+		}
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetTrackPayload() *TrackWebhookPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*WebhookEvent_TrackPayload); ok {
+			return x.TrackPayload
+		}
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetEgressPayload() *EgressInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*WebhookEvent_EgressPayload); ok {
+			return x.EgressPayload
+		}
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetIngressPayload() *IngressInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*WebhookEvent_IngressPayload); ok {
+			return x.IngressPayload
+		}
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetSipPayload() *SIPCallInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*WebhookEvent_SipPayload); ok {
+			return x.SipPayload
+		}
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetAgentPayload() *Job {
+	if x != nil {
+		if x, ok := x.Payload.(*WebhookEvent_AgentPayload); ok {
+			return x.AgentPayload
+		}
+	}
+	return nil
+}
+
+type isWebhookEvent_Payload interface {
+	isWebhookEvent_Payload()
+}
+
+type WebhookEvent_RoomPayload struct {
+	// set when event is room_*
+	RoomPayload *Room `protobuf:"bytes,19,opt,name=room_payload,json=roomPayload,proto3,oneof"`
+}
+
+type WebhookEvent_ParticipantPayload struct {
+	// set when event is participant_*
+	ParticipantPayload *ParticipantWebhookPayload `protobuf:"bytes,20,opt,name=participant_payload,json=participantPayload,proto3,oneof"`
+}
+
+type WebhookEvent_TrackPayload struct {
+	// set when event is track_*
+	TrackPayload *TrackWebhookPayload `protobuf:"bytes,21,opt,name=track_payload,json=trackPayload,proto3,oneof"`
+}
+
+type WebhookEvent_EgressPayload struct {
+	// set when event is egress_*
+	EgressPayload *EgressInfo `protobuf:"bytes,22,opt,name=egress_payload,json=egressPayload,proto3,oneof"`
+}
+
+type WebhookEvent_IngressPayload struct {
+	// set when event is ingress_*
+	IngressPayload *IngressInfo `protobuf:"bytes,23,opt,name=ingress_payload,json=ingressPayload,proto3,oneof"`
+}
+
+type WebhookEvent_SipPayload struct {
+	// set when event is sip_call_*
+	SipPayload *SIPCallInfo `protobuf:"bytes,24,opt,name=sip_payload,json=sipPayload,proto3,oneof"`
+}
+
+type WebhookEvent_AgentPayload struct {
+	// set when event is agent_job_*
+	AgentPayload *Job `protobuf:"bytes,25,opt,name=agent_payload,json=agentPayload,proto3,oneof"`
+}
+
+func (*WebhookEvent_RoomPayload) isWebhookEvent_Payload() {}
+
+func (*WebhookEvent_ParticipantPayload) isWebhookEvent_Payload() {}
+
+func (*WebhookEvent_TrackPayload) isWebhookEvent_Payload() {}
+
+func (*WebhookEvent_EgressPayload) isWebhookEvent_Payload() {}
+
+func (*WebhookEvent_IngressPayload) isWebhookEvent_Payload() {}
+
+func (*WebhookEvent_SipPayload) isWebhookEvent_Payload() {}
+
+func (*WebhookEvent_AgentPayload) isWebhookEvent_Payload() {}
+
+type ParticipantWebhookPayload struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Room        *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	Participant *ParticipantInfo       `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
+	// set when event is participant_reconnected
+	Reconnect *ParticipantReconnect `protobuf:"bytes,3,opt,name=reconnect,proto3" json:"reconnect,omitempty"`
+	// set when event is participant_moved
+	Move          *ParticipantMove `protobuf:"bytes,4,opt,name=move,proto3" json:"move,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantWebhookPayload) Reset() {
+	*x = ParticipantWebhookPayload{}
+	mi := &file_livekit_webhook_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantWebhookPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantWebhookPayload) ProtoMessage() {}
+
+func (x *ParticipantWebhookPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_livekit_webhook_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantWebhookPayload.ProtoReflect.Descriptor instead.
+func (*ParticipantWebhookPayload) Descriptor() ([]byte, []int) {
+	return file_livekit_webhook_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParticipantWebhookPayload) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *ParticipantWebhookPayload) GetParticipant() *ParticipantInfo {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+func (x *ParticipantWebhookPayload) GetReconnect() *ParticipantReconnect {
+	if x != nil {
+		return x.Reconnect
+	}
+	return nil
+}
+
+func (x *ParticipantWebhookPayload) GetMove() *ParticipantMove {
+	if x != nil {
+		return x.Move
+	}
+	return nil
+}
+
+type TrackWebhookPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Room  *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// publisher of the track
+	Participant *ParticipantInfo `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
+	Track       *TrackInfo       `protobuf:"bytes,3,opt,name=track,proto3" json:"track,omitempty"`
+	// set when event is track_muted or track_unmuted
+	MuteChange    *TrackMuteChange `protobuf:"bytes,4,opt,name=mute_change,json=muteChange,proto3" json:"mute_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackWebhookPayload) Reset() {
+	*x = TrackWebhookPayload{}
+	mi := &file_livekit_webhook_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackWebhookPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackWebhookPayload) ProtoMessage() {}
+
+func (x *TrackWebhookPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_livekit_webhook_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackWebhookPayload.ProtoReflect.Descriptor instead.
+func (*TrackWebhookPayload) Descriptor() ([]byte, []int) {
+	return file_livekit_webhook_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TrackWebhookPayload) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *TrackWebhookPayload) GetParticipant() *ParticipantInfo {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+func (x *TrackWebhookPayload) GetTrack() *TrackInfo {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+func (x *TrackWebhookPayload) GetMuteChange() *TrackMuteChange {
+	if x != nil {
+		return x.MuteChange
+	}
+	return nil
+}
+
+// track_muted and track_unmuted are sent with the track after the change, published by participant
+type TrackMuteChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// participant that changed the mute state, the publisher unless muted by another participant
+	// with permission, empty when muted through the RoomService API
+	ActorIdentity string `protobuf:"bytes,1,opt,name=actor_identity,json=actorIdentity,proto3" json:"actor_identity,omitempty"`
+	ActorSid      string `protobuf:"bytes,2,opt,name=actor_sid,json=actorSid,proto3" json:"actor_sid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackMuteChange) Reset() {
+	*x = TrackMuteChange{}
+	mi := &file_livekit_webhook_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackMuteChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackMuteChange) ProtoMessage() {}
+
+func (x *TrackMuteChange) ProtoReflect() protoreflect.Message {
+	mi := &file_livekit_webhook_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackMuteChange.ProtoReflect.Descriptor instead.
+func (*TrackMuteChange) Descriptor() ([]byte, []int) {
+	return file_livekit_webhook_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TrackMuteChange) GetActorIdentity() string {
+	if x != nil {
+		return x.ActorIdentity
+	}
+	return ""
+}
+
+func (x *TrackMuteChange) GetActorSid() string {
+	if x != nil {
+		return x.ActorSid
+	}
+	return ""
+}
+
+// participant_moved is sent for the destination room, the participant is no longer in the source room
+type ParticipantMove struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceRoom    string                 `protobuf:"bytes,1,opt,name=source_room,json=sourceRoom,proto3" json:"source_room,omitempty"`
+	SourceRoomSid string                 `protobuf:"bytes,2,opt,name=source_room_sid,json=sourceRoomSid,proto3" json:"source_room_sid,omitempty"`
+	// sid of the participant in the source room
+	PreviousParticipantSid string `protobuf:"bytes,3,opt,name=previous_participant_sid,json=previousParticipantSid,proto3" json:"previous_participant_sid,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ParticipantMove) Reset() {
+	*x = ParticipantMove{}
+	mi := &file_livekit_webhook_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantMove) ProtoMessage() {}
+
+func (x *ParticipantMove) ProtoReflect() protoreflect.Message {
+	mi := &file_livekit_webhook_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantMove.ProtoReflect.Descriptor instead.
+func (*ParticipantMove) Descriptor() ([]byte, []int) {
+	return file_livekit_webhook_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ParticipantMove) GetSourceRoom() string {
+	if x != nil {
+		return x.SourceRoom
+	}
+	return ""
+}
+
+func (x *ParticipantMove) GetSourceRoomSid() string {
+	if x != nil {
+		return x.SourceRoomSid
+	}
+	return ""
+}
+
+func (x *ParticipantMove) GetPreviousParticipantSid() string {
+	if x != nil {
+		return x.PreviousParticipantSid
+	}
+	return ""
+}
+
+type ParticipantReconnect struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// matches session_id of the participant
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// sid of the connection that was lost
+	PreviousParticipantSid string `protobuf:"bytes,2,opt,name=previous_participant_sid,json=previousParticipantSid,proto3" json:"previous_participant_sid,omitempty"`
+	// sid of the new connection
+	ParticipantSid string `protobuf:"bytes,3,opt,name=participant_sid,json=participantSid,proto3" json:"participant_sid,omitempty"`
+	// time between losing the previous connection and establishing the new one
+	GapMs         int64           `protobuf:"varint,4,opt,name=gap_ms,json=gapMs,proto3" json:"gap_ms,omitempty"`
+	Reason        ReconnectReason `protobuf:"varint,5,opt,name=reason,proto3,enum=livekit.ReconnectReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantReconnect) Reset() {
+	*x = ParticipantReconnect{}
+	mi := &file_livekit_webhook_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantReconnect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantReconnect) ProtoMessage() {}
+
+func (x *ParticipantReconnect) ProtoReflect() protoreflect.Message {
+	mi := &file_livekit_webhook_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantReconnect.ProtoReflect.Descriptor instead.
+func (*ParticipantReconnect) Descriptor() ([]byte, []int) {
+	return file_livekit_webhook_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ParticipantReconnect) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ParticipantReconnect) GetPreviousParticipantSid() string {
+	if x != nil {
+		return x.PreviousParticipantSid
+	}
+	return ""
+}
+
+func (x *ParticipantReconnect) GetParticipantSid() string {
+	if x != nil {
+		return x.ParticipantSid
+	}
+	return ""
+}
+
+func (x *ParticipantReconnect) GetGapMs() int64 {
+	if x != nil {
+		return x.GapMs
+	}
+	return 0
+}
+
+func (x *ParticipantReconnect) GetReason() ReconnectReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReconnectReason_RR_UNKNOWN
+}
+
 var File_livekit_webhook_proto protoreflect.FileDescriptor
 
 var file_livekit_webhook_proto_rawDesc = string([]byte{
@@ -163,35 +717,150 @@ var file_livekit_webhook_proto_rawDesc = string([]byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x5f,
 	0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x6c, 0x69,
 	0x76, 0x65, 0x6b, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xf0, 0x02, 0x0a, 0x0c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f,
-	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b,
-	0x69, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x3a, 0x0a,
-	0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x65, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x37, 0x0a, 0x0c, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x69, 0x6e, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69,
-	0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x72, 0x61,
-	0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x23, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x44,
-	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x42, 0x46, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0xaa, 0x02, 0x0d,
-	0x4c, 0x69, 0x76, 0x65, 0x4b, 0x69, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0xea, 0x02, 0x0e,
-	0x4c, 0x69, 0x76, 0x65, 0x4b, 0x69, 0x74, 0x3a, 0x3a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x5f, 0x73, 0x69, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x5f,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x09, 0x0a, 0x0c,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x76,
+	0x65, 0x6b, 0x69, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x12, 0x34, 0x0a, 0x0b, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74,
+	0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x65, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a, 0x0c, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x28, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0b, 0x6e, 0x75, 0x6d,
+	0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x52,
+	0x0a, 0x15, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x14, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x5f, 0x6d, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x4d, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x44, 0x0a, 0x11, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x5f, 0x6d, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x69, 0x70, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x53, 0x49,
+	0x50, 0x43, 0x61, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x69, 0x70, 0x43, 0x61,
+	0x6c, 0x6c, 0x12, 0x29, 0x0a, 0x09, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e,
+	0x4a, 0x6f, 0x62, 0x52, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x32, 0x0a,
+	0x0c, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x6f, 0x6f, 0x6d, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x55, 0x0a, 0x13, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x48, 0x00, 0x52, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x43, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52,
+	0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3c, 0x0a,
+	0x0e, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e,
+	0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3f, 0x0a, 0x0f, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x49,
+	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x37, 0x0a, 0x0b,
+	0x73, 0x69, 0x70, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x53, 0x49, 0x50, 0x43,
+	0x61, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x70, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x33, 0x0a, 0x0d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c,
+	0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x4a, 0x6f, 0x62, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xe5, 0x01, 0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69,
+	0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12,
+	0x2c, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0xd9, 0x01,
+	0x0a, 0x13, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x39,
+	0x0a, 0x0b, 0x6d, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0a, 0x6d,
+	0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x55, 0x0a, 0x0f, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x64,
+	0x22, 0x94, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x4d, 0x6f, 0x76, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72,
+	0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x69, 0x64, 0x12, 0x38, 0x0a,
+	0x18, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x16, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x53, 0x69, 0x64, 0x22, 0xe1, 0x01, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x38, 0x0a, 0x18, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x16, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x53,
+	0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x61, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x67, 0x61, 0x70, 0x4d, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x76, 0x65,
+	0x6b, 0x69, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x46, 0x5a, 0x23, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69,
+	0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6c, 0x69, 0x76, 0x65, 0x6b,
+	0x69, 0x74, 0xaa, 0x02, 0x0d, 0x4c, 0x69, 0x76, 0x65, 0x4b, 0x69, 0x74, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0xea, 0x02, 0x0e, 0x4c, 0x69, 0x76, 0x65, 0x4b, 0x69, 0x74, 0x3a, 0x3a, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -206,26 +875,55 @@ func file_livekit_webhook_proto_rawDescGZIP() []byte {
 	return file_livekit_webhook_proto_rawDescData
 }
 
-var file_livekit_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_livekit_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_livekit_webhook_proto_goTypes = []any{
-	(*WebhookEvent)(nil),    // 0: livekit.WebhookEvent
-	(*Room)(nil),            // 1: livekit.Room
-	(*ParticipantInfo)(nil), // 2: livekit.ParticipantInfo
-	(*EgressInfo)(nil),      // 3: livekit.EgressInfo
-	(*IngressInfo)(nil),     // 4: livekit.IngressInfo
-	(*TrackInfo)(nil),       // 5: livekit.TrackInfo
+	(*WebhookEvent)(nil),              // 0: livekit.WebhookEvent
+	(*ParticipantWebhookPayload)(nil), // 1: livekit.ParticipantWebhookPayload
+	(*TrackWebhookPayload)(nil),       // 2: livekit.TrackWebhookPayload
+	(*TrackMuteChange)(nil),           // 3: livekit.TrackMuteChange
+	(*ParticipantMove)(nil),           // 4: livekit.ParticipantMove
+	(*ParticipantReconnect)(nil),      // 5: livekit.ParticipantReconnect
+	(*Room)(nil),                      // 6: livekit.Room
+	(*ParticipantInfo)(nil),           // 7: livekit.ParticipantInfo
+	(*EgressInfo)(nil),                // 8: livekit.EgressInfo
+	(*IngressInfo)(nil),               // 9: livekit.IngressInfo
+	(*TrackInfo)(nil),                 // 10: livekit.TrackInfo
+	(*SIPCallInfo)(nil),               // 11: livekit.SIPCallInfo
+	(*Job)(nil),                       // 12: livekit.Job
+	(ReconnectReason)(0),              // 13: livekit.ReconnectReason
 }
 var file_livekit_webhook_proto_depIdxs = []int32{
-	1, // 0: livekit.WebhookEvent.room:type_name -> livekit.Room
-	2, // 1: livekit.WebhookEvent.participant:type_name -> livekit.ParticipantInfo
-	3, // 2: livekit.WebhookEvent.egress_info:type_name -> livekit.EgressInfo
-	4, // 3: livekit.WebhookEvent.ingress_info:type_name -> livekit.IngressInfo
-	5, // 4: livekit.WebhookEvent.track:type_name -> livekit.TrackInfo
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	6,  // 0: livekit.WebhookEvent.room:type_name -> livekit.Room
+	7,  // 1: livekit.WebhookEvent.participant:type_name -> livekit.ParticipantInfo
+	8,  // 2: livekit.WebhookEvent.egress_info:type_name -> livekit.EgressInfo
+	9,  // 3: livekit.WebhookEvent.ingress_info:type_name -> livekit.IngressInfo
+	10, // 4: livekit.WebhookEvent.track:type_name -> livekit.TrackInfo
+	5,  // 5: livekit.WebhookEvent.participant_reconnect:type_name -> livekit.ParticipantReconnect
+	4,  // 6: livekit.WebhookEvent.participant_move:type_name -> livekit.ParticipantMove
+	3,  // 7: livekit.WebhookEvent.track_mute_change:type_name -> livekit.TrackMuteChange
+	11, // 8: livekit.WebhookEvent.sip_call:type_name -> livekit.SIPCallInfo
+	12, // 9: livekit.WebhookEvent.agent_job:type_name -> livekit.Job
+	6,  // 10: livekit.WebhookEvent.room_payload:type_name -> livekit.Room
+	1,  // 11: livekit.WebhookEvent.participant_payload:type_name -> livekit.ParticipantWebhookPayload
+	2,  // 12: livekit.WebhookEvent.track_payload:type_name -> livekit.TrackWebhookPayload
+	8,  // 13: livekit.WebhookEvent.egress_payload:type_name -> livekit.EgressInfo
+	9,  // 14: livekit.WebhookEvent.ingress_payload:type_name -> livekit.IngressInfo
+	11, // 15: livekit.WebhookEvent.sip_payload:type_name -> livekit.SIPCallInfo
+	12, // 16: livekit.WebhookEvent.agent_payload:type_name -> livekit.Job
+	6,  // 17: livekit.ParticipantWebhookPayload.room:type_name -> livekit.Room
+	7,  // 18: livekit.ParticipantWebhookPayload.participant:type_name -> livekit.ParticipantInfo
+	5,  // 19: livekit.ParticipantWebhookPayload.reconnect:type_name -> livekit.ParticipantReconnect
+	4,  // 20: livekit.ParticipantWebhookPayload.move:type_name -> livekit.ParticipantMove
+	6,  // 21: livekit.TrackWebhookPayload.room:type_name -> livekit.Room
+	7,  // 22: livekit.TrackWebhookPayload.participant:type_name -> livekit.ParticipantInfo
+	10, // 23: livekit.TrackWebhookPayload.track:type_name -> livekit.TrackInfo
+	3,  // 24: livekit.TrackWebhookPayload.mute_change:type_name -> livekit.TrackMuteChange
+	13, // 25: livekit.ParticipantReconnect.reason:type_name -> livekit.ReconnectReason
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_livekit_webhook_proto_init() }
@@ -236,13 +934,24 @@ func file_livekit_webhook_proto_init() {
 	file_livekit_models_proto_init()
 	file_livekit_egress_proto_init()
 	file_livekit_ingress_proto_init()
+	file_livekit_sip_proto_init()
+	file_livekit_agent_proto_init()
+	file_livekit_webhook_proto_msgTypes[0].OneofWrappers = []any{
+		(*WebhookEvent_RoomPayload)(nil),
+		(*WebhookEvent_ParticipantPayload)(nil),
+		(*WebhookEvent_TrackPayload)(nil),
+		(*WebhookEvent_EgressPayload)(nil),
+		(*WebhookEvent_IngressPayload)(nil),
+		(*WebhookEvent_SipPayload)(nil),
+		(*WebhookEvent_AgentPayload)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_livekit_webhook_proto_rawDesc), len(file_livekit_webhook_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,89 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"errors"
+	"slices"
+)
+
+var ErrNoCapableMediaWorker = errors.New("no capable worker available")
+
+// Supports checks whether a worker with these capabilities can handle the request.
+// Workers that do not advertise capabilities are assumed to support any request that does not require a GPU.
+func (c *MediaWorkerCapabilities) Supports(r *MediaWorkerRequirements) bool {
+	if r == nil {
+		return true
+	}
+	if c == nil {
+		return !r.RequireGpu
+	}
+	if r.RequireGpu && !c.GpuAvailable {
+		return false
+	}
+	if r.RequestType != "" && len(c.RequestTypes) != 0 && !slices.Contains(c.RequestTypes, r.RequestType) {
+		return false
+	}
+	if r.VideoCodec != VideoCodec_DEFAULT_VC && len(c.VideoCodecs) != 0 && !slices.Contains(c.VideoCodecs, r.VideoCodec) {
+		return false
+	}
+	if r.AudioCodec != AudioCodec_DEFAULT_AC && len(c.AudioCodecs) != 0 && !slices.Contains(c.AudioCodecs, r.AudioCodec) {
+		return false
+	}
+	// compare orientation independent sizes, so that portrait outputs match landscape limits
+	if c.MaxWidth != 0 && c.MaxHeight != 0 {
+		long, short := max(r.Width, r.Height), min(r.Width, r.Height)
+		if long > max(c.MaxWidth, c.MaxHeight) || short > min(c.MaxWidth, c.MaxHeight) {
+			return false
+		}
+	}
+	if c.MaxFramerate != 0 && r.Framerate > c.MaxFramerate {
+		return false
+	}
+	return true
+}
+
+// CanAccept checks whether a worker with this load has room for the request.
+func (l *MediaWorkerLoad) CanAccept(r *MediaWorkerRequirements) bool {
+	if l == nil {
+		return true
+	}
+	if l.MaxRequests != 0 && l.ActiveRequests >= l.MaxRequests {
+		return false
+	}
+	return l.AvailableCpu >= r.GetEstimatedCpu()
+}
+
+// CanAccept checks whether the worker is both capable of handling the request and has capacity for it.
+func (w *MediaWorkerInfo) CanAccept(r *MediaWorkerRequirements) bool {
+	return w.GetCapabilities().Supports(r) && w.GetLoad().CanAccept(r)
+}
+
+// SelectMediaWorker picks the least loaded worker that can accept the request.
+func SelectMediaWorker(workers []*MediaWorkerInfo, r *MediaWorkerRequirements) (*MediaWorkerInfo, error) {
+	var best *MediaWorkerInfo
+	for _, w := range workers {
+		if !w.CanAccept(r) {
+			continue
+		}
+		if best == nil || w.GetLoad().GetAvailableCpu() > best.GetLoad().GetAvailableCpu() {
+			best = w
+		}
+	}
+	if best == nil {
+		return nil, ErrNoCapableMediaWorker
+	}
+	return best, nil
+}
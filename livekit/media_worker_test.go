@@ -0,0 +1,91 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectMediaWorker(t *testing.T) {
+	legacy := &MediaWorkerInfo{
+		WorkerId: "legacy",
+		Load:     &MediaWorkerLoad{AvailableCpu: 8},
+	}
+	cpu := &MediaWorkerInfo{
+		WorkerId: "cpu",
+		Capabilities: &MediaWorkerCapabilities{
+			RequestTypes: []string{"room_composite", "track"},
+			VideoCodecs:  []VideoCodec{VideoCodec_H264_MAIN, VideoCodec_H264_BASELINE},
+			MaxWidth:     1920,
+			MaxHeight:    1080,
+			MaxFramerate: 30,
+		},
+		Load: &MediaWorkerLoad{AvailableCpu: 4},
+	}
+	gpu := &MediaWorkerInfo{
+		WorkerId: "gpu",
+		Capabilities: &MediaWorkerCapabilities{
+			GpuAvailable: true,
+			MaxWidth:     3840,
+			MaxHeight:    2160,
+		},
+		Load: &MediaWorkerLoad{AvailableCpu: 2, ActiveRequests: 1, MaxRequests: 2},
+	}
+
+	t.Run("capabilities", func(t *testing.T) {
+		req := &MediaWorkerRequirements{RequestType: "room_composite", Width: 1080, Height: 1920, Framerate: 30}
+		require.True(t, cpu.Capabilities.Supports(req))
+		require.True(t, legacy.Capabilities.Supports(req))
+
+		req.Framerate = 60
+		require.False(t, cpu.Capabilities.Supports(req))
+
+		req = &MediaWorkerRequirements{RequestType: "web"}
+		require.False(t, cpu.Capabilities.Supports(req))
+
+		req = &MediaWorkerRequirements{VideoCodec: VideoCodec_VP8}
+		require.False(t, cpu.Capabilities.Supports(req))
+		require.True(t, gpu.Capabilities.Supports(req))
+
+		req = &MediaWorkerRequirements{RequireGpu: true}
+		require.False(t, legacy.Capabilities.Supports(req))
+		require.True(t, gpu.Capabilities.Supports(req))
+	})
+
+	t.Run("select", func(t *testing.T) {
+		workers := []*MediaWorkerInfo{cpu, gpu, legacy}
+
+		w, err := SelectMediaWorker(workers, &MediaWorkerRequirements{RequestType: "track", EstimatedCpu: 1})
+		require.NoError(t, err)
+		require.Equal(t, "legacy", w.WorkerId)
+
+		w, err = SelectMediaWorker(workers, &MediaWorkerRequirements{Width: 3840, Height: 2160, EstimatedCpu: 1})
+		require.NoError(t, err)
+		require.Equal(t, "legacy", w.WorkerId)
+
+		w, err = SelectMediaWorker(workers, &MediaWorkerRequirements{RequireGpu: true, EstimatedCpu: 1})
+		require.NoError(t, err)
+		require.Equal(t, "gpu", w.WorkerId)
+
+		_, err = SelectMediaWorker(workers, &MediaWorkerRequirements{RequireGpu: true, EstimatedCpu: 3})
+		require.ErrorIs(t, err, ErrNoCapableMediaWorker)
+
+		gpu.Load.ActiveRequests = 2
+		_, err = SelectMediaWorker(workers, &MediaWorkerRequirements{RequireGpu: true})
+		require.ErrorIs(t, err, ErrNoCapableMediaWorker)
+	})
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+// ProjectScoped is implemented by resources and requests carrying a project ID.
+type ProjectScoped interface {
+	GetProjectId() string
+}
+
+var (
+	_ ProjectScoped = (*Room)(nil)
+	_ ProjectScoped = (*EgressInfo)(nil)
+	_ ProjectScoped = (*IngressInfo)(nil)
+	_ ProjectScoped = (*SIPInboundTrunkInfo)(nil)
+	_ ProjectScoped = (*SIPOutboundTrunkInfo)(nil)
+	_ ProjectScoped = (*SIPDispatchRuleInfo)(nil)
+	_ ProjectScoped = (*SIPCallInfo)(nil)
+	_ ProjectScoped = (*WebhookEvent)(nil)
+)
+
+// InProject returns true if the resource belongs to the project. An empty project ID matches any resource.
+func InProject(v ProjectScoped, projectID string) bool {
+	return projectID == "" || v.GetProjectId() == projectID
+}
+
+// FilterByProject returns the items belonging to the project. An empty project ID keeps all items.
+func FilterByProject[T ProjectScoped](arr []T, projectID string) []T {
+	if projectID == "" {
+		return arr
+	}
+	return filterSlice(arr, func(v T) bool {
+		return v.GetProjectId() == projectID
+	})
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+var DefaultReconnectPolicy = &ReconnectPolicy{
+	MinBackoffMs:  500,
+	MaxBackoffMs:  30000,
+	Multiplier:    2,
+	Jitter:        0.5,
+	MaxAttempts:   10,
+	MaxDurationMs: 120000,
+}
+
+// WithDefaults returns a copy of the policy with unset fields filled from DefaultReconnectPolicy.
+// MaxBackoffMs is raised to MinBackoffMs when lower. A negative Jitter disables jitter and is kept as is.
+func (p *ReconnectPolicy) WithDefaults() *ReconnectPolicy {
+	res := &ReconnectPolicy{
+		MinBackoffMs:       p.GetMinBackoffMs(),
+		MaxBackoffMs:       p.GetMaxBackoffMs(),
+		Multiplier:         p.GetMultiplier(),
+		Jitter:             p.GetJitter(),
+		MaxAttempts:        p.GetMaxAttempts(),
+		MaxDurationMs:      p.GetMaxDurationMs(),
+		MaintenanceWindows: p.GetMaintenanceWindows(),
+	}
+	if res.MinBackoffMs == 0 {
+		res.MinBackoffMs = DefaultReconnectPolicy.MinBackoffMs
+	}
+	if res.MaxBackoffMs == 0 {
+		res.MaxBackoffMs = DefaultReconnectPolicy.MaxBackoffMs
+	}
+	res.MaxBackoffMs = max(res.MaxBackoffMs, res.MinBackoffMs)
+	if res.Multiplier < 1 {
+		res.Multiplier = DefaultReconnectPolicy.Multiplier
+	}
+	if res.Jitter == 0 || res.Jitter > 1 {
+		res.Jitter = DefaultReconnectPolicy.Jitter
+	}
+	if res.MaxAttempts == 0 {
+		res.MaxAttempts = DefaultReconnectPolicy.MaxAttempts
+	}
+	if res.MaxDurationMs == 0 {
+		res.MaxDurationMs = DefaultReconnectPolicy.MaxDurationMs
+	}
+	return res
+}
+
+// Backoff returns the randomized delay before the given reconnect attempt, starting at 0.
+// The delay grows exponentially from MinBackoffMs, is capped at MaxBackoffMs and reduced by up to Jitter of its value.
+func (p *ReconnectPolicy) Backoff(attempt int) time.Duration {
+	return p.backoff(attempt, rand.Float64())
+}
+
+func (p *ReconnectPolicy) backoff(attempt int, r float64) time.Duration {
+	p = p.WithDefaults()
+	delay := float64(p.MinBackoffMs) * math.Pow(float64(p.Multiplier), float64(max(attempt, 0)))
+	delay = min(delay, float64(p.MaxBackoffMs))
+	delay -= delay * float64(max(p.Jitter, 0)) * r
+	return time.Duration(delay * float64(time.Millisecond))
+}
+
+// CanRetry reports whether another attempt fits in the retry budget.
+// attempt is the number of attempts made so far and elapsed the time since the connection was lost.
+func (p *ReconnectPolicy) CanRetry(attempt int, elapsed time.Duration) bool {
+	p = p.WithDefaults()
+	return attempt < int(p.MaxAttempts) && elapsed < time.Duration(p.MaxDurationMs)*time.Millisecond
+}
+
+// NextAttemptAt returns the time of the given reconnect attempt, moved past any maintenance window it would fall into.
+func (p *ReconnectPolicy) NextAttemptAt(now time.Time, attempt int) time.Time {
+	at := now.Add(p.Backoff(attempt))
+	for moved := true; moved; {
+		moved = false
+		for _, w := range p.GetMaintenanceWindows() {
+			if w.Contains(at) {
+				at = w.EndTime()
+				moved = true
+			}
+		}
+	}
+	return at
+}
+
+func (w *MaintenanceWindow) StartTime() time.Time {
+	return time.UnixMilli(w.StartAt)
+}
+
+func (w *MaintenanceWindow) EndTime() time.Time {
+	return time.UnixMilli(w.EndAt)
+}
+
+func (w *MaintenanceWindow) Contains(t time.Time) bool {
+	return !t.Before(w.StartTime()) && t.Before(w.EndTime())
+}
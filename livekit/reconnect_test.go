@@ -0,0 +1,87 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconnectPolicy(t *testing.T) {
+	t.Run("backoff", func(t *testing.T) {
+		p := &ReconnectPolicy{
+			MinBackoffMs: 100,
+			MaxBackoffMs: 1000,
+			Multiplier:   2,
+			Jitter:       0.5,
+		}
+		require.Equal(t, 100*time.Millisecond, p.backoff(0, 0))
+		require.Equal(t, 400*time.Millisecond, p.backoff(2, 0))
+		require.Equal(t, 200*time.Millisecond, p.backoff(2, 1))
+		require.Equal(t, time.Second, p.backoff(10, 0))
+
+		for i := 0; i < 100; i++ {
+			d := p.Backoff(3)
+			require.GreaterOrEqual(t, d, 400*time.Millisecond)
+			require.LessOrEqual(t, d, 800*time.Millisecond)
+		}
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		var p *ReconnectPolicy
+		require.Equal(t, DefaultReconnectPolicy.MinBackoffMs, p.WithDefaults().MinBackoffMs)
+		require.Equal(t, 500*time.Millisecond, p.backoff(0, 0))
+
+		// max backoff is at least min backoff
+		p = &ReconnectPolicy{MinBackoffMs: 2000, MaxBackoffMs: 1000}
+		require.Equal(t, uint32(2000), p.WithDefaults().MaxBackoffMs)
+		require.Equal(t, 2*time.Second, p.backoff(3, 0))
+	})
+
+	t.Run("without jitter", func(t *testing.T) {
+		p := &ReconnectPolicy{MinBackoffMs: 100, Jitter: -1}
+		require.Equal(t, float32(-1), p.WithDefaults().WithDefaults().Jitter)
+		require.Equal(t, 100*time.Millisecond, p.backoff(0, 1))
+		require.Equal(t, 100*time.Millisecond, p.Backoff(0))
+	})
+
+	t.Run("retry budget", func(t *testing.T) {
+		p := &ReconnectPolicy{MaxAttempts: 3, MaxDurationMs: 1000}
+		require.True(t, p.CanRetry(2, 500*time.Millisecond))
+		require.False(t, p.CanRetry(3, 500*time.Millisecond))
+		require.False(t, p.CanRetry(1, time.Second))
+	})
+
+	t.Run("maintenance windows", func(t *testing.T) {
+		now := time.UnixMilli(1_000_000)
+		p := &ReconnectPolicy{
+			MinBackoffMs: 100,
+			Jitter:       1,
+			MaintenanceWindows: []*MaintenanceWindow{
+				{StartAt: 1_003_000, EndAt: 1_004_000},
+				{StartAt: 1_000_000, EndAt: 1_003_000},
+			},
+		}
+		at := p.NextAttemptAt(now, 0)
+		require.Equal(t, time.UnixMilli(1_004_000), at)
+
+		p.MaintenanceWindows = nil
+		at = p.NextAttemptAt(now, 0)
+		require.False(t, at.Before(now))
+		require.False(t, at.After(now.Add(100*time.Millisecond)))
+	})
+}
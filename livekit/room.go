@@ -0,0 +1,94 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func (p *MoveParticipantRequest) Validate() error {
+	if p.Room == "" {
+		return errors.New("missing room")
+	}
+	if p.Identity == "" {
+		return errors.New("missing identity")
+	}
+	if p.DestinationRoom == "" {
+		return errors.New("missing destination room")
+	}
+	if p.DestinationRoom == p.Room {
+		return errors.New("destination room must be different from room")
+	}
+	return nil
+}
+
+// MovedParticipant returns the state of the participant in the destination room.
+// Identity, metadata, published tracks and attributes are carried over, with the permission
+// and attribute changes of the request applied. The server assigns a new sid.
+func (p *MoveParticipantRequest) MovedParticipant(info *ParticipantInfo) *ParticipantInfo {
+	moved := proto.Clone(info).(*ParticipantInfo)
+	moved.Sid = ""
+	if p.Permission != nil {
+		moved.Permission = proto.Clone(p.Permission).(*ParticipantPermission)
+	}
+	if len(p.Attributes) > 0 && moved.Attributes == nil {
+		moved.Attributes = make(map[string]string, len(p.Attributes))
+	}
+	for k, v := range p.Attributes {
+		if v == "" {
+			delete(moved.Attributes, k)
+		} else {
+			moved.Attributes[k] = v
+		}
+	}
+	return moved
+}
+
+// Merge returns a copy of the preset with every field set in override replacing the preset value.
+// Lists and messages are replaced as a whole rather than merged, so an override with a single agent
+// dispatches only that agent.
+func (c *RoomConfiguration) Merge(override *RoomConfiguration) *RoomConfiguration {
+	merged := &RoomConfiguration{}
+	if c != nil {
+		merged = proto.Clone(c).(*RoomConfiguration)
+	}
+	if override == nil {
+		return merged
+	}
+	dst := merged.ProtoReflect()
+	proto.Clone(override).ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		dst.Set(fd, v)
+		return true
+	})
+	return merged
+}
+
+// RoomConfiguration returns the configuration of the room created by the request,
+// the preset named by RoomPreset with the parameters set in the request applied over it.
+func (p *CreateRoomRequest) RoomConfiguration(preset *RoomConfiguration) *RoomConfiguration {
+	return preset.Merge(&RoomConfiguration{
+		EmptyTimeout:     p.EmptyTimeout,
+		DepartureTimeout: p.DepartureTimeout,
+		MaxParticipants:  p.MaxParticipants,
+		Egress:           p.Egress,
+		MinPlayoutDelay:  p.MinPlayoutDelay,
+		MaxPlayoutDelay:  p.MaxPlayoutDelay,
+		SyncStreams:      p.SyncStreams,
+		Agents:           p.Agents,
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMoveParticipantRequest(t *testing.T) {
+	req := &MoveParticipantRequest{Room: "lobby", Identity: "caller", DestinationRoom: "agent"}
+	require.NoError(t, req.Validate())
+	require.Error(t, (&MoveParticipantRequest{Room: "lobby", Identity: "caller"}).Validate())
+	require.Error(t, (&MoveParticipantRequest{Room: "lobby", Identity: "caller", DestinationRoom: "lobby"}).Validate())
+
+	info := &ParticipantInfo{
+		Sid:        "PA_1",
+		Identity:   "caller",
+		Permission: &ParticipantPermission{CanPublish: true},
+		Attributes: map[string]string{"a": "1", "b": "2"},
+	}
+	req.Permission = &ParticipantPermission{CanSubscribe: true}
+	req.Attributes = map[string]string{"b": "", "c": "3"}
+
+	moved := req.MovedParticipant(info)
+	require.Empty(t, moved.Sid)
+	require.Equal(t, "caller", moved.Identity)
+	require.True(t, moved.Permission.CanSubscribe)
+	require.False(t, moved.Permission.CanPublish)
+	require.Equal(t, map[string]string{"a": "1", "c": "3"}, moved.Attributes)
+
+	// original is left untouched
+	require.Equal(t, "PA_1", info.Sid)
+	require.Equal(t, map[string]string{"a": "1", "b": "2"}, info.Attributes)
+}
+
+func TestRoomConfigurationMerge(t *testing.T) {
+	preset := &RoomConfiguration{
+		Name:            "support",
+		EmptyTimeout:    300,
+		MaxParticipants: 10,
+		Agents: []*RoomAgentDispatch{
+			{AgentName: "greeter"},
+			{AgentName: "notetaker"},
+		},
+		EnabledCodecs: []*Codec{{Mime: "video/vp8"}, {Mime: "audio/opus"}},
+	}
+
+	merged := preset.Merge(&RoomConfiguration{
+		MaxParticipants: 2,
+		Agents:          []*RoomAgentDispatch{{AgentName: "triage"}},
+	})
+	require.Equal(t, "support", merged.Name)
+	require.Equal(t, uint32(300), merged.EmptyTimeout)
+	require.Equal(t, uint32(2), merged.MaxParticipants)
+	require.Len(t, merged.Agents, 1)
+	require.Equal(t, "triage", merged.Agents[0].AgentName)
+	require.Len(t, merged.EnabledCodecs, 2)
+
+	// preset is left untouched
+	require.Equal(t, uint32(10), preset.MaxParticipants)
+	require.Len(t, preset.Agents, 2)
+
+	require.True(t, proto.Equal(preset, preset.Merge(nil)))
+	require.True(t, proto.Equal(preset, (*RoomConfiguration)(nil).Merge(preset)))
+
+	req := &CreateRoomRequest{Name: "ticket-1", RoomPreset: "support", DepartureTimeout: 20, SyncStreams: true}
+	conf := req.RoomConfiguration(preset)
+	require.Equal(t, uint32(300), conf.EmptyTimeout)
+	require.Equal(t, uint32(20), conf.DepartureTimeout)
+	require.Equal(t, uint32(10), conf.MaxParticipants)
+	require.True(t, conf.SyncStreams)
+	require.Len(t, conf.Agents, 2)
+}
@@ -680,6 +680,9 @@ func (p *ListSIPInboundTrunkRequest) Filter(info *SIPInboundTrunkInfo) bool {
 	if info == nil {
 		return true // for FilterSlice to work correctly with missing IDs
 	}
+	if !InProject(info, p.ProjectId) {
+		return false
+	}
 	if len(p.TrunkIds) != 0 && !slices.Contains(p.TrunkIds, info.SipTrunkId) {
 		return false
 	}
@@ -709,6 +712,9 @@ func (p *ListSIPOutboundTrunkRequest) Filter(info *SIPOutboundTrunkInfo) bool {
 	if info == nil {
 		return true // for FilterSlice to work correctly with missing IDs
 	}
+	if !InProject(info, p.ProjectId) {
+		return false
+	}
 	if len(p.TrunkIds) != 0 && !slices.Contains(p.TrunkIds, info.SipTrunkId) {
 		return false
 	}
@@ -738,6 +744,9 @@ func (p *ListSIPDispatchRuleRequest) Filter(info *SIPDispatchRuleInfo) bool {
 	if info == nil {
 		return true // for FilterSlice to work correctly with missing IDs
 	}
+	if !InProject(info, p.ProjectId) {
+		return false
+	}
 	if len(p.DispatchRuleIds) != 0 && !slices.Contains(p.DispatchRuleIds, info.SipDispatchRuleId) {
 		return false
 	}
@@ -762,3 +771,44 @@ func (p *ListSIPDispatchRuleRequest) FilterSlice(arr []*SIPDispatchRuleInfo) []*
 	})
 	return filterSlice(arr, p.Filter)
 }
+
+// ValidationWarnings returns non-fatal issues of a valid request.
+func (p *CreateSIPInboundTrunkRequest) ValidationWarnings() []*ValidationWarning {
+	var w ValidationWarnings
+	t := p.GetTrunk()
+	hasAuth := t.GetAuthUsername() != "" || t.GetAuthPassword() != ""
+	if !hasAuth && len(t.GetAllowedAddresses()) == 0 {
+		w.Add("trunk.allowed_addresses", ValidationWarningInsecure, "trunk accepts calls from any address to its numbers")
+	}
+	if hasAuth && (t.GetAuthUsername() == "" || t.GetAuthPassword() == "") {
+		w.Add("trunk.auth_password", ValidationWarningInsecure, "only one of auth username and password is set")
+	}
+	return w
+}
+
+// ValidationWarnings returns non-fatal issues of a valid request.
+func (p *CreateSIPOutboundTrunkRequest) ValidationWarnings() []*ValidationWarning {
+	var w ValidationWarnings
+	t := p.GetTrunk()
+	if t.GetAuthUsername() == "" && t.GetAuthPassword() == "" {
+		w.Add("trunk.auth_username", ValidationWarningServerDefault, "no credentials set, calls will not be authenticated")
+	}
+	if t.GetTransport() == SIPTransport_SIP_TRANSPORT_AUTO {
+		w.Add("trunk.transport", ValidationWarningServerDefault, "transport is selected automatically")
+	}
+	return w
+}
+
+// ValidationWarnings returns non-fatal issues of a valid request.
+func (p *CreateSIPDispatchRuleRequest) ValidationWarnings() []*ValidationWarning {
+	var w ValidationWarnings
+	prefix := "dispatch_rule."
+	if p.DispatchRule == nil {
+		prefix = ""
+		w.Add("dispatch_rule", ValidationWarningDeprecatedField, "rule fields should be set in dispatch_rule")
+	}
+	if len(p.DispatchRuleInfo().TrunkIds) == 0 {
+		w.Add(prefix+"trunk_ids", ValidationWarningBroadMatch, "rule applies to calls from all inbound trunks")
+	}
+	return w
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"errors"
+	"time"
+
+	"github.com/livekit/psrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+const DefaultSIPCredentialRotationOverlap = 24 * time.Hour
+
+var (
+	ErrSIPRotationNotActive = errors.New("no credential rotation in overlap window")
+	// rotating again during the overlap would invalidate the previous credentials without warning
+	ErrSIPRotationInProgress = psrpc.NewErrorf(psrpc.FailedPrecondition, "credential rotation in overlap window, complete or roll it back first")
+)
+
+func (p *RotateSIPTrunkCredentialsRequest) Validate() error {
+	if p.SipTrunkId == "" {
+		return errors.New("trunk id must be set")
+	}
+	if p.Credentials == nil || (p.Credentials.AuthUsername == "" && p.Credentials.AuthPassword == "") {
+		return errors.New("new credentials must be set")
+	}
+	if p.Overlap != nil && p.Overlap.AsDuration() < 0 {
+		return errors.New("overlap must not be negative")
+	}
+	return nil
+}
+
+func (p *RotateSIPTrunkCredentialsRequest) overlap() time.Duration {
+	if p.Overlap == nil {
+		return DefaultSIPCredentialRotationOverlap
+	}
+	return p.Overlap.AsDuration()
+}
+
+// StatusAt returns the rotation status at a given time, the overlap ends without updating the rotation.
+func (r *SIPTrunkCredentialRotation) StatusAt(now time.Time) SIPCredentialRotationStatus {
+	if r == nil {
+		return SIPCredentialRotationStatus_SIP_CREDENTIAL_ROTATION_NONE
+	}
+	if r.Status == SIPCredentialRotationStatus_SIP_CREDENTIAL_ROTATION_OVERLAP && now.UnixNano() >= r.OverlapEndsAtNs {
+		return SIPCredentialRotationStatus_SIP_CREDENTIAL_ROTATION_COMPLETE
+	}
+	return r.Status
+}
+
+// Redacted returns a copy of the rotation without the previous password, for API responses.
+func (r *SIPTrunkCredentialRotation) Redacted() *SIPTrunkCredentialRotation {
+	if r == nil {
+		return nil
+	}
+	c := proto.Clone(r).(*SIPTrunkCredentialRotation)
+	if c.PreviousCredentials != nil {
+		c.PreviousCredentials.AuthPassword = ""
+	}
+	return c
+}
+
+// RotateCredentials replaces trunk credentials, keeping current ones valid during the overlap window.
+func (p *SIPInboundTrunkInfo) RotateCredentials(req *RotateSIPTrunkCredentialsRequest, now time.Time) error {
+	return rotateSIPCredentials(&p.AuthUsername, &p.AuthPassword, &p.CredentialRotation, p.SipTrunkId, req, now)
+}
+
+// RollbackCredentials restores trunk credentials replaced by a rotation that is still in the overlap window.
+func (p *SIPInboundTrunkInfo) RollbackCredentials(now time.Time) error {
+	return rollbackSIPCredentials(&p.AuthUsername, &p.AuthPassword, p.CredentialRotation, now)
+}
+
+// CompleteCredentialRotation ends the overlap window early, previous credentials stop being accepted.
+func (p *SIPInboundTrunkInfo) CompleteCredentialRotation(now time.Time) error {
+	return completeSIPCredentialRotation(p.CredentialRotation, now)
+}
+
+// ValidCredentials returns credentials accepted for the trunk, current credentials first.
+func (p *SIPInboundTrunkInfo) ValidCredentials(now time.Time) []*SIPTrunkCredentials {
+	return validSIPCredentials(p.AuthUsername, p.AuthPassword, p.CredentialRotation, now)
+}
+
+// RotateCredentials replaces trunk credentials, keeping current ones valid during the overlap window.
+func (p *SIPOutboundTrunkInfo) RotateCredentials(req *RotateSIPTrunkCredentialsRequest, now time.Time) error {
+	return rotateSIPCredentials(&p.AuthUsername, &p.AuthPassword, &p.CredentialRotation, p.SipTrunkId, req, now)
+}
+
+// RollbackCredentials restores trunk credentials replaced by a rotation that is still in the overlap window.
+func (p *SIPOutboundTrunkInfo) RollbackCredentials(now time.Time) error {
+	return rollbackSIPCredentials(&p.AuthUsername, &p.AuthPassword, p.CredentialRotation, now)
+}
+
+// CompleteCredentialRotation ends the overlap window early, previous credentials stop being accepted.
+func (p *SIPOutboundTrunkInfo) CompleteCredentialRotation(now time.Time) error {
+	return completeSIPCredentialRotation(p.CredentialRotation, now)
+}
+
+// ValidCredentials returns credentials accepted for the trunk, current credentials first.
+func (p *SIPOutboundTrunkInfo) ValidCredentials(now time.Time) []*SIPTrunkCredentials {
+	return validSIPCredentials(p.AuthUsername, p.AuthPassword, p.CredentialRotation, now)
+}
+
+func rotateSIPCredentials(user, pass *string, rotation **SIPTrunkCredentialRotation, trunkID string, req *RotateSIPTrunkCredentialsRequest, now time.Time) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+	if (*rotation).StatusAt(now) == SIPCredentialRotationStatus_SIP_CREDENTIAL_ROTATION_OVERLAP {
+		return ErrSIPRotationInProgress
+	}
+	*rotation = &SIPTrunkCredentialRotation{
+		SipTrunkId: trunkID,
+		Status:     SIPCredentialRotationStatus_SIP_CREDENTIAL_ROTATION_OVERLAP,
+		PreviousCredentials: &SIPTrunkCredentials{
+			AuthUsername: *user,
+			AuthPassword: *pass,
+		},
+		StartedAtNs:     now.UnixNano(),
+		OverlapEndsAtNs: now.Add(req.overlap()).UnixNano(),
+	}
+	*user = req.Credentials.AuthUsername
+	*pass = req.Credentials.AuthPassword
+	return nil
+}
+
+func rollbackSIPCredentials(user, pass *string, rotation *SIPTrunkCredentialRotation, now time.Time) error {
+	if rotation.StatusAt(now) != SIPCredentialRotationStatus_SIP_CREDENTIAL_ROTATION_OVERLAP {
+		return ErrSIPRotationNotActive
+	}
+	prev := rotation.PreviousCredentials
+	*user, *pass = prev.GetAuthUsername(), prev.GetAuthPassword()
+	rotation.Status = SIPCredentialRotationStatus_SIP_CREDENTIAL_ROTATION_ROLLED_BACK
+	rotation.PreviousCredentials = nil
+	rotation.OverlapEndsAtNs = now.UnixNano()
+	return nil
+}
+
+func completeSIPCredentialRotation(rotation *SIPTrunkCredentialRotation, now time.Time) error {
+	if rotation.StatusAt(now) != SIPCredentialRotationStatus_SIP_CREDENTIAL_ROTATION_OVERLAP {
+		return ErrSIPRotationNotActive
+	}
+	rotation.Status = SIPCredentialRotationStatus_SIP_CREDENTIAL_ROTATION_COMPLETE
+	rotation.PreviousCredentials = nil
+	rotation.OverlapEndsAtNs = now.UnixNano()
+	return nil
+}
+
+func validSIPCredentials(user, pass string, rotation *SIPTrunkCredentialRotation, now time.Time) []*SIPTrunkCredentials {
+	creds := []*SIPTrunkCredentials{{AuthUsername: user, AuthPassword: pass}}
+	if rotation.StatusAt(now) == SIPCredentialRotationStatus_SIP_CREDENTIAL_ROTATION_OVERLAP && rotation.PreviousCredentials != nil {
+		creds = append(creds, rotation.PreviousCredentials)
+	}
+	return creds
+}
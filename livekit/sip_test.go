@@ -3,11 +3,13 @@ package livekit
 import (
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestSIPTrunkAs(t *testing.T) {
@@ -408,3 +410,74 @@ func TestDispatchRuleUpdate(t *testing.T) {
 		},
 	}, r)
 }
+
+func TestSIPTrunkCredentialRotation(t *testing.T) {
+	now := time.Unix(1000, 0)
+	tr := &SIPInboundTrunkInfo{SipTrunkId: "ST_1", AuthUsername: "old", AuthPassword: "oldpass"}
+
+	err := tr.RotateCredentials(&RotateSIPTrunkCredentialsRequest{SipTrunkId: "ST_1"}, now)
+	require.Error(t, err)
+
+	err = tr.RotateCredentials(&RotateSIPTrunkCredentialsRequest{
+		SipTrunkId:  "ST_1",
+		Credentials: &SIPTrunkCredentials{AuthUsername: "new", AuthPassword: "newpass"},
+		Overlap:     durationpb.New(time.Hour),
+	}, now)
+	require.NoError(t, err)
+	require.Equal(t, "new", tr.AuthUsername)
+	require.Equal(t, SIPCredentialRotationStatus_SIP_CREDENTIAL_ROTATION_OVERLAP, tr.CredentialRotation.StatusAt(now))
+	require.Len(t, tr.ValidCredentials(now), 2)
+	require.Equal(t, "", tr.CredentialRotation.Redacted().PreviousCredentials.AuthPassword)
+	require.Equal(t, "oldpass", tr.CredentialRotation.PreviousCredentials.AuthPassword)
+
+	later := now.Add(2 * time.Hour)
+	require.Equal(t, SIPCredentialRotationStatus_SIP_CREDENTIAL_ROTATION_COMPLETE, tr.CredentialRotation.StatusAt(later))
+	require.Len(t, tr.ValidCredentials(later), 1)
+	require.ErrorIs(t, tr.RollbackCredentials(later), ErrSIPRotationNotActive)
+
+	require.NoError(t, tr.RollbackCredentials(now.Add(time.Minute)))
+	require.Equal(t, "old", tr.AuthUsername)
+	require.Equal(t, "oldpass", tr.AuthPassword)
+	require.Equal(t, SIPCredentialRotationStatus_SIP_CREDENTIAL_ROTATION_ROLLED_BACK, tr.CredentialRotation.Status)
+	require.Len(t, tr.ValidCredentials(now), 1)
+
+	// a second rotation has to wait for the overlap of the first to end
+	rotate := func(user string, now time.Time) error {
+		return tr.RotateCredentials(&RotateSIPTrunkCredentialsRequest{
+			SipTrunkId:  "ST_1",
+			Credentials: &SIPTrunkCredentials{AuthUsername: user, AuthPassword: user + "pass"},
+			Overlap:     durationpb.New(time.Hour),
+		}, now)
+	}
+	require.NoError(t, rotate("new", now))
+	require.ErrorIs(t, rotate("newer", now.Add(time.Minute)), ErrSIPRotationInProgress)
+	require.Equal(t, "old", tr.CredentialRotation.PreviousCredentials.AuthUsername)
+
+	require.NoError(t, tr.CompleteCredentialRotation(now.Add(time.Minute)))
+	require.Equal(t, SIPCredentialRotationStatus_SIP_CREDENTIAL_ROTATION_COMPLETE, tr.CredentialRotation.Status)
+	require.Len(t, tr.ValidCredentials(now.Add(time.Minute)), 1)
+	require.ErrorIs(t, tr.CompleteCredentialRotation(now.Add(time.Minute)), ErrSIPRotationNotActive)
+	require.NoError(t, rotate("newer", now.Add(time.Minute)))
+	require.Equal(t, "new", tr.CredentialRotation.PreviousCredentials.AuthUsername)
+}
+
+func TestSIPValidationWarnings(t *testing.T) {
+	in := &CreateSIPInboundTrunkRequest{
+		Trunk:        &SIPInboundTrunkInfo{Numbers: []string{"+1"}},
+		ValidateOnly: true,
+	}
+	require.NoError(t, in.Validate())
+	require.True(t, IsValidateOnly(in))
+	w := in.ValidationWarnings()
+	require.Len(t, w, 1)
+	require.Equal(t, ValidationWarningInsecure, w[0].Code)
+
+	in.Trunk.AuthUsername, in.Trunk.AuthPassword = "user", "pass"
+	require.Empty(t, in.ValidationWarnings())
+
+	rule := &CreateSIPDispatchRuleRequest{DispatchRule: &SIPDispatchRuleInfo{}}
+	w = rule.ValidationWarnings()
+	require.Len(t, w, 1)
+	require.Equal(t, "dispatch_rule.trunk_ids", w[0].Field)
+	require.Equal(t, ValidationWarningBroadMatch, w[0].Code)
+}
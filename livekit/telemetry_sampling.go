@@ -0,0 +1,64 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"time"
+)
+
+// Rule returns the rule for the label, falling back to the default rule. Nil means no sampling.
+func (p *TelemetrySamplingPolicy) Rule(label MetricLabel) *TelemetrySamplingRule {
+	for _, r := range p.GetRules() {
+		if r.Label == label {
+			return r
+		}
+	}
+	return p.GetDefaultRule()
+}
+
+// IsExpired reports whether the policy no longer applies at the given time.
+func (p *TelemetrySamplingPolicy) IsExpired(now time.Time) bool {
+	return p.GetExpiresAt() != 0 && !now.Before(time.UnixMilli(p.GetExpiresAt()))
+}
+
+// Rate returns the fraction of samples to send, clamped to [0, 1]. Rules without a rate send everything.
+func (r *TelemetrySamplingRule) Rate() float32 {
+	if r == nil || r.SampleRate == nil {
+		return 1
+	}
+	return min(max(*r.SampleRate, 0), 1)
+}
+
+func (r *TelemetrySamplingRule) BurstInterval() time.Duration {
+	return time.Duration(r.GetBurstIntervalMs()) * time.Millisecond
+}
+
+// Rate returns the fraction of samples which were sent.
+func (s *MetricLabelSampling) Rate() float64 {
+	if s.GetTotal() == 0 {
+		return 1
+	}
+	return float64(s.GetSent()) / float64(s.GetTotal())
+}
+
+// Scale returns the factor to multiply counts of sent samples of the label by to estimate the recorded counts.
+func (s *MetricsSampling) Scale(label MetricLabel) float64 {
+	for _, l := range s.GetLabels() {
+		if l.Label == label && l.Sent != 0 {
+			return float64(l.Total) / float64(l.Sent)
+		}
+	}
+	return 1
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import "time"
+
+// preciseTime returns the millisecond timestamp when set, and falls back to the seconds timestamp otherwise.
+// The zero time is returned when neither is set.
+func preciseTime(sec, ms int64) time.Time {
+	switch {
+	case ms != 0:
+		return time.UnixMilli(ms)
+	case sec != 0:
+		return time.Unix(sec, 0)
+	default:
+		return time.Time{}
+	}
+}
+
+// CreatedAtTime returns the creation time of the event, preferring the millisecond timestamp.
+func (e *WebhookEvent) CreatedAtTime() time.Time {
+	return preciseTime(e.GetCreatedAt(), e.GetCreatedAtMs())
+}
+
+// SetCreatedAt sets both the seconds and millisecond creation timestamps of the event.
+func (e *WebhookEvent) SetCreatedAt(t time.Time) {
+	e.CreatedAt = t.Unix()
+	e.CreatedAtMs = t.UnixMilli()
+}
+
+// CreationTimeTime returns the creation time of the room, preferring the millisecond timestamp.
+func (r *Room) CreationTimeTime() time.Time {
+	return preciseTime(r.GetCreationTime(), r.GetCreationTimeMs())
+}
+
+// SetCreationTime sets both the seconds and millisecond creation timestamps of the room.
+func (r *Room) SetCreationTime(t time.Time) {
+	r.CreationTime = t.Unix()
+	r.CreationTimeMs = t.UnixMilli()
+}
+
+// JoinedAtTime returns the join time of the participant, preferring the millisecond timestamp.
+func (p *ParticipantInfo) JoinedAtTime() time.Time {
+	return preciseTime(p.GetJoinedAt(), p.GetJoinedAtMs())
+}
+
+// SetJoinedAt sets both the seconds and millisecond join timestamps of the participant.
+func (p *ParticipantInfo) SetJoinedAt(t time.Time) {
+	p.JoinedAt = t.Unix()
+	p.JoinedAtMs = t.UnixMilli()
+}
+
+// Duration returns the time between the call being answered and ending, zero if it was never answered
+// or is still active.
+func (c *SIPCallInfo) Duration() time.Duration {
+	if c.GetStartedAtNs() == 0 || c.GetEndedAtNs() < c.GetStartedAtNs() {
+		return 0
+	}
+	return time.Duration(c.GetEndedAtNs() - c.GetStartedAtNs())
+}
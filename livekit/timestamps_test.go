@@ -0,0 +1,68 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestamps(t *testing.T) {
+	now := time.UnixMilli(1700000000123)
+
+	t.Run("webhook event", func(t *testing.T) {
+		e := &WebhookEvent{}
+		require.True(t, e.CreatedAtTime().IsZero())
+
+		e.CreatedAt = now.Unix()
+		require.Equal(t, time.Unix(now.Unix(), 0), e.CreatedAtTime())
+
+		e.SetCreatedAt(now)
+		require.Equal(t, now.Unix(), e.CreatedAt)
+		require.Equal(t, now.UnixMilli(), e.CreatedAtMs)
+		require.True(t, now.Equal(e.CreatedAtTime()))
+	})
+
+	t.Run("room", func(t *testing.T) {
+		r := &Room{CreationTime: now.Unix()}
+		require.Equal(t, time.Unix(now.Unix(), 0), r.CreationTimeTime())
+
+		r.SetCreationTime(now)
+		require.Equal(t, now.UnixMilli(), r.CreationTimeMs)
+		require.True(t, now.Equal(r.CreationTimeTime()))
+	})
+
+	t.Run("participant", func(t *testing.T) {
+		p := &ParticipantInfo{JoinedAt: now.Unix()}
+		require.Equal(t, time.Unix(now.Unix(), 0), p.JoinedAtTime())
+
+		p.SetJoinedAt(now)
+		require.Equal(t, now.UnixMilli(), p.JoinedAtMs)
+		require.True(t, now.Equal(p.JoinedAtTime()))
+	})
+
+	t.Run("sip call", func(t *testing.T) {
+		c := &SIPCallInfo{StartedAtNs: now.UnixNano()}
+		require.Zero(t, c.Duration())
+
+		c.EndedAtNs = now.Add(90 * time.Second).UnixNano()
+		require.Equal(t, 90*time.Second, c.Duration())
+
+		c.StartedAtNs = 0
+		require.Zero(t, c.Duration())
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import "strings"
+
+// NewTranscription returns the transcription of a participant's track, ready to send with ToProto.
+func NewTranscription(participantIdentity, trackID string, segments ...*TranscriptionSegment) *Transcription {
+	return &Transcription{
+		TranscribedParticipantIdentity: participantIdentity,
+		TrackId:                        trackID,
+		Segments:                       segments,
+	}
+}
+
+// NewTranscriptionSegment returns a segment of text spoken between startTime and endTime.
+// Interim segments are sent with final set to false and replaced by later segments with the same id.
+// confidence ranges from 0 to 1, 0 when the speech to text provider does not report it.
+func NewTranscriptionSegment(id, text, language string, startTime, endTime uint64, final bool, confidence float32) *TranscriptionSegment {
+	return &TranscriptionSegment{
+		Id:         id,
+		Text:       text,
+		StartTime:  startTime,
+		EndTime:    endTime,
+		Final:      final,
+		Language:   language,
+		Confidence: confidence,
+	}
+}
+
+// ToProto implements DataPacket in Go SDK.
+func (p *Transcription) ToProto() *DataPacket {
+	return &DataPacket{
+		Value: &DataPacket_Transcription{
+			Transcription: p,
+		},
+	}
+}
+
+// FinalText joins the text of the final segments, skipping interim ones.
+func (p *Transcription) FinalText() string {
+	var texts []string
+	for _, s := range p.GetSegments() {
+		if s.GetFinal() && s.GetText() != "" {
+			texts = append(texts, s.GetText())
+		}
+	}
+	return strings.Join(texts, " ")
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTranscription(t *testing.T) {
+	tr := NewTranscription("caller", "TR_1",
+		NewTranscriptionSegment("1", "hello", "en-US", 0, 500, true, 0.9),
+		NewTranscriptionSegment("2", "wor", "en-US", 500, 700, false, 0),
+	)
+	require.Equal(t, "hello", tr.FinalText())
+
+	tr.Segments[1] = NewTranscriptionSegment("2", "world", "en-US", 500, 900, true, 0.8)
+	require.Equal(t, "hello world", tr.FinalText())
+
+	pkt := tr.ToProto()
+	data, err := proto.Marshal(pkt)
+	require.NoError(t, err)
+	var got DataPacket
+	require.NoError(t, proto.Unmarshal(data, &got))
+	require.True(t, proto.Equal(tr, got.GetTranscription()))
+	require.Equal(t, float32(0.9), got.GetTranscription().Segments[0].Confidence)
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import "fmt"
+
+// ValidationWarning codes
+const (
+	ValidationWarningDeprecatedField = "deprecated_field"
+	// a setting was left empty and the server default will be used
+	ValidationWarningServerDefault = "server_default"
+	// the configuration is accepted, but is likely broader than intended
+	ValidationWarningBroadMatch = "broad_match"
+	ValidationWarningInsecure   = "insecure"
+	// the setting could not be fully verified without side effects, e.g. storage credentials
+	ValidationWarningUnverified = "unverified"
+)
+
+// ValidateOnlyRequest is implemented by create requests supporting dry runs.
+type ValidateOnlyRequest interface {
+	GetValidateOnly() bool
+}
+
+var (
+	_ ValidateOnlyRequest = (*CreateRoomRequest)(nil)
+	_ ValidateOnlyRequest = (*RoomCompositeEgressRequest)(nil)
+	_ ValidateOnlyRequest = (*WebEgressRequest)(nil)
+	_ ValidateOnlyRequest = (*ParticipantEgressRequest)(nil)
+	_ ValidateOnlyRequest = (*TrackCompositeEgressRequest)(nil)
+	_ ValidateOnlyRequest = (*TrackEgressRequest)(nil)
+	_ ValidateOnlyRequest = (*CreateIngressRequest)(nil)
+	_ ValidateOnlyRequest = (*CreateSIPInboundTrunkRequest)(nil)
+	_ ValidateOnlyRequest = (*CreateSIPOutboundTrunkRequest)(nil)
+	_ ValidateOnlyRequest = (*CreateSIPDispatchRuleRequest)(nil)
+)
+
+// IsValidateOnly returns true if the request asks for validation only.
+func IsValidateOnly(req any) bool {
+	r, ok := req.(ValidateOnlyRequest)
+	return ok && r.GetValidateOnly()
+}
+
+type ValidationWarnings []*ValidationWarning
+
+func (w *ValidationWarnings) Add(field, code, format string, args ...any) {
+	*w = append(*w, &ValidationWarning{
+		Field:   field,
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
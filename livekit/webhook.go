@@ -0,0 +1,277 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The Set*Payload methods set the payload of the event together with the legacy fields it duplicates,
+// so that receivers reading either get the same values.
+
+func (e *WebhookEvent) SetRoomPayload(room *Room) {
+	e.Payload = &WebhookEvent_RoomPayload{RoomPayload: room}
+	e.Room = room
+}
+
+func (e *WebhookEvent) SetParticipantPayload(p *ParticipantWebhookPayload) {
+	e.Payload = &WebhookEvent_ParticipantPayload{ParticipantPayload: p}
+	e.Room = p.Room
+	e.Participant = p.Participant
+	e.ParticipantReconnect = p.Reconnect
+	e.ParticipantMove = p.Move
+}
+
+func (e *WebhookEvent) SetTrackPayload(p *TrackWebhookPayload) {
+	e.Payload = &WebhookEvent_TrackPayload{TrackPayload: p}
+	e.Room = p.Room
+	e.Participant = p.Participant
+	e.Track = p.Track
+	e.TrackMuteChange = p.MuteChange
+}
+
+func (e *WebhookEvent) SetEgressPayload(info *EgressInfo) {
+	e.Payload = &WebhookEvent_EgressPayload{EgressPayload: info}
+	e.EgressInfo = info
+}
+
+func (e *WebhookEvent) SetIngressPayload(info *IngressInfo) {
+	e.Payload = &WebhookEvent_IngressPayload{IngressPayload: info}
+	e.IngressInfo = info
+}
+
+func (e *WebhookEvent) SetSIPPayload(call *SIPCallInfo) {
+	e.Payload = &WebhookEvent_SipPayload{SipPayload: call}
+	e.SipCall = call
+}
+
+func (e *WebhookEvent) SetAgentPayload(job *Job) {
+	e.Payload = &WebhookEvent_AgentPayload{AgentPayload: job}
+	e.AgentJob = job
+}
+
+// SyncPayload sets the payload from the legacy fields of events created by older versions, or the
+// unset legacy fields from the payload. It only writes missing fields, so calling it again does not modify
+// the event.
+func (e *WebhookEvent) SyncPayload() {
+	if e.Payload == nil {
+		e.setPayloadFromLegacy()
+		return
+	}
+
+	switch p := e.Payload.(type) {
+	case *WebhookEvent_RoomPayload:
+		setIfNil(&e.Room, p.RoomPayload)
+	case *WebhookEvent_ParticipantPayload:
+		setIfNil(&e.Room, p.ParticipantPayload.GetRoom())
+		setIfNil(&e.Participant, p.ParticipantPayload.GetParticipant())
+		setIfNil(&e.ParticipantReconnect, p.ParticipantPayload.GetReconnect())
+		setIfNil(&e.ParticipantMove, p.ParticipantPayload.GetMove())
+	case *WebhookEvent_TrackPayload:
+		setIfNil(&e.Room, p.TrackPayload.GetRoom())
+		setIfNil(&e.Participant, p.TrackPayload.GetParticipant())
+		setIfNil(&e.Track, p.TrackPayload.GetTrack())
+		setIfNil(&e.TrackMuteChange, p.TrackPayload.GetMuteChange())
+	case *WebhookEvent_EgressPayload:
+		setIfNil(&e.EgressInfo, p.EgressPayload)
+	case *WebhookEvent_IngressPayload:
+		setIfNil(&e.IngressInfo, p.IngressPayload)
+	case *WebhookEvent_SipPayload:
+		setIfNil(&e.SipCall, p.SipPayload)
+	case *WebhookEvent_AgentPayload:
+		setIfNil(&e.AgentJob, p.AgentPayload)
+	}
+}
+
+// setPayloadFromLegacy picks the payload from the most specific legacy field set.
+func (e *WebhookEvent) setPayloadFromLegacy() {
+	switch {
+	case e.AgentJob != nil:
+		e.Payload = &WebhookEvent_AgentPayload{AgentPayload: e.AgentJob}
+	case e.SipCall != nil:
+		e.Payload = &WebhookEvent_SipPayload{SipPayload: e.SipCall}
+	case e.EgressInfo != nil:
+		e.Payload = &WebhookEvent_EgressPayload{EgressPayload: e.EgressInfo}
+	case e.IngressInfo != nil:
+		e.Payload = &WebhookEvent_IngressPayload{IngressPayload: e.IngressInfo}
+	case e.Track != nil:
+		e.Payload = &WebhookEvent_TrackPayload{TrackPayload: &TrackWebhookPayload{
+			Room:        e.Room,
+			Participant: e.Participant,
+			Track:       e.Track,
+			MuteChange:  e.TrackMuteChange,
+		}}
+	case e.Participant != nil:
+		e.Payload = &WebhookEvent_ParticipantPayload{ParticipantPayload: &ParticipantWebhookPayload{
+			Room:        e.Room,
+			Participant: e.Participant,
+			Reconnect:   e.ParticipantReconnect,
+			Move:        e.ParticipantMove,
+		}}
+	case e.Room != nil:
+		e.Payload = &WebhookEvent_RoomPayload{RoomPayload: e.Room}
+	}
+}
+
+func setIfNil[T any](field **T, v *T) {
+	if *field == nil && v != nil {
+		*field = v
+	}
+}
+
+// payload types of the event name prefixes, see WebhookEvent.Validate
+var webhookEventPayloads = []struct {
+	prefix  string
+	payload func(e *WebhookEvent) (bool, bool)
+}{
+	{"room_", func(e *WebhookEvent) (bool, bool) {
+		_, ok := e.Payload.(*WebhookEvent_RoomPayload)
+		return ok, e.Room != nil
+	}},
+	{"participant_", func(e *WebhookEvent) (bool, bool) {
+		_, ok := e.Payload.(*WebhookEvent_ParticipantPayload)
+		return ok, e.Participant != nil
+	}},
+	{"track_", func(e *WebhookEvent) (bool, bool) {
+		_, ok := e.Payload.(*WebhookEvent_TrackPayload)
+		return ok, e.Track != nil
+	}},
+	{"egress_", func(e *WebhookEvent) (bool, bool) {
+		_, ok := e.Payload.(*WebhookEvent_EgressPayload)
+		return ok, e.EgressInfo != nil
+	}},
+	{"ingress_", func(e *WebhookEvent) (bool, bool) {
+		_, ok := e.Payload.(*WebhookEvent_IngressPayload)
+		return ok, e.IngressInfo != nil
+	}},
+	{"sip_call_", func(e *WebhookEvent) (bool, bool) {
+		_, ok := e.Payload.(*WebhookEvent_SipPayload)
+		return ok, e.SipCall != nil
+	}},
+	{"agent_job_", func(e *WebhookEvent) (bool, bool) {
+		_, ok := e.Payload.(*WebhookEvent_AgentPayload)
+		return ok, e.AgentJob != nil
+	}},
+}
+
+// Validate checks that the event carries the payload its name calls for, in the payload oneof or in
+// the legacy fields. Events named webhook_* must not have a payload. Other event names are accepted,
+// so that receivers do not reject events added by newer versions.
+func (e *WebhookEvent) Validate() error {
+	if e.Event == "" {
+		return errors.New("missing event name")
+	}
+	if strings.HasPrefix(e.Event, "webhook_") {
+		if e.Payload != nil {
+			return fmt.Errorf("%s event must not have a payload", e.Event)
+		}
+		return nil
+	}
+
+	for _, p := range webhookEventPayloads {
+		if !strings.HasPrefix(e.Event, p.prefix) {
+			continue
+		}
+		matches, legacy := p.payload(e)
+		switch {
+		case e.Payload != nil && !matches:
+			return fmt.Errorf("%s event has a %T payload", e.Event, e.Payload)
+		case e.Payload == nil && !legacy:
+			return fmt.Errorf("%s event is missing its payload", e.Event)
+		}
+		break
+	}
+
+	switch e.Event {
+	case "participant_reconnected":
+		if e.ParticipantReconnect == nil && e.GetParticipantPayload().GetReconnect() == nil {
+			return errors.New("participant_reconnected event is missing the reconnect details")
+		}
+	case "participant_moved":
+		if e.ParticipantMove == nil && e.GetParticipantPayload().GetMove() == nil {
+			return errors.New("participant_moved event is missing the move details")
+		}
+	}
+	return nil
+}
+
+// fields cleared by Redacted, by name in any message
+var webhookRedactedFieldNames = map[protoreflect.Name]bool{
+	"metadata":               true,
+	"attributes":             true,
+	"participant_attributes": true,
+	"headers":                true,
+	"token":                  true,
+	"secret":                 true,
+	"api_secret":             true,
+	"session_token":          true,
+	"stream_key":             true,
+	"turn_password":          true,
+	"access_key":             true,
+	"account_key":            true,
+	"credentials":            true,
+	"auth_password":          true,
+	"password":               true,
+}
+
+// fields cleared by Redacted, by full name
+var webhookRedactedFields = map[protoreflect.FullName]bool{
+	// rtmp urls carry the stream key
+	"livekit.StreamInfo.url":    true,
+	"livekit.StreamOutput.urls": true,
+}
+
+// Redacted returns a copy of the event without metadata, attributes and credentials, for logging.
+func (e *WebhookEvent) Redacted() *WebhookEvent {
+	if e == nil {
+		return nil
+	}
+	c := proto.Clone(e).(*WebhookEvent)
+	redactMessage(c.ProtoReflect())
+	return c
+}
+
+func redactMessage(m protoreflect.Message) {
+	var redacted []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if webhookRedactedFieldNames[fd.Name()] || webhookRedactedFields[fd.FullName()] {
+			redacted = append(redacted, fd)
+			return true
+		}
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			l := v.List()
+			for i := range l.Len() {
+				redactMessage(l.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				redactMessage(v.Message())
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			redactMessage(v.Message())
+		}
+		return true
+	})
+	for _, fd := range redacted {
+		m.Clear(fd)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livekit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWebhookEventPayload(t *testing.T) {
+	room := &Room{Name: "room"}
+	participant := &ParticipantInfo{Identity: "alice"}
+	track := &TrackInfo{Sid: "TR_1"}
+
+	t.Run("set payload", func(t *testing.T) {
+		e := &WebhookEvent{}
+		e.SetTrackPayload(&TrackWebhookPayload{Room: room, Participant: participant, Track: track})
+		require.Equal(t, room, e.Room)
+		require.Equal(t, participant, e.Participant)
+		require.Equal(t, track, e.Track)
+		require.Equal(t, track, e.GetTrackPayload().Track)
+
+		e = &WebhookEvent{}
+		e.SetEgressPayload(&EgressInfo{EgressId: "EG_1"})
+		require.Equal(t, "EG_1", e.EgressInfo.EgressId)
+		require.Nil(t, e.GetRoomPayload())
+	})
+
+	t.Run("from legacy fields", func(t *testing.T) {
+		cases := []struct {
+			name  string
+			event *WebhookEvent
+			check func(t *testing.T, e *WebhookEvent)
+		}{
+			{"room", &WebhookEvent{Room: room}, func(t *testing.T, e *WebhookEvent) {
+				require.Equal(t, room, e.GetRoomPayload())
+			}},
+			{"participant", &WebhookEvent{Room: room, Participant: participant, ParticipantMove: &ParticipantMove{SourceRoom: "lobby"}}, func(t *testing.T, e *WebhookEvent) {
+				require.Equal(t, participant, e.GetParticipantPayload().Participant)
+				require.Equal(t, "lobby", e.GetParticipantPayload().Move.SourceRoom)
+			}},
+			{"track", &WebhookEvent{Room: room, Participant: participant, Track: track}, func(t *testing.T, e *WebhookEvent) {
+				require.Equal(t, track, e.GetTrackPayload().Track)
+				require.Equal(t, room, e.GetTrackPayload().Room)
+			}},
+			{"sip", &WebhookEvent{SipCall: &SIPCallInfo{CallId: "SCL_1"}, Room: room}, func(t *testing.T, e *WebhookEvent) {
+				require.Equal(t, "SCL_1", e.GetSipPayload().CallId)
+			}},
+			{"agent", &WebhookEvent{AgentJob: &Job{Id: "AJ_1"}, Room: room}, func(t *testing.T, e *WebhookEvent) {
+				require.Equal(t, "AJ_1", e.GetAgentPayload().Id)
+			}},
+			{"none", &WebhookEvent{Event: "webhook_test"}, func(t *testing.T, e *WebhookEvent) {
+				require.Nil(t, e.Payload)
+			}},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				c.event.SyncPayload()
+				c.check(t, c.event)
+			})
+		}
+	})
+
+	t.Run("from payload", func(t *testing.T) {
+		e := &WebhookEvent{Payload: &WebhookEvent_ParticipantPayload{ParticipantPayload: &ParticipantWebhookPayload{
+			Room:        room,
+			Participant: participant,
+		}}}
+		e.SyncPayload()
+		require.Equal(t, room, e.Room)
+		require.Equal(t, participant, e.Participant)
+		require.Nil(t, e.ParticipantMove)
+
+		// legacy fields which are already set are kept
+		other := &Room{Name: "other"}
+		e.Room = other
+		e.SyncPayload()
+		require.Equal(t, other, e.Room)
+	})
+}
+
+func TestWebhookEventValidate(t *testing.T) {
+	room := &Room{Name: "room"}
+	participant := &ParticipantInfo{Identity: "alice"}
+
+	cases := []struct {
+		name  string
+		event *WebhookEvent
+		err   string
+	}{
+		{"missing name", &WebhookEvent{Room: room}, "missing event name"},
+		{"legacy fields", &WebhookEvent{Event: "room_started", Room: room}, ""},
+		{"payload", &WebhookEvent{Event: "room_started", Payload: &WebhookEvent_RoomPayload{RoomPayload: room}}, ""},
+		{"missing payload", &WebhookEvent{Event: "participant_joined", Room: room}, "participant_joined event is missing its payload"},
+		{"mismatched payload", &WebhookEvent{Event: "egress_started", Payload: &WebhookEvent_RoomPayload{RoomPayload: room}}, "egress_started event has a *livekit.WebhookEvent_RoomPayload payload"},
+		{"missing move", &WebhookEvent{Event: "participant_moved", Room: room, Participant: participant}, "participant_moved event is missing the move details"},
+		{"move", &WebhookEvent{Event: "participant_moved", Room: room, Participant: participant, ParticipantMove: &ParticipantMove{}}, ""},
+		{"test event", &WebhookEvent{Event: "webhook_test"}, ""},
+		{"test event payload", &WebhookEvent{Event: "webhook_test", Payload: &WebhookEvent_RoomPayload{RoomPayload: room}}, "webhook_test event must not have a payload"},
+		{"room event without room", &WebhookEvent{Event: "room_finished"}, "room_finished event is missing its payload"},
+		{"newer event", &WebhookEvent{Event: "whiteboard_updated"}, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.event.Validate()
+			if c.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, c.err)
+			}
+		})
+	}
+}
+
+func TestWebhookEventRedacted(t *testing.T) {
+	e := &WebhookEvent{Event: "participant_joined"}
+	e.SetParticipantPayload(&ParticipantWebhookPayload{
+		Room:        &Room{Name: "room", Metadata: "room secret"},
+		Participant: &ParticipantInfo{Identity: "alice", Metadata: "{}", Attributes: map[string]string{"a": "b"}},
+	})
+	r := e.Redacted()
+	require.Equal(t, "alice", r.Participant.Identity)
+	require.Empty(t, r.Participant.Metadata)
+	require.Empty(t, r.Participant.Attributes)
+	require.Empty(t, r.GetParticipantPayload().Room.Metadata)
+	// the event is not modified
+	require.Equal(t, "room secret", e.Room.Metadata)
+
+	e = &WebhookEvent{Event: "egress_started"}
+	e.SetEgressPayload(&EgressInfo{
+		EgressId: "EG_1",
+		Request: &EgressInfo_RoomComposite{RoomComposite: &RoomCompositeEgressRequest{
+			FileOutputs: []*EncodedFileOutput{{Output: &EncodedFileOutput_S3{S3: &S3Upload{AccessKey: "key", Secret: "secret", SessionToken: "session", Bucket: "bucket"}}}},
+		}},
+		StreamResults: []*StreamInfo{{Url: "rtmp://host/app/stream_key"}},
+	})
+	r = e.Redacted()
+	s3 := r.EgressInfo.GetRoomComposite().FileOutputs[0].GetS3()
+	require.Empty(t, s3.AccessKey)
+	require.Empty(t, s3.Secret)
+	require.Empty(t, s3.SessionToken)
+	require.Equal(t, "bucket", s3.Bucket)
+	require.Empty(t, r.GetEgressPayload().StreamResults[0].Url)
+
+	// as received by a webhook endpoint
+	e = &WebhookEvent{Event: "ingress_started"}
+	e.SetIngressPayload(&IngressInfo{IngressId: "IN_1", StreamKey: "stream key", Url: "rtmp://host/x"})
+	data, err := proto.Marshal(e)
+	require.NoError(t, err)
+	var received WebhookEvent
+	require.NoError(t, proto.Unmarshal(data, &received))
+	require.NoError(t, received.Validate())
+	r = received.Redacted()
+	require.Equal(t, "IN_1", r.IngressInfo.IngressId)
+	require.Empty(t, r.IngressInfo.StreamKey)
+	require.Empty(t, r.GetIngressPayload().StreamKey)
+	require.Equal(t, "stream key", received.IngressInfo.StreamKey)
+
+	require.Nil(t, (*WebhookEvent)(nil).Redacted())
+}
@@ -14,7 +14,15 @@
 
 package logger
 
-import "sync"
+import (
+	"fmt"
+	"maps"
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/livekit/protocol/logger/zaputil"
+)
 
 type Config struct {
 	JSON  bool   `yaml:"json,omitempty"`
@@ -28,6 +36,14 @@ type Config struct {
 
 	ComponentLevels map[string]string `yaml:"component_levels,omitempty"`
 
+	// format of logs, read when creating the logger
+	Encoder EncoderConfig `yaml:"encoder,omitempty"`
+
+	// true to write logs to stderr from a goroutine, so logging does not block when stderr is slow.
+	// logs are buffered up to AsyncBufferSize, and dropped while the buffer is full
+	Async           bool `yaml:"async,omitempty"`
+	AsyncBufferSize int  `yaml:"async_buffer_size,omitempty"`
+
 	// global sampling per server
 	// when sampling, the first N logs will be logged
 	SampleInitial int `yaml:"sample_initial,omitempty"`
@@ -39,7 +55,17 @@ type Config struct {
 	ItemSampleInitial  int `yaml:"item_sample_initial,omitempty"`
 	ItemSampleInterval int `yaml:"item_sample_interval,omitempty"`
 
+	// deduplication of repeated messages, keyed by message. the repetitions of a message within the interval
+	// of its rule are logged once, followed by a summary with their count. rules are read when creating the logger
+	Dedupe map[string]zaputil.DedupeRule `yaml:"dedupe,omitempty"`
+
+	// true to log the chain of wrapped errors of Warnw and Errorw in errorChain, and their code (e.g. psrpc errors) in errorCode
+	ErrorChain bool `yaml:"error_chain,omitempty"`
+	// true to log the stack of calls to Warnw and Errorw with errors in errorStacktrace
+	ErrorStacktrace bool `yaml:"error_stacktrace,omitempty"`
+
 	lock               sync.Mutex       `yaml:"-"`
+	levelLock          sync.Mutex       `yaml:"-"`
 	onUpdatedCallbacks []ConfigObserver `yaml:"-"`
 }
 
@@ -49,6 +75,9 @@ func (c *Config) Update(o *Config) error {
 	c.lock.Lock()
 	c.JSON = o.JSON
 	c.Level = o.Level
+	c.Encoder = o.Encoder
+	c.Async = o.Async
+	c.AsyncBufferSize = o.AsyncBufferSize
 	c.Sample = o.Sample
 	c.SampleInitial = o.SampleInitial
 	c.SampleInterval = o.SampleInterval
@@ -56,6 +85,9 @@ func (c *Config) Update(o *Config) error {
 	c.ItemSampleInitial = o.ItemSampleInitial
 	c.ItemSampleInterval = o.ItemSampleInterval
 	c.ComponentLevels = o.ComponentLevels
+	c.Dedupe = o.Dedupe
+	c.ErrorChain = o.ErrorChain
+	c.ErrorStacktrace = o.ErrorStacktrace
 	callbacks := c.onUpdatedCallbacks
 	c.lock.Unlock()
 
@@ -72,3 +104,78 @@ func (c *Config) AddUpdateObserver(cb ConfigObserver) {
 	defer c.lock.Unlock()
 	c.onUpdatedCallbacks = append(c.onUpdatedCallbacks, cb)
 }
+
+// Levels returns the default level and the levels of components.
+func (c *Config) Levels() (string, map[string]string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.Level, maps.Clone(c.ComponentLevels)
+}
+
+// SetLevel changes the default level of loggers created from the config at runtime.
+func (c *Config) SetLevel(level string) error {
+	if err := validateLevel(level); err != nil {
+		return err
+	}
+	return c.updateLevels(func(o *Config) {
+		o.Level = level
+	})
+}
+
+// SetComponentLevel changes the level of the component and its sub-components at runtime,
+// e.g. "webhook" to debug while leaving "rpc" at info. An empty level reverts to the default level.
+func (c *Config) SetComponentLevel(component, level string) error {
+	if level != "" {
+		if err := validateLevel(level); err != nil {
+			return err
+		}
+	}
+	return c.updateLevels(func(o *Config) {
+		levels := maps.Clone(o.ComponentLevels)
+		if levels == nil {
+			levels = make(map[string]string)
+		}
+		if level == "" {
+			delete(levels, component)
+		} else {
+			levels[component] = level
+		}
+		o.ComponentLevels = levels
+	})
+}
+
+// updateLevels applies the change to a copy of the config, which is not modified in place as loggers read it.
+func (c *Config) updateLevels(change func(o *Config)) error {
+	c.levelLock.Lock()
+	defer c.levelLock.Unlock()
+
+	c.lock.Lock()
+	o := &Config{
+		JSON:               c.JSON,
+		Level:              c.Level,
+		Encoder:            c.Encoder,
+		Async:              c.Async,
+		AsyncBufferSize:    c.AsyncBufferSize,
+		Sample:             c.Sample,
+		ComponentLevels:    c.ComponentLevels,
+		SampleInitial:      c.SampleInitial,
+		SampleInterval:     c.SampleInterval,
+		ItemSampleSeconds:  c.ItemSampleSeconds,
+		ItemSampleInitial:  c.ItemSampleInitial,
+		ItemSampleInterval: c.ItemSampleInterval,
+		Dedupe:             c.Dedupe,
+		ErrorChain:         c.ErrorChain,
+		ErrorStacktrace:    c.ErrorStacktrace,
+	}
+	c.lock.Unlock()
+
+	change(o)
+	return c.Update(o)
+}
+
+func validateLevel(level string) error {
+	if _, err := zapcore.ParseLevel(level); err != nil {
+		return fmt.Errorf("invalid log level %q", level)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"context"
+)
+
+type loggerKey struct{}
+
+type fieldsKey struct{}
+
+// contextFields are the request fields of a context, added to the loggers derived from it
+type contextFields struct {
+	requestID   string
+	room        string
+	participant string
+}
+
+type contextLogger struct {
+	l Logger
+	// fields of the context when the logger was added, which the logger is expected to have
+	fields contextFields
+}
+
+// WithContext returns a context carrying l, see FromContext.
+func WithContext(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, contextLogger{l, getContextFields(ctx)})
+}
+
+// FromContext returns the logger of ctx, or the default logger, with the request id, room and participant of ctx.
+// Fields set before the logger was added to ctx are expected to be on the logger already.
+func FromContext(ctx context.Context) Logger {
+	cl, ok := ctx.Value(loggerKey{}).(contextLogger)
+	if !ok {
+		cl.l = GetLogger()
+	}
+	return withContextFields(cl.l, getContextFields(ctx), cl.fields)
+}
+
+// WithContextValues returns l with the request id, room and participant of ctx,
+// for loggers not derived from the context, e.g. loggers of workers processing requests.
+func WithContextValues(ctx context.Context, l Logger) Logger {
+	return withContextFields(l, getContextFields(ctx), contextFields{})
+}
+
+// WithRequestID returns a context with the request id logged by the loggers derived from it.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	f := getContextFields(ctx)
+	f.requestID = requestID
+	return context.WithValue(ctx, fieldsKey{}, f)
+}
+
+// WithRoom returns a context with the room logged by the loggers derived from it.
+func WithRoom(ctx context.Context, room string) context.Context {
+	f := getContextFields(ctx)
+	f.room = room
+	return context.WithValue(ctx, fieldsKey{}, f)
+}
+
+// WithParticipant returns a context with the participant identity logged by the loggers derived from it.
+func WithParticipant(ctx context.Context, identity string) context.Context {
+	f := getContextFields(ctx)
+	f.participant = identity
+	return context.WithValue(ctx, fieldsKey{}, f)
+}
+
+// RequestIDFromContext returns the request id of ctx, see WithRequestID.
+func RequestIDFromContext(ctx context.Context) string {
+	return getContextFields(ctx).requestID
+}
+
+func getContextFields(ctx context.Context) contextFields {
+	f, _ := ctx.Value(fieldsKey{}).(contextFields)
+	return f
+}
+
+func withContextFields(l Logger, f, prev contextFields) Logger {
+	var values []any
+	if f.requestID != prev.requestID && f.requestID != "" {
+		values = append(values, "requestID", f.requestID)
+	}
+	if f.room != prev.room && f.room != "" {
+		values = append(values, "room", f.room)
+	}
+	if f.participant != prev.participant && f.participant != "" {
+		values = append(values, "participant", f.participant)
+	}
+	if len(values) == 0 {
+		return l
+	}
+	return l.WithValues(values...)
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/livekit/protocol/logger/zaputil"
+	"github.com/livekit/protocol/utils/must"
+)
+
+func TestFromContext(t *testing.T) {
+	ws := &testBufferedWriteSyncer{}
+	l := must.Get(NewZapLogger(&Config{JSON: true}, WithTap(zaputil.NewWriteEnabler(ws, zapcore.DebugLevel))))
+
+	last := func() map[string]any {
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(ws.Bytes(), &entry))
+		ws.Reset()
+		return entry
+	}
+
+	ctx := WithRequestID(context.Background(), "req1")
+	ctx = WithRoom(ctx, "room1")
+	ctx = WithContext(ctx, WithContextValues(ctx, l))
+	ctx = WithParticipant(ctx, "p1")
+	require.Equal(t, "req1", RequestIDFromContext(ctx))
+
+	FromContext(ctx).Infow("joined")
+	entry := last()
+	require.Equal(t, "req1", entry["requestID"])
+	require.Equal(t, "room1", entry["room"])
+	require.Equal(t, "p1", entry["participant"])
+
+	// the fields of the logger are not repeated
+	FromContext(ctx).Infow("joined")
+	require.Equal(t, 1, strings.Count(ws.String(), `"room":`))
+	require.Equal(t, 1, strings.Count(ws.String(), `"participant":`))
+
+	require.Equal(t, GetLogger(), FromContext(context.Background()))
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/livekit/protocol/logger/zaputil"
+)
+
+const (
+	EncodingJSON    = "json"
+	EncodingConsole = "console"
+
+	// OmitKey omits an entry key from logs
+	OmitKey = "-"
+)
+
+// time formats of EncoderConfig.TimeFormat
+const (
+	TimeFormatEpoch       = "epoch"
+	TimeFormatEpochMillis = "millis"
+	TimeFormatEpochNanos  = "nanos"
+	TimeFormatISO8601     = "iso8601"
+	TimeFormatRFC3339     = "rfc3339"
+	TimeFormatRFC3339Nano = "rfc3339nano"
+)
+
+type EncoderConfig struct {
+	// encoding of logs written to stderr, json or console. defaults to json when Config.JSON is set, console otherwise
+	Encoding string `yaml:"encoding,omitempty"`
+	// encoding of logs written to the tap, json or console. defaults to json
+	TapEncoding string `yaml:"tap_encoding,omitempty"`
+	// format of timestamps: epoch, millis, nanos, iso8601, rfc3339 or rfc3339nano.
+	// defaults to epoch for json, and iso8601 for console
+	TimeFormat string `yaml:"time_format,omitempty"`
+	// frames skipped when reporting the caller, for loggers wrapped by helpers
+	CallerSkip int `yaml:"caller_skip,omitempty"`
+
+	// names of entry keys, defaulting to zap's names, or OmitKey to leave the entry out
+	TimeKey       string `yaml:"time_key,omitempty"`
+	LevelKey      string `yaml:"level_key,omitempty"`
+	NameKey       string `yaml:"name_key,omitempty"`
+	CallerKey     string `yaml:"caller_key,omitempty"`
+	MessageKey    string `yaml:"message_key,omitempty"`
+	StacktraceKey string `yaml:"stacktrace_key,omitempty"`
+}
+
+func (c *EncoderConfig) validate() error {
+	for _, encoding := range []string{c.Encoding, c.TapEncoding} {
+		switch encoding {
+		case "", EncodingJSON, EncodingConsole:
+		default:
+			return fmt.Errorf("invalid log encoding %q", encoding)
+		}
+	}
+	switch c.TimeFormat {
+	case "", TimeFormatEpoch, TimeFormatEpochMillis, TimeFormatEpochNanos, TimeFormatISO8601, TimeFormatRFC3339, TimeFormatRFC3339Nano:
+	default:
+		return fmt.Errorf("invalid log time format %q", c.TimeFormat)
+	}
+	if c.CallerSkip < 0 {
+		return fmt.Errorf("invalid log caller skip %d", c.CallerSkip)
+	}
+	return nil
+}
+
+func (c *EncoderConfig) newEncoder(encoding string) zapcore.Encoder {
+	var conf zapcore.EncoderConfig
+	if encoding == EncodingJSON {
+		conf = zap.NewProductionEncoderConfig()
+	} else {
+		conf = zap.NewDevelopmentEncoderConfig()
+	}
+
+	if c.TimeFormat != "" {
+		// validated, the names match zap's
+		_ = conf.EncodeTime.UnmarshalText([]byte(c.TimeFormat))
+	}
+	setKey(&conf.TimeKey, c.TimeKey)
+	setKey(&conf.LevelKey, c.LevelKey)
+	setKey(&conf.NameKey, c.NameKey)
+	setKey(&conf.CallerKey, c.CallerKey)
+	setKey(&conf.MessageKey, c.MessageKey)
+	setKey(&conf.StacktraceKey, c.StacktraceKey)
+
+	if encoding == EncodingJSON {
+		return zapcore.NewJSONEncoder(conf)
+	}
+	return zapcore.NewConsoleEncoder(conf)
+}
+
+func setKey(key *string, name string) {
+	switch name {
+	case "":
+	case OmitKey:
+		*key = zapcore.OmitKey
+	default:
+		*key = name
+	}
+}
+
+// newZapLoggerWithEncoder creates the logger with the encoders of the sinks in the config
+func newZapLoggerWithEncoder(zap *zap.SugaredLogger, zc *zapConfig, sampler *zaputil.Sampler) ZapLogger {
+	c := &zc.conf.Encoder
+	encoding := c.Encoding
+	if encoding == "" {
+		if zc.conf.JSON {
+			encoding = EncodingJSON
+		} else {
+			encoding = EncodingConsole
+		}
+	}
+	tapEncoding := c.TapEncoding
+	if tapEncoding == "" {
+		tapEncoding = EncodingJSON
+	}
+
+	if encoding == tapEncoding {
+		return newZapLogger(zap, zc, zaputil.NewSharedEncoder(c.newEncoder(encoding)), sampler)
+	}
+	return newZapLogger(zap, zc, zaputil.NewSplitEncoder(c.newEncoder(encoding), c.newEncoder(tapEncoding)), sampler)
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/livekit/protocol/logger/zaputil"
+	"github.com/livekit/protocol/utils/must"
+)
+
+func TestEncoderConfig(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		ws := &testBufferedWriteSyncer{}
+		l := must.Get(NewZapLogger(&Config{
+			JSON: true,
+			Encoder: EncoderConfig{
+				TimeFormat: TimeFormatRFC3339,
+				TimeKey:    "timestamp",
+				MessageKey: "message",
+				CallerKey:  OmitKey,
+			},
+		}, WithTap(zaputil.NewWriteEnabler(ws, zapcore.DebugLevel))))
+
+		l.Infow("test")
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(ws.Bytes(), &entry))
+		require.Equal(t, "test", entry["message"])
+		require.NotContains(t, entry, "caller")
+		_, err := time.Parse(time.RFC3339, entry["timestamp"].(string))
+		require.NoError(t, err)
+	})
+
+	t.Run("console tap", func(t *testing.T) {
+		ws := &testBufferedWriteSyncer{}
+		l := must.Get(NewZapLogger(&Config{
+			Encoder: EncoderConfig{TapEncoding: EncodingConsole},
+		}, WithTap(zaputil.NewWriteEnabler(ws, zapcore.DebugLevel))))
+
+		l.Infow("test", "key", "value")
+
+		require.False(t, json.Valid(ws.Bytes()))
+		require.True(t, strings.Contains(ws.String(), "INFO\t"), ws.String())
+		require.True(t, strings.Contains(ws.String(), `{"key": "value"}`), ws.String())
+	})
+
+	t.Run("caller skip", func(t *testing.T) {
+		ws := &testBufferedWriteSyncer{}
+		l := must.Get(NewZapLogger(&Config{
+			Encoder: EncoderConfig{CallerSkip: 1},
+		}, WithTap(zaputil.NewWriteEnabler(ws, zapcore.DebugLevel))))
+
+		testLogCaller(l.Infow)
+
+		log := must.Get(unmarshalTestLogOutput(ws.Bytes()))
+		require.True(t, strings.HasPrefix(log.Caller, "logger/encoder_test.go:"), log.Caller)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := NewZapLogger(&Config{Encoder: EncoderConfig{Encoding: "xml"}})
+		require.Error(t, err)
+		_, err = NewZapLogger(&Config{Encoder: EncoderConfig{TimeFormat: "unix"}})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// errorFields returns the fields describing err enabled in the config
+func (zc *zapConfig) errorFields(err error) []any {
+	var fields []any
+	if zc.errorChain {
+		if code, ok := ErrorCode(err); ok {
+			fields = append(fields, zap.String("errorCode", code))
+		}
+		if errors.Unwrap(err) != nil || isJoined(err) {
+			fields = append(fields, zap.Array("errorChain", errorChain{err}))
+		}
+	}
+	if zc.errorStacktrace {
+		// skips errorFields and Warnw/Errorw
+		fields = append(fields, zap.StackSkip("errorStacktrace", 2))
+	}
+	return fields
+}
+
+// ErrorCode returns the code of the first error in the chain of err with one, e.g. psrpc errors.
+func ErrorCode(err error) (string, bool) {
+	for err != nil {
+		if code, ok := errorCode(err); ok {
+			return code, true
+		}
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, err := range joined.Unwrap() {
+				if code, ok := ErrorCode(err); ok {
+					return code, true
+				}
+			}
+			return "", false
+		}
+		err = errors.Unwrap(err)
+	}
+	return "", false
+}
+
+// errorCode returns the value of the Code method of err, the method is looked up by name
+// to support error types with string codes from other packages, like psrpc.ErrorCode
+func errorCode(err error) (code string, ok bool) {
+	v := reflect.ValueOf(err)
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return "", false
+	}
+	m := v.MethodByName("Code")
+	if !m.IsValid() {
+		return "", false
+	}
+	if t := m.Type(); t.NumIn() != 0 || t.NumOut() != 1 || t.Out(0).Kind() != reflect.String {
+		return "", false
+	}
+	code = m.Call(nil)[0].String()
+	return code, code != ""
+}
+
+func isJoined(err error) bool {
+	_, ok := err.(interface{ Unwrap() []error })
+	return ok
+}
+
+// errorChain logs the errors wrapped by an error, from the outermost
+type errorChain struct {
+	err error
+}
+
+func (c errorChain) MarshalLogArray(e zapcore.ArrayEncoder) error {
+	for err := c.err; err != nil; err = errors.Unwrap(err) {
+		e.AppendObject(errorLink{err})
+	}
+	return nil
+}
+
+type errorLink struct {
+	err error
+}
+
+func (l errorLink) MarshalLogObject(e zapcore.ObjectEncoder) error {
+	e.AddString("type", fmt.Sprintf("%T", l.err))
+	e.AddString("message", l.err.Error())
+	if code, ok := errorCode(l.err); ok {
+		e.AddString("code", code)
+	}
+	if joined, ok := l.err.(interface{ Unwrap() []error }); ok {
+		e.AddArray("causes", zapcore.ArrayMarshalerFunc(func(e zapcore.ArrayEncoder) error {
+			for _, err := range joined.Unwrap() {
+				if err != nil {
+					e.AppendArray(errorChain{err})
+				}
+			}
+			return nil
+		}))
+	}
+	return nil
+}
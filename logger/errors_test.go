@@ -0,0 +1,73 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/livekit/psrpc"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/livekit/protocol/logger/zaputil"
+	"github.com/livekit/protocol/utils/must"
+)
+
+func TestErrorCode(t *testing.T) {
+	_, ok := ErrorCode(errors.New("error"))
+	require.False(t, ok)
+
+	err := fmt.Errorf("failed to send webhook: %w", psrpc.NewErrorf(psrpc.Unavailable, "unavailable"))
+	code, ok := ErrorCode(err)
+	require.True(t, ok)
+	require.Equal(t, string(psrpc.Unavailable), code)
+
+	code, ok = ErrorCode(errors.Join(errors.New("error"), err))
+	require.True(t, ok)
+	require.Equal(t, string(psrpc.Unavailable), code)
+}
+
+func TestErrorFields(t *testing.T) {
+	ws := &testBufferedWriteSyncer{}
+	l := must.Get(NewZapLogger(&Config{JSON: true, ErrorChain: true, ErrorStacktrace: true}, WithTap(zaputil.NewWriteEnabler(ws, zapcore.DebugLevel))))
+
+	cause := psrpc.NewErrorf(psrpc.DeadlineExceeded, "timeout")
+	l.Warnw("failed to send webhook", fmt.Errorf("post: %w", cause))
+
+	var entry struct {
+		Error           string `json:"error"`
+		ErrorCode       string `json:"errorCode"`
+		ErrorStacktrace string `json:"errorStacktrace"`
+		ErrorChain      []struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+			Code    string `json:"code"`
+		} `json:"errorChain"`
+	}
+	require.NoError(t, json.Unmarshal(ws.Bytes(), &entry))
+	require.Equal(t, "post: timeout", entry.Error)
+	require.Equal(t, string(psrpc.DeadlineExceeded), entry.ErrorCode)
+	require.Len(t, entry.ErrorChain, 2)
+	require.Equal(t, "*fmt.wrapError", entry.ErrorChain[0].Type)
+	require.Equal(t, "post: timeout", entry.ErrorChain[0].Message)
+	require.Equal(t, "timeout", entry.ErrorChain[1].Message)
+	require.Equal(t, string(psrpc.DeadlineExceeded), entry.ErrorChain[1].Code)
+	require.True(t, strings.Contains(entry.ErrorStacktrace, "logger.TestErrorFields"), entry.ErrorStacktrace)
+	require.False(t, strings.Contains(entry.ErrorStacktrace, "logger.(*zapLogger"), entry.ErrorStacktrace)
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// LevelRequest changes the default level when Component is empty, or the level of the component.
+type LevelRequest struct {
+	Component string `json:"component,omitempty"`
+	// an empty level reverts the component to the default level
+	Level string `json:"level"`
+}
+
+type LevelResponse struct {
+	Level           string            `json:"level"`
+	ComponentLevels map[string]string `json:"componentLevels,omitempty"`
+}
+
+// LevelHandler returns an HTTP handler reporting the log levels of the config on GET,
+// and changing them with a JSON LevelRequest on PUT or POST.
+// It should only be exposed on an administrative listener.
+func LevelHandler(conf *Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			var req LevelRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			var err error
+			if req.Component == "" {
+				err = conf.SetLevel(req.Level)
+			} else {
+				err = conf.SetComponentLevel(req.Component, req.Level)
+			}
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		level, componentLevels := conf.Levels()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(LevelResponse{Level: level, ComponentLevels: componentLevels})
+	})
+}
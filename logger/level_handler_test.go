@@ -0,0 +1,74 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLevelHandler(t *testing.T) {
+	conf := &Config{Level: "info"}
+	l, err := NewZapLogger(conf)
+	require.NoError(t, err)
+	webhook := zapLoggerCore(l.WithComponent("webhook"))
+	rpc := zapLoggerCore(l.WithComponent("rpc"))
+	require.False(t, webhook.Enabled(zapcore.DebugLevel))
+
+	h := LevelHandler(conf)
+	serve := func(method, body string) (int, LevelResponse) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(method, "/", strings.NewReader(body)))
+		var res LevelResponse
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		}
+		return w.Code, res
+	}
+
+	code, res := serve("PUT", `{"component": "webhook", "level": "debug"}`)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "info", res.Level)
+	require.Equal(t, map[string]string{"webhook": "debug"}, res.ComponentLevels)
+	require.True(t, webhook.Enabled(zapcore.DebugLevel))
+	require.True(t, zapLoggerCore(l.WithComponent("webhook").WithComponent("sub")).Enabled(zapcore.DebugLevel))
+	require.False(t, rpc.Enabled(zapcore.DebugLevel))
+
+	code, _ = serve("PUT", `{"level": "warn"}`)
+	require.Equal(t, http.StatusOK, code)
+	require.False(t, rpc.Enabled(zapcore.InfoLevel))
+	require.True(t, webhook.Enabled(zapcore.DebugLevel))
+
+	code, res = serve("PUT", `{"component": "webhook"}`)
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, res.ComponentLevels)
+	require.False(t, webhook.Enabled(zapcore.InfoLevel))
+
+	code, _ = serve("PUT", `{"level": "loud"}`)
+	require.Equal(t, http.StatusBadRequest, code)
+
+	code, res = serve("GET", "")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "warn", res.Level)
+
+	code, _ = serve("DELETE", "")
+	require.Equal(t, http.StatusMethodNotAllowed, code)
+}
@@ -202,6 +202,14 @@ type zapConfig struct {
 	writeEnablers *xsync.MapOf[string, *zaputil.WriteEnabler]
 	levelEnablers *xsync.MapOf[string, *zaputil.OrLevelEnabler]
 	tap           *zaputil.WriteEnabler
+	cores         []func(zapcore.LevelEnabler) zapcore.Core
+	deduper       *zaputil.Deduper
+	// stderr, or its async writer
+	out   zapcore.WriteSyncer
+	async *zaputil.AsyncWriter
+	// details logged with the errors of Warnw and Errorw
+	errorChain      bool
+	errorStacktrace bool
 }
 
 type ZapLoggerOption func(*zapConfig)
@@ -212,6 +220,13 @@ func WithTap(tap *zaputil.WriteEnabler) ZapLoggerOption {
 	}
 }
 
+// WithCore sends logs to an additional core, e.g. to export them, created with the level of each component.
+func WithCore(newCore func(level zapcore.LevelEnabler) zapcore.Core) ZapLoggerOption {
+	return func(zc *zapConfig) {
+		zc.cores = append(zc.cores, newCore)
+	}
+}
+
 type ZapComponentLeveler interface {
 	ComponentLevel(component string) zapcore.LevelEnabler
 }
@@ -221,6 +236,10 @@ type ZapLogger interface {
 	ToZap() *zap.SugaredLogger
 	ComponentLeveler() ZapComponentLeveler
 	WithMinLevel(lvl zapcore.LevelEnabler) Logger
+	// Sync flushes buffered logs, it should be called before shutting down when logging asynchronously
+	Sync() error
+	// DroppedLogs returns the number of logs dropped by the async writer as its buffer was full
+	DroppedLogs() uint64
 }
 
 type zapLogger[T zaputil.Encoder[T]] struct {
@@ -231,24 +250,39 @@ type zapLogger[T zaputil.Encoder[T]] struct {
 	deferred  []*zaputil.Deferrer
 	sampler   *zaputil.Sampler
 	minLevel  zapcore.LevelEnabler
+	// values of the logger, added to the cores of WithCore
+	coreFields []zapcore.Field
 }
 
 func FromZapLogger(log *zap.Logger, conf *Config, opts ...ZapLoggerOption) (ZapLogger, error) {
+	if err := conf.Encoder.validate(); err != nil {
+		return nil, err
+	}
 	if log == nil {
 		log = zap.New(nil).WithOptions(zap.AddCaller(), zap.AddStacktrace(zap.ErrorLevel))
 	}
-	zap := log.WithOptions(zap.AddCallerSkip(1)).Sugar()
+	zap := log.WithOptions(zap.AddCallerSkip(1 + conf.Encoder.CallerSkip)).Sugar()
 
 	zc := &zapConfig{
-		conf:          conf,
-		sc:            newSharedConfig(conf),
-		writeEnablers: xsync.NewMapOf[string, *zaputil.WriteEnabler](),
-		levelEnablers: xsync.NewMapOf[string, *zaputil.OrLevelEnabler](),
-		tap:           zaputil.NewDiscardWriteEnabler(),
+		conf:            conf,
+		sc:              newSharedConfig(conf),
+		writeEnablers:   xsync.NewMapOf[string, *zaputil.WriteEnabler](),
+		levelEnablers:   xsync.NewMapOf[string, *zaputil.OrLevelEnabler](),
+		tap:             zaputil.NewDiscardWriteEnabler(),
+		errorChain:      conf.ErrorChain,
+		errorStacktrace: conf.ErrorStacktrace,
 	}
 	for _, opt := range opts {
 		opt(zc)
 	}
+	zc.out = os.Stderr
+	if conf.Async {
+		zc.async = zaputil.NewAsyncWriter(os.Stderr, conf.AsyncBufferSize)
+		zc.out = zc.async
+	}
+	if len(conf.Dedupe) != 0 {
+		zc.deduper = zaputil.NewDeduper(conf.Dedupe)
+	}
 
 	var sampler *zaputil.Sampler
 	if conf.Sample {
@@ -263,11 +297,7 @@ func FromZapLogger(log *zap.Logger, conf *Config, opts ...ZapLoggerOption) (ZapL
 		sampler = zaputil.NewSampler(time.Second, initial, interval)
 	}
 
-	if conf.JSON {
-		return newZapLogger(zap, zc, zaputil.NewProductionEncoder(), sampler), nil
-	} else {
-		return newZapLogger(zap, zc, zaputil.NewDevelopmentEncoder(), sampler), nil
-	}
+	return newZapLoggerWithEncoder(zap, zc, sampler), nil
 }
 
 func NewZapLogger(conf *Config, opts ...ZapLoggerOption) (ZapLogger, error) {
@@ -287,19 +317,31 @@ func newZapLogger[T zaputil.Encoder[T]](zap *zap.SugaredLogger, zc *zapConfig, e
 
 func (l *zapLogger[T]) makeZap() *zap.SugaredLogger {
 	var console *zaputil.WriteEnabler
+	var level zapcore.LevelEnabler = l.sc.ComponentLevel(l.component)
 	if l.minLevel == nil {
 		console, _ = l.writeEnablers.LoadOrCompute(l.component, func() *zaputil.WriteEnabler {
-			return zaputil.NewWriteEnabler(os.Stderr, l.sc.ComponentLevel(l.component))
+			return zaputil.NewWriteEnabler(l.out, level)
 		})
 	} else {
-		enab := zaputil.OrLevelEnabler{l.minLevel, l.sc.ComponentLevel(l.component)}
-		console = zaputil.NewWriteEnabler(os.Stderr, enab)
+		level = zaputil.OrLevelEnabler{l.minLevel, level}
+		console = zaputil.NewWriteEnabler(l.out, level)
 	}
 
 	c := l.enc.Core(console, l.tap)
+	if len(l.cores) != 0 {
+		cores := []zapcore.Core{c}
+		for _, newCore := range l.cores {
+			cores = append(cores, newCore(level).With(l.coreFields))
+		}
+		c = zapcore.NewTee(cores...)
+	}
+	c = newRedactCore(c)
 	for i := range l.deferred {
 		c = zaputil.NewDeferredValueCore(c, l.deferred[i])
 	}
+	if l.deduper != nil {
+		c = zaputil.NewDedupeCore(c, l.deduper)
+	}
 	if l.sampler != nil {
 		c = zaputil.NewSamplerCore(c, l.sampler)
 	}
@@ -326,6 +368,17 @@ func (l zapLoggerComponentLeveler[T]) ComponentLevel(component string) zapcore.L
 	return enab
 }
 
+func (l *zapLogger[T]) Sync() error {
+	return l.zap.Sync()
+}
+
+func (l *zapLogger[T]) DroppedLogs() uint64 {
+	if l.async == nil {
+		return 0
+	}
+	return l.async.Dropped()
+}
+
 func (l *zapLogger[T]) ComponentLeveler() ZapComponentLeveler {
 	return zapLoggerComponentLeveler[T]{l}
 }
@@ -348,6 +401,7 @@ func (l *zapLogger[T]) Infow(msg string, keysAndValues ...any) {
 func (l *zapLogger[T]) Warnw(msg string, err error, keysAndValues ...any) {
 	if err != nil {
 		keysAndValues = append(keysAndValues, "error", err)
+		keysAndValues = append(keysAndValues, l.errorFields(err)...)
 	}
 	l.zap.Warnw(msg, keysAndValues...)
 }
@@ -355,13 +409,23 @@ func (l *zapLogger[T]) Warnw(msg string, err error, keysAndValues ...any) {
 func (l *zapLogger[T]) Errorw(msg string, err error, keysAndValues ...any) {
 	if err != nil {
 		keysAndValues = append(keysAndValues, "error", err)
+		keysAndValues = append(keysAndValues, l.errorFields(err)...)
 	}
 	l.zap.Errorw(msg, keysAndValues...)
 }
 
 func (l *zapLogger[T]) WithValues(keysAndValues ...any) Logger {
 	dup := *l
+	keysAndValues = redactValues(keysAndValues)
 	dup.enc = dup.enc.WithValues(keysAndValues...)
+	if len(l.cores) != 0 {
+		dup.coreFields = slices.Clip(l.coreFields)
+		for i := 1; i < len(keysAndValues); i += 2 {
+			if key, ok := keysAndValues[i-1].(string); ok {
+				dup.coreFields = append(dup.coreFields, zap.Any(key, keysAndValues[i]))
+			}
+		}
+	}
 	dup.zap = dup.makeZap()
 	return &dup
 }
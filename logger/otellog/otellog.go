@@ -0,0 +1,224 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otellog forwards logger output to OpenTelemetry as log records.
+package otellog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/livekit/protocol/logger"
+)
+
+const DefaultScopeName = "github.com/livekit/protocol/logger"
+
+type Options struct {
+	// instrumentation scope of the records, defaults to DefaultScopeName
+	ScopeName string
+}
+
+func (o Options) scopeName() string {
+	if o.ScopeName == "" {
+		return DefaultScopeName
+	}
+	return o.ScopeName
+}
+
+// WithProvider returns an option exporting the logs of a zap logger to the provider, next to its regular output.
+// Logs follow the component levels of the logger.
+func WithProvider(provider log.LoggerProvider, opts Options) logger.ZapLoggerOption {
+	l := provider.Logger(opts.scopeName())
+	return logger.WithCore(func(level zapcore.LevelEnabler) zapcore.Core {
+		return newCore(l, level)
+	})
+}
+
+// Context returns a field correlating records with the span of the context, e.g. log.Infow("joined", otellog.Context(ctx)).
+// Other cores skip the field.
+func Context(ctx context.Context) zapcore.Field {
+	return zapcore.Field{Key: "ctx", Type: zapcore.SkipType, Interface: ctx}
+}
+
+// NewCore returns a zap core emitting log records to the provider.
+// A context.Context logged as a value, see Context, is not exported as an attribute,
+// it correlates the record with the span it carries instead.
+func NewCore(provider log.LoggerProvider, level zapcore.LevelEnabler, opts Options) zapcore.Core {
+	return newCore(provider.Logger(opts.scopeName()), level)
+}
+
+func newCore(l log.Logger, level zapcore.LevelEnabler) zapcore.Core {
+	return &core{
+		LevelEnabler: level,
+		log:          l,
+		ctx:          context.Background(),
+	}
+}
+
+type core struct {
+	zapcore.LevelEnabler
+	log    log.Logger
+	ctx    context.Context
+	fields []zapcore.Field
+}
+
+func (c *core) With(fields []zapcore.Field) zapcore.Core {
+	dup := *c
+	dup.ctx, dup.fields = c.extractContext(c.fields, fields)
+	return &dup
+}
+
+func (c *core) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *core) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	ctx, fields := c.extractContext(c.fields, fields)
+
+	var r log.Record
+	r.SetTimestamp(ent.Time)
+	r.SetObservedTimestamp(time.Now())
+	r.SetSeverity(severity(ent.Level))
+	r.SetSeverityText(ent.Level.String())
+	r.SetBody(log.StringValue(ent.Message))
+	if ent.LoggerName != "" {
+		r.AddAttributes(log.String("logger", ent.LoggerName))
+	}
+	if ent.Caller.Defined {
+		r.AddAttributes(
+			log.String("code.filepath", ent.Caller.File),
+			log.Int("code.lineno", ent.Caller.Line),
+		)
+		if ent.Caller.Function != "" {
+			r.AddAttributes(log.String("code.function", ent.Caller.Function))
+		}
+	}
+	if ent.Stack != "" {
+		r.AddAttributes(log.String("exception.stacktrace", ent.Stack))
+	}
+
+	enc := zapcore.NewMapObjectEncoder()
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+	for k, v := range enc.Fields {
+		r.AddAttributes(log.KeyValue{Key: k, Value: value(v)})
+	}
+
+	c.log.Emit(ctx, r)
+	return nil
+}
+
+func (c *core) Sync() error {
+	return nil
+}
+
+// extractContext returns the fields without the last context among them
+func (c *core) extractContext(prev, fields []zapcore.Field) (context.Context, []zapcore.Field) {
+	ctx := c.ctx
+	out := make([]zapcore.Field, len(prev), len(prev)+len(fields))
+	copy(out, prev)
+	for _, f := range fields {
+		if fctx, ok := f.Interface.(context.Context); ok && (f.Type == zapcore.SkipType || f.Type == zapcore.ReflectType) {
+			ctx = fctx
+			continue
+		}
+		out = append(out, f)
+	}
+	return ctx, out
+}
+
+func severity(level zapcore.Level) log.Severity {
+	switch level {
+	case zapcore.DebugLevel:
+		return log.SeverityDebug
+	case zapcore.InfoLevel:
+		return log.SeverityInfo
+	case zapcore.WarnLevel:
+		return log.SeverityWarn
+	case zapcore.ErrorLevel:
+		return log.SeverityError
+	case zapcore.DPanicLevel:
+		return log.SeverityFatal1
+	case zapcore.PanicLevel:
+		return log.SeverityFatal2
+	case zapcore.FatalLevel:
+		return log.SeverityFatal3
+	default:
+		return log.SeverityUndefined
+	}
+}
+
+// value converts the values of zapcore.MapObjectEncoder
+func value(v any) log.Value {
+	switch v := v.(type) {
+	case nil:
+		return log.Value{}
+	case string:
+		return log.StringValue(v)
+	case bool:
+		return log.BoolValue(v)
+	case int:
+		return log.IntValue(v)
+	case int8:
+		return log.Int64Value(int64(v))
+	case int16:
+		return log.Int64Value(int64(v))
+	case int32:
+		return log.Int64Value(int64(v))
+	case int64:
+		return log.Int64Value(v)
+	case uint:
+		return log.Int64Value(int64(v))
+	case uint8:
+		return log.Int64Value(int64(v))
+	case uint16:
+		return log.Int64Value(int64(v))
+	case uint32:
+		return log.Int64Value(int64(v))
+	case uint64:
+		return log.Int64Value(int64(v))
+	case float32:
+		return log.Float64Value(float64(v))
+	case float64:
+		return log.Float64Value(v)
+	case []byte:
+		return log.BytesValue(v)
+	case time.Time:
+		return log.StringValue(v.Format(time.RFC3339Nano))
+	case time.Duration:
+		return log.StringValue(v.String())
+	case []any:
+		vs := make([]log.Value, 0, len(v))
+		for _, e := range v {
+			vs = append(vs, value(e))
+		}
+		return log.SliceValue(vs...)
+	case map[string]any:
+		kvs := make([]log.KeyValue, 0, len(v))
+		for k, e := range v {
+			kvs = append(kvs, log.KeyValue{Key: k, Value: value(e)})
+		}
+		return log.MapValue(kvs...)
+	default:
+		return log.StringValue(fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otellog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/logtest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/livekit/protocol/logger"
+)
+
+func TestCore(t *testing.T) {
+	rec := logtest.NewRecorder()
+	l, err := logger.NewZapLogger(&logger.Config{Level: "info"}, WithProvider(rec, Options{}))
+	require.NoError(t, err)
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	l.WithName("room").WithValues("room", "r1").Warnw("slow", errors.New("timeout"), "count", 3, Context(ctx))
+	l.Debugw("hidden")
+
+	scopes := rec.Result()
+	require.Len(t, scopes, 1)
+	require.Equal(t, DefaultScopeName, scopes[0].Name)
+	require.Len(t, scopes[0].Records, 1)
+
+	r := scopes[0].Records[0]
+	require.Equal(t, "slow", r.Body().AsString())
+	require.Equal(t, log.SeverityWarn, r.Severity())
+	require.Equal(t, sc, trace.SpanContextFromContext(r.Context()))
+
+	attrs := map[string]log.Value{}
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		attrs[kv.Key] = kv.Value
+		return true
+	})
+	require.Equal(t, "room", attrs["logger"].AsString())
+	require.Equal(t, "r1", attrs["room"].AsString())
+	require.Equal(t, "timeout", attrs["error"].AsString())
+	require.Equal(t, int64(3), attrs["count"].AsInt64())
+	require.NotContains(t, attrs, "ctx")
+}
@@ -40,10 +40,17 @@ type protoMarshaller struct {
 }
 
 func (p protoMarshaller) MarshalLogObject(e zapcore.ObjectEncoder) error {
+	r := redactions.Load()
 	fields := p.m.Descriptor().Fields()
 	for i := 0; i < fields.Len(); i++ {
 		f := fields.Get(i)
 		k := f.JSONName()
+		if r.redactsField(k) {
+			if p.m.Has(f) {
+				e.AddString(k, RedactedValue)
+			}
+			continue
+		}
 		v := p.m.Get(f)
 
 		if f.IsMap() {
@@ -79,6 +86,10 @@ func (p protoMapMarshaller) MarshalLogObject(e zapcore.ObjectEncoder) error {
 		case protoreflect.StringKind:
 			k = ki.String()
 		}
+		if redactions.Load().redactsField(k) {
+			e.AddString(k, RedactedValue)
+			return true
+		}
 		marshalProtoField(k, p.f.MapValue(), vi, e)
 		return true
 	})
@@ -105,7 +116,7 @@ func (p protoListMarshaller) MarshalLogArray(e zapcore.ArrayEncoder) error {
 		case protoreflect.FloatKind, protoreflect.DoubleKind:
 			e.AppendFloat64(v.Float())
 		case protoreflect.StringKind:
-			e.AppendString(v.String())
+			e.AppendString(redactString(p.f.JSONName(), v.String()))
 		case protoreflect.BytesKind:
 			e.AppendString(marshalProtoBytes(v.Bytes()))
 		case protoreflect.MessageKind:
@@ -137,7 +148,7 @@ func marshalProtoField(k string, f protoreflect.FieldDescriptor, v protoreflect.
 		}
 	case protoreflect.StringKind:
 		if s := v.String(); s != "" {
-			e.AddString(k, s)
+			e.AddString(k, redactString(k, s))
 		}
 	case protoreflect.BytesKind:
 		if b := v.Bytes(); len(b) != 0 {
@@ -0,0 +1,453 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/utils/streamurl"
+)
+
+// RedactedValue is logged in place of the values of sensitive fields.
+const RedactedValue = "<redacted>"
+
+// Redactor returns the value to log in place of a sensitive string logged with the key, and true if it was redacted.
+type Redactor func(key, value string) (string, bool)
+
+// field names redacted by default, compared ignoring case, underscores and dashes
+var defaultRedactedFields = []string{
+	"token",
+	"secret",
+	"api_secret",
+	"password",
+	"auth_password",
+	"stream_key",
+	"access_key",
+	"account_key",
+	"credentials",
+	"session_token",
+	"private_key",
+	"authorization",
+}
+
+type redaction struct {
+	fields    map[string]struct{}
+	redactors []Redactor
+}
+
+var (
+	redactionLock sync.Mutex
+	redactions    atomic.Pointer[redaction]
+)
+
+func init() {
+	r := &redaction{
+		fields:    make(map[string]struct{}),
+		redactors: []Redactor{RedactStreamURL},
+	}
+	for _, name := range defaultRedactedFields {
+		r.fields[normalizeFieldName(name)] = struct{}{}
+	}
+	redactions.Store(r)
+}
+
+// RedactFields adds field names to the deny list. The values of fields with these names are never logged,
+// whether logged directly, in protos or in structs and objects logged wholesale.
+// Names are compared ignoring case, underscores and dashes, so "api_secret" also matches "apiSecret".
+func RedactFields(names ...string) {
+	redactionLock.Lock()
+	defer redactionLock.Unlock()
+
+	prev := redactions.Load()
+	r := &redaction{
+		fields:    make(map[string]struct{}, len(prev.fields)+len(names)),
+		redactors: prev.redactors,
+	}
+	for name := range prev.fields {
+		r.fields[name] = struct{}{}
+	}
+	for _, name := range names {
+		r.fields[normalizeFieldName(name)] = struct{}{}
+	}
+	redactions.Store(r)
+}
+
+// RegisterRedactor adds a redactor for string values, e.g. to remove credentials embedded in urls.
+func RegisterRedactor(redactor Redactor) {
+	redactionLock.Lock()
+	defer redactionLock.Unlock()
+
+	prev := redactions.Load()
+	redactors := make([]Redactor, 0, len(prev.redactors)+1)
+	redactors = append(redactors, prev.redactors...)
+	r := &redaction{
+		fields:    prev.fields,
+		redactors: append(redactors, redactor),
+	}
+	redactions.Store(r)
+}
+
+// RedactStreamURL redacts the stream key of rtmp urls, it is registered by default.
+func RedactStreamURL(_, value string) (string, bool) {
+	prefix, _, suffix, ok := streamurl.SplitStreamKey(value)
+	if !ok {
+		return value, false
+	}
+	return prefix + RedactedValue + suffix, true
+}
+
+func normalizeFieldName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '_', '-':
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, name)
+}
+
+func (r *redaction) empty() bool {
+	return len(r.fields) == 0 && len(r.redactors) == 0
+}
+
+func (r *redaction) redactsField(name string) bool {
+	_, ok := r.fields[normalizeFieldName(name)]
+	return ok
+}
+
+func (r *redaction) redactString(key, value string) (string, bool) {
+	redacted := false
+	for _, redactor := range r.redactors {
+		if v, ok := redactor(key, value); ok {
+			value, redacted = v, true
+		}
+	}
+	return value, redacted
+}
+
+func redactString(key, value string) string {
+	value, _ = redactions.Load().redactString(key, value)
+	return value
+}
+
+// redactFields returns the fields with sensitive values replaced, it only copies the fields when one is redacted
+func redactFields(fields []zapcore.Field) []zapcore.Field {
+	r := redactions.Load()
+	var redacted []zapcore.Field
+	for i := range fields {
+		f, ok := r.redactField(fields[i])
+		if !ok {
+			continue
+		}
+		if redacted == nil {
+			redacted = make([]zapcore.Field, len(fields))
+			copy(redacted, fields)
+		}
+		redacted[i] = f
+	}
+	if redacted == nil {
+		return fields
+	}
+	return redacted
+}
+
+// redactValues returns the values with sensitive values replaced, for values added to encoders rather than logged as fields
+func redactValues(keysAndValues []any) []any {
+	r := redactions.Load()
+	var redacted []any
+	for i := 1; i < len(keysAndValues); i += 2 {
+		key, ok := keysAndValues[i-1].(string)
+		if !ok {
+			continue
+		}
+		f, ok := r.redactField(zap.Any(key, keysAndValues[i]))
+		if !ok {
+			continue
+		}
+		if redacted == nil {
+			redacted = slices.Clone(keysAndValues)
+		}
+		if f.Type == zapcore.StringType {
+			redacted[i] = f.String
+		} else {
+			redacted[i] = f.Interface
+		}
+	}
+	if redacted == nil {
+		return keysAndValues
+	}
+	return redacted
+}
+
+func (r *redaction) redactField(f zapcore.Field) (zapcore.Field, bool) {
+	switch f.Type {
+	case zapcore.SkipType, zapcore.NamespaceType, zapcore.ErrorType:
+		return f, false
+	}
+	if r.redactsField(f.Key) {
+		return zap.String(f.Key, RedactedValue), true
+	}
+
+	switch f.Type {
+	case zapcore.StringType:
+		if s, ok := r.redactString(f.Key, f.String); ok {
+			return zap.String(f.Key, s), true
+		}
+	case zapcore.ObjectMarshalerType:
+		if _, ok := f.Interface.(protoMarshaller); ok {
+			// protos redact their own fields
+			return f, false
+		}
+		return zap.Object(f.Key, redactedObject{f.Interface.(zapcore.ObjectMarshaler)}), true
+	case zapcore.ArrayMarshalerType:
+		return zap.Array(f.Key, redactedArray{f.Key, f.Interface.(zapcore.ArrayMarshaler)}), true
+	case zapcore.StringerType:
+		// protos are stringers, and would otherwise be logged in text format
+		if m, ok := f.Interface.(proto.Message); ok && m.ProtoReflect().IsValid() {
+			return zap.Object(f.Key, Proto(m)), true
+		}
+	case zapcore.ReflectType:
+		if m, ok := f.Interface.(proto.Message); ok && m.ProtoReflect().IsValid() {
+			return zap.Object(f.Key, Proto(m)), true
+		}
+		if v, ok := r.redactReflected(f.Key, f.Interface); ok {
+			return zap.Any(f.Key, v), true
+		}
+	}
+	return f, false
+}
+
+// redactReflected redacts values logged wholesale, which are encoded as json
+func (r *redaction) redactReflected(key string, v any) (any, bool) {
+	switch reflect.Indirect(reflect.ValueOf(v)).Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+	default:
+		return nil, false
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, false
+	}
+	var decoded any
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return nil, false
+	}
+	return r.redactJSON(key, decoded)
+}
+
+func (r *redaction) redactJSON(key string, v any) (any, bool) {
+	redacted := false
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if r.redactsField(k) {
+				v[k], redacted = RedactedValue, true
+			} else if e, ok := r.redactJSON(k, e); ok {
+				v[k], redacted = e, true
+			}
+		}
+	case []any:
+		for i, e := range v {
+			if e, ok := r.redactJSON(key, e); ok {
+				v[i], redacted = e, true
+			}
+		}
+	case string:
+		return r.redactString(key, v)
+	}
+	return v, redacted
+}
+
+type redactedObject struct {
+	m zapcore.ObjectMarshaler
+}
+
+func (o redactedObject) MarshalLogObject(e zapcore.ObjectEncoder) error {
+	return o.m.MarshalLogObject(redactObjectEncoder{e, redactions.Load()})
+}
+
+type redactedArray struct {
+	key string
+	m   zapcore.ArrayMarshaler
+}
+
+func (a redactedArray) MarshalLogArray(e zapcore.ArrayEncoder) error {
+	return a.m.MarshalLogArray(redactArrayEncoder{e, a.key, redactions.Load()})
+}
+
+// redactObjectEncoder redacts the fields of objects that may hold sensitive values
+type redactObjectEncoder struct {
+	zapcore.ObjectEncoder
+	r *redaction
+}
+
+func (e redactObjectEncoder) AddArray(key string, v zapcore.ArrayMarshaler) error {
+	if e.r.redactsField(key) {
+		e.ObjectEncoder.AddString(key, RedactedValue)
+		return nil
+	}
+	return e.ObjectEncoder.AddArray(key, redactedArray{key, v})
+}
+
+func (e redactObjectEncoder) AddObject(key string, v zapcore.ObjectMarshaler) error {
+	if e.r.redactsField(key) {
+		e.ObjectEncoder.AddString(key, RedactedValue)
+		return nil
+	}
+	if _, ok := v.(protoMarshaller); ok {
+		return e.ObjectEncoder.AddObject(key, v)
+	}
+	return e.ObjectEncoder.AddObject(key, redactedObject{v})
+}
+
+func (e redactObjectEncoder) AddBinary(key string, v []byte) {
+	if e.r.redactsField(key) {
+		e.ObjectEncoder.AddString(key, RedactedValue)
+		return
+	}
+	e.ObjectEncoder.AddBinary(key, v)
+}
+
+func (e redactObjectEncoder) AddByteString(key string, v []byte) {
+	if e.r.redactsField(key) {
+		e.ObjectEncoder.AddString(key, RedactedValue)
+		return
+	}
+	if s, ok := e.r.redactString(key, string(v)); ok {
+		e.ObjectEncoder.AddString(key, s)
+		return
+	}
+	e.ObjectEncoder.AddByteString(key, v)
+}
+
+func (e redactObjectEncoder) AddString(key, v string) {
+	if e.r.redactsField(key) {
+		e.ObjectEncoder.AddString(key, RedactedValue)
+		return
+	}
+	v, _ = e.r.redactString(key, v)
+	e.ObjectEncoder.AddString(key, v)
+}
+
+func (e redactObjectEncoder) AddReflected(key string, v any) error {
+	if e.r.redactsField(key) {
+		e.ObjectEncoder.AddString(key, RedactedValue)
+		return nil
+	}
+	if m, ok := v.(proto.Message); ok {
+		return e.ObjectEncoder.AddObject(key, Proto(m))
+	}
+	if r, ok := e.r.redactReflected(key, v); ok {
+		v = r
+	}
+	return e.ObjectEncoder.AddReflected(key, v)
+}
+
+// redactArrayEncoder redacts the elements of arrays logged with key
+type redactArrayEncoder struct {
+	zapcore.ArrayEncoder
+	key string
+	r   *redaction
+}
+
+func (e redactArrayEncoder) AppendArray(v zapcore.ArrayMarshaler) error {
+	return e.ArrayEncoder.AppendArray(redactedArray{e.key, v})
+}
+
+func (e redactArrayEncoder) AppendObject(v zapcore.ObjectMarshaler) error {
+	if _, ok := v.(protoMarshaller); ok {
+		return e.ArrayEncoder.AppendObject(v)
+	}
+	return e.ArrayEncoder.AppendObject(redactedObject{v})
+}
+
+func (e redactArrayEncoder) AppendByteString(v []byte) {
+	if s, ok := e.r.redactString(e.key, string(v)); ok {
+		e.ArrayEncoder.AppendString(s)
+		return
+	}
+	e.ArrayEncoder.AppendByteString(v)
+}
+
+func (e redactArrayEncoder) AppendString(v string) {
+	v, _ = e.r.redactString(e.key, v)
+	e.ArrayEncoder.AppendString(v)
+}
+
+func (e redactArrayEncoder) AppendReflected(v any) error {
+	if m, ok := v.(proto.Message); ok {
+		return e.ArrayEncoder.AppendObject(Proto(m))
+	}
+	if r, ok := e.r.redactReflected(e.key, v); ok {
+		v = r
+	}
+	return e.ArrayEncoder.AppendReflected(v)
+}
+
+// redactCore redacts the fields of the logs written to the core
+type redactCore struct {
+	zapcore.Core
+}
+
+func newRedactCore(core zapcore.Core) zapcore.Core {
+	return &redactCore{core}
+}
+
+func (c *redactCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactCore{c.Core.With(redactFields(fields))}
+}
+
+// Check lets the wrapped core decide which of its cores write the entry, e.g. for tees and samplers,
+// and redacts the fields written to them.
+func (c *redactCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if redactions.Load().empty() {
+		return c.Core.Check(ent, ce)
+	}
+	checked := c.Core.Check(ent, nil)
+	if checked == nil {
+		return ce
+	}
+	// errors are reported where zap loggers report them by default
+	checked.ErrorOutput = zapcore.Lock(os.Stderr)
+	return ce.AddCore(ent, &redactCheckedCore{c.Core, checked})
+}
+
+func (c *redactCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(ent, redactFields(fields))
+}
+
+// redactCheckedCore writes an entry checked by the wrapped core with redacted fields
+type redactCheckedCore struct {
+	zapcore.Core
+	ce *zapcore.CheckedEntry
+}
+
+func (c *redactCheckedCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	// the caller and stack are only added to the entry once checked
+	c.ce.Entry = ent
+	c.ce.Write(redactFields(fields)...)
+	return nil
+}
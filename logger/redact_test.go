@@ -0,0 +1,140 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/logger/zaputil"
+	"github.com/livekit/protocol/utils/must"
+)
+
+type testWriteSyncer struct {
+	lines [][]byte
+}
+
+func (w *testWriteSyncer) Write(p []byte) (int, error) {
+	w.lines = append(w.lines, append([]byte(nil), p...))
+	return len(p), nil
+}
+
+func (w *testWriteSyncer) Sync() error { return nil }
+
+type testCredentials struct {
+	Username  string `json:"username"`
+	APISecret string `json:"apiSecret"`
+}
+
+type testObject struct {
+	name     string
+	password string
+}
+
+func (o testObject) MarshalLogObject(e zapcore.ObjectEncoder) error {
+	e.AddString("name", o.name)
+	e.AddString("password", o.password)
+	return nil
+}
+
+func TestRedact(t *testing.T) {
+	ws := &testWriteSyncer{}
+	l := must.Get(logger.NewZapLogger(&logger.Config{JSON: true}, logger.WithTap(zaputil.NewWriteEnabler(ws, zapcore.DebugLevel))))
+
+	last := func() map[string]any {
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(ws.lines[len(ws.lines)-1], &entry))
+		return entry
+	}
+
+	l.WithValues("token", "t1").Infow("joined", "identity", "p1")
+	entry := last()
+	require.Equal(t, logger.RedactedValue, entry["token"])
+	require.Equal(t, "p1", entry["identity"])
+
+	l.Infow("upload", "request", &livekit.S3Upload{AccessKey: "key", Secret: "secret", Bucket: "bucket"})
+	require.Equal(t, map[string]any{
+		"accessKey": logger.RedactedValue,
+		"secret":    logger.RedactedValue,
+		"bucket":    "bucket",
+	}, last()["request"])
+
+	l.Infow("stream", "output", logger.Proto(&livekit.StreamOutput{Urls: []string{"rtmp://localhost/live/abcdef"}}))
+	require.Equal(t, []any{"rtmp://localhost/live/" + logger.RedactedValue}, last()["output"].(map[string]any)["urls"])
+
+	l.Infow("upload", "request", (*livekit.S3Upload)(nil))
+	require.Equal(t, "<nil>", last()["request"])
+
+	l.Infow("credentials", "credentials", []testCredentials{{Username: "u", APISecret: "s"}})
+	require.Equal(t, logger.RedactedValue, last()["credentials"])
+
+	l.Infow("object", "object", testObject{"o", "p"}, "users", []testCredentials{{Username: "u", APISecret: "s"}})
+	entry = last()
+	require.Equal(t, map[string]any{"name": "o", "password": logger.RedactedValue}, entry["object"])
+	require.Equal(t, []any{map[string]any{"username": "u", "apiSecret": logger.RedactedValue}}, entry["users"])
+
+	// the deny list is global and cannot be reverted, no other test logs identities
+	logger.RedactFields("identity")
+	logger.RegisterRedactor(func(key, value string) (string, bool) {
+		if key == "room" && value == "private" {
+			return "room-" + logger.RedactedValue, true
+		}
+		return value, false
+	})
+	l.Infow("joined", "Identity", "p1", "room", "private")
+	entry = last()
+	require.Equal(t, logger.RedactedValue, entry["Identity"])
+	require.Equal(t, "room-"+logger.RedactedValue, entry["room"])
+}
+
+// filterCore drops entries with a message when they are checked
+type filterCore struct {
+	zapcore.Core
+	message string
+}
+
+func (c filterCore) With(fields []zapcore.Field) zapcore.Core {
+	return filterCore{c.Core.With(fields), c.message}
+}
+
+func (c filterCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if ent.Message == c.message {
+		return ce
+	}
+	return c.Core.Check(ent, ce)
+}
+
+func TestRedactCheck(t *testing.T) {
+	ws := &testWriteSyncer{}
+	l := must.Get(logger.NewZapLogger(&logger.Config{JSON: true}, logger.WithCore(func(level zapcore.LevelEnabler) zapcore.Core {
+		return filterCore{zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), ws, level), "filtered"}
+	})))
+
+	// cores decide which entries they write
+	l.Infow("filtered", "token", "t1")
+	require.Empty(t, ws.lines)
+
+	l.Infow("joined", "token", "t1")
+	require.Len(t, ws.lines, 1)
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(ws.lines[0], &entry))
+	require.Equal(t, logger.RedactedValue, entry["token"])
+}
@@ -17,9 +17,14 @@ package logger
 import (
 	"context"
 	"log/slog"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"go.uber.org/zap/exp/zapslog"
+	"go.uber.org/zap/zapcore"
 )
 
 // NewSlogDiscard creates a slog.Handler that discards all logs.
@@ -39,6 +44,11 @@ func ToSlogHandler(log Logger) slog.Handler {
 	return slogHandler{log, ""}
 }
 
+// ToSlog converts Logger to *slog.Logger.
+func ToSlog(log Logger) *slog.Logger {
+	return slog.New(ToSlogHandler(log))
+}
+
 type slogDiscard struct{}
 
 func (slogDiscard) Enabled(ctx context.Context, level slog.Level) bool {
@@ -77,21 +87,30 @@ func (l slogHandler) getGroup() string {
 func (l slogHandler) Handle(ctx context.Context, r slog.Record) error {
 	keysAndValues := make([]any, 0, r.NumAttrs()*2)
 	group := l.getGroup()
+	var err error
 	r.Attrs(func(attr slog.Attr) bool {
-		keysAndValues = append(keysAndValues, group+attr.Key, attr.Value.Resolve().Any())
+		if e, ok := attr.Value.Resolve().Any().(error); ok && err == nil && (attr.Key == "error" || attr.Key == "err") {
+			err = e
+			return true
+		}
+		keysAndValues = append(keysAndValues, group+attr.Key, slogValueToAny(attr.Value))
 		return true
 	})
-	switch r.Level {
-	case slog.LevelDebug:
-		l.log.Debugw(r.Message, keysAndValues...)
-	default:
-		fallthrough
-	case slog.LevelInfo:
+	switch {
+	case r.Level >= slog.LevelError:
+		l.log.Errorw(r.Message, err, keysAndValues...)
+	case r.Level >= slog.LevelWarn:
+		l.log.Warnw(r.Message, err, keysAndValues...)
+	case r.Level >= slog.LevelInfo:
+		if err != nil {
+			keysAndValues = append(keysAndValues, "error", err)
+		}
 		l.log.Infow(r.Message, keysAndValues...)
-	case slog.LevelWarn:
-		l.log.Warnw(r.Message, nil, keysAndValues...)
-	case slog.LevelError:
-		l.log.Errorw(r.Message, nil, keysAndValues...)
+	default:
+		if err != nil {
+			keysAndValues = append(keysAndValues, "error", err)
+		}
+		l.log.Debugw(r.Message, keysAndValues...)
 	}
 	return nil
 }
@@ -101,7 +120,7 @@ func (l slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
 	keysAndValues := make([]any, 0, len(attrs)*2)
 	group := l.getGroup()
 	for _, attr := range attrs {
-		keysAndValues = append(keysAndValues, group+attr.Key, attr.Value.Resolve().Any())
+		keysAndValues = append(keysAndValues, group+attr.Key, slogValueToAny(attr.Value))
 	}
 	log = log.WithValues(keysAndValues...)
 	return slogHandler{log, l.group}
@@ -114,3 +133,169 @@ func (l slogHandler) WithGroup(name string) slog.Handler {
 	}
 	return slogHandler{l.log, group}
 }
+
+// slogValueToAny converts groups to objects, so their attributes keep their structure
+func slogValueToAny(v slog.Value) any {
+	v = v.Resolve()
+	if v.Kind() == slog.KindGroup {
+		return slogGroup(v.Group())
+	}
+	return v.Any()
+}
+
+type slogGroup []slog.Attr
+
+func (g slogGroup) MarshalLogObject(e zapcore.ObjectEncoder) error {
+	for _, attr := range g {
+		if err := e.AddReflected(attr.Key, slogValueToAny(attr.Value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SlogLogger is a Logger writing to a *slog.Logger, see FromSlog.
+type SlogLogger struct {
+	log       *slog.Logger
+	name      string
+	callDepth int
+}
+
+// FromSlog converts *slog.Logger to Logger. Names and components are logged in the "logger" attribute,
+// values implementing zapcore.ObjectMarshaler or zapcore.ArrayMarshaler are converted to groups and lists.
+func FromSlog(log *slog.Logger) Logger {
+	if h, ok := log.Handler().(slogHandler); ok {
+		return h.log
+	}
+	return &SlogLogger{log: log}
+}
+
+func (l *SlogLogger) write(level slog.Level, msg string, err error, keysAndValues []any) {
+	ctx := context.Background()
+	h := l.log.Handler()
+	if !h.Enabled(ctx, level) {
+		return
+	}
+	var pcs [1]uintptr
+	// skip runtime.Callers, write, and the exported method
+	runtime.Callers(3+l.callDepth, pcs[:])
+	r := slog.NewRecord(time.Now(), level, msg, pcs[0])
+	if l.name != "" {
+		r.AddAttrs(slog.String("logger", l.name))
+	}
+	r.Add(zapToSlogArgs(keysAndValues)...)
+	if err != nil {
+		r.AddAttrs(slog.Any("error", err))
+	}
+	_ = h.Handle(ctx, r)
+}
+
+func (l *SlogLogger) Debugw(msg string, keysAndValues ...any) {
+	l.write(slog.LevelDebug, msg, nil, keysAndValues)
+}
+
+func (l *SlogLogger) Infow(msg string, keysAndValues ...any) {
+	l.write(slog.LevelInfo, msg, nil, keysAndValues)
+}
+
+func (l *SlogLogger) Warnw(msg string, err error, keysAndValues ...any) {
+	l.write(slog.LevelWarn, msg, err, keysAndValues)
+}
+
+func (l *SlogLogger) Errorw(msg string, err error, keysAndValues ...any) {
+	l.write(slog.LevelError, msg, err, keysAndValues)
+}
+
+func (l *SlogLogger) WithValues(keysAndValues ...any) Logger {
+	dup := *l
+	dup.log = l.log.With(zapToSlogArgs(keysAndValues)...)
+	return &dup
+}
+
+func (l *SlogLogger) WithUnlikelyValues(keysAndValues ...any) UnlikelyLogger {
+	return UnlikelyLogger{l, keysAndValues}
+}
+
+func (l *SlogLogger) WithName(name string) Logger {
+	dup := *l
+	if l.name != "" {
+		dup.name = l.name + "." + name
+	} else {
+		dup.name = name
+	}
+	return &dup
+}
+
+func (l *SlogLogger) WithComponent(component string) Logger {
+	// component levels are left to the slog handler
+	return l.WithName(component)
+}
+
+func (l *SlogLogger) WithCallDepth(depth int) Logger {
+	dup := *l
+	dup.callDepth += depth
+	return &dup
+}
+
+func (l *SlogLogger) WithItemSampler() Logger {
+	// slog does not support sampling
+	return l
+}
+
+func (l *SlogLogger) WithoutSampler() Logger {
+	return l
+}
+
+func (l *SlogLogger) WithDeferredValues() (Logger, DeferredFieldResolver) {
+	return l, func(args ...any) {}
+}
+
+// zapToSlogArgs converts values logged for zap, e.g. zapcore.ObjectMarshaler, to slog values.
+func zapToSlogArgs(keysAndValues []any) []any {
+	args := make([]any, 0, len(keysAndValues))
+	for i := 0; i < len(keysAndValues); i++ {
+		switch v := keysAndValues[i].(type) {
+		case string:
+			if i+1 < len(keysAndValues) {
+				args = append(args, slog.Any(v, zapToSlogValue(keysAndValues[i+1])))
+				i++
+			} else {
+				args = append(args, v)
+			}
+		default:
+			args = append(args, v)
+		}
+	}
+	return args
+}
+
+func zapToSlogValue(v any) slog.Value {
+	switch v := v.(type) {
+	case zapcore.ObjectMarshaler:
+		enc := zapcore.NewMapObjectEncoder()
+		if err := v.MarshalLogObject(enc); err != nil {
+			return slog.StringValue(err.Error())
+		}
+		return mapToSlogValue(enc.Fields)
+	case zapcore.ArrayMarshaler:
+		enc := zapcore.NewMapObjectEncoder()
+		if err := enc.AddArray("v", v); err != nil {
+			return slog.StringValue(err.Error())
+		}
+		return slog.AnyValue(enc.Fields["v"])
+	}
+	return slog.AnyValue(v)
+}
+
+func mapToSlogValue(fields map[string]any) slog.Value {
+	attrs := make([]slog.Attr, 0, len(fields))
+	for k, v := range fields {
+		if m, ok := v.(map[string]any); ok {
+			attrs = append(attrs, slog.Attr{Key: k, Value: mapToSlogValue(m)})
+		} else {
+			attrs = append(attrs, slog.Any(k, v))
+		}
+	}
+	slices.SortFunc(attrs, func(a, b slog.Attr) int { return strings.Compare(a.Key, b.Key) })
+	return slog.GroupValue(attrs...)
+}
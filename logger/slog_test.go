@@ -0,0 +1,89 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+type testObject struct {
+	Name  string
+	Count int
+}
+
+func (o testObject) MarshalLogObject(e zapcore.ObjectEncoder) error {
+	e.AddString("name", o.Name)
+	e.AddInt("count", o.Count)
+	return nil
+}
+
+type testHandler struct {
+	records []slog.Record
+}
+
+func (h *testHandler) Enabled(ctx context.Context, level slog.Level) bool { return true }
+func (h *testHandler) Handle(ctx context.Context, r slog.Record) error {
+	h.records = append(h.records, r)
+	return nil
+}
+func (h *testHandler) WithAttrs(attrs []slog.Attr) slog.Handler { return h }
+func (h *testHandler) WithGroup(name string) slog.Handler       { return h }
+
+func TestFromSlog(t *testing.T) {
+	var buf bytes.Buffer
+	log := FromSlog(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))).
+		WithName("room").
+		WithValues("room", "r1")
+
+	log.Warnw("slow", errors.New("timeout"), "object", testObject{"a", 2})
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	require.Equal(t, "WARN", entry["level"])
+	require.Equal(t, "slow", entry["msg"])
+	require.Equal(t, "room", entry["logger"])
+	require.Equal(t, "r1", entry["room"])
+	require.Equal(t, "timeout", entry["error"])
+	require.Equal(t, map[string]any{"name": "a", "count": float64(2)}, entry["object"])
+
+	buf.Reset()
+	FromSlog(slog.New(slog.NewJSONHandler(&buf, nil))).Debugw("hidden")
+	require.Empty(t, buf.String())
+}
+
+func TestToSlog(t *testing.T) {
+	h := &testHandler{}
+	log := ToSlog(FromSlog(slog.New(h)))
+	log.Error("failed", "error", errors.New("boom"), slog.Group("g", "k", "v"))
+	log.Log(context.Background(), slog.LevelWarn+1, "warning")
+
+	require.Len(t, h.records, 2)
+	require.Equal(t, slog.LevelError, h.records[0].Level)
+	attrs := map[string]slog.Value{}
+	h.records[0].Attrs(func(a slog.Attr) bool {
+		attrs[a.Key] = a.Value
+		return true
+	})
+	require.Equal(t, "boom", attrs["error"].Any().(error).Error())
+	require.Equal(t, slog.LevelWarn, h.records[1].Level)
+}
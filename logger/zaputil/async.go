@@ -0,0 +1,108 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zaputil
+
+import (
+	"sync"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap/zapcore"
+)
+
+const DefaultAsyncBufferSize = 1024
+
+// AsyncWriter writes to a WriteSyncer from a goroutine, so writers are not blocked when it is slow.
+// Writes made while the buffer is full are dropped.
+type AsyncWriter struct {
+	ws      zapcore.WriteSyncer
+	ch      chan []byte
+	done    chan struct{}
+	dropped atomic.Uint64
+
+	mu      sync.Mutex
+	drained *sync.Cond
+	pending int
+	closed  bool
+}
+
+// NewAsyncWriter returns a writer buffering up to size writes, DefaultAsyncBufferSize when size is zero.
+func NewAsyncWriter(ws zapcore.WriteSyncer, size int) *AsyncWriter {
+	if size <= 0 {
+		size = DefaultAsyncBufferSize
+	}
+	w := &AsyncWriter{
+		ws:   ws,
+		ch:   make(chan []byte, size),
+		done: make(chan struct{}),
+	}
+	w.drained = sync.NewCond(&w.mu)
+	go w.run()
+	return w
+}
+
+func (w *AsyncWriter) run() {
+	defer close(w.done)
+	for b := range w.ch {
+		_, _ = w.ws.Write(b)
+
+		w.mu.Lock()
+		w.pending--
+		if w.pending == 0 {
+			w.drained.Broadcast()
+		}
+		w.mu.Unlock()
+	}
+}
+
+func (w *AsyncWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed || w.pending == cap(w.ch) {
+		w.dropped.Inc()
+		return len(p), nil
+	}
+	// the encoders reuse their buffers
+	w.ch <- append([]byte(nil), p...)
+	w.pending++
+	return len(p), nil
+}
+
+// Sync waits for the buffered writes, and syncs the underlying writer.
+func (w *AsyncWriter) Sync() error {
+	w.mu.Lock()
+	for w.pending != 0 {
+		w.drained.Wait()
+	}
+	w.mu.Unlock()
+	return w.ws.Sync()
+}
+
+// Close flushes the buffered writes and stops the writer, later writes are dropped.
+func (w *AsyncWriter) Close() error {
+	w.mu.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.ch)
+	}
+	w.mu.Unlock()
+
+	<-w.done
+	return w.ws.Sync()
+}
+
+// Dropped returns the number of writes dropped because the buffer was full or the writer closed.
+func (w *AsyncWriter) Dropped() uint64 {
+	return w.dropped.Load()
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zaputil
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type blockingWriteSyncer struct {
+	unblock chan struct{}
+	mu      sync.Mutex
+	buf     bytes.Buffer
+	syncs   int
+}
+
+func (w *blockingWriteSyncer) Write(p []byte) (int, error) {
+	<-w.unblock
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.buf.Write(p)
+}
+
+func (w *blockingWriteSyncer) Sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.syncs++
+	return nil
+}
+
+func TestAsyncWriter(t *testing.T) {
+	ws := &blockingWriteSyncer{unblock: make(chan struct{})}
+	w := NewAsyncWriter(ws, 2)
+
+	b := []byte("a")
+	for range 4 {
+		n, err := w.Write(b)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+		// writes are copied
+		b[0]++
+	}
+	require.Equal(t, uint64(2), w.Dropped())
+
+	close(ws.unblock)
+	require.NoError(t, w.Sync())
+	require.Equal(t, "ab", ws.buf.String())
+	require.Equal(t, 1, ws.syncs)
+
+	_, _ = w.Write([]byte("e"))
+	require.NoError(t, w.Close())
+	require.Equal(t, "abe", ws.buf.String())
+
+	_, _ = w.Write([]byte("f"))
+	require.Equal(t, uint64(3), w.Dropped())
+	require.NoError(t, w.Close())
+}
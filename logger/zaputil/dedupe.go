@@ -0,0 +1,180 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zaputil
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const DefaultDedupeInterval = 10 * time.Second
+
+// DedupeRule collapses the repetitions of a message, e.g. a warning logged for each failed request to the same URL.
+type DedupeRule struct {
+	// fields logged with the message telling repetitions apart, e.g. "url". Only the message is compared when empty
+	Fields []string `yaml:"fields,omitempty"`
+	// repetitions within the interval are logged once, in a summary with their count. Defaults to DefaultDedupeInterval
+	Interval time.Duration `yaml:"interval,omitempty"`
+}
+
+// Deduper holds the repetitions of the messages with rules, it is shared by the cores of a logger.
+type Deduper struct {
+	rules map[string]DedupeRule
+
+	mu      sync.Mutex
+	entries map[string]*dedupeEntry
+}
+
+type dedupeEntry struct {
+	until      time.Time
+	interval   time.Duration
+	suppressed int
+	// last suppressed repetition
+	core   zapcore.Core
+	ent    zapcore.Entry
+	fields []zapcore.Field
+}
+
+// NewDeduper returns a deduper for the messages the rules are keyed by.
+func NewDeduper(rules map[string]DedupeRule) *Deduper {
+	return &Deduper{
+		rules:   rules,
+		entries: make(map[string]*dedupeEntry),
+	}
+}
+
+// NewDedupeCore logs the first of the repetitions of messages with rules within their interval,
+// and a summary with the number of the others once the interval ends.
+func NewDedupeCore(core zapcore.Core, d *Deduper) zapcore.Core {
+	return &dedupeCore{
+		Core: core,
+		d:    d,
+	}
+}
+
+type dedupeCore struct {
+	zapcore.Core
+	d *Deduper
+}
+
+func (c *dedupeCore) Level() zapcore.Level {
+	return zapcore.LevelOf(c.Core)
+}
+
+func (c *dedupeCore) With(fields []zapcore.Field) zapcore.Core {
+	return &dedupeCore{
+		Core: c.Core.With(fields),
+		d:    c.d,
+	}
+}
+
+func (c *dedupeCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.Enabled(ent.Level) {
+		return ce
+	}
+	if _, ok := c.d.rules[ent.Message]; ok {
+		// the fields telling repetitions apart are only known when writing
+		return ce.AddCore(ent, c)
+	}
+	return c.Core.Check(ent, ce)
+}
+
+func (c *dedupeCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	if c.d.admit(c.Core, ent, fields) {
+		write(c.Core, ent, fields)
+	}
+	return nil
+}
+
+func (d *Deduper) admit(core zapcore.Core, ent zapcore.Entry, fields []zapcore.Field) bool {
+	rule := d.rules[ent.Message]
+	interval := rule.Interval
+	if interval <= 0 {
+		interval = DefaultDedupeInterval
+	}
+	key := dedupeKey(ent, rule, fields)
+
+	d.mu.Lock()
+	e, ok := d.entries[key]
+	if ok && ent.Time.Before(e.until) {
+		e.suppressed++
+		e.core, e.ent, e.fields = core, ent, fields
+		d.mu.Unlock()
+		return false
+	}
+
+	next := &dedupeEntry{until: ent.Time.Add(interval), interval: interval}
+	d.entries[key] = next
+	d.mu.Unlock()
+
+	if ok {
+		// the interval ended before the summary was flushed
+		e.flush()
+	}
+	time.AfterFunc(interval, func() {
+		d.mu.Lock()
+		if d.entries[key] == next {
+			delete(d.entries, key)
+		} else {
+			next = nil
+		}
+		d.mu.Unlock()
+		next.flush()
+	})
+	return true
+}
+
+func (e *dedupeEntry) flush() {
+	if e == nil || e.suppressed == 0 {
+		return
+	}
+	ent := e.ent
+	ent.Time = time.Now()
+	fields := append(e.fields[:len(e.fields):len(e.fields)], zap.Int("repeated", e.suppressed), zap.Duration("interval", e.interval))
+	write(e.core, ent, fields)
+}
+
+func dedupeKey(ent zapcore.Entry, rule DedupeRule, fields []zapcore.Field) string {
+	var b strings.Builder
+	b.WriteString(ent.LoggerName)
+	b.WriteByte(0)
+	b.WriteString(ent.Level.String())
+	b.WriteByte(0)
+	b.WriteString(ent.Message)
+	for _, name := range rule.Fields {
+		b.WriteByte(0)
+		for _, f := range fields {
+			if f.Key == name {
+				enc := zapcore.NewMapObjectEncoder()
+				f.AddTo(enc)
+				fmt.Fprint(&b, enc.Fields[name])
+				break
+			}
+		}
+	}
+	return b.String()
+}
+
+// write passes the entry to the cores of core enabled for its level
+func write(core zapcore.Core, ent zapcore.Entry, fields []zapcore.Field) {
+	if ce := core.Check(ent, nil); ce != nil {
+		ce.Write(fields...)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zaputil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestDedupeCore(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	d := NewDeduper(map[string]DedupeRule{
+		"failed to send webhook": {Fields: []string{"url"}, Interval: 50 * time.Millisecond},
+	})
+	s := zap.New(NewDedupeCore(core, d)).Sugar()
+
+	for range 5 {
+		s.Warnw("failed to send webhook", "url", "https://a")
+		s.Warnw("failed to send webhook", "url", "https://b")
+		s.Infow("sent webhook", "url", "https://a")
+	}
+	require.Equal(t, 7, logs.Len())
+	require.Equal(t, 2, logs.FilterMessage("failed to send webhook").Len())
+
+	require.Eventually(t, func() bool {
+		return logs.FilterField(zap.Int("repeated", 4)).Len() == 2
+	}, time.Second, 10*time.Millisecond)
+
+	summary := logs.FilterField(zap.Int("repeated", 4)).FilterField(zap.String("url", "https://a")).All()
+	require.Len(t, summary, 1)
+	require.Equal(t, zapcore.WarnLevel, summary[0].Level)
+	require.Equal(t, "failed to send webhook", summary[0].Message)
+
+	// a message is logged again once its interval ends
+	s.Warnw("failed to send webhook", "url", "https://a")
+	require.Equal(t, 3, logs.FilterMessage("failed to send webhook").FilterField(zap.String("url", "https://a")).Len())
+}
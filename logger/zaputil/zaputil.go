@@ -46,6 +46,14 @@ func NewDevelopmentEncoder() DevelopmentEncoder {
 	}
 }
 
+// NewSplitEncoder returns an encoder writing the console and json sinks with their own encoders.
+func NewSplitEncoder(console, json zapcore.Encoder) DevelopmentEncoder {
+	return DevelopmentEncoder{
+		console: console,
+		json:    json,
+	}
+}
+
 func (e DevelopmentEncoder) WithValues(kvs ...any) DevelopmentEncoder {
 	e.console = encoderWithValues(e.console, kvs...)
 	e.json = encoderWithValues(e.json, kvs...)
@@ -66,6 +74,13 @@ func NewProductionEncoder() ProductionEncoder {
 	}
 }
 
+// NewSharedEncoder returns an encoder writing the console and json sinks with the same encoder.
+func NewSharedEncoder(enc zapcore.Encoder) ProductionEncoder {
+	return ProductionEncoder{
+		json: enc,
+	}
+}
+
 func (e ProductionEncoder) WithValues(kvs ...any) ProductionEncoder {
 	e.json = encoderWithValues(e.json, kvs...)
 	return e
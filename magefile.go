@@ -58,15 +58,19 @@ func Proto() error {
 		"livekit_rtc.proto",
 		"livekit_webhook.proto",
 		"livekit_metrics.proto",
+		"livekit_token_template.proto",
 	}
 	grpcProtoFiles := []string{
 		"infra/link.proto",
 		"rpc/analytics.proto",
+		"rpc/webhook.proto",
+		"rpc/registry.proto",
 	}
 	psrpcProtoFiles := []string{
 		"rpc/agent.proto",
 		"rpc/agent_dispatch.proto",
 		"rpc/egress.proto",
+		"rpc/health.proto",
 		"rpc/ingress.proto",
 		"rpc/io.proto",
 		"rpc/keepalive.proto",
@@ -75,6 +79,7 @@ func Proto() error {
 		"rpc/roommanager.proto",
 		"rpc/signal.proto",
 		"rpc/sip.proto",
+		"rpc/webhook_dispatch.proto",
 	}
 
 	fmt.Println("generating protobuf")
@@ -9,5 +9,6 @@ export * from "./gen/livekit_models_pb.js";
 export * from "./gen/livekit_room_pb.js";
 export * from "./gen/livekit_rtc_pb.js";
 export * from "./gen/livekit_sip_pb.js";
+export * from "./gen/livekit_token_template_pb.js";
 export * from "./gen/livekit_webhook_pb.js";
 export * from "./gen/version.js";
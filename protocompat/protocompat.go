@@ -0,0 +1,242 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protocompat
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	schemaFile   = "schema.json"
+	messagesFile = "messages.json"
+
+	// unknown field appended to golden messages, the largest valid number should stay unused
+	unknownFieldNumber = protowire.MaxValidNumber
+)
+
+type Params struct {
+	// directory of the schema snapshot and golden messages, usually testdata
+	Dir string
+	// proto packages to check, their files must be registered by importing the generated code
+	Packages []protoreflect.FullName
+	// write the current schema and goldens of new messages instead of failing on them.
+	// Goldens of existing messages are never rewritten, they stand for older versions.
+	Update bool
+}
+
+// Check fails t when the registered files of the packages break compatibility with the snapshot in
+// params.Dir: when fields were renumbered, removed without being reserved, renamed or changed to an
+// incompatible type, when golden messages serialized by older versions do not decode without unknown
+// fields and round-trip, or when unknown fields are not preserved.
+//
+//	var update = flag.Bool("update", false, "update the compatibility snapshot")
+//
+//	func TestCompatibility(t *testing.T) {
+//		protocompat.Check(t, protocompat.Params{Dir: "testdata", Packages: []protoreflect.FullName{"livekit"}, Update: *update})
+//	}
+func Check(t testing.TB, params Params) {
+	t.Helper()
+
+	files := packageFiles(params.Packages)
+	if len(files) == 0 {
+		t.Fatalf("no files registered for packages %v", params.Packages)
+	}
+	schema := NewSchema(files...)
+
+	old := &Schema{}
+	if err := readJSON(filepath.Join(params.Dir, schemaFile), old); errors.Is(err, fs.ErrNotExist) && params.Update {
+		old = schema
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	for _, issue := range schema.Compare(old) {
+		t.Error(issue)
+	}
+
+	goldens := map[string][]byte{}
+	if err := readJSON(filepath.Join(params.Dir, messagesFile), &goldens); err != nil && !(errors.Is(err, fs.ErrNotExist) && params.Update) {
+		t.Fatal(err)
+	}
+	for _, name := range sortedKeys(goldens) {
+		if _, ok := schema.Messages[name]; !ok {
+			// reported by Compare
+			continue
+		}
+		if err := checkGolden(protoreflect.FullName(name), goldens[name]); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+
+	var added bool
+	for _, name := range sortedKeys(schema.Messages) {
+		if _, ok := goldens[name]; ok {
+			continue
+		}
+		if !params.Update {
+			t.Errorf("%s: no golden message, update the snapshot", name)
+			continue
+		}
+		b, err := marshalSample(protoreflect.FullName(name))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		goldens[name] = b
+		added = true
+	}
+
+	if params.Update && !t.Failed() {
+		if err := os.MkdirAll(params.Dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := writeJSON(filepath.Join(params.Dir, schemaFile), schema); err != nil {
+			t.Fatal(err)
+		}
+		if added {
+			if err := writeJSON(filepath.Join(params.Dir, messagesFile), goldens); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func packageFiles(packages []protoreflect.FullName) []protoreflect.FileDescriptor {
+	var files []protoreflect.FileDescriptor
+	for _, pkg := range packages {
+		protoregistry.GlobalFiles.RangeFilesByPackage(pkg, func(fd protoreflect.FileDescriptor) bool {
+			files = append(files, fd)
+			return true
+		})
+	}
+	return files
+}
+
+func marshalSample(name protoreflect.FullName) ([]byte, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return proto.MarshalOptions{Deterministic: true}.Marshal(NewSample(mt))
+}
+
+// checkGolden decodes b, serialized by an older version, and checks that it has no unknown fields
+// and round-trips without losing data, with an unknown field appended.
+func checkGolden(name protoreflect.FullName, b []byte) error {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		return err
+	}
+
+	m := mt.New().Interface()
+	if err := proto.Unmarshal(b, m); err != nil {
+		return fmt.Errorf("golden does not decode: %w", err)
+	}
+	if path := findUnknown(m.ProtoReflect(), string(name)); path != "" {
+		return fmt.Errorf("golden has fields unknown to %s, a field was renumbered or removed", path)
+	}
+
+	unknown := protowire.AppendTag(nil, unknownFieldNumber, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 1)
+	in := append(bytes.Clone(b), unknown...)
+	m = mt.New().Interface()
+	if err := proto.Unmarshal(in, m); err != nil {
+		return err
+	}
+	out, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return err
+	}
+	// fields are encoded in declaration order, which may change, so decode again instead of
+	// comparing bytes
+	rt := mt.New().Interface()
+	if err := proto.Unmarshal(out, rt); err != nil {
+		return err
+	}
+	if len(out) != len(in) || !proto.Equal(m, rt) {
+		return errors.New("golden does not round-trip")
+	}
+	if !bytes.HasSuffix(rt.ProtoReflect().GetUnknown(), unknown) {
+		return errors.New("unknown fields were not preserved")
+	}
+	return nil
+}
+
+// findUnknown returns the path of the first message with unknown fields.
+// Fields with reserved numbers were removed on purpose and are ignored.
+func findUnknown(m protoreflect.Message, path string) string {
+	if !onlyReserved(m.Descriptor(), m.GetUnknown()) {
+		return path
+	}
+	var found string
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fieldPath := path + "." + string(fd.Name())
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			for i := range v.List().Len() {
+				if found = findUnknown(v.List().Get(i).Message(), fieldPath); found != "" {
+					break
+				}
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				found = findUnknown(v.Message(), fieldPath)
+				return found == ""
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			found = findUnknown(v.Message(), fieldPath)
+		}
+		return found == ""
+	})
+	return found
+}
+
+// onlyReserved returns true if all unknown fields have numbers reserved by the message
+func onlyReserved(md protoreflect.MessageDescriptor, b protoreflect.RawFields) bool {
+	for len(b) > 0 {
+		num, _, n := protowire.ConsumeField(b)
+		if n < 0 || !md.ReservedRanges().Has(num) {
+			return false
+		}
+		b = b[n:]
+	}
+	return true
+}
+
+func readJSON(path string, v any) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func writeJSON(path string, v any) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
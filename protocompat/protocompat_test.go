@@ -0,0 +1,118 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protocompat
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/livekit/protocol/livekit"
+	_ "github.com/livekit/protocol/rpc"
+)
+
+var update = flag.Bool("update", false, "write the current schema and goldens of new messages to testdata")
+
+func TestCompatibility(t *testing.T) {
+	Check(t, Params{
+		Dir:      "testdata",
+		Packages: []protoreflect.FullName{"livekit", "rpc"},
+		Update:   *update,
+	})
+}
+
+func TestCompare(t *testing.T) {
+	field := func(name string, num int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(num),
+			Type:     typ.Enum(),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		}
+	}
+	file := func(fields []*descriptorpb.FieldDescriptorProto, reserved []*descriptorpb.DescriptorProto_ReservedRange, values ...string) protoreflect.FileDescriptor {
+		enum := &descriptorpb.EnumDescriptorProto{Name: proto.String("Kind")}
+		for i, v := range values {
+			enum.Value = append(enum.Value, &descriptorpb.EnumValueDescriptorProto{Name: proto.String(v), Number: proto.Int32(int32(i))})
+		}
+		fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+			Name:        proto.String("test.proto"),
+			Package:     proto.String("test"),
+			Syntax:      proto.String("proto3"),
+			EnumType:    []*descriptorpb.EnumDescriptorProto{enum},
+			MessageType: []*descriptorpb.DescriptorProto{{Name: proto.String("Msg"), Field: fields, ReservedRange: reserved}},
+		}, nil)
+		require.NoError(t, err)
+		return fd
+	}
+
+	old := NewSchema(file([]*descriptorpb.FieldDescriptorProto{
+		field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+		field("count", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32),
+		field("size", 3, descriptorpb.FieldDescriptorProto_TYPE_UINT32),
+	}, nil, "A", "B"))
+
+	t.Run("compatible", func(t *testing.T) {
+		s := NewSchema(file([]*descriptorpb.FieldDescriptorProto{
+			field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_BYTES),
+			field("count", 2, descriptorpb.FieldDescriptorProto_TYPE_INT64),
+			field("name", 4, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+		}, []*descriptorpb.DescriptorProto_ReservedRange{{Start: proto.Int32(3), End: proto.Int32(4)}}, "A", "B", "C"))
+		require.Empty(t, s.Compare(old))
+	})
+
+	t.Run("incompatible", func(t *testing.T) {
+		s := NewSchema(file([]*descriptorpb.FieldDescriptorProto{
+			field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_FIXED32),
+			field("count", 4, descriptorpb.FieldDescriptorProto_TYPE_INT32),
+			field("length", 3, descriptorpb.FieldDescriptorProto_TYPE_UINT32),
+		}, nil, "A", "BB"))
+		require.Equal(t, []string{
+			"test.Msg.id (1): kind changed from string to fixed32",
+			"test.Msg.count: field 2 removed without being reserved",
+			"test.Msg.size (3): renamed to length",
+			"test.Kind.B: value 1 renamed to BB",
+		}, s.Compare(old))
+	})
+}
+
+func TestCheckGolden(t *testing.T) {
+	name := (&livekit.ParticipantInfo{}).ProtoReflect().Descriptor().FullName()
+	b, err := marshalSample(name)
+	require.NoError(t, err)
+	require.NoError(t, checkGolden(name, b))
+
+	// a field that is not in ParticipantInfo, as if it was removed
+	b = append(b, 0xf8, 0x7f, 0x01) // field 2047, varint 1
+	require.ErrorContains(t, checkGolden(name, b), "unknown to livekit.ParticipantInfo")
+
+	// removed fields are accepted once their number is reserved
+	name = (&livekit.WebhookSigningKey{}).ProtoReflect().Descriptor().FullName()
+	b, err = proto.Marshal(&livekit.WebhookSigningKey{KeyId: "kid", ApiKey: "key"})
+	require.NoError(t, err)
+	b = append(b, 0x1a, 0x01, 's') // field 3, "s"
+	require.NoError(t, checkGolden(name, b))
+
+	m := NewSample((&livekit.ParticipantInfo{}).ProtoReflect().Type()).(*livekit.ParticipantInfo)
+	require.NotEmpty(t, m.Sid)
+	require.NotEmpty(t, m.Tracks)
+	require.NotNil(t, m.Permission)
+}
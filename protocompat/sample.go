@@ -0,0 +1,105 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protocompat
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// depth of the nested messages set in samples, which bounds recursive types
+const sampleDepth = 3
+
+// NewSample returns a message of type mt with every field set to a value derived from its number,
+// so that serialized samples are deterministic. Lists and maps hold a single element and only the
+// first field of each oneof is set.
+func NewSample(mt protoreflect.MessageType) proto.Message {
+	m := mt.New()
+	fillSample(m, 0)
+	return m.Interface()
+}
+
+func fillSample(m protoreflect.Message, depth int) {
+	fields := m.Descriptor().Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		if od := fd.ContainingOneof(); od != nil && !od.IsSynthetic() && od.Fields().Get(0) != fd {
+			continue
+		}
+		isMessage := fd.Message() != nil && !fd.IsMap() || fd.IsMap() && fd.MapValue().Message() != nil
+		if isMessage && depth >= sampleDepth {
+			continue
+		}
+
+		switch {
+		case fd.IsList():
+			l := m.Mutable(fd).List()
+			if fd.Message() != nil {
+				v := l.NewElement()
+				fillSample(v.Message(), depth+1)
+				l.Append(v)
+			} else if v, ok := sampleScalar(fd); ok {
+				l.Append(v)
+			}
+		case fd.IsMap():
+			k, _ := sampleScalar(fd.MapKey())
+			mp := m.Mutable(fd).Map()
+			if fd.MapValue().Message() != nil {
+				v := mp.NewValue()
+				fillSample(v.Message(), depth+1)
+				mp.Set(k.MapKey(), v)
+			} else if v, ok := sampleScalar(fd.MapValue()); ok {
+				mp.Set(k.MapKey(), v)
+			}
+		case fd.Message() != nil:
+			fillSample(m.Mutable(fd).Message(), depth+1)
+		default:
+			if v, ok := sampleScalar(fd); ok {
+				m.Set(fd, v)
+			}
+		}
+	}
+}
+
+func sampleScalar(fd protoreflect.FieldDescriptor) (protoreflect.Value, bool) {
+	n := int64(fd.Number())
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(true), true
+	case protoreflect.EnumKind:
+		// the last value, the zero value would not be serialized
+		values := fd.Enum().Values()
+		v := values.Get(values.Len() - 1).Number()
+		return protoreflect.ValueOfEnum(v), v != 0
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(n)), true
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(n), true
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(n)), true
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(uint64(n)), true
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(n) + 0.5), true
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(float64(n) + 0.5), true
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(string(fd.Name())), true
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(fd.Name())), true
+	default:
+		return protoreflect.Value{}, false
+	}
+}
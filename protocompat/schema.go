@@ -0,0 +1,240 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package protocompat checks that proto changes stay compatible with older clients, by comparing the
+// current descriptors to a schema snapshot and decoding messages serialized by older versions.
+//
+// After a compatible change to the protobufs, update the snapshot of this repository with
+//
+//	go test ./protocompat -run TestCompatibility -update
+package protocompat
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Schema is a snapshot of the field numbers, names and types of the messages and enums of proto files.
+type Schema struct {
+	Messages map[string]*MessageSchema `json:"messages"`
+	Enums    map[string]*EnumSchema    `json:"enums"`
+}
+
+type MessageSchema struct {
+	Fields   map[int32]FieldSchema `json:"fields"`
+	Reserved [][2]int32            `json:"reserved,omitempty"` // [start, end) ranges
+}
+
+type FieldSchema struct {
+	Name        string `json:"name"`
+	Kind        string `json:"kind"`
+	Cardinality string `json:"cardinality"`
+	// full name of the message or enum type
+	Type  string `json:"type,omitempty"`
+	Oneof string `json:"oneof,omitempty"`
+}
+
+type EnumSchema struct {
+	Values   map[int32]string `json:"values"`
+	Reserved [][2]int32       `json:"reserved,omitempty"` // [start, end] ranges
+}
+
+// NewSchema records the messages and enums of files, including nested ones.
+func NewSchema(files ...protoreflect.FileDescriptor) *Schema {
+	s := &Schema{
+		Messages: map[string]*MessageSchema{},
+		Enums:    map[string]*EnumSchema{},
+	}
+	for _, fd := range files {
+		s.addEnums(fd.Enums())
+		s.addMessages(fd.Messages())
+	}
+	return s
+}
+
+func (s *Schema) addEnums(eds protoreflect.EnumDescriptors) {
+	for i := range eds.Len() {
+		ed := eds.Get(i)
+		es := &EnumSchema{Values: map[int32]string{}}
+		for j := range ed.Values().Len() {
+			v := ed.Values().Get(j)
+			es.Values[int32(v.Number())] = string(v.Name())
+		}
+		for j := range ed.ReservedRanges().Len() {
+			r := ed.ReservedRanges().Get(j)
+			es.Reserved = append(es.Reserved, [2]int32{int32(r[0]), int32(r[1])})
+		}
+		s.Enums[string(ed.FullName())] = es
+	}
+}
+
+func (s *Schema) addMessages(mds protoreflect.MessageDescriptors) {
+	for i := range mds.Len() {
+		md := mds.Get(i)
+		s.addEnums(md.Enums())
+		s.addMessages(md.Messages())
+		if md.IsMapEntry() {
+			continue
+		}
+
+		ms := &MessageSchema{Fields: map[int32]FieldSchema{}}
+		for j := range md.Fields().Len() {
+			fd := md.Fields().Get(j)
+			f := FieldSchema{
+				Name:        string(fd.Name()),
+				Kind:        fd.Kind().String(),
+				Cardinality: fd.Cardinality().String(),
+			}
+			if fd.IsMap() {
+				f.Kind = fmt.Sprintf("map<%s, %s>", fieldType(fd.MapKey()), fieldType(fd.MapValue()))
+			} else {
+				f.Type = fieldTypeName(fd)
+			}
+			if od := fd.ContainingOneof(); od != nil && !od.IsSynthetic() {
+				f.Oneof = string(od.Name())
+			}
+			ms.Fields[int32(fd.Number())] = f
+		}
+		for j := range md.ReservedRanges().Len() {
+			r := md.ReservedRanges().Get(j)
+			ms.Reserved = append(ms.Reserved, [2]int32{int32(r[0]), int32(r[1])})
+		}
+		s.Messages[string(md.FullName())] = ms
+	}
+}
+
+func fieldTypeName(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return string(fd.Message().FullName())
+	case protoreflect.EnumKind:
+		return string(fd.Enum().FullName())
+	default:
+		return ""
+	}
+}
+
+func fieldType(fd protoreflect.FieldDescriptor) string {
+	if name := fieldTypeName(fd); name != "" {
+		return name
+	}
+	return fd.Kind().String()
+}
+
+// wire compatible kinds, see https://protobuf.dev/programming-guides/proto3/#updating
+var kindGroups = map[string]string{
+	"int32":    "varint",
+	"int64":    "varint",
+	"uint32":   "varint",
+	"uint64":   "varint",
+	"bool":     "varint",
+	"enum":     "varint",
+	"sint32":   "zigzag",
+	"sint64":   "zigzag",
+	"fixed32":  "fixed32",
+	"sfixed32": "fixed32",
+	"fixed64":  "fixed64",
+	"sfixed64": "fixed64",
+	"string":   "bytes",
+	"bytes":    "bytes",
+}
+
+// Compare returns the changes from old that break clients built with it, sorted by type name.
+// Adding messages, enums, fields and values is compatible. Removing them is only compatible when
+// their numbers are reserved. Renaming them breaks the json encoding used by twirp clients.
+func (s *Schema) Compare(old *Schema) []string {
+	var issues []string
+	for _, name := range sortedKeys(old.Messages) {
+		om := old.Messages[name]
+		nm, ok := s.Messages[name]
+		if !ok {
+			issues = append(issues, fmt.Sprintf("%s: message removed", name))
+			continue
+		}
+		for _, num := range sortedKeys(om.Fields) {
+			of := om.Fields[num]
+			nf, ok := nm.Fields[num]
+			if !ok {
+				if !reserved(nm.Reserved, num, false) {
+					issues = append(issues, fmt.Sprintf("%s.%s: field %d removed without being reserved", name, of.Name, num))
+				}
+				continue
+			}
+			issues = append(issues, compareField(name, num, of, nf)...)
+		}
+	}
+
+	for _, name := range sortedKeys(old.Enums) {
+		oe := old.Enums[name]
+		ne, ok := s.Enums[name]
+		if !ok {
+			issues = append(issues, fmt.Sprintf("%s: enum removed", name))
+			continue
+		}
+		for _, num := range sortedKeys(oe.Values) {
+			ov := oe.Values[num]
+			nv, ok := ne.Values[num]
+			switch {
+			case !ok && !reserved(ne.Reserved, num, true):
+				issues = append(issues, fmt.Sprintf("%s.%s: value %d removed without being reserved", name, ov, num))
+			case ok && nv != ov:
+				issues = append(issues, fmt.Sprintf("%s.%s: value %d renamed to %s", name, ov, num, nv))
+			}
+		}
+	}
+	return issues
+}
+
+func compareField(msg string, num int32, of, nf FieldSchema) []string {
+	var issues []string
+	prefix := fmt.Sprintf("%s.%s (%d)", msg, of.Name, num)
+	if nf.Name != of.Name {
+		issues = append(issues, fmt.Sprintf("%s: renamed to %s", prefix, nf.Name))
+	}
+	if nf.Kind != of.Kind && (kindGroups[nf.Kind] == "" || kindGroups[nf.Kind] != kindGroups[of.Kind]) {
+		issues = append(issues, fmt.Sprintf("%s: kind changed from %s to %s", prefix, of.Kind, nf.Kind))
+	} else if nf.Type != of.Type && nf.Kind == of.Kind {
+		issues = append(issues, fmt.Sprintf("%s: type changed from %s to %s", prefix, of.Type, nf.Type))
+	}
+	if nf.Cardinality != of.Cardinality {
+		issues = append(issues, fmt.Sprintf("%s: cardinality changed from %s to %s", prefix, of.Cardinality, nf.Cardinality))
+	}
+	if nf.Oneof != of.Oneof && of.Oneof != "" {
+		issues = append(issues, fmt.Sprintf("%s: moved out of oneof %s", prefix, of.Oneof))
+	}
+	return issues
+}
+
+// reserved returns true if num is in ranges, which are exclusive of their end for message fields
+// and inclusive for enum values.
+func reserved(ranges [][2]int32, num int32, inclusive bool) bool {
+	for _, r := range ranges {
+		if num >= r[0] && (num < r[1] || inclusive && num == r[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
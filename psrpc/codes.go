@@ -0,0 +1,171 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package psrpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc/status"
+
+	"github.com/livekit/protocol/utils/xtwirp"
+	"github.com/livekit/psrpc"
+)
+
+// FromError converts twirp errors, grpc statuses and context errors to psrpc errors, so that gateways
+// can match any of them with errors.Is(err, psrpc.NotFound) and map them with the ErrorCode methods.
+// Other errors are Unknown, psrpc errors are returned as is.
+func FromError(err error) psrpc.Error {
+	if err == nil {
+		return nil
+	}
+
+	var psrpcErr psrpc.Error
+	if errors.As(err, &psrpcErr) {
+		return psrpcErr
+	}
+	var twirpErr twirp.Error
+	if errors.As(err, &twirpErr) {
+		return psrpc.NewError(CodeFromTwirp(twirpErr.Code()), err)
+	}
+	if st, ok := status.FromError(err); ok {
+		return psrpc.NewErrorFromResponse(string(psrpc.ErrorCodeFromGRPC(st.Code())), st.Message(), st.Proto().Details...)
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return psrpc.NewError(psrpc.Canceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return psrpc.NewError(psrpc.DeadlineExceeded, err)
+	default:
+		return psrpc.NewError(psrpc.Unknown, err)
+	}
+}
+
+// Code returns the psrpc error code of err, see FromError.
+func Code(err error) psrpc.ErrorCode {
+	if err == nil {
+		return psrpc.OK
+	}
+	return FromError(err).Code()
+}
+
+// HTTPStatus returns the http status for err, see FromError.
+func HTTPStatus(err error) int {
+	return Code(err).ToHTTP()
+}
+
+// ToGRPC converts err to a grpc status error, keeping the details of psrpc and grpc errors.
+func ToGRPC(err error) error {
+	if err == nil {
+		return nil
+	}
+	return status.Convert(FromError(err)).Err()
+}
+
+// ToTwirp converts err to a twirp error, keeping the details of psrpc and grpc errors as metadata.
+func ToTwirp(err error) twirp.Error {
+	if err == nil {
+		return nil
+	}
+	// psrpc errors convert to twirp errors themselves, without details
+	var psrpcErr psrpc.Error
+	var twirpErr twirp.Error
+	if !errors.As(err, &psrpcErr) && errors.As(err, &twirpErr) {
+		return twirpErr
+	}
+	return xtwirp.ToError(ToGRPC(err))
+}
+
+func CodeFromTwirp(code twirp.ErrorCode) psrpc.ErrorCode {
+	switch code {
+	case twirp.NoError:
+		return psrpc.OK
+	case twirp.Canceled:
+		return psrpc.Canceled
+	case twirp.InvalidArgument:
+		return psrpc.InvalidArgument
+	case twirp.Malformed:
+		return psrpc.MalformedRequest
+	case twirp.DeadlineExceeded:
+		return psrpc.DeadlineExceeded
+	case twirp.NotFound, twirp.BadRoute:
+		return psrpc.NotFound
+	case twirp.AlreadyExists:
+		return psrpc.AlreadyExists
+	case twirp.PermissionDenied:
+		return psrpc.PermissionDenied
+	case twirp.Unauthenticated:
+		return psrpc.Unauthenticated
+	case twirp.ResourceExhausted:
+		return psrpc.ResourceExhausted
+	case twirp.FailedPrecondition:
+		return psrpc.FailedPrecondition
+	case twirp.Aborted:
+		return psrpc.Aborted
+	case twirp.OutOfRange:
+		return psrpc.OutOfRange
+	case twirp.Unimplemented:
+		return psrpc.Unimplemented
+	case twirp.Internal:
+		return psrpc.Internal
+	case twirp.Unavailable:
+		return psrpc.Unavailable
+	case twirp.DataLoss:
+		return psrpc.DataLoss
+	default:
+		return psrpc.Unknown
+	}
+}
+
+// CodeFromHTTP returns the error code of an http status. Codes sharing a status with others, like
+// Canceled and Unavailable, do not round trip.
+func CodeFromHTTP(code int) psrpc.ErrorCode {
+	switch code {
+	case http.StatusBadRequest:
+		return psrpc.InvalidArgument
+	case http.StatusUnauthorized:
+		return psrpc.Unauthenticated
+	case http.StatusForbidden:
+		return psrpc.PermissionDenied
+	case http.StatusNotFound:
+		return psrpc.NotFound
+	case http.StatusNotAcceptable:
+		return psrpc.NotAcceptable
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return psrpc.DeadlineExceeded
+	case http.StatusConflict:
+		return psrpc.AlreadyExists
+	case http.StatusPreconditionFailed:
+		return psrpc.FailedPrecondition
+	case http.StatusRequestedRangeNotSatisfiable:
+		return psrpc.OutOfRange
+	case http.StatusTooManyRequests:
+		return psrpc.ResourceExhausted
+	case http.StatusNotImplemented:
+		return psrpc.Unimplemented
+	case http.StatusServiceUnavailable, http.StatusBadGateway:
+		return psrpc.Unavailable
+	}
+	switch {
+	case code >= 200 && code < 300:
+		return psrpc.OK
+	case code >= 500:
+		return psrpc.Internal
+	default:
+		return psrpc.Unknown
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package psrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/psrpc"
+)
+
+func TestErrorCodes(t *testing.T) {
+	allCodes := []psrpc.ErrorCode{
+		psrpc.Canceled, psrpc.MalformedRequest, psrpc.DeadlineExceeded, psrpc.Unavailable, psrpc.Unknown,
+		psrpc.InvalidArgument, psrpc.NotFound, psrpc.AlreadyExists, psrpc.PermissionDenied, psrpc.ResourceExhausted,
+		psrpc.FailedPrecondition, psrpc.Aborted, psrpc.OutOfRange, psrpc.Unimplemented, psrpc.Internal,
+		psrpc.DataLoss, psrpc.Unauthenticated,
+	}
+
+	t.Run("twirp round trip", func(t *testing.T) {
+		for _, code := range allCodes {
+			require.Equal(t, code, CodeFromTwirp(code.ToTwirp()), code)
+		}
+	})
+
+	t.Run("grpc round trip", func(t *testing.T) {
+		for _, code := range allCodes {
+			if code == psrpc.MalformedRequest {
+				// grpc has no malformed request
+				continue
+			}
+			err := ToGRPC(psrpc.NewErrorf(code, "failed"))
+			require.Equal(t, code.ToGRPC(), status.Code(err), code)
+			require.Equal(t, code, Code(err), code)
+		}
+	})
+
+	t.Run("http", func(t *testing.T) {
+		for _, code := range allCodes {
+			// codes sharing a status map back to the same code
+			require.Equal(t, code.ToHTTP(), CodeFromHTTP(code.ToHTTP()).ToHTTP(), code)
+		}
+		require.Equal(t, psrpc.OK, CodeFromHTTP(http.StatusNoContent))
+		require.Equal(t, psrpc.Internal, CodeFromHTTP(http.StatusInsufficientStorage))
+		require.Equal(t, http.StatusNotFound, HTTPStatus(twirp.NotFoundError("room")))
+	})
+
+	t.Run("errors.Is", func(t *testing.T) {
+		for _, err := range []error{
+			twirp.NotFoundError("room"),
+			fmt.Errorf("wrapped: %w", twirp.NotFoundError("room")),
+			status.Error(codes.NotFound, "room"),
+			psrpc.NewErrorf(psrpc.NotFound, "room"),
+		} {
+			require.ErrorIs(t, FromError(err), psrpc.NotFound, err)
+			require.Equal(t, twirp.NotFound, ToTwirp(err).Code(), err)
+		}
+
+		require.ErrorIs(t, FromError(context.DeadlineExceeded), psrpc.DeadlineExceeded)
+		require.ErrorIs(t, FromError(context.DeadlineExceeded), context.DeadlineExceeded)
+		require.ErrorIs(t, FromError(errors.New("failed")), psrpc.Unknown)
+		require.Nil(t, FromError(nil))
+		require.Equal(t, psrpc.OK, Code(nil))
+
+		// the converted error still unwraps to the original
+		var twirpErr twirp.Error
+		require.ErrorAs(t, FromError(twirp.NotFoundError("room")), &twirpErr)
+	})
+
+	t.Run("details", func(t *testing.T) {
+		err := psrpc.NewError(psrpc.NotFound, errors.New("room"), &livekit.Room{Name: "room"})
+		st := status.Convert(ToGRPC(err))
+		require.Len(t, st.Details(), 1)
+
+		details := FromError(st.Err()).DetailsProto()
+		require.Len(t, details, 1)
+		require.NotEmpty(t, ToTwirp(err).Meta("error_details"))
+	})
+}
@@ -159,3 +159,10 @@ func GetRedisClient(conf *RedisConfig) (redis.UniversalClient, error) {
 
 	return rc, nil
 }
+
+// HealthCheck returns a health check that pings the redis server.
+func HealthCheck(rc redis.UniversalClient) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		return rc.Ping(ctx).Err()
+	}
+}
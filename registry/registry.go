@@ -0,0 +1,158 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package registry serves the descriptors of all protos of this module, so dynamic consumers
+// (proxies, schema registries, plugins) can decode messages without vendoring generated code.
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/livekit/protocol/rpc"
+
+	// register the descriptors of every generated package
+	_ "github.com/livekit/protocol/infra"
+	_ "github.com/livekit/protocol/livekit"
+	_ "github.com/livekit/protocol/replay"
+)
+
+const (
+	goPackagePrefix = "github.com/livekit/protocol/"
+	contentType     = "application/x-protobuf"
+)
+
+type descriptorSet struct {
+	set     *descriptorpb.FileDescriptorSet
+	encoded []byte
+	version string
+}
+
+var load = sync.OnceValue(func() *descriptorSet {
+	var files []protoreflect.FileDescriptor
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		// dependencies come first, as required by protodesc.NewFiles
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		files = append(files, fd)
+	}
+
+	var ours []protoreflect.FileDescriptor
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		if opts, ok := fd.Options().(*descriptorpb.FileOptions); ok && strings.HasPrefix(opts.GetGoPackage(), goPackagePrefix) {
+			ours = append(ours, fd)
+		}
+		return true
+	})
+	// registration order depends on package init order, sort for a stable version
+	sort.Slice(ours, func(i, j int) bool { return ours[i].Path() < ours[j].Path() })
+	for _, fd := range ours {
+		add(fd)
+	}
+
+	s := &descriptorSet{set: &descriptorpb.FileDescriptorSet{}}
+	for _, fd := range files {
+		s.set.File = append(s.set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(s.set)
+	if err != nil {
+		panic(err)
+	}
+	s.encoded = encoded
+	sum := sha256.Sum256(encoded)
+	s.version = hex.EncodeToString(sum[:16])
+	return s
+})
+
+// FileDescriptorSet returns the descriptors of all protos of this module and their dependencies.
+// The returned set is shared and must not be modified.
+func FileDescriptorSet() *descriptorpb.FileDescriptorSet {
+	return load().set
+}
+
+// MarshaledFileDescriptorSet returns the deterministic encoding of FileDescriptorSet.
+func MarshaledFileDescriptorSet() []byte {
+	return load().encoded
+}
+
+// Version returns a checksum of the descriptor set, which changes whenever any of the protos change.
+func Version() string {
+	return load().version
+}
+
+// Files returns the descriptors as a registry, e.g. for resolving message types with dynamicpb.
+func Files() (*protoregistry.Files, error) {
+	return protodesc.NewFiles(FileDescriptorSet())
+}
+
+// Server implements the ProtoRegistry service.
+type Server struct {
+	rpc.UnimplementedProtoRegistryServer
+}
+
+var _ rpc.ProtoRegistryServer = (*Server)(nil)
+
+func NewServer() *Server {
+	return &Server{}
+}
+
+func (s *Server) GetDescriptorSet(_ context.Context, req *rpc.GetDescriptorSetRequest) (*rpc.GetDescriptorSetResponse, error) {
+	d := load()
+	if req.KnownVersion == d.version {
+		return &rpc.GetDescriptorSetResponse{Version: d.version, NotModified: true}, nil
+	}
+	return &rpc.GetDescriptorSetResponse{Version: d.version, FileDescriptorSet: d.encoded}, nil
+}
+
+// Handler serves the marshaled descriptor set over HTTP, using the version as ETag.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		d := load()
+		etag := `"` + d.version + `"`
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", contentType)
+		if r.Method == http.MethodHead {
+			return
+		}
+		_, _ = w.Write(d.encoded)
+	})
+}
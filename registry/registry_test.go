@@ -0,0 +1,120 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/rpc"
+)
+
+func TestFileDescriptorSet(t *testing.T) {
+	files, err := Files()
+	require.NoError(t, err)
+
+	for _, path := range []string{"livekit_room.proto", "livekit_rtc.proto", "cloud_replay.proto", "infra/link.proto", "rpc/registry.proto"} {
+		_, err = files.FindFileByPath(path)
+		require.NoError(t, err, path)
+	}
+
+	// messages decode without the generated types
+	encoded, err := proto.Marshal(&livekit.Room{Name: "room", NumParticipants: 2})
+	require.NoError(t, err)
+	desc, err := files.FindDescriptorByName("livekit.Room")
+	require.NoError(t, err)
+	msg := dynamicpb.NewMessage(desc.(protoreflect.MessageDescriptor))
+	require.NoError(t, proto.Unmarshal(encoded, msg))
+	require.Equal(t, "room", msg.Get(msg.Descriptor().Fields().ByName("name")).String())
+
+	require.Len(t, Version(), 32)
+	require.Equal(t, Version(), Version())
+}
+
+func TestServer(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	rpc.RegisterProtoRegistryServer(srv, NewServer())
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client := rpc.NewProtoRegistryClient(conn)
+
+	res, err := client.GetDescriptorSet(context.Background(), &rpc.GetDescriptorSetRequest{})
+	require.NoError(t, err)
+	require.Equal(t, Version(), res.Version)
+	require.False(t, res.NotModified)
+
+	var set descriptorpb.FileDescriptorSet
+	require.NoError(t, proto.Unmarshal(res.FileDescriptorSet, &set))
+	_, err = protodesc.NewFiles(&set)
+	require.NoError(t, err)
+
+	res, err = client.GetDescriptorSet(context.Background(), &rpc.GetDescriptorSetRequest{KnownVersion: res.Version})
+	require.NoError(t, err)
+	require.True(t, res.NotModified)
+	require.Empty(t, res.FileDescriptorSet)
+}
+
+func TestHandler(t *testing.T) {
+	s := httptest.NewServer(Handler())
+	defer s.Close()
+
+	res, err := http.Get(s.URL)
+	require.NoError(t, err)
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, MarshaledFileDescriptorSet(), body)
+	etag := res.Header.Get("ETag")
+	require.Equal(t, `"`+Version()+`"`, etag)
+
+	req, err := http.NewRequest(http.MethodGet, s.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set("If-None-Match", etag)
+	res, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	_ = res.Body.Close()
+	require.Equal(t, http.StatusNotModified, res.StatusCode)
+
+	res, err = http.Post(s.URL, "text/plain", nil)
+	require.NoError(t, err)
+	_ = res.Body.Close()
+	require.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+}
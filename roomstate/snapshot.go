@@ -0,0 +1,130 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roomstate
+
+import (
+	"encoding/json"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+// Snapshot is a point in time copy of the state, used to resume consuming events without replaying the whole stream.
+type Snapshot struct {
+	Rooms       []RoomSnapshot `json:"rooms"`
+	LastEventAt time.Time      `json:"last_event_at"`
+	// ids of recently applied events
+	EventIDs []string `json:"event_ids,omitempty"`
+}
+
+type RoomSnapshot struct {
+	Room         *livekit.Room
+	Participants []*livekit.ParticipantInfo
+}
+
+type roomSnapshotJSON struct {
+	Room         json.RawMessage   `json:"room"`
+	Participants []json.RawMessage `json:"participants,omitempty"`
+}
+
+func (r RoomSnapshot) MarshalJSON() ([]byte, error) {
+	room, err := protojson.Marshal(r.Room)
+	if err != nil {
+		return nil, err
+	}
+	v := roomSnapshotJSON{Room: room}
+	for _, p := range r.Participants {
+		b, err := protojson.Marshal(p)
+		if err != nil {
+			return nil, err
+		}
+		v.Participants = append(v.Participants, b)
+	}
+	return json.Marshal(v)
+}
+
+func (r *RoomSnapshot) UnmarshalJSON(data []byte) error {
+	var v roomSnapshotJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	r.Room = &livekit.Room{}
+	if err := protojson.Unmarshal(v.Room, r.Room); err != nil {
+		return err
+	}
+	r.Participants = nil
+	for _, b := range v.Participants {
+		p := &livekit.ParticipantInfo{}
+		if err := protojson.Unmarshal(b, p); err != nil {
+			return err
+		}
+		r.Participants = append(r.Participants, p)
+	}
+	return nil
+}
+
+// Snapshot returns a copy of the current state.
+func (s *State) Snapshot() *Snapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	snap := &Snapshot{
+		Rooms:       make([]RoomSnapshot, 0, len(s.rooms)),
+		LastEventAt: s.lastEvent,
+		EventIDs:    append([]string(nil), s.seenOrder...),
+	}
+	for _, r := range s.rooms {
+		snap.Rooms = append(snap.Rooms, RoomSnapshot{
+			Room:         proto.Clone(r.info).(*livekit.Room),
+			Participants: cloneParticipants(r),
+		})
+	}
+	return snap
+}
+
+// Restore replaces the state with the snapshot.
+func (s *State) Restore(snap *Snapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rooms = make(map[string]*room, len(snap.Rooms))
+	s.roomNames = make(map[string]string, len(snap.Rooms))
+	for _, rs := range snap.Rooms {
+		if rs.Room == nil {
+			continue
+		}
+		r := &room{
+			info:         proto.Clone(rs.Room).(*livekit.Room),
+			participants: make(map[string]*livekit.ParticipantInfo, len(rs.Participants)),
+		}
+		for _, p := range rs.Participants {
+			r.participants[p.Identity] = proto.Clone(p).(*livekit.ParticipantInfo)
+		}
+		s.rooms[r.info.Name] = r
+		if r.info.Sid != "" {
+			s.roomNames[r.info.Sid] = r.info.Name
+		}
+	}
+	s.lastEvent = snap.LastEventAt
+
+	s.seen = make(map[string]struct{}, len(snap.EventIDs))
+	s.seenOrder = nil
+	for _, id := range snap.EventIDs {
+		s.isDuplicate(id)
+	}
+}
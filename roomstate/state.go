@@ -0,0 +1,488 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package roomstate reconstructs the state of rooms, participants and tracks from an ordered stream of
+// webhook or analytics events, for services mirroring LiveKit state.
+package roomstate
+
+import (
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/webhook"
+)
+
+// number of event ids remembered to skip redelivered events
+const defaultDedupeSize = 1000
+
+type changeType int
+
+const (
+	roomStarted changeType = iota
+	roomFinished
+	participantJoined
+	participantUpdated
+	participantLeft
+	trackPublished
+	trackUpdated
+	trackUnpublished
+	trackMuted
+	trackUnmuted
+)
+
+// change is a webhook or analytics event, normalized for the reducer.
+type change struct {
+	typ         changeType
+	id          string
+	at          time.Time
+	roomSid     string
+	roomName    string
+	room        *livekit.Room
+	participant *livekit.ParticipantInfo
+	track       *livekit.TrackInfo
+	trackSid    string
+	// set when the participant was moved from another room
+	move *livekit.ParticipantMove
+}
+
+type room struct {
+	info *livekit.Room
+	// by identity
+	participants map[string]*livekit.ParticipantInfo
+}
+
+// State is an in-memory mirror of the rooms of a deployment. It is safe for concurrent use.
+// Events must be applied in the order they were emitted, redelivered events are ignored.
+type State struct {
+	mu        sync.RWMutex
+	rooms     map[string]*room
+	roomNames map[string]string
+	lastEvent time.Time
+
+	seen      map[string]struct{}
+	seenOrder []string
+}
+
+func New() *State {
+	return &State{
+		rooms:     make(map[string]*room),
+		roomNames: make(map[string]string),
+		seen:      make(map[string]struct{}),
+	}
+}
+
+// ApplyWebhook applies a webhook event. It returns false if the event did not change the state.
+func (s *State) ApplyWebhook(event *livekit.WebhookEvent) bool {
+	c := change{
+		id:          event.Id,
+		at:          event.CreatedAtTime(),
+		room:        event.Room,
+		participant: event.Participant,
+		track:       event.Track,
+	}
+	switch event.Event {
+	case webhook.EventRoomStarted:
+		c.typ = roomStarted
+	case webhook.EventRoomFinished:
+		c.typ = roomFinished
+	case webhook.EventParticipantJoined:
+		c.typ = participantJoined
+	case webhook.EventParticipantLeft:
+		c.typ = participantLeft
+	case webhook.EventParticipantMoved:
+		c.typ = participantJoined
+		c.move = event.ParticipantMove
+	case webhook.EventTrackPublished:
+		c.typ = trackPublished
+	case webhook.EventTrackUnpublished:
+		c.typ = trackUnpublished
+	case webhook.EventTrackMuted:
+		c.typ = trackMuted
+	case webhook.EventTrackUnmuted:
+		c.typ = trackUnmuted
+	default:
+		return false
+	}
+	return s.apply(c)
+}
+
+// ApplyAnalytics applies an analytics event. It returns false if the event did not change the state.
+func (s *State) ApplyAnalytics(event *livekit.AnalyticsEvent) bool {
+	c := change{
+		id:          event.Id,
+		roomSid:     event.RoomId,
+		room:        event.Room,
+		participant: event.Participant,
+		track:       event.Track,
+		trackSid:    event.TrackId,
+	}
+	if event.Timestamp != nil {
+		c.at = event.Timestamp.AsTime()
+	}
+	switch event.Type {
+	case livekit.AnalyticsEventType_ROOM_CREATED:
+		c.typ = roomStarted
+	case livekit.AnalyticsEventType_ROOM_ENDED:
+		c.typ = roomFinished
+	case livekit.AnalyticsEventType_PARTICIPANT_JOINED:
+		c.typ = participantJoined
+	case livekit.AnalyticsEventType_PARTICIPANT_ACTIVE,
+		livekit.AnalyticsEventType_PARTICIPANT_RESUMED,
+		livekit.AnalyticsEventType_RECONNECTED:
+		c.typ = participantUpdated
+	case livekit.AnalyticsEventType_PARTICIPANT_LEFT:
+		c.typ = participantLeft
+	case livekit.AnalyticsEventType_TRACK_PUBLISHED:
+		c.typ = trackPublished
+	case livekit.AnalyticsEventType_TRACK_PUBLISHED_UPDATE:
+		c.typ = trackUpdated
+	case livekit.AnalyticsEventType_TRACK_UNPUBLISHED:
+		c.typ = trackUnpublished
+	case livekit.AnalyticsEventType_TRACK_MUTED:
+		c.typ = trackMuted
+	case livekit.AnalyticsEventType_TRACK_UNMUTED:
+		c.typ = trackUnmuted
+	default:
+		return false
+	}
+	if c.participant == nil && event.ParticipantId != "" {
+		c.participant = &livekit.ParticipantInfo{Sid: event.ParticipantId}
+	}
+	return s.apply(c)
+}
+
+func (s *State) apply(c change) bool {
+	if c.room != nil {
+		if c.roomSid == "" {
+			c.roomSid = c.room.Sid
+		}
+		c.roomName = c.room.Name
+	}
+	if c.track != nil && c.trackSid == "" {
+		c.trackSid = c.track.Sid
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isDuplicate(c.id) {
+		return false
+	}
+	if c.roomName == "" {
+		c.roomName = s.roomNames[c.roomSid]
+	}
+	if c.roomName == "" {
+		return false
+	}
+	if c.at.After(s.lastEvent) {
+		s.lastEvent = c.at
+	} else if c.at.IsZero() {
+		c.at = time.Now()
+	}
+
+	if c.move != nil {
+		s.moveOut(c)
+	}
+
+	r := s.rooms[c.roomName]
+	switch c.typ {
+	case roomStarted:
+		if r != nil && (r.info.Sid == c.roomSid || r.info.Sid == "") {
+			if c.roomSid != "" {
+				s.roomNames[c.roomSid] = c.roomName
+			}
+			return s.updateRoom(r, c)
+		}
+		s.removeRoom(c.roomName)
+		info := &livekit.Room{Name: c.roomName, Sid: c.roomSid}
+		if c.room != nil {
+			info = proto.Clone(c.room).(*livekit.Room)
+		}
+		if info.CreationTimeTime().IsZero() {
+			info.SetCreationTime(c.at)
+		}
+		info.NumParticipants = 0
+		info.NumPublishers = 0
+		s.rooms[c.roomName] = &room{
+			info:         info,
+			participants: make(map[string]*livekit.ParticipantInfo),
+		}
+		if c.roomSid != "" {
+			s.roomNames[c.roomSid] = c.roomName
+		}
+		return true
+
+	case roomFinished:
+		if r == nil || (c.roomSid != "" && r.info.Sid != c.roomSid) {
+			return false
+		}
+		s.removeRoom(c.roomName)
+		return true
+	}
+
+	if r == nil {
+		// events of a room we have not seen starting, e.g. when consuming from the middle of the stream
+		r = &room{
+			info:         &livekit.Room{Name: c.roomName, Sid: c.roomSid},
+			participants: make(map[string]*livekit.ParticipantInfo),
+		}
+		s.rooms[c.roomName] = r
+		if c.roomSid != "" {
+			s.roomNames[c.roomSid] = c.roomName
+		}
+	} else if c.roomSid != "" && r.info.Sid != "" && r.info.Sid != c.roomSid {
+		// event of a previous session of the room
+		return false
+	}
+
+	changed := s.applyParticipant(r, c)
+	if changed {
+		if c.room != nil {
+			s.updateRoom(r, c)
+		}
+		r.info.NumParticipants = uint32(len(r.participants))
+		r.info.NumPublishers = uint32(countPublishers(r))
+	}
+	return changed
+}
+
+// moveOut removes a moved participant from the source room
+func (s *State) moveOut(c change) {
+	r := s.rooms[c.move.SourceRoom]
+	if r == nil || c.participant == nil {
+		return
+	}
+	if c.move.SourceRoomSid != "" && r.info.Sid != "" && r.info.Sid != c.move.SourceRoomSid {
+		return
+	}
+	p := r.participants[c.participant.Identity]
+	if p == nil || (c.move.PreviousParticipantSid != "" && p.Sid != c.move.PreviousParticipantSid) {
+		return
+	}
+	delete(r.participants, p.Identity)
+	r.info.NumParticipants = uint32(len(r.participants))
+	r.info.NumPublishers = uint32(countPublishers(r))
+}
+
+func (s *State) updateRoom(r *room, c change) bool {
+	if c.room == nil {
+		return false
+	}
+	numParticipants, numPublishers := r.info.NumParticipants, r.info.NumPublishers
+	creationTime, creationTimeMs := r.info.CreationTime, r.info.CreationTimeMs
+	r.info = proto.Clone(c.room).(*livekit.Room)
+	r.info.NumParticipants, r.info.NumPublishers = numParticipants, numPublishers
+	if r.info.CreationTimeTime().IsZero() {
+		r.info.CreationTime, r.info.CreationTimeMs = creationTime, creationTimeMs
+	}
+	return true
+}
+
+func (s *State) applyParticipant(r *room, c change) bool {
+	if c.participant == nil {
+		return false
+	}
+	p := s.findParticipant(r, c.participant)
+
+	switch c.typ {
+	case participantJoined, participantUpdated:
+		if c.participant.Identity == "" {
+			if p == nil {
+				return false
+			}
+			// sparse update, only the state is known
+			if c.participant.State != 0 {
+				p.State = c.participant.State
+			}
+			return true
+		}
+		tracks := p.GetTracks()
+		p = proto.Clone(c.participant).(*livekit.ParticipantInfo)
+		if len(p.Tracks) == 0 {
+			p.Tracks = tracks
+		}
+		if c.typ == participantJoined && p.JoinedAtTime().IsZero() {
+			p.SetJoinedAt(c.at)
+		}
+		r.participants[p.Identity] = p
+		return true
+
+	case participantLeft:
+		if p == nil || (c.participant.Sid != "" && p.Sid != c.participant.Sid) {
+			// the previous connection of a reconnected participant left
+			return false
+		}
+		delete(r.participants, p.Identity)
+		return true
+	}
+
+	if p == nil {
+		return false
+	}
+	i := slices.IndexFunc(p.Tracks, func(t *livekit.TrackInfo) bool {
+		return t.Sid == c.trackSid
+	})
+
+	switch c.typ {
+	case trackPublished, trackUpdated:
+		if c.track == nil {
+			return false
+		}
+		t := proto.Clone(c.track).(*livekit.TrackInfo)
+		if i < 0 {
+			p.Tracks = append(p.Tracks, t)
+		} else {
+			p.Tracks[i] = t
+		}
+		return true
+
+	case trackUnpublished:
+		if i < 0 {
+			return false
+		}
+		p.Tracks = slices.Delete(p.Tracks, i, i+1)
+		return true
+
+	case trackMuted, trackUnmuted:
+		if i < 0 {
+			return false
+		}
+		p.Tracks[i].Muted = c.typ == trackMuted
+		return true
+	}
+	return false
+}
+
+func (s *State) findParticipant(r *room, p *livekit.ParticipantInfo) *livekit.ParticipantInfo {
+	if p.Identity != "" {
+		return r.participants[p.Identity]
+	}
+	for _, rp := range r.participants {
+		if rp.Sid == p.Sid {
+			return rp
+		}
+	}
+	return nil
+}
+
+func (s *State) removeRoom(name string) {
+	if r := s.rooms[name]; r != nil {
+		delete(s.roomNames, r.info.Sid)
+		delete(s.rooms, name)
+	}
+}
+
+func (s *State) isDuplicate(id string) bool {
+	if id == "" {
+		return false
+	}
+	if _, ok := s.seen[id]; ok {
+		return true
+	}
+	s.seen[id] = struct{}{}
+	s.seenOrder = append(s.seenOrder, id)
+	if len(s.seenOrder) > defaultDedupeSize {
+		delete(s.seen, s.seenOrder[0])
+		s.seenOrder = s.seenOrder[1:]
+	}
+	return false
+}
+
+func countPublishers(r *room) int {
+	n := 0
+	for _, p := range r.participants {
+		if len(p.Tracks) > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// Room returns a copy of the room.
+func (s *State) Room(name string) (*livekit.Room, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	r, ok := s.rooms[name]
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(r.info).(*livekit.Room), true
+}
+
+// Rooms returns copies of all rooms, sorted by name.
+func (s *State) Rooms() []*livekit.Room {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rooms := make([]*livekit.Room, 0, len(s.rooms))
+	for _, r := range s.rooms {
+		rooms = append(rooms, proto.Clone(r.info).(*livekit.Room))
+	}
+	sort.Slice(rooms, func(i, j int) bool {
+		return rooms[i].Name < rooms[j].Name
+	})
+	return rooms
+}
+
+// Participant returns a copy of the participant, including its published tracks.
+func (s *State) Participant(roomName, identity string) (*livekit.ParticipantInfo, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	r, ok := s.rooms[roomName]
+	if !ok {
+		return nil, false
+	}
+	p, ok := r.participants[identity]
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(p).(*livekit.ParticipantInfo), true
+}
+
+// Participants returns copies of the participants of the room, sorted by identity.
+func (s *State) Participants(roomName string) []*livekit.ParticipantInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	r, ok := s.rooms[roomName]
+	if !ok {
+		return nil
+	}
+	return cloneParticipants(r)
+}
+
+// LastEventAt returns the time of the latest applied event.
+func (s *State) LastEventAt() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastEvent
+}
+
+func cloneParticipants(r *room) []*livekit.ParticipantInfo {
+	participants := make([]*livekit.ParticipantInfo, 0, len(r.participants))
+	for _, p := range r.participants {
+		participants = append(participants, proto.Clone(p).(*livekit.ParticipantInfo))
+	}
+	sort.Slice(participants, func(i, j int) bool {
+		return participants[i].Identity < participants[j].Identity
+	})
+	return participants
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roomstate
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/webhook"
+)
+
+func TestWebhookEvents(t *testing.T) {
+	s := New()
+	room := &livekit.Room{Name: "room", Sid: "RM_1"}
+	alice := &livekit.ParticipantInfo{Identity: "alice", Sid: "PA_1"}
+	track := &livekit.TrackInfo{Sid: "TR_1", Type: livekit.TrackType_AUDIO}
+
+	require.True(t, s.ApplyWebhook(&livekit.WebhookEvent{Id: "1", Event: webhook.EventRoomStarted, Room: room, CreatedAt: 100}))
+	require.True(t, s.ApplyWebhook(&livekit.WebhookEvent{Id: "2", Event: webhook.EventParticipantJoined, Room: room, Participant: alice}))
+	require.True(t, s.ApplyWebhook(&livekit.WebhookEvent{Id: "3", Event: webhook.EventTrackPublished, Room: room, Participant: alice, Track: track}))
+	// redelivered
+	require.False(t, s.ApplyWebhook(&livekit.WebhookEvent{Id: "3", Event: webhook.EventTrackPublished, Room: room, Participant: alice, Track: track}))
+
+	r, ok := s.Room("room")
+	require.True(t, ok)
+	require.Equal(t, "RM_1", r.Sid)
+	require.EqualValues(t, 1, r.NumParticipants)
+	require.EqualValues(t, 1, r.NumPublishers)
+	require.Equal(t, int64(100), r.CreationTime)
+
+	p, ok := s.Participant("room", "alice")
+	require.True(t, ok)
+	require.Len(t, p.Tracks, 1)
+
+	// returned values are copies
+	p.Tracks = nil
+	p, _ = s.Participant("room", "alice")
+	require.Len(t, p.Tracks, 1)
+
+	// alice reconnects with a new sid, the left event of the previous connection arrives late
+	alice2 := &livekit.ParticipantInfo{Identity: "alice", Sid: "PA_2"}
+	require.True(t, s.ApplyWebhook(&livekit.WebhookEvent{Id: "4", Event: webhook.EventParticipantJoined, Room: room, Participant: alice2}))
+	require.False(t, s.ApplyWebhook(&livekit.WebhookEvent{Id: "5", Event: webhook.EventParticipantLeft, Room: room, Participant: alice}))
+	p, ok = s.Participant("room", "alice")
+	require.True(t, ok)
+	require.Equal(t, "PA_2", p.Sid)
+
+	require.True(t, s.ApplyWebhook(&livekit.WebhookEvent{Id: "6", Event: webhook.EventTrackMuted, Room: room, Participant: alice2, Track: track}))
+	p, _ = s.Participant("room", "alice")
+	require.True(t, p.Tracks[0].Muted)
+	require.True(t, s.ApplyWebhook(&livekit.WebhookEvent{Id: "6a", Event: webhook.EventTrackUnmuted, Room: room, Participant: alice2, Track: track}))
+	p, _ = s.Participant("room", "alice")
+	require.False(t, p.Tracks[0].Muted)
+
+	require.True(t, s.ApplyWebhook(&livekit.WebhookEvent{Id: "6b", Event: webhook.EventTrackUnpublished, Room: room, Participant: alice2, Track: track}))
+	require.True(t, s.ApplyWebhook(&livekit.WebhookEvent{Id: "7", Event: webhook.EventParticipantLeft, Room: room, Participant: alice2}))
+	require.Empty(t, s.Participants("room"))
+
+	require.True(t, s.ApplyWebhook(&livekit.WebhookEvent{Id: "8", Event: webhook.EventRoomFinished, Room: room}))
+	_, ok = s.Room("room")
+	require.False(t, ok)
+	require.Empty(t, s.Rooms())
+}
+
+func TestParticipantMoved(t *testing.T) {
+	s := New()
+	lobby := &livekit.Room{Name: "lobby", Sid: "RM_1"}
+	main := &livekit.Room{Name: "main", Sid: "RM_2"}
+	track := &livekit.TrackInfo{Sid: "TR_1", Type: livekit.TrackType_AUDIO}
+
+	s.ApplyWebhook(&livekit.WebhookEvent{Id: "1", Event: webhook.EventParticipantJoined, Room: lobby,
+		Participant: &livekit.ParticipantInfo{Identity: "alice", Sid: "PA_1"}})
+	s.ApplyWebhook(&livekit.WebhookEvent{Id: "2", Event: webhook.EventTrackPublished, Room: lobby,
+		Participant: &livekit.ParticipantInfo{Identity: "alice", Sid: "PA_1"}, Track: track})
+
+	require.True(t, s.ApplyWebhook(&livekit.WebhookEvent{
+		Id:          "3",
+		Event:       webhook.EventParticipantMoved,
+		Room:        main,
+		Participant: &livekit.ParticipantInfo{Identity: "alice", Sid: "PA_2", Tracks: []*livekit.TrackInfo{track}},
+		ParticipantMove: &livekit.ParticipantMove{
+			SourceRoom:             "lobby",
+			SourceRoomSid:          "RM_1",
+			PreviousParticipantSid: "PA_1",
+		},
+	}))
+	require.Empty(t, s.Participants("lobby"))
+	r, _ := s.Room("lobby")
+	require.Zero(t, r.NumParticipants)
+
+	p, ok := s.Participant("main", "alice")
+	require.True(t, ok)
+	require.Equal(t, "PA_2", p.Sid)
+	require.Len(t, p.Tracks, 1)
+}
+
+func TestAnalyticsEvents(t *testing.T) {
+	s := New()
+	ts := timestamppb.New(time.Unix(200, 0))
+
+	require.True(t, s.ApplyAnalytics(&livekit.AnalyticsEvent{
+		Type:      livekit.AnalyticsEventType_ROOM_CREATED,
+		Timestamp: ts,
+		Room:      &livekit.Room{Name: "room", Sid: "RM_1"},
+	}))
+	require.True(t, s.ApplyAnalytics(&livekit.AnalyticsEvent{
+		Type:        livekit.AnalyticsEventType_PARTICIPANT_JOINED,
+		Timestamp:   ts,
+		RoomId:      "RM_1",
+		Participant: &livekit.ParticipantInfo{Identity: "bob", Sid: "PA_1", State: livekit.ParticipantInfo_JOINED},
+	}))
+	require.True(t, s.ApplyAnalytics(&livekit.AnalyticsEvent{
+		Type:          livekit.AnalyticsEventType_PARTICIPANT_ACTIVE,
+		RoomId:        "RM_1",
+		ParticipantId: "PA_1",
+		Participant:   &livekit.ParticipantInfo{Sid: "PA_1", State: livekit.ParticipantInfo_ACTIVE},
+	}))
+	require.True(t, s.ApplyAnalytics(&livekit.AnalyticsEvent{
+		Type:          livekit.AnalyticsEventType_TRACK_PUBLISHED,
+		RoomId:        "RM_1",
+		ParticipantId: "PA_1",
+		Track:         &livekit.TrackInfo{Sid: "TR_1", Type: livekit.TrackType_VIDEO},
+	}))
+	require.True(t, s.ApplyAnalytics(&livekit.AnalyticsEvent{
+		Type:          livekit.AnalyticsEventType_TRACK_MUTED,
+		RoomId:        "RM_1",
+		ParticipantId: "PA_1",
+		TrackId:       "TR_1",
+	}))
+	// unknown room
+	require.False(t, s.ApplyAnalytics(&livekit.AnalyticsEvent{
+		Type:          livekit.AnalyticsEventType_TRACK_UNMUTED,
+		RoomId:        "RM_2",
+		ParticipantId: "PA_1",
+		TrackId:       "TR_1",
+	}))
+
+	p, ok := s.Participant("room", "bob")
+	require.True(t, ok)
+	require.Equal(t, livekit.ParticipantInfo_ACTIVE, p.State)
+	require.True(t, time.Unix(200, 0).Equal(p.JoinedAtTime()))
+	require.Len(t, p.Tracks, 1)
+	require.True(t, p.Tracks[0].Muted)
+	require.True(t, time.Unix(200, 0).Equal(s.LastEventAt()))
+}
+
+func TestSnapshot(t *testing.T) {
+	s := New()
+	room := &livekit.Room{Name: "room", Sid: "RM_1"}
+	s.ApplyWebhook(&livekit.WebhookEvent{Id: "1", Event: webhook.EventRoomStarted, Room: room, CreatedAt: 100})
+	s.ApplyWebhook(&livekit.WebhookEvent{Id: "2", Event: webhook.EventParticipantJoined, Room: room,
+		Participant: &livekit.ParticipantInfo{Identity: "alice", Sid: "PA_1"}, CreatedAt: 101})
+
+	data, err := json.Marshal(s.Snapshot())
+	require.NoError(t, err)
+
+	var snap Snapshot
+	require.NoError(t, json.Unmarshal(data, &snap))
+
+	restored := New()
+	restored.Restore(&snap)
+	require.True(t, s.LastEventAt().Equal(restored.LastEventAt()))
+	r, ok := restored.Room("room")
+	require.True(t, ok)
+	require.EqualValues(t, 1, r.NumParticipants)
+	_, ok = restored.Participant("room", "alice")
+	require.True(t, ok)
+
+	// events applied before the snapshot are still deduplicated
+	require.False(t, restored.ApplyWebhook(&livekit.WebhookEvent{Id: "2", Event: webhook.EventParticipantJoined, Room: room,
+		Participant: &livekit.ParticipantInfo{Identity: "alice", Sid: "PA_1"}}))
+
+	// analytics events without a room name resolve through the restored sid index
+	require.True(t, restored.ApplyAnalytics(&livekit.AnalyticsEvent{
+		Type:          livekit.AnalyticsEventType_PARTICIPANT_LEFT,
+		RoomId:        "RM_1",
+		ParticipantId: "PA_1",
+	}))
+	require.Empty(t, restored.Participants("room"))
+}
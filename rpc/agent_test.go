@@ -0,0 +1,29 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+func TestJobTerminateReason(t *testing.T) {
+	require.Equal(t, livekit.JobTerminationReason_JTR_REQUESTED, JobTerminateReason_TERMINATION_REQUESTED.TerminationReason())
+	require.Equal(t, livekit.JobTerminationReason_JTR_AGENT_LEFT_ROOM, JobTerminateReason_AGENT_LEFT_ROOM.TerminationReason())
+	require.Equal(t, livekit.JobTerminationReason_JTR_UNSPECIFIED, JobTerminateReason(100).TerminationReason())
+}
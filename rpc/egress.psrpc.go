@@ -281,39 +281,42 @@ func (s *egressHandlerServer) Kill() {
 }
 
 var psrpcFileDescriptor2 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xd1, 0x4e, 0xdb, 0x3e,
-	0x14, 0xc6, 0xff, 0xa6, 0x7f, 0x02, 0x39, 0xa5, 0x5d, 0xe5, 0x31, 0x61, 0x0a, 0x68, 0xa5, 0xec,
-	0x22, 0x37, 0x4b, 0x11, 0x5c, 0xed, 0x6e, 0x03, 0x55, 0xa3, 0x12, 0xd2, 0xa6, 0x74, 0x68, 0xd2,
-	0x76, 0x11, 0x25, 0x8e, 0xc7, 0xac, 0x26, 0xb1, 0x67, 0x3b, 0xf4, 0x19, 0x78, 0x8c, 0x69, 0x6f,
-	0xc0, 0xc5, 0x9e, 0x6f, 0xaa, 0xd3, 0x86, 0xb4, 0xa8, 0xd3, 0x2e, 0xfd, 0x7d, 0xe7, 0xfb, 0xe5,
-	0x9c, 0xa3, 0x13, 0xe8, 0x28, 0x49, 0x07, 0xec, 0x56, 0x31, 0xad, 0x7d, 0xa9, 0x84, 0x11, 0xb8,
-	0xa1, 0x24, 0xed, 0xb6, 0x84, 0x34, 0x5c, 0xe4, 0x73, 0xad, 0xbb, 0x9b, 0xf2, 0x3b, 0x36, 0xe1,
-	0x26, 0xac, 0x57, 0xf6, 0x7f, 0xfd, 0x0f, 0x78, 0x6c, 0x22, 0x65, 0x86, 0x56, 0x0d, 0xd8, 0x8f,
-	0x82, 0x69, 0x83, 0x0f, 0xc0, 0x2d, 0xcb, 0x42, 0x9e, 0x10, 0xd4, 0x43, 0x9e, 0x1b, 0x6c, 0x97,
-	0xc2, 0x28, 0xc1, 0xd7, 0xd0, 0x56, 0x42, 0x64, 0x21, 0x15, 0x99, 0x14, 0x9a, 0x1b, 0x46, 0x36,
-	0x7b, 0xc8, 0x6b, 0x9e, 0x9d, 0xf8, 0xf3, 0x4f, 0xf8, 0x81, 0x10, 0xd9, 0xe5, 0xc2, 0x5d, 0x22,
-	0x5f, 0xfd, 0x17, 0xb4, 0x54, 0xdd, 0xc5, 0xaf, 0xa1, 0x31, 0x65, 0x31, 0x69, 0x5a, 0xc4, 0x7e,
-	0x85, 0xf8, 0xcc, 0xe2, 0xd5, 0xe0, 0xac, 0x0e, 0x0f, 0xa1, 0x29, 0x23, 0x65, 0x38, 0xe5, 0x32,
-	0xca, 0x0d, 0x69, 0xd9, 0xd8, 0x71, 0x15, 0xfb, 0xf8, 0xe8, 0xad, 0xc6, 0xeb, 0x39, 0xfc, 0x01,
-	0x9e, 0x19, 0x15, 0xd1, 0x49, 0x6d, 0x08, 0xc7, 0xa2, 0x5e, 0x55, 0xa8, 0x4f, 0x33, 0x7f, 0xed,
-	0x14, 0x6d, 0xb3, 0x64, 0xe3, 0x73, 0xd8, 0xb4, 0x0a, 0xd9, 0xb2, 0x98, 0x83, 0x65, 0xcc, 0x6a,
-	0xba, 0xac, 0xc5, 0x7b, 0xb0, 0x65, 0x37, 0xc9, 0x13, 0xd2, 0xb0, 0x4b, 0x76, 0x66, 0xcf, 0x51,
-	0x82, 0x77, 0x61, 0xd3, 0x88, 0x09, 0xcb, 0xc9, 0xb6, 0x95, 0xcb, 0x07, 0x7e, 0x01, 0xce, 0x54,
-	0x87, 0x85, 0x4a, 0x89, 0x5b, 0xca, 0x53, 0x7d, 0xa3, 0x52, 0x7c, 0x0a, 0xbb, 0x34, 0x15, 0x45,
-	0x12, 0xc6, 0x11, 0x9d, 0x14, 0x32, 0x64, 0x79, 0x14, 0xa7, 0x2c, 0x21, 0xd0, 0x43, 0xde, 0x76,
-	0x80, 0xad, 0x77, 0x61, 0xad, 0x61, 0xe9, 0xe0, 0x13, 0x68, 0x31, 0x6d, 0x78, 0x16, 0x19, 0x96,
-	0x84, 0x54, 0x16, 0xa4, 0xdd, 0x43, 0x1e, 0x0a, 0x76, 0x2a, 0xf1, 0x52, 0x16, 0x17, 0x2e, 0x6c,
-	0xa9, 0xb2, 0xe1, 0xfe, 0x3e, 0xec, 0x5d, 0x73, 0x6d, 0xde, 0x51, 0xc3, 0xef, 0x96, 0x37, 0xd1,
-	0x7f, 0x03, 0xe4, 0xa9, 0xa5, 0xa5, 0xc8, 0x35, 0xc3, 0x47, 0x00, 0xd5, 0x15, 0x69, 0x82, 0x7a,
-	0x0d, 0xcf, 0x0d, 0xdc, 0xc5, 0x19, 0xe9, 0xb3, 0xdf, 0x08, 0xda, 0x65, 0x62, 0x94, 0x1b, 0xa6,
-	0xf2, 0x28, 0xc5, 0xef, 0xa1, 0x59, 0xbb, 0x46, 0xbc, 0xe7, 0x2b, 0x49, 0xfd, 0xa7, 0xf7, 0xd9,
-	0x7d, 0x5e, 0xad, 0x77, 0x01, 0xf8, 0x26, 0xfa, 0xf0, 0x70, 0x8f, 0x9c, 0x0e, 0x7a, 0x8b, 0x4e,
-	0x11, 0xfe, 0x0a, 0x9d, 0xd5, 0xb6, 0xf0, 0xa1, 0xa5, 0xad, 0x19, 0xa4, 0x7b, 0xb4, 0xc6, 0x2d,
-	0x67, 0xa9, 0xe0, 0x1b, 0x1e, 0x3a, 0xfb, 0x89, 0xa0, 0x55, 0xda, 0x57, 0x51, 0x9e, 0xa4, 0x4c,
-	0xe1, 0x11, 0xec, 0xdc, 0xc8, 0x24, 0x32, 0x6c, 0x6c, 0x14, 0x8b, 0x32, 0x7c, 0x58, 0xf5, 0x57,
-	0x97, 0xff, 0xda, 0xbd, 0xf3, 0x70, 0x8f, 0x36, 0x3a, 0x08, 0x0f, 0x01, 0xc6, 0x46, 0xc8, 0x79,
-	0xcf, 0xdd, 0xaa, 0xf4, 0x51, 0xfc, 0x17, 0xcc, 0xc5, 0xf1, 0x97, 0x97, 0xb7, 0xdc, 0x7c, 0x2f,
-	0x62, 0x9f, 0x8a, 0x6c, 0x30, 0x2f, 0x1c, 0xd8, 0x9f, 0x9e, 0x8a, 0x74, 0xa0, 0x24, 0x8d, 0x1d,
-	0xfb, 0x3a, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x6f, 0xc8, 0xf7, 0xcf, 0x40, 0x04, 0x00, 0x00,
+	// 579 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdf, 0x4e, 0xdb, 0x3c,
+	0x14, 0xff, 0x4c, 0x3f, 0x0a, 0x3d, 0xa5, 0xa5, 0xf2, 0xd8, 0x30, 0x05, 0xb4, 0x52, 0x76, 0x91,
+	0x9b, 0xa5, 0x08, 0xae, 0x76, 0xb7, 0xc1, 0xaa, 0x51, 0x89, 0x69, 0x53, 0x18, 0x42, 0xda, 0x2e,
+	0x22, 0xc7, 0xf1, 0x98, 0xd5, 0x24, 0x36, 0xb6, 0x03, 0xcf, 0xc0, 0x63, 0xec, 0x15, 0xb8, 0xd8,
+	0x3b, 0xed, 0x2d, 0x26, 0x9c, 0x36, 0xa4, 0x45, 0x9d, 0x76, 0xe9, 0xdf, 0xbf, 0x9c, 0xdf, 0xd1,
+	0x51, 0xa0, 0xa3, 0x15, 0x1b, 0xf0, 0x2b, 0xcd, 0x8d, 0xf1, 0x95, 0x96, 0x56, 0xe2, 0x9a, 0x56,
+	0xac, 0xdb, 0x92, 0xca, 0x0a, 0x99, 0x4d, 0xb0, 0xee, 0x46, 0x22, 0x6e, 0xf8, 0x58, 0xd8, 0xb0,
+	0xaa, 0xec, 0xbe, 0x98, 0xa2, 0x22, 0xb3, 0x5c, 0x67, 0x34, 0x29, 0xf0, 0xfe, 0xef, 0xff, 0x01,
+	0x9f, 0x5b, 0xaa, 0xed, 0xd0, 0xa9, 0x03, 0x7e, 0x9d, 0x73, 0x63, 0xf1, 0x36, 0x34, 0x0a, 0x7b,
+	0x28, 0x62, 0x82, 0x7a, 0xc8, 0x6b, 0x04, 0xab, 0x05, 0x30, 0x8a, 0xf1, 0x19, 0xb4, 0xb5, 0x94,
+	0x69, 0xc8, 0x64, 0xaa, 0xa4, 0x11, 0x96, 0x93, 0xe5, 0x1e, 0xf2, 0x9a, 0x87, 0xfb, 0xfe, 0xe4,
+	0x23, 0x7e, 0x20, 0x65, 0x7a, 0x32, 0x65, 0x67, 0x92, 0x4f, 0xff, 0x0b, 0x5a, 0xba, 0xca, 0xe2,
+	0xd7, 0x50, 0xbb, 0xe5, 0x11, 0x69, 0xba, 0x88, 0xad, 0x32, 0xe2, 0x92, 0x47, 0xf3, 0xc6, 0x07,
+	0x1d, 0x1e, 0x42, 0x53, 0x51, 0x6d, 0x05, 0x13, 0x8a, 0x66, 0x96, 0xb4, 0x9c, 0x6d, 0xaf, 0xb4,
+	0x7d, 0x7e, 0xe4, 0xe6, 0xed, 0x55, 0x1f, 0xfe, 0x04, 0xeb, 0x56, 0x53, 0x36, 0xae, 0x94, 0xa8,
+	0xbb, 0xa8, 0x57, 0x65, 0xd4, 0x97, 0x07, 0x7e, 0x61, 0x8b, 0xb6, 0x9d, 0xa1, 0xf1, 0x11, 0x2c,
+	0x3b, 0x84, 0xac, 0xb8, 0x98, 0xed, 0xd9, 0x98, 0x79, 0x77, 0xa1, 0xc5, 0x9b, 0xb0, 0xe2, 0x36,
+	0x29, 0x62, 0x52, 0x73, 0x4b, 0xae, 0x3f, 0x3c, 0x47, 0x31, 0xde, 0x80, 0x65, 0x2b, 0xc7, 0x3c,
+	0x23, 0xab, 0x0e, 0x2e, 0x1e, 0xf8, 0x39, 0xd4, 0x6f, 0x4d, 0x98, 0xeb, 0x84, 0x34, 0x0a, 0xf8,
+	0xd6, 0x5c, 0xe8, 0x04, 0x1f, 0xc0, 0x06, 0x4b, 0x64, 0x1e, 0x87, 0x11, 0x65, 0xe3, 0x5c, 0x85,
+	0x3c, 0xa3, 0x51, 0xc2, 0x63, 0x02, 0x3d, 0xe4, 0xad, 0x06, 0xd8, 0x71, 0xc7, 0x8e, 0x1a, 0x16,
+	0x0c, 0xde, 0x87, 0x16, 0x37, 0x56, 0xa4, 0xd4, 0xf2, 0x38, 0x64, 0x2a, 0x27, 0xed, 0x1e, 0xf2,
+	0x50, 0xb0, 0x56, 0x82, 0x27, 0x2a, 0xc7, 0xef, 0x61, 0x4d, 0xf3, 0xeb, 0x5c, 0x68, 0x9e, 0xf2,
+	0xcc, 0x1a, 0xb2, 0xee, 0x8a, 0xf5, 0xca, 0x62, 0x1f, 0x79, 0x2c, 0xe8, 0xa5, 0xd4, 0x63, 0xae,
+	0x83, 0x8a, 0x2e, 0x98, 0x71, 0x1d, 0x37, 0x60, 0x45, 0x17, 0xb5, 0xfb, 0x5b, 0xb0, 0x79, 0x26,
+	0x8c, 0x7d, 0xc7, 0xac, 0xb8, 0x99, 0xdd, 0x67, 0xff, 0x0d, 0x90, 0xa7, 0x94, 0x51, 0x32, 0x33,
+	0x1c, 0xef, 0x02, 0x94, 0xb7, 0x68, 0x08, 0xea, 0xd5, 0xbc, 0x46, 0xd0, 0x98, 0x1e, 0xa3, 0x39,
+	0xfc, 0x85, 0xa0, 0x5d, 0x38, 0x46, 0x93, 0xd3, 0xc6, 0x1f, 0xa0, 0x59, 0xb9, 0x69, 0xbc, 0xe9,
+	0x6b, 0xc5, 0xfc, 0xa7, 0x57, 0xde, 0x7d, 0x56, 0x76, 0x99, 0x06, 0x7c, 0x97, 0x7d, 0xb8, 0xbf,
+	0x43, 0xf5, 0x0e, 0x7a, 0x8b, 0x0e, 0x10, 0xfe, 0x06, 0x9d, 0xf9, 0xb1, 0xf0, 0x8e, 0x4b, 0x5b,
+	0x50, 0xa4, 0xbb, 0xbb, 0x80, 0x2d, 0xba, 0x94, 0xe1, 0x4b, 0x1e, 0x3a, 0xfc, 0x89, 0xa0, 0x55,
+	0xd0, 0xa7, 0x34, 0x8b, 0x13, 0xae, 0xf1, 0x08, 0xd6, 0x2e, 0x54, 0x4c, 0x2d, 0x3f, 0xb7, 0x9a,
+	0xd3, 0x14, 0xef, 0x94, 0xf3, 0x55, 0xe1, 0xbf, 0x4e, 0x5f, 0xbf, 0xbf, 0x43, 0x4b, 0x1d, 0x84,
+	0x87, 0x00, 0xe7, 0x56, 0xaa, 0xc9, 0xcc, 0xdd, 0x52, 0xfa, 0x08, 0xfe, 0x4b, 0xcc, 0xf1, 0xde,
+	0xd7, 0x97, 0x57, 0xc2, 0xfe, 0xc8, 0x23, 0x9f, 0xc9, 0x74, 0x30, 0x11, 0x0e, 0xdc, 0xaf, 0x83,
+	0xc9, 0x64, 0xa0, 0x15, 0x8b, 0xea, 0xee, 0x75, 0xf4, 0x67, 0x00, 0x5d, 0x55, 0x9f, 0x3f, 0x9e,
+	0x04, 0x00, 0x00,
 }
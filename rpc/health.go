@@ -0,0 +1,207 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// subsystem names shared by services, so that dashboards and alerts match across them
+const (
+	HealthSubsystemRedis        = "redis"
+	HealthSubsystemWebhookQueue = "webhook_queue"
+	HealthSubsystemSigningKeys  = "signing_keys"
+)
+
+const DefaultHealthCheckTimeout = 2 * time.Second
+
+// HealthCheckFunc returns an error when the subsystem is unhealthy.
+type HealthCheckFunc func(ctx context.Context) error
+
+type healthCheck struct {
+	name     string
+	critical bool
+	check    HealthCheckFunc
+}
+
+// HealthChecker implements HealthServerImpl from registered subsystem checks. A failing critical
+// check reports the node NOT_SERVING, other failures report it DEGRADED.
+//
+//	hc := rpc.NewHealthChecker(0)
+//	hc.Register(rpc.HealthSubsystemRedis, true, redis.HealthCheck(rc))
+//	hc.Register(rpc.HealthSubsystemWebhookQueue, false, notifier.HealthCheck)
+//	hc.Register(rpc.HealthSubsystemSigningKeys, true, auth.KeyProviderHealthCheck(kp))
+//	srv, err := rpc.NewHealthServer(hc, bus)
+//	err = srv.RegisterCheckTopic(nodeID)
+//	http.Handle("/healthz", hc)
+type HealthChecker struct {
+	timeout  time.Duration
+	draining atomic.Bool
+
+	mu     sync.RWMutex
+	checks []healthCheck
+}
+
+// NewHealthChecker creates a HealthChecker which gives each check timeout to complete,
+// DefaultHealthCheckTimeout when zero.
+func NewHealthChecker(timeout time.Duration) *HealthChecker {
+	if timeout <= 0 {
+		timeout = DefaultHealthCheckTimeout
+	}
+	return &HealthChecker{timeout: timeout}
+}
+
+// Register adds or replaces the check of a subsystem.
+func (h *HealthChecker) Register(name string, critical bool, check HealthCheckFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	c := healthCheck{name: name, critical: critical, check: check}
+	if i := slices.IndexFunc(h.checks, func(c healthCheck) bool { return c.name == name }); i != -1 {
+		h.checks[i] = c
+	} else {
+		h.checks = append(h.checks, c)
+	}
+}
+
+func (h *HealthChecker) Deregister(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.checks = slices.DeleteFunc(h.checks, func(c healthCheck) bool { return c.name == name })
+}
+
+// SetDraining reports the node NOT_SERVING regardless of its checks, so that orchestrators and
+// clients stop sending requests while it shuts down.
+func (h *HealthChecker) SetDraining(draining bool) {
+	h.draining.Store(draining)
+}
+
+// Check runs the checks of the requested subsystems, or of all subsystems, concurrently.
+// Requested subsystems without a check are reported UNKNOWN and do not affect the status.
+func (h *HealthChecker) Check(ctx context.Context, req *HealthCheckRequest) (*HealthCheckResponse, error) {
+	h.mu.RLock()
+	checks := slices.Clone(h.checks)
+	h.mu.RUnlock()
+
+	res := &HealthCheckResponse{}
+	if names := req.GetSubsystems(); len(names) != 0 {
+		res.Subsystems = make([]*SubsystemHealth, len(names))
+		filtered := checks[:0:0]
+		for i, name := range names {
+			if j := slices.IndexFunc(checks, func(c healthCheck) bool { return c.name == name }); j != -1 {
+				filtered = append(filtered, checks[j])
+			} else {
+				res.Subsystems[i] = &SubsystemHealth{
+					Name:      name,
+					Status:    HealthStatus_HEALTH_STATUS_UNKNOWN,
+					Message:   "no check registered",
+					CheckedAt: time.Now().UnixMilli(),
+				}
+			}
+		}
+		checks = filtered
+	} else {
+		res.Subsystems = make([]*SubsystemHealth, len(checks))
+	}
+
+	results := make([]*SubsystemHealth, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = h.run(ctx, c)
+		}()
+	}
+	wg.Wait()
+
+	// fill the slots of registered subsystems in order, unknown ones were set above
+	j := 0
+	for i := range res.Subsystems {
+		if res.Subsystems[i] == nil {
+			res.Subsystems[i] = results[j]
+			j++
+		}
+	}
+
+	res.Status = HealthStatus_HEALTH_STATUS_SERVING
+	for _, s := range res.Subsystems {
+		switch {
+		case s.Status == HealthStatus_HEALTH_STATUS_NOT_SERVING && s.Critical:
+			res.Status = HealthStatus_HEALTH_STATUS_NOT_SERVING
+		case s.Status == HealthStatus_HEALTH_STATUS_NOT_SERVING && res.Status == HealthStatus_HEALTH_STATUS_SERVING:
+			res.Status = HealthStatus_HEALTH_STATUS_DEGRADED
+		}
+	}
+	if h.draining.Load() {
+		res.Status = HealthStatus_HEALTH_STATUS_NOT_SERVING
+	}
+	return res, nil
+}
+
+func (h *HealthChecker) run(ctx context.Context, c healthCheck) *SubsystemHealth {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	s := &SubsystemHealth{
+		Name:     c.name,
+		Status:   HealthStatus_HEALTH_STATUS_SERVING,
+		Critical: c.critical,
+	}
+	if err := c.check(ctx); err != nil {
+		s.Status = HealthStatus_HEALTH_STATUS_NOT_SERVING
+		s.Message = err.Error()
+	}
+	s.CheckedAt = time.Now().UnixMilli()
+	return s
+}
+
+// ServeHTTP writes the HealthCheckResponse as json, with status 503 when the node is NOT_SERVING,
+// for liveness and readiness probes. Subsystems can be selected with the subsystem query parameter.
+func (h *HealthChecker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	res, err := h.Check(r.Context(), &HealthCheckRequest{Subsystems: r.URL.Query()["subsystem"]})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	b, err := protojson.Marshal(res)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !res.IsServing() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_, _ = w.Write(b)
+}
+
+// IsServing returns true if the node accepts requests, possibly DEGRADED. Clients can use it to
+// open a circuit breaker or to exclude the node from selection.
+func (r *HealthCheckResponse) IsServing() bool {
+	switch r.GetStatus() {
+	case HealthStatus_HEALTH_STATUS_SERVING, HealthStatus_HEALTH_STATUS_DEGRADED:
+		return true
+	default:
+		return false
+	}
+}
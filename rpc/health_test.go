@@ -0,0 +1,151 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/livekit/psrpc"
+)
+
+func TestHealthChecker(t *testing.T) {
+	errDown := errors.New("down")
+	ok := func(ctx context.Context) error { return nil }
+	fail := func(ctx context.Context) error { return errDown }
+
+	t.Run("status", func(t *testing.T) {
+		hc := NewHealthChecker(0)
+		res, err := hc.Check(context.Background(), &HealthCheckRequest{})
+		require.NoError(t, err)
+		require.Equal(t, HealthStatus_HEALTH_STATUS_SERVING, res.Status)
+
+		hc.Register(HealthSubsystemRedis, true, ok)
+		hc.Register(HealthSubsystemWebhookQueue, false, fail)
+		res, err = hc.Check(context.Background(), &HealthCheckRequest{})
+		require.NoError(t, err)
+		require.Equal(t, HealthStatus_HEALTH_STATUS_DEGRADED, res.Status)
+		require.True(t, res.IsServing())
+		require.Len(t, res.Subsystems, 2)
+		require.Equal(t, HealthSubsystemRedis, res.Subsystems[0].Name)
+		require.Equal(t, HealthStatus_HEALTH_STATUS_SERVING, res.Subsystems[0].Status)
+		require.Equal(t, HealthStatus_HEALTH_STATUS_NOT_SERVING, res.Subsystems[1].Status)
+		require.Equal(t, "down", res.Subsystems[1].Message)
+		require.NotZero(t, res.Subsystems[1].CheckedAt)
+
+		hc.Register(HealthSubsystemRedis, true, fail)
+		res, err = hc.Check(context.Background(), &HealthCheckRequest{})
+		require.NoError(t, err)
+		require.Equal(t, HealthStatus_HEALTH_STATUS_NOT_SERVING, res.Status)
+		require.False(t, res.IsServing())
+
+		hc.Deregister(HealthSubsystemRedis)
+		res, err = hc.Check(context.Background(), &HealthCheckRequest{})
+		require.NoError(t, err)
+		require.Equal(t, HealthStatus_HEALTH_STATUS_DEGRADED, res.Status)
+		require.Len(t, res.Subsystems, 1)
+	})
+
+	t.Run("subsystems", func(t *testing.T) {
+		hc := NewHealthChecker(0)
+		hc.Register(HealthSubsystemRedis, true, fail)
+		hc.Register(HealthSubsystemSigningKeys, true, ok)
+
+		res, err := hc.Check(context.Background(), &HealthCheckRequest{
+			Subsystems: []string{"missing", HealthSubsystemSigningKeys},
+		})
+		require.NoError(t, err)
+		require.Equal(t, HealthStatus_HEALTH_STATUS_SERVING, res.Status)
+		require.Len(t, res.Subsystems, 2)
+		require.Equal(t, "missing", res.Subsystems[0].Name)
+		require.Equal(t, HealthStatus_HEALTH_STATUS_UNKNOWN, res.Subsystems[0].Status)
+		require.Equal(t, HealthSubsystemSigningKeys, res.Subsystems[1].Name)
+		require.True(t, res.Subsystems[1].Critical)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		hc := NewHealthChecker(10 * time.Millisecond)
+		hc.Register(HealthSubsystemRedis, true, func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		res, err := hc.Check(context.Background(), &HealthCheckRequest{})
+		require.NoError(t, err)
+		require.Equal(t, HealthStatus_HEALTH_STATUS_NOT_SERVING, res.Status)
+		require.Equal(t, context.DeadlineExceeded.Error(), res.Subsystems[0].Message)
+	})
+
+	t.Run("draining", func(t *testing.T) {
+		hc := NewHealthChecker(0)
+		hc.Register(HealthSubsystemRedis, true, ok)
+		hc.SetDraining(true)
+		res, err := hc.Check(context.Background(), &HealthCheckRequest{})
+		require.NoError(t, err)
+		require.Equal(t, HealthStatus_HEALTH_STATUS_NOT_SERVING, res.Status)
+		require.Equal(t, HealthStatus_HEALTH_STATUS_SERVING, res.Subsystems[0].Status)
+
+		hc.SetDraining(false)
+		res, err = hc.Check(context.Background(), &HealthCheckRequest{})
+		require.NoError(t, err)
+		require.Equal(t, HealthStatus_HEALTH_STATUS_SERVING, res.Status)
+	})
+
+	t.Run("http", func(t *testing.T) {
+		hc := NewHealthChecker(0)
+		hc.Register(HealthSubsystemRedis, true, fail)
+		hc.Register(HealthSubsystemWebhookQueue, false, ok)
+
+		w := httptest.NewRecorder()
+		hc.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+		require.Equal(t, http.StatusServiceUnavailable, w.Code)
+		res := &HealthCheckResponse{}
+		require.NoError(t, protojson.Unmarshal(w.Body.Bytes(), res))
+		require.Equal(t, HealthStatus_HEALTH_STATUS_NOT_SERVING, res.Status)
+
+		w = httptest.NewRecorder()
+		hc.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz?subsystem="+HealthSubsystemWebhookQueue, nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		require.NoError(t, protojson.Unmarshal(w.Body.Bytes(), res))
+		require.Equal(t, HealthStatus_HEALTH_STATUS_SERVING, res.Status)
+		require.Len(t, res.Subsystems, 1)
+	})
+
+	t.Run("rpc", func(t *testing.T) {
+		hc := NewHealthChecker(0)
+		hc.Register(HealthSubsystemWebhookQueue, false, fail)
+
+		bus := psrpc.NewLocalMessageBus()
+		srv, err := NewHealthServer(hc, bus)
+		require.NoError(t, err)
+		defer srv.Shutdown()
+		require.NoError(t, srv.RegisterCheckTopic("node"))
+
+		client, err := NewHealthClient(bus)
+		require.NoError(t, err)
+		defer client.Close()
+
+		res, err := client.Check(context.Background(), "node", &HealthCheckRequest{})
+		require.NoError(t, err)
+		require.Equal(t, HealthStatus_HEALTH_STATUS_DEGRADED, res.Status)
+		require.True(t, res.IsServing())
+	})
+}
@@ -338,7 +338,7 @@ func (s *ingressHandlerServer) Kill() {
 	s.rpc.Close(true)
 }
 
-var psrpcFileDescriptor3 = []byte{
+var psrpcFileDescriptor4 = []byte{
 	// 748 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
 	0x10, 0xc6, 0x52, 0xb2, 0x6a, 0x8d, 0x2c, 0x5b, 0x5e, 0xff, 0x80, 0x62, 0x21, 0xff, 0xd0, 0x17,
@@ -293,112 +293,113 @@ func (s *iOInfoServer) Kill() {
 	s.rpc.Close(true)
 }
 
-var psrpcFileDescriptor4 = []byte{
-	// 1681 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5f, 0x53, 0xe3, 0xc8,
-	0x11, 0x8f, 0xb1, 0x31, 0xb8, 0x6d, 0xb0, 0x19, 0x0c, 0x25, 0x4c, 0x72, 0xcb, 0xfa, 0xb2, 0x39,
-	0x36, 0xa9, 0x32, 0x39, 0xf2, 0x90, 0xe4, 0xae, 0x72, 0x75, 0xc0, 0x7a, 0x77, 0x95, 0xb0, 0xbb,
-	0x8e, 0x80, 0x87, 0xe4, 0x45, 0x19, 0xa4, 0xc1, 0xcc, 0x21, 0x6b, 0x94, 0x99, 0x11, 0xbb, 0x7c,
-	0x82, 0xe4, 0xe3, 0xe4, 0x13, 0xa4, 0x2a, 0x9f, 0x21, 0x2f, 0xf9, 0x26, 0x79, 0x4d, 0xcd, 0x1f,
-	0xc9, 0x32, 0x96, 0x17, 0xc8, 0x13, 0x56, 0xff, 0xba, 0x7b, 0xfa, 0xdf, 0xf4, 0x74, 0x03, 0x2d,
-	0x9e, 0x04, 0x07, 0x94, 0x0d, 0x12, 0xce, 0x24, 0x43, 0x55, 0x9e, 0x04, 0xbd, 0x6e, 0x44, 0x6f,
-	0xc9, 0x0d, 0x95, 0x3e, 0x19, 0x73, 0x22, 0x84, 0x81, 0x7a, 0x5b, 0x19, 0x95, 0xc6, 0x45, 0xf2,
-	0x46, 0x46, 0x16, 0x34, 0xb1, 0x24, 0x94, 0x91, 0x38, 0x63, 0x13, 0x4b, 0xdb, 0x1d, 0x33, 0x36,
-	0x8e, 0xc8, 0x81, 0xfe, 0xba, 0x4c, 0xaf, 0x0e, 0xc8, 0x24, 0x91, 0x77, 0x16, 0xfc, 0xe2, 0x3e,
-	0x18, 0xa6, 0x1c, 0x4b, 0xca, 0x62, 0x83, 0xf7, 0x0f, 0xa0, 0xf3, 0x86, 0xc8, 0xa1, 0x3e, 0xd6,
-	0x23, 0x7f, 0x4d, 0x89, 0x90, 0x68, 0x17, 0x1a, 0xc6, 0x3c, 0x9f, 0x86, 0x4e, 0x65, 0xaf, 0xb2,
-	0xdf, 0xf0, 0x56, 0x0d, 0xc1, 0x0d, 0xfb, 0x7f, 0xab, 0x40, 0xf7, 0x22, 0x09, 0xb1, 0x24, 0xef,
-	0x88, 0xe4, 0x34, 0xc8, 0xa5, 0xbe, 0x82, 0x1a, 0x8d, 0xaf, 0x98, 0x16, 0x68, 0x1e, 0x6e, 0x0e,
-	0xac, 0xa5, 0x03, 0xa3, 0xdb, 0x8d, 0xaf, 0x98, 0xa7, 0x19, 0x50, 0x1f, 0xd6, 0xf0, 0xed, 0xd8,
-	0x0f, 0x92, 0xd4, 0x4f, 0x05, 0x1e, 0x13, 0xa7, 0xba, 0x57, 0xd9, 0x5f, 0xf2, 0x9a, 0xf8, 0x76,
-	0x7c, 0x92, 0xa4, 0x17, 0x8a, 0xa4, 0x78, 0x26, 0xf8, 0x53, 0x81, 0xa7, 0x66, 0x78, 0x26, 0xf8,
-	0x53, 0xc6, 0xd3, 0xbf, 0x80, 0xad, 0x37, 0x44, 0xba, 0xf1, 0x54, 0xbf, 0xb5, 0xe4, 0x27, 0x00,
-	0x36, 0x90, 0x53, 0x07, 0x1a, 0x96, 0xe2, 0x86, 0x0a, 0x16, 0x92, 0x13, 0x3c, 0xf1, 0x6f, 0xc8,
-	0x9d, 0xb3, 0x64, 0x60, 0x43, 0xf9, 0x03, 0xb9, 0xeb, 0xff, 0x7d, 0x09, 0xb6, 0xef, 0xeb, 0x15,
-	0x09, 0x8b, 0x05, 0x41, 0xfb, 0x33, 0x2e, 0x76, 0x73, 0x17, 0x8b, 0xbc, 0xc6, 0xc7, 0x2e, 0x2c,
-	0x4b, 0x76, 0x43, 0x62, 0xab, 0xde, 0x7c, 0xa0, 0x2d, 0xa8, 0x7f, 0x14, 0x7e, 0xca, 0x23, 0xed,
-	0x72, 0xc3, 0x5b, 0xfe, 0x28, 0x2e, 0x78, 0x84, 0x2e, 0x60, 0x3d, 0x62, 0xe3, 0x31, 0x8d, 0xc7,
-	0xfe, 0x15, 0x25, 0x51, 0x28, 0x9c, 0xda, 0x5e, 0x75, 0xbf, 0x79, 0x38, 0x18, 0xf0, 0x24, 0x18,
-	0x94, 0xdb, 0x32, 0x38, 0x35, 0x12, 0xaf, 0xb5, 0xc0, 0x30, 0x96, 0xfc, 0xce, 0x5b, 0x8b, 0x8a,
-	0xb4, 0xde, 0xf7, 0x80, 0xe6, 0x99, 0x50, 0x07, 0xaa, 0xca, 0x6d, 0x13, 0x15, 0xf5, 0x53, 0xd9,
-	0x7a, 0x8b, 0xa3, 0x94, 0x64, 0xb6, 0xea, 0x8f, 0x6f, 0x96, 0x7e, 0x53, 0xe9, 0x8f, 0x61, 0xc7,
-	0xa4, 0xda, 0x1a, 0x70, 0x26, 0xb1, 0x24, 0x8f, 0x8c, 0xf2, 0x2f, 0x60, 0x59, 0x28, 0x76, 0xad,
-	0xb5, 0x79, 0xb8, 0x75, 0x3f, 0x58, 0x46, 0x97, 0xe1, 0xe9, 0xff, 0xb7, 0x02, 0x7b, 0x6f, 0x88,
-	0x3c, 0x73, 0x47, 0xe7, 0x3c, 0x8d, 0x6f, 0x8e, 0x52, 0x79, 0x4d, 0x62, 0x49, 0x03, 0x5d, 0xa9,
-	0xd9, 0x81, 0x7d, 0x68, 0x0a, 0x9a, 0xf8, 0x01, 0x8e, 0x22, 0x75, 0x62, 0x5d, 0x9d, 0x78, 0xbc,
-	0xe4, 0x54, 0xbc, 0x86, 0xa0, 0xc9, 0x09, 0x8e, 0x22, 0x37, 0x44, 0xdb, 0x50, 0xbb, 0xe2, 0x6c,
-	0x62, 0x5c, 0xd1, 0xa0, 0xfe, 0x46, 0xcf, 0xa0, 0xa1, 0xfe, 0xfa, 0xd7, 0x4c, 0x48, 0x67, 0x25,
-	0x07, 0x57, 0x15, 0xf1, 0x2d, 0x13, 0x12, 0x21, 0x58, 0x92, 0xcc, 0xa4, 0x45, 0x23, 0x4b, 0x92,
-	0xa1, 0x5d, 0x58, 0x91, 0xcc, 0x88, 0x2c, 0xe7, 0x40, 0x5d, 0x32, 0x2d, 0xf0, 0x25, 0x34, 0x05,
-	0x0f, 0x7c, 0x1c, 0x86, 0xca, 0x1b, 0x5d, 0x9f, 0x86, 0x01, 0x04, 0x0f, 0x8e, 0x0c, 0x15, 0xed,
-	0x41, 0x4d, 0x99, 0xeb, 0xac, 0xea, 0x18, 0xb4, 0x74, 0x3e, 0xcf, 0xdc, 0x91, 0x32, 0xd6, 0xd3,
-	0x48, 0xff, 0x1f, 0x15, 0x78, 0xfe, 0x19, 0xcf, 0x6d, 0xe1, 0xf5, 0x60, 0x35, 0x15, 0x84, 0xc7,
-	0x78, 0x42, 0xb2, 0x0b, 0x99, 0x7d, 0x2b, 0x2c, 0xc1, 0x42, 0x7c, 0x64, 0x3c, 0xb4, 0x19, 0xcc,
-	0xbf, 0x11, 0x82, 0x5a, 0xc8, 0x59, 0xa2, 0xfd, 0x5a, 0xf5, 0xf4, 0x6f, 0xb4, 0x07, 0x2d, 0x15,
-	0x46, 0xa9, 0x8e, 0x53, 0x71, 0xd4, 0x96, 0x7b, 0x20, 0x68, 0xa2, 0x2d, 0x30, 0x17, 0x24, 0xe1,
-	0xec, 0x07, 0x12, 0x48, 0x85, 0x2f, 0x9b, 0xcc, 0x5a, 0x8a, 0x1b, 0xf6, 0xff, 0x5d, 0x83, 0x67,
-	0x43, 0x55, 0x24, 0x58, 0x92, 0x33, 0x77, 0xf4, 0x8a, 0x8a, 0x04, 0xcb, 0xe0, 0xda, 0x4b, 0x23,
-	0x22, 0x16, 0xe4, 0x6a, 0xb5, 0x2c, 0x57, 0xbf, 0x04, 0xa4, 0x78, 0x12, 0xcc, 0x25, 0x0d, 0x68,
-	0x82, 0x63, 0x99, 0x17, 0x92, 0x66, 0xed, 0x08, 0x9a, 0x8c, 0xa6, 0xa0, 0x1b, 0xce, 0x99, 0x0e,
-	0x73, 0xa6, 0xbf, 0x84, 0x75, 0x75, 0xa6, 0xba, 0x4a, 0x71, 0x3a, 0xb9, 0x24, 0xbc, 0x50, 0x09,
-	0x6b, 0x16, 0x79, 0xaf, 0x01, 0xf4, 0x02, 0x5a, 0x19, 0xab, 0x4e, 0x71, 0x33, 0x67, 0x6c, 0x5a,
-	0xba, 0xce, 0xf3, 0x57, 0xa0, 0xe5, 0x48, 0x98, 0x29, 0x9c, 0xd6, 0x48, 0xcb, 0x00, 0x56, 0xdf,
-	0xa3, 0x0a, 0xa2, 0x03, 0xd5, 0x84, 0xc6, 0x36, 0xa6, 0xea, 0xa7, 0xea, 0x09, 0x31, 0xf3, 0x15,
-	0xb1, 0xae, 0x93, 0xb4, 0x1c, 0xb3, 0x11, 0x8d, 0x95, 0x36, 0x7b, 0xec, 0xbd, 0x92, 0x05, 0x43,
-	0xd6, 0xb6, 0x85, 0xd0, 0x21, 0x9f, 0x24, 0xc7, 0x3e, 0x96, 0x92, 0xd3, 0xcb, 0x54, 0x12, 0xe1,
-	0x34, 0x74, 0xeb, 0xf8, 0xad, 0x2e, 0xb5, 0x07, 0xb2, 0x34, 0x18, 0x2a, 0xe1, 0xa3, 0x5c, 0xd6,
-	0x74, 0x91, 0x36, 0x99, 0xa5, 0xe6, 0x45, 0xdc, 0x5a, 0x54, 0xc4, 0xbd, 0x63, 0xe8, 0x96, 0xa9,
-	0x7a, 0x52, 0xaf, 0xf9, 0x67, 0x0b, 0xf6, 0x16, 0xdb, 0x6b, 0xef, 0xc1, 0x2e, 0x34, 0xd4, 0xc3,
-	0xe7, 0x17, 0x2f, 0x82, 0x22, 0xbc, 0x57, 0x17, 0xe1, 0x6b, 0xe8, 0xce, 0xd6, 0x92, 0xba, 0x48,
-	0x32, 0xeb, 0xf0, 0x9b, 0x49, 0xb1, 0x94, 0x0c, 0x84, 0x5e, 0x42, 0xa7, 0x28, 0xa2, 0xd5, 0xea,
-	0x50, 0x7b, 0xed, 0x02, 0xbd, 0x4c, 0xfb, 0x84, 0x48, 0x1c, 0x62, 0x89, 0x4d, 0x69, 0xcf, 0x68,
-	0x7f, 0x67, 0x21, 0xf4, 0x11, 0xb6, 0x8b, 0x22, 0x85, 0x24, 0x35, 0x75, 0x92, 0xbe, 0x7f, 0x20,
-	0x49, 0xb6, 0xd3, 0x17, 0xee, 0xc0, 0xfd, 0x5c, 0x6d, 0x25, 0x65, 0x98, 0x2a, 0x1e, 0x6e, 0x52,
-	0xac, 0x0b, 0x4b, 0xdf, 0x7e, 0x53, 0x3c, 0x96, 0xac, 0x2a, 0x2c, 0x7f, 0xa2, 0x6a, 0xe5, 0x4f,
-	0xd4, 0x72, 0xf1, 0x89, 0x1a, 0x40, 0x9d, 0x13, 0x91, 0x46, 0x52, 0x57, 0xe9, 0xfa, 0xe1, 0x76,
-	0x56, 0x05, 0xb9, 0xc9, 0x1a, 0xf5, 0x2c, 0xd7, 0xdc, 0x4d, 0x6d, 0xcc, 0xdd, 0xd4, 0x03, 0xe8,
-	0x2a, 0x8e, 0xd0, 0xca, 0xfb, 0x3c, 0x8d, 0xc8, 0xf4, 0x4e, 0x6f, 0x08, 0x9a, 0x14, 0xa3, 0x31,
-	0xd7, 0x95, 0x5a, 0xf7, 0xba, 0x12, 0x3a, 0x85, 0x95, 0x6b, 0x82, 0x43, 0xc2, 0x85, 0xb3, 0xa6,
-	0xa3, 0x7b, 0xf8, 0xb8, 0xe8, 0xbe, 0x35, 0x42, 0x26, 0x9e, 0x99, 0x0a, 0xc4, 0x61, 0xcb, 0xfe,
-	0xf4, 0x25, 0x2b, 0x66, 0x6e, 0x5d, 0xeb, 0xfe, 0xee, 0x49, 0xba, 0xcf, 0xd9, 0xfd, 0xbc, 0x6d,
-	0x5e, 0xcf, 0x23, 0xea, 0xcc, 0xe9, 0x41, 0xea, 0xd8, 0xcc, 0x1f, 0xf4, 0x94, 0x33, 0xa7, 0x0a,
-	0xcf, 0xd9, 0x8c, 0x6f, 0x9b, 0x78, 0x1e, 0x41, 0xc7, 0xd0, 0xa6, 0x71, 0x10, 0xa5, 0x21, 0xc9,
-	0x4f, 0xdb, 0xd4, 0x09, 0xde, 0xc9, 0xdf, 0xeb, 0x33, 0x77, 0x64, 0xb8, 0x3f, 0x24, 0xea, 0x4d,
-	0x12, 0xde, 0xba, 0x95, 0xc8, 0x74, 0x7c, 0x07, 0x1d, 0x12, 0xe3, 0x4b, 0xd5, 0xab, 0xae, 0x08,
-	0x96, 0x29, 0x27, 0xc2, 0x69, 0xef, 0x55, 0xf7, 0xd7, 0x0b, 0x43, 0xe0, 0x99, 0x3b, 0x7a, 0x6d,
-	0x30, 0xaf, 0x6d, 0x99, 0xed, 0xb7, 0xb6, 0x81, 0xd3, 0x58, 0x8f, 0x3f, 0x92, 0x4e, 0x08, 0x4b,
-	0xa5, 0xd3, 0xd1, 0xad, 0x66, 0x67, 0x60, 0x86, 0xd7, 0x41, 0x36, 0xbc, 0x0e, 0x5e, 0xd9, 0xe1,
-	0xd5, 0x5b, 0xb7, 0x12, 0xe7, 0x46, 0x00, 0x0d, 0x61, 0x43, 0xcf, 0x8b, 0xea, 0xbd, 0xc9, 0x26,
-	0x5c, 0x67, 0xe3, 0x21, 0x2d, 0x6d, 0x35, 0x4e, 0xe2, 0x28, 0xca, 0x08, 0xe8, 0x19, 0x34, 0x75,
-	0x7f, 0x49, 0x38, 0x11, 0x44, 0x3a, 0x5d, 0x53, 0xb5, 0x8a, 0x34, 0xd2, 0x14, 0xf4, 0xad, 0x65,
-	0x08, 0x58, 0x7c, 0x45, 0xc7, 0xce, 0x96, 0x3e, 0xa1, 0x97, 0xbb, 0xe9, 0x31, 0x36, 0x39, 0xd1,
-	0x50, 0x76, 0x84, 0x16, 0x36, 0x24, 0xf4, 0x1a, 0x3a, 0x13, 0x12, 0x52, 0xec, 0x93, 0x38, 0xe0,
-	0x77, 0x3a, 0x9a, 0xce, 0xb6, 0x8e, 0xf6, 0x6e, 0x31, 0x50, 0xef, 0x14, 0xcf, 0x30, 0x67, 0xf1,
-	0xda, 0x93, 0x59, 0x42, 0xef, 0x2d, 0xf4, 0x16, 0xf7, 0x84, 0xa7, 0x34, 0xdd, 0xde, 0x37, 0xd0,
-	0x2a, 0xd6, 0xc8, 0x93, 0x64, 0x5f, 0x83, 0xb3, 0xa8, 0xbe, 0x9f, 0xaa, 0x67, 0x51, 0xcd, 0x3e,
-	0xe9, 0x01, 0x79, 0x9f, 0x0d, 0xab, 0xf6, 0x6d, 0x9a, 0x19, 0x56, 0xbf, 0x86, 0x86, 0x99, 0x45,
-	0xca, 0xc6, 0x77, 0x2b, 0xa0, 0xc7, 0xeb, 0xd5, 0xc0, 0xfe, 0xea, 0xff, 0xa7, 0x02, 0x2b, 0x16,
-	0x41, 0x3f, 0x06, 0x88, 0x6e, 0xf2, 0x69, 0xc6, 0x3e, 0x3c, 0xd1, 0x8d, 0x1d, 0x64, 0x76, 0xa1,
-	0x21, 0x58, 0xca, 0x03, 0xe2, 0xd3, 0x24, 0x1b, 0xc1, 0x0c, 0xc1, 0x4d, 0xd0, 0x4b, 0x58, 0xc9,
-	0x46, 0x82, 0xaa, 0x3e, 0xb7, 0x5d, 0x3c, 0xf7, 0x82, 0x53, 0x2f, 0xc3, 0xd1, 0x97, 0x76, 0x78,
-	0xad, 0x95, 0xf3, 0x65, 0x93, 0xac, 0x1a, 0x54, 0x97, 0xcb, 0x59, 0xd4, 0xd4, 0xfa, 0x1c, 0xaa,
-	0xb7, 0x14, 0x3b, 0x75, 0xdd, 0x34, 0xe6, 0x38, 0x14, 0xf6, 0xf3, 0xbf, 0xc0, 0xc6, 0x5c, 0xeb,
-	0x46, 0x0e, 0x74, 0x4f, 0x87, 0x6f, 0x8e, 0x4e, 0xfe, 0xe4, 0x1f, 0x9d, 0x9c, 0x0c, 0x47, 0xe7,
-	0xfe, 0x07, 0xcf, 0x1f, 0xb9, 0xef, 0x3b, 0x3f, 0x42, 0x00, 0x75, 0x43, 0xea, 0x54, 0x50, 0x1b,
-	0x9a, 0xde, 0xf0, 0x8f, 0x17, 0xc3, 0xb3, 0x73, 0x0d, 0x2e, 0x29, 0xd0, 0x1b, 0xfe, 0x7e, 0x78,
-	0x72, 0xde, 0xa9, 0xa2, 0x55, 0xa8, 0xbd, 0xf2, 0x3e, 0x8c, 0x3a, 0xb5, 0xc3, 0x7f, 0xd5, 0xa1,
-	0xee, 0x7e, 0x50, 0x71, 0x44, 0xdf, 0x42, 0xeb, 0x84, 0x13, 0x2c, 0x89, 0x59, 0x04, 0x51, 0xd9,
-	0x66, 0xd8, 0xdb, 0x9e, 0xbb, 0xa4, 0x43, 0xb5, 0xc4, 0x2a, 0x61, 0x93, 0xd4, 0xff, 0x47, 0xf8,
-	0xd7, 0xd0, 0xc8, 0x77, 0x5b, 0xb4, 0x95, 0x2d, 0x53, 0x33, 0xbb, 0x6e, 0xaf, 0x4c, 0x21, 0x1a,
-	0x02, 0x9c, 0x52, 0x91, 0x49, 0x4e, 0xaf, 0xf7, 0x94, 0x98, 0x89, 0xef, 0x96, 0x62, 0x76, 0x5a,
-	0x39, 0x86, 0xb5, 0x99, 0x4d, 0x19, 0xed, 0x68, 0x1b, 0xca, 0xb6, 0xe7, 0x85, 0x3e, 0xfc, 0x0e,
-	0xd6, 0x4c, 0xf4, 0xec, 0xda, 0x84, 0x4a, 0xb7, 0xce, 0x85, 0xe2, 0x2e, 0xac, 0xcf, 0xee, 0x8f,
-	0xa8, 0x57, 0xba, 0x54, 0x66, 0xde, 0x2c, 0x5e, 0x38, 0xd1, 0x29, 0xa0, 0xf9, 0x65, 0x10, 0x7d,
-	0x51, 0x70, 0xa9, 0x64, 0x4b, 0x5c, 0x68, 0xd8, 0x0f, 0xb0, 0xb3, 0x70, 0xed, 0x41, 0x2f, 0x32,
-	0x3b, 0x3e, 0xbb, 0x10, 0xf6, 0x7e, 0xf6, 0x10, 0x9b, 0xb5, 0x7c, 0x0c, 0xce, 0xa2, 0x67, 0x13,
-	0xfd, 0xf4, 0x31, 0x83, 0x72, 0xef, 0xc5, 0xa3, 0xde, 0xde, 0x69, 0x88, 0x8a, 0x2d, 0x68, 0x26,
-	0x44, 0x25, 0xbd, 0x69, 0x51, 0x88, 0x8e, 0x9f, 0xff, 0xf9, 0xd9, 0x98, 0xca, 0xeb, 0xf4, 0x72,
-	0x10, 0xb0, 0xc9, 0x81, 0xcd, 0xba, 0xf9, 0x47, 0x4e, 0xc0, 0xa2, 0x03, 0x9e, 0x04, 0x97, 0x75,
-	0xfd, 0xf5, 0xab, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x40, 0x69, 0xaf, 0x6a, 0x12, 0x00,
-	0x00,
+var psrpcFileDescriptor5 = []byte{
+	// 1703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x53, 0xe3, 0xc8,
+	0x15, 0x0f, 0xb6, 0x31, 0xf6, 0xb3, 0xc1, 0xa6, 0x31, 0x94, 0x30, 0x9b, 0x1d, 0xc6, 0x9b, 0xc9,
+	0x32, 0x49, 0x95, 0xc9, 0x92, 0x43, 0x92, 0xdd, 0xca, 0xd6, 0x82, 0xc7, 0x33, 0xe3, 0x84, 0x19,
+	0x1c, 0x01, 0x87, 0xe4, 0xa2, 0x34, 0x52, 0x63, 0x7a, 0x91, 0xd5, 0x4a, 0x77, 0x8b, 0x19, 0x8e,
+	0x39, 0x25, 0x9f, 0x2a, 0x55, 0xf9, 0x0c, 0xb9, 0xe4, 0x9b, 0xe4, 0x9a, 0xea, 0x3f, 0x92, 0x65,
+	0x2c, 0xef, 0xc0, 0x9e, 0xb0, 0xde, 0xef, 0xfd, 0x7f, 0xaf, 0xbb, 0xdf, 0x03, 0x9a, 0x3c, 0xf6,
+	0x0f, 0x29, 0xeb, 0xc7, 0x9c, 0x49, 0x86, 0xca, 0x3c, 0xf6, 0xbb, 0x9d, 0x90, 0xde, 0x91, 0x5b,
+	0x2a, 0x3d, 0x32, 0xe1, 0x44, 0x08, 0x03, 0x75, 0xb7, 0x53, 0x2a, 0x8d, 0xf2, 0xe4, 0xcd, 0x94,
+	0x2c, 0x68, 0x6c, 0x49, 0x28, 0x25, 0x71, 0xc6, 0xa6, 0x96, 0xb6, 0x37, 0x61, 0x6c, 0x12, 0x92,
+	0x43, 0xfd, 0x75, 0x95, 0x5c, 0x1f, 0x92, 0x69, 0x2c, 0xef, 0x2d, 0xf8, 0xf9, 0x43, 0x30, 0x48,
+	0x38, 0x96, 0x94, 0x45, 0x06, 0xef, 0x1d, 0x42, 0xfb, 0x0d, 0x91, 0x43, 0x6d, 0xd6, 0x25, 0x7f,
+	0x4b, 0x88, 0x90, 0x68, 0x0f, 0xea, 0xc6, 0x3d, 0x8f, 0x06, 0xce, 0xca, 0xfe, 0xca, 0x41, 0xdd,
+	0xad, 0x19, 0xc2, 0x28, 0xe8, 0xfd, 0x63, 0x05, 0x3a, 0x97, 0x71, 0x80, 0x25, 0x79, 0x47, 0x24,
+	0xa7, 0x7e, 0x26, 0xf5, 0x25, 0x54, 0x68, 0x74, 0xcd, 0xb4, 0x40, 0xe3, 0x68, 0xab, 0x6f, 0x3d,
+	0xed, 0x1b, 0xdd, 0xa3, 0xe8, 0x9a, 0xb9, 0x9a, 0x01, 0xf5, 0x60, 0x1d, 0xdf, 0x4d, 0x3c, 0x3f,
+	0x4e, 0xbc, 0x44, 0xe0, 0x09, 0x71, 0xca, 0xfb, 0x2b, 0x07, 0x25, 0xb7, 0x81, 0xef, 0x26, 0x83,
+	0x38, 0xb9, 0x54, 0x24, 0xc5, 0x33, 0xc5, 0x1f, 0x73, 0x3c, 0x15, 0xc3, 0x33, 0xc5, 0x1f, 0x53,
+	0x9e, 0xde, 0x25, 0x6c, 0xbf, 0x21, 0x72, 0x14, 0xcd, 0xf4, 0x5b, 0x4f, 0x7e, 0x0a, 0x40, 0xa3,
+	0x07, 0x01, 0xd4, 0x2d, 0x65, 0x14, 0x28, 0x58, 0x48, 0x4e, 0xf0, 0xd4, 0xbb, 0x25, 0xf7, 0x4e,
+	0xc9, 0xc0, 0x86, 0xf2, 0x47, 0x72, 0xdf, 0xfb, 0x67, 0x09, 0x76, 0x1e, 0xea, 0x15, 0x31, 0x8b,
+	0x04, 0x41, 0x07, 0x73, 0x21, 0x76, 0xb2, 0x10, 0xf3, 0xbc, 0x26, 0xc6, 0x0e, 0xac, 0x4a, 0x76,
+	0x4b, 0x22, 0xab, 0xde, 0x7c, 0xa0, 0x6d, 0xa8, 0x7e, 0x10, 0x5e, 0xc2, 0x43, 0x1d, 0x72, 0xdd,
+	0x5d, 0xfd, 0x20, 0x2e, 0x79, 0x88, 0x2e, 0x61, 0x23, 0x64, 0x93, 0x09, 0x8d, 0x26, 0xde, 0x35,
+	0x25, 0x61, 0x20, 0x9c, 0xca, 0x7e, 0xf9, 0xa0, 0x71, 0xd4, 0xef, 0xf3, 0xd8, 0xef, 0x17, 0xfb,
+	0xd2, 0x3f, 0x35, 0x12, 0xaf, 0xb5, 0xc0, 0x30, 0x92, 0xfc, 0xde, 0x5d, 0x0f, 0xf3, 0xb4, 0xee,
+	0x77, 0x80, 0x16, 0x99, 0x50, 0x1b, 0xca, 0x2a, 0x6c, 0x93, 0x15, 0xf5, 0x53, 0xf9, 0x7a, 0x87,
+	0xc3, 0x84, 0xa4, 0xbe, 0xea, 0x8f, 0xaf, 0x4b, 0xbf, 0x5d, 0xe9, 0x4d, 0x60, 0xd7, 0x94, 0xda,
+	0x3a, 0x70, 0x2e, 0xb1, 0x24, 0x8f, 0xcc, 0xf2, 0x2f, 0x61, 0x55, 0x28, 0x76, 0xad, 0xb5, 0x71,
+	0xb4, 0xfd, 0x30, 0x59, 0x46, 0x97, 0xe1, 0xe9, 0xfd, 0x6f, 0x05, 0xf6, 0xdf, 0x10, 0x79, 0x3e,
+	0x1a, 0x5f, 0xf0, 0x24, 0xba, 0x3d, 0x4e, 0xe4, 0x0d, 0x89, 0x24, 0xf5, 0x75, 0xa7, 0xa6, 0x06,
+	0x7b, 0xd0, 0x10, 0x34, 0xf6, 0x7c, 0x1c, 0x86, 0xca, 0x62, 0x55, 0x59, 0x3c, 0x29, 0x39, 0x2b,
+	0x6e, 0x5d, 0xd0, 0x78, 0x80, 0xc3, 0x70, 0x14, 0xa0, 0x1d, 0xa8, 0x5c, 0x73, 0x36, 0x75, 0x4a,
+	0x19, 0xa8, 0xbf, 0xd1, 0x33, 0xa8, 0xab, 0xbf, 0xde, 0x0d, 0x13, 0xd2, 0x59, 0xcb, 0xc0, 0x9a,
+	0x22, 0xbe, 0x65, 0x42, 0x22, 0x04, 0x25, 0xc9, 0x9c, 0x72, 0x86, 0x94, 0x24, 0x43, 0x7b, 0xb0,
+	0x26, 0x99, 0x11, 0x59, 0xcd, 0x80, 0xaa, 0x64, 0x5a, 0xe0, 0x0b, 0x68, 0x08, 0xee, 0x7b, 0x38,
+	0x08, 0x54, 0x34, 0x4e, 0x25, 0x63, 0x00, 0xc1, 0xfd, 0x63, 0x43, 0x45, 0xfb, 0x50, 0x51, 0xee,
+	0x3a, 0x35, 0x9d, 0x83, 0xa6, 0xae, 0xe7, 0xf9, 0x68, 0xac, 0x9c, 0x75, 0x35, 0xd2, 0xfb, 0x7b,
+	0x09, 0x9e, 0xff, 0x40, 0xe4, 0xb6, 0xf1, 0xba, 0x50, 0x4b, 0x04, 0xe1, 0x11, 0x9e, 0x92, 0xf4,
+	0x40, 0xa6, 0xdf, 0x0a, 0x8b, 0xb1, 0x10, 0x1f, 0x18, 0x0f, 0x6c, 0x05, 0xb3, 0x6f, 0x84, 0xa0,
+	0x12, 0x70, 0x16, 0xeb, 0xb8, 0x6a, 0xae, 0xfe, 0x8d, 0xf6, 0xa1, 0xa9, 0xd2, 0x28, 0x95, 0x39,
+	0x95, 0x47, 0xed, 0xb9, 0x0b, 0x82, 0xc6, 0xda, 0x03, 0x73, 0x40, 0x62, 0xce, 0xbe, 0x27, 0xbe,
+	0x54, 0xf8, 0xaa, 0xa9, 0xac, 0xa5, 0x8c, 0x02, 0x74, 0x06, 0x9d, 0x98, 0x93, 0x3b, 0xca, 0x12,
+	0xe1, 0xf9, 0x9c, 0x04, 0xca, 0x5f, 0x1c, 0x0a, 0xa7, 0xaa, 0x9b, 0xf6, 0xb3, 0xac, 0xd0, 0x69,
+	0x4c, 0x83, 0x19, 0x8f, 0xbb, 0x95, 0x4a, 0xe6, 0x88, 0xbd, 0xff, 0x54, 0xe0, 0xd9, 0x50, 0x75,
+	0x1d, 0x96, 0xe4, 0x7c, 0x34, 0x7e, 0x45, 0x45, 0x8c, 0xa5, 0x7f, 0xe3, 0x26, 0x21, 0x11, 0x4b,
+	0x8a, 0x5f, 0x2b, 0x2a, 0xfe, 0xaf, 0x00, 0x29, 0x9e, 0x18, 0x73, 0x49, 0x7d, 0x1a, 0xe3, 0x48,
+	0x66, 0x9d, 0xa9, 0x59, 0xdb, 0x82, 0xc6, 0xe3, 0x19, 0x38, 0x0a, 0x16, 0x72, 0x01, 0x0b, 0xb9,
+	0x78, 0x09, 0x1b, 0xca, 0xa6, 0x3a, 0x9b, 0x51, 0x32, 0xbd, 0x22, 0x3c, 0xd7, 0x5a, 0xeb, 0x16,
+	0x79, 0xaf, 0x01, 0xf4, 0x02, 0x9a, 0x29, 0xab, 0xee, 0x99, 0x46, 0xc6, 0xd8, 0xb0, 0x74, 0xdd,
+	0x38, 0x5f, 0x82, 0x96, 0x23, 0x41, 0xaa, 0x70, 0xd6, 0x74, 0x4d, 0x03, 0x58, 0x7d, 0x8f, 0xea,
+	0xb0, 0x36, 0x94, 0x63, 0x1a, 0xd9, 0x22, 0xa9, 0x9f, 0xea, 0x92, 0x89, 0x98, 0xa7, 0x88, 0x55,
+	0x5d, 0xf5, 0xd5, 0x88, 0x8d, 0x69, 0xa4, 0xb4, 0x59, 0xb3, 0x0f, 0xce, 0x00, 0x18, 0xb2, 0xf6,
+	0x2d, 0x80, 0x36, 0xf9, 0x28, 0x39, 0xf6, 0xb0, 0x94, 0x9c, 0x5e, 0x25, 0x92, 0x08, 0xa7, 0xae,
+	0xcb, 0xfa, 0x3b, 0xdd, 0xbb, 0x9f, 0xa8, 0x52, 0x7f, 0xa8, 0x84, 0x8f, 0x33, 0x59, 0x73, 0x2d,
+	0xb5, 0xc8, 0x3c, 0x35, 0x3b, 0x15, 0xcd, 0x65, 0xa7, 0xa2, 0x7b, 0x02, 0x9d, 0x22, 0x55, 0x4f,
+	0xba, 0xbc, 0xfe, 0xd5, 0x84, 0xfd, 0xe5, 0xfe, 0xda, 0x83, 0xb5, 0x07, 0x75, 0xf5, 0x92, 0x7a,
+	0xf9, 0x93, 0xa5, 0x08, 0xef, 0xd5, 0xc9, 0xfa, 0x0a, 0x3a, 0xf3, 0xbd, 0xa4, 0x1a, 0x56, 0xa6,
+	0x4f, 0xc6, 0x56, 0x9c, 0x6f, 0x25, 0x03, 0xa1, 0x97, 0xd0, 0xce, 0x8b, 0x68, 0xb5, 0x3a, 0xd5,
+	0x6e, 0x2b, 0x47, 0x2f, 0xd2, 0x3e, 0x25, 0x12, 0x07, 0x58, 0x62, 0xa7, 0xb6, 0xa0, 0xfd, 0x9d,
+	0x85, 0xd0, 0x07, 0xd8, 0xc9, 0x8b, 0xe4, 0x8a, 0xd4, 0xd0, 0x45, 0xfa, 0xee, 0x13, 0x45, 0xb2,
+	0x4f, 0x47, 0xee, 0x0c, 0x3c, 0xac, 0xd5, 0x76, 0x5c, 0x84, 0xa9, 0xe6, 0xe1, 0xa6, 0xc4, 0xba,
+	0xb1, 0xf4, 0x75, 0x62, 0x9a, 0xc7, 0x92, 0x55, 0x87, 0x65, 0x6f, 0x5e, 0xa5, 0xf8, 0xcd, 0x5b,
+	0xcd, 0xbf, 0x79, 0x7d, 0xa8, 0x72, 0x22, 0x92, 0x50, 0xea, 0x2e, 0xdd, 0x38, 0xda, 0x49, 0xbb,
+	0x20, 0x73, 0x59, 0xa3, 0xae, 0xe5, 0x5a, 0x38, 0xa9, 0xf5, 0x85, 0x93, 0x7a, 0x08, 0x1d, 0xc5,
+	0x11, 0x58, 0x79, 0x8f, 0x27, 0x21, 0x99, 0x9d, 0xe9, 0x4d, 0x41, 0xe3, 0x7c, 0x36, 0x16, 0xae,
+	0xb9, 0xe6, 0xc3, 0x6b, 0xee, 0x14, 0xd6, 0x6e, 0x08, 0x0e, 0x08, 0x17, 0xce, 0xba, 0xce, 0xee,
+	0xd1, 0xe3, 0xb2, 0xfb, 0xd6, 0x08, 0x99, 0x7c, 0xa6, 0x2a, 0x10, 0x87, 0x6d, 0xfb, 0xd3, 0x93,
+	0x2c, 0x5f, 0xb9, 0x0d, 0xad, 0xfb, 0xdb, 0x27, 0xe9, 0xbe, 0x60, 0x0f, 0xeb, 0xb6, 0x75, 0xb3,
+	0x88, 0x28, 0x9b, 0x33, 0x43, 0xca, 0x6c, 0x1a, 0x0f, 0x7a, 0x8a, 0xcd, 0x99, 0xc2, 0x0b, 0x36,
+	0x17, 0xdb, 0x16, 0x5e, 0x44, 0xd0, 0x09, 0xb4, 0x68, 0xe4, 0x87, 0x49, 0x40, 0x32, 0x6b, 0x5b,
+	0xba, 0xc0, 0xbb, 0xf9, 0x77, 0xc1, 0x70, 0x9f, 0xc5, 0xea, 0x91, 0x13, 0xee, 0x86, 0x95, 0x48,
+	0x75, 0x7c, 0x0b, 0x6d, 0x12, 0xe1, 0x2b, 0x75, 0x57, 0x5d, 0x13, 0x2c, 0x13, 0x4e, 0x84, 0xd3,
+	0xda, 0x2f, 0x1f, 0x6c, 0xe4, 0xa6, 0xca, 0xf3, 0xd1, 0xf8, 0xb5, 0xc1, 0xdc, 0x96, 0x65, 0xb6,
+	0xdf, 0xda, 0x07, 0x4e, 0x23, 0x3d, 0x4f, 0x49, 0x3a, 0x25, 0x2c, 0x91, 0x4e, 0x5b, 0x5f, 0x35,
+	0xbb, 0x7d, 0x33, 0x0d, 0xf7, 0xd3, 0x69, 0xb8, 0xff, 0xca, 0x4e, 0xc3, 0xee, 0x86, 0x95, 0xb8,
+	0x30, 0x02, 0x68, 0x08, 0x9b, 0x7a, 0x00, 0x55, 0xef, 0x4d, 0x3a, 0x32, 0x3b, 0x9b, 0x9f, 0xd2,
+	0xd2, 0x52, 0xf3, 0x29, 0x0e, 0xc3, 0x94, 0x80, 0x9e, 0x41, 0x43, 0xdf, 0x2f, 0x31, 0x27, 0x82,
+	0x48, 0xa7, 0x63, 0xba, 0x56, 0x91, 0xc6, 0x9a, 0x82, 0xbe, 0xb1, 0x0c, 0x3e, 0x8b, 0xae, 0xe9,
+	0xc4, 0xd9, 0xd6, 0x16, 0xba, 0x59, 0x98, 0x2e, 0x63, 0xd3, 0x81, 0x86, 0x52, 0x13, 0xc0, 0x33,
+	0x12, 0x7a, 0x0d, 0xed, 0x29, 0x09, 0x28, 0xf6, 0x48, 0xe4, 0xf3, 0x7b, 0x9d, 0x4d, 0x67, 0x47,
+	0x67, 0x7b, 0x2f, 0x9f, 0xa8, 0x77, 0x8a, 0x67, 0x98, 0xb1, 0xb8, 0xad, 0xe9, 0x3c, 0xa1, 0xfb,
+	0x16, 0xba, 0xcb, 0xef, 0x84, 0xa7, 0x5c, 0xba, 0xdd, 0xaf, 0xa1, 0x99, 0xef, 0x91, 0x27, 0xc9,
+	0xbe, 0x06, 0x67, 0x59, 0x7f, 0x3f, 0x55, 0xcf, 0xb2, 0x9e, 0x7d, 0xd2, 0x03, 0xf2, 0x3e, 0x9d,
+	0x7e, 0xed, 0xdb, 0x34, 0x37, 0xfd, 0x7e, 0x05, 0x75, 0x33, 0x8b, 0x14, 0xed, 0x03, 0x56, 0x40,
+	0xcf, 0xeb, 0x35, 0xdf, 0xfe, 0xea, 0xfd, 0x77, 0x05, 0xd6, 0x2c, 0x82, 0x3e, 0x03, 0x08, 0x6f,
+	0xb3, 0x69, 0xc6, 0x3e, 0x3c, 0xe1, 0xad, 0x1d, 0x64, 0xf6, 0xa0, 0x2e, 0x58, 0xc2, 0x7d, 0xe2,
+	0xd1, 0x38, 0x9d, 0xe9, 0x0c, 0x61, 0x14, 0xa3, 0x97, 0xb0, 0x96, 0x8e, 0x04, 0x65, 0x6d, 0xb7,
+	0x95, 0xb7, 0x7b, 0xc9, 0xa9, 0x9b, 0xe2, 0xe8, 0x0b, 0x3b, 0x0d, 0x57, 0x8a, 0xf9, 0xd2, 0xd1,
+	0x58, 0x4d, 0xbe, 0xab, 0xc5, 0x2c, 0x6a, 0x0c, 0x7e, 0x0e, 0xe5, 0x3b, 0x8a, 0xed, 0x78, 0xb7,
+	0xc0, 0xa1, 0xb0, 0x5f, 0xfc, 0x15, 0x36, 0x17, 0xae, 0x6e, 0xe4, 0x40, 0xe7, 0x74, 0xf8, 0xe6,
+	0x78, 0xf0, 0x67, 0xef, 0x78, 0x30, 0x18, 0x8e, 0x2f, 0xbc, 0x33, 0xd7, 0x1b, 0x8f, 0xde, 0xb7,
+	0x7f, 0x82, 0x00, 0xaa, 0x86, 0xd4, 0x5e, 0x41, 0x2d, 0x68, 0xb8, 0xc3, 0x3f, 0x5d, 0x0e, 0xcf,
+	0x2f, 0x34, 0x58, 0x52, 0xa0, 0x3b, 0xfc, 0xc3, 0x70, 0x70, 0xd1, 0x2e, 0xa3, 0x1a, 0x54, 0x5e,
+	0xb9, 0x67, 0xe3, 0x76, 0xe5, 0xe8, 0xdf, 0x55, 0xa8, 0x8e, 0xce, 0x54, 0x1e, 0xd1, 0x37, 0xd0,
+	0x1c, 0x70, 0x82, 0x25, 0x31, 0x9b, 0x25, 0x2a, 0x5a, 0x35, 0xbb, 0x3b, 0x0b, 0x87, 0x74, 0xa8,
+	0xb6, 0x62, 0x25, 0x6c, 0x8a, 0xfa, 0x63, 0x84, 0x7f, 0x03, 0xf5, 0x6c, 0x59, 0x46, 0xdb, 0xe9,
+	0x76, 0x36, 0xb7, 0x3c, 0x77, 0x8b, 0x14, 0xa2, 0x21, 0xc0, 0x29, 0x15, 0xa9, 0xe4, 0xec, 0x78,
+	0xcf, 0x88, 0xa9, 0xf8, 0x5e, 0x21, 0x66, 0xa7, 0x95, 0x13, 0x58, 0x9f, 0x5b, 0xbd, 0xd1, 0xae,
+	0xf6, 0xa1, 0x68, 0x1d, 0x5f, 0x1a, 0xc3, 0xef, 0x61, 0xdd, 0x64, 0xcf, 0xee, 0x61, 0xa8, 0x70,
+	0x8d, 0x5d, 0x2a, 0x3e, 0x82, 0x8d, 0xf9, 0x85, 0x14, 0x75, 0x0b, 0xb7, 0xd4, 0x34, 0x9a, 0xe5,
+	0x1b, 0x2c, 0x3a, 0x05, 0xb4, 0xb8, 0x5d, 0xa2, 0xcf, 0x73, 0x21, 0x15, 0xac, 0x9d, 0x4b, 0x1d,
+	0xfb, 0x1e, 0x76, 0x97, 0xee, 0x51, 0xe8, 0x45, 0xea, 0xc7, 0x0f, 0x6e, 0x98, 0xdd, 0x9f, 0x7f,
+	0x8a, 0xcd, 0x7a, 0x3e, 0x01, 0x67, 0xd9, 0xb3, 0x89, 0x7e, 0xf6, 0x98, 0x41, 0xb9, 0xfb, 0xe2,
+	0x51, 0x6f, 0xef, 0x2c, 0x45, 0xf9, 0x2b, 0x68, 0x2e, 0x45, 0x05, 0x77, 0xd3, 0xb2, 0x14, 0x9d,
+	0x3c, 0xff, 0xcb, 0xb3, 0x09, 0x95, 0x37, 0xc9, 0x55, 0xdf, 0x67, 0xd3, 0x43, 0x5b, 0x75, 0xf3,
+	0x9f, 0x21, 0x9f, 0x85, 0x87, 0x3c, 0xf6, 0xaf, 0xaa, 0xfa, 0xeb, 0xd7, 0xff, 0x1f, 0x00, 0x62,
+	0xec, 0xa0, 0x69, 0xbb, 0x12, 0x00, 0x00,
 }
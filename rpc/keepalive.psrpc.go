@@ -121,7 +121,7 @@ func (s *keepaliveServer[NodeIDTopicType]) Kill() {
 	s.rpc.Close(true)
 }
 
-var psrpcFileDescriptor5 = []byte{
+var psrpcFileDescriptor6 = []byte{
 	// 178 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2e, 0x2a, 0x48, 0xd6,
 	0xcf, 0x4e, 0x4d, 0x2d, 0x48, 0xcc, 0xc9, 0x2c, 0x4b, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
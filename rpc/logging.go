@@ -62,6 +62,7 @@ func newClientRPCLoggerInterceptor(l logger.Logger) psrpc.ClientRPCInterceptor {
 		return func(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) (res proto.Message, err error) {
 			start := time.Now()
 			defer func() {
+				l := logger.WithContextValues(ctx, l)
 				if err != nil {
 					l.Warnw("client error", err, "topic", rpcInfo.Topic, "request", logger.Proto(req), "response", logger.Proto(res), "duration", time.Since(start))
 				} else {
@@ -76,7 +77,7 @@ func newClientRPCLoggerInterceptor(l logger.Logger) psrpc.ClientRPCInterceptor {
 func newServerRPCLoggerInterceptor(l logger.Logger) psrpc.ServerRPCInterceptor {
 	loggers := newLoggerCache()
 	return func(ctx context.Context, req proto.Message, rpcInfo psrpc.RPCInfo, handler psrpc.ServerRPCHandler) (res proto.Message, err error) {
-		l := loggers.Get(rpcInfo, l)
+		l := logger.WithContextValues(ctx, loggers.Get(rpcInfo, l))
 		start := time.Now()
 		defer func() {
 			if err != nil {
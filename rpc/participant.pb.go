@@ -44,7 +44,7 @@ var file_rpc_participant_proto_rawDesc = string([]byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x6c, 0x69, 0x76,
 	0x65, 0x6b, 0x69, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x12, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x90, 0x05, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x8c, 0x06, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
 	0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x7f, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50,
 	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x6c, 0x69, 0x76,
 	0x65, 0x6b, 0x69, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
@@ -85,10 +85,17 @@ var file_rpc_participant_proto_rawDesc = string([]byte{
 	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x22, 0x24, 0xb2, 0x89, 0x01, 0x20, 0x10, 0x01, 0x1a, 0x1c, 0x0a, 0x0b, 0x70, 0x61,
 	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x12, 0x7a, 0x0a, 0x0f, 0x4d, 0x6f, 0x76, 0x65,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x6c, 0x69,
+	0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c,
+	0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24,
+	0xb2, 0x89, 0x01, 0x20, 0x10, 0x01, 0x1a, 0x1c, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x18, 0x01, 0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x76, 0x65, 0x6b, 0x69, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2f, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var file_rpc_participant_proto_goTypes = []any{
@@ -97,28 +104,32 @@ var file_rpc_participant_proto_goTypes = []any{
 	(*livekit.UpdateParticipantRequest)(nil),    // 2: livekit.UpdateParticipantRequest
 	(*livekit.UpdateSubscriptionsRequest)(nil),  // 3: livekit.UpdateSubscriptionsRequest
 	(*livekit.ForwardParticipantRequest)(nil),   // 4: livekit.ForwardParticipantRequest
-	(*livekit.RemoveParticipantResponse)(nil),   // 5: livekit.RemoveParticipantResponse
-	(*livekit.MuteRoomTrackResponse)(nil),       // 6: livekit.MuteRoomTrackResponse
-	(*livekit.ParticipantInfo)(nil),             // 7: livekit.ParticipantInfo
-	(*livekit.UpdateSubscriptionsResponse)(nil), // 8: livekit.UpdateSubscriptionsResponse
-	(*livekit.ForwardParticipantResponse)(nil),  // 9: livekit.ForwardParticipantResponse
+	(*livekit.MoveParticipantRequest)(nil),      // 5: livekit.MoveParticipantRequest
+	(*livekit.RemoveParticipantResponse)(nil),   // 6: livekit.RemoveParticipantResponse
+	(*livekit.MuteRoomTrackResponse)(nil),       // 7: livekit.MuteRoomTrackResponse
+	(*livekit.ParticipantInfo)(nil),             // 8: livekit.ParticipantInfo
+	(*livekit.UpdateSubscriptionsResponse)(nil), // 9: livekit.UpdateSubscriptionsResponse
+	(*livekit.ForwardParticipantResponse)(nil),  // 10: livekit.ForwardParticipantResponse
+	(*livekit.MoveParticipantResponse)(nil),     // 11: livekit.MoveParticipantResponse
 }
 var file_rpc_participant_proto_depIdxs = []int32{
-	0, // 0: rpc.Participant.RemoveParticipant:input_type -> livekit.RoomParticipantIdentity
-	1, // 1: rpc.Participant.MutePublishedTrack:input_type -> livekit.MuteRoomTrackRequest
-	2, // 2: rpc.Participant.UpdateParticipant:input_type -> livekit.UpdateParticipantRequest
-	3, // 3: rpc.Participant.UpdateSubscriptions:input_type -> livekit.UpdateSubscriptionsRequest
-	4, // 4: rpc.Participant.ForwardParticipant:input_type -> livekit.ForwardParticipantRequest
-	5, // 5: rpc.Participant.RemoveParticipant:output_type -> livekit.RemoveParticipantResponse
-	6, // 6: rpc.Participant.MutePublishedTrack:output_type -> livekit.MuteRoomTrackResponse
-	7, // 7: rpc.Participant.UpdateParticipant:output_type -> livekit.ParticipantInfo
-	8, // 8: rpc.Participant.UpdateSubscriptions:output_type -> livekit.UpdateSubscriptionsResponse
-	9, // 9: rpc.Participant.ForwardParticipant:output_type -> livekit.ForwardParticipantResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: rpc.Participant.RemoveParticipant:input_type -> livekit.RoomParticipantIdentity
+	1,  // 1: rpc.Participant.MutePublishedTrack:input_type -> livekit.MuteRoomTrackRequest
+	2,  // 2: rpc.Participant.UpdateParticipant:input_type -> livekit.UpdateParticipantRequest
+	3,  // 3: rpc.Participant.UpdateSubscriptions:input_type -> livekit.UpdateSubscriptionsRequest
+	4,  // 4: rpc.Participant.ForwardParticipant:input_type -> livekit.ForwardParticipantRequest
+	5,  // 5: rpc.Participant.MoveParticipant:input_type -> livekit.MoveParticipantRequest
+	6,  // 6: rpc.Participant.RemoveParticipant:output_type -> livekit.RemoveParticipantResponse
+	7,  // 7: rpc.Participant.MutePublishedTrack:output_type -> livekit.MuteRoomTrackResponse
+	8,  // 8: rpc.Participant.UpdateParticipant:output_type -> livekit.ParticipantInfo
+	9,  // 9: rpc.Participant.UpdateSubscriptions:output_type -> livekit.UpdateSubscriptionsResponse
+	10, // 10: rpc.Participant.ForwardParticipant:output_type -> livekit.ForwardParticipantResponse
+	11, // 11: rpc.Participant.MoveParticipant:output_type -> livekit.MoveParticipantResponse
+	6,  // [6:12] is the sub-list for method output_type
+	0,  // [0:6] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_rpc_participant_proto_init() }
@@ -14,7 +14,7 @@ import (
 	"github.com/livekit/psrpc/version"
 )
 import livekit1 "github.com/livekit/protocol/livekit"
-import livekit6 "github.com/livekit/protocol/livekit"
+import livekit5 "github.com/livekit/protocol/livekit"
 
 var _ = version.PsrpcVersion_0_6
 
@@ -23,15 +23,17 @@ var _ = version.PsrpcVersion_0_6
 // ============================
 
 type ParticipantClient[ParticipantTopicType ~string] interface {
-	RemoveParticipant(ctx context.Context, participant ParticipantTopicType, req *livekit6.RoomParticipantIdentity, opts ...psrpc.RequestOption) (*livekit6.RemoveParticipantResponse, error)
+	RemoveParticipant(ctx context.Context, participant ParticipantTopicType, req *livekit5.RoomParticipantIdentity, opts ...psrpc.RequestOption) (*livekit5.RemoveParticipantResponse, error)
 
-	MutePublishedTrack(ctx context.Context, participant ParticipantTopicType, req *livekit6.MuteRoomTrackRequest, opts ...psrpc.RequestOption) (*livekit6.MuteRoomTrackResponse, error)
+	MutePublishedTrack(ctx context.Context, participant ParticipantTopicType, req *livekit5.MuteRoomTrackRequest, opts ...psrpc.RequestOption) (*livekit5.MuteRoomTrackResponse, error)
 
-	UpdateParticipant(ctx context.Context, participant ParticipantTopicType, req *livekit6.UpdateParticipantRequest, opts ...psrpc.RequestOption) (*livekit1.ParticipantInfo, error)
+	UpdateParticipant(ctx context.Context, participant ParticipantTopicType, req *livekit5.UpdateParticipantRequest, opts ...psrpc.RequestOption) (*livekit1.ParticipantInfo, error)
 
-	UpdateSubscriptions(ctx context.Context, participant ParticipantTopicType, req *livekit6.UpdateSubscriptionsRequest, opts ...psrpc.RequestOption) (*livekit6.UpdateSubscriptionsResponse, error)
+	UpdateSubscriptions(ctx context.Context, participant ParticipantTopicType, req *livekit5.UpdateSubscriptionsRequest, opts ...psrpc.RequestOption) (*livekit5.UpdateSubscriptionsResponse, error)
 
-	ForwardParticipant(ctx context.Context, participant ParticipantTopicType, req *livekit6.ForwardParticipantRequest, opts ...psrpc.RequestOption) (*livekit6.ForwardParticipantResponse, error)
+	ForwardParticipant(ctx context.Context, participant ParticipantTopicType, req *livekit5.ForwardParticipantRequest, opts ...psrpc.RequestOption) (*livekit5.ForwardParticipantResponse, error)
+
+	MoveParticipant(ctx context.Context, participant ParticipantTopicType, req *livekit5.MoveParticipantRequest, opts ...psrpc.RequestOption) (*livekit5.MoveParticipantResponse, error)
 
 	// Close immediately, without waiting for pending RPCs
 	Close()
@@ -42,15 +44,17 @@ type ParticipantClient[ParticipantTopicType ~string] interface {
 // ================================
 
 type ParticipantServerImpl interface {
-	RemoveParticipant(context.Context, *livekit6.RoomParticipantIdentity) (*livekit6.RemoveParticipantResponse, error)
+	RemoveParticipant(context.Context, *livekit5.RoomParticipantIdentity) (*livekit5.RemoveParticipantResponse, error)
+
+	MutePublishedTrack(context.Context, *livekit5.MuteRoomTrackRequest) (*livekit5.MuteRoomTrackResponse, error)
 
-	MutePublishedTrack(context.Context, *livekit6.MuteRoomTrackRequest) (*livekit6.MuteRoomTrackResponse, error)
+	UpdateParticipant(context.Context, *livekit5.UpdateParticipantRequest) (*livekit1.ParticipantInfo, error)
 
-	UpdateParticipant(context.Context, *livekit6.UpdateParticipantRequest) (*livekit1.ParticipantInfo, error)
+	UpdateSubscriptions(context.Context, *livekit5.UpdateSubscriptionsRequest) (*livekit5.UpdateSubscriptionsResponse, error)
 
-	UpdateSubscriptions(context.Context, *livekit6.UpdateSubscriptionsRequest) (*livekit6.UpdateSubscriptionsResponse, error)
+	ForwardParticipant(context.Context, *livekit5.ForwardParticipantRequest) (*livekit5.ForwardParticipantResponse, error)
 
-	ForwardParticipant(context.Context, *livekit6.ForwardParticipantRequest) (*livekit6.ForwardParticipantResponse, error)
+	MoveParticipant(context.Context, *livekit5.MoveParticipantRequest) (*livekit5.MoveParticipantResponse, error)
 }
 
 // ============================
@@ -68,6 +72,8 @@ type ParticipantServer[ParticipantTopicType ~string] interface {
 	DeregisterUpdateSubscriptionsTopic(participant ParticipantTopicType)
 	RegisterForwardParticipantTopic(participant ParticipantTopicType) error
 	DeregisterForwardParticipantTopic(participant ParticipantTopicType)
+	RegisterMoveParticipantTopic(participant ParticipantTopicType) error
+	DeregisterMoveParticipantTopic(participant ParticipantTopicType)
 	RegisterAllParticipantTopics(participant ParticipantTopicType) error
 	DeregisterAllParticipantTopics(participant ParticipantTopicType)
 
@@ -98,6 +104,7 @@ func NewParticipantClient[ParticipantTopicType ~string](bus psrpc.MessageBus, op
 	sd.RegisterMethod("UpdateParticipant", false, false, true, true)
 	sd.RegisterMethod("UpdateSubscriptions", false, false, true, true)
 	sd.RegisterMethod("ForwardParticipant", false, false, true, true)
+	sd.RegisterMethod("MoveParticipant", false, false, true, true)
 
 	rpcClient, err := client.NewRPCClient(sd, bus, opts...)
 	if err != nil {
@@ -109,24 +116,28 @@ func NewParticipantClient[ParticipantTopicType ~string](bus psrpc.MessageBus, op
 	}, nil
 }
 
-func (c *participantClient[ParticipantTopicType]) RemoveParticipant(ctx context.Context, participant ParticipantTopicType, req *livekit6.RoomParticipantIdentity, opts ...psrpc.RequestOption) (*livekit6.RemoveParticipantResponse, error) {
-	return client.RequestSingle[*livekit6.RemoveParticipantResponse](ctx, c.client, "RemoveParticipant", []string{string(participant)}, req, opts...)
+func (c *participantClient[ParticipantTopicType]) RemoveParticipant(ctx context.Context, participant ParticipantTopicType, req *livekit5.RoomParticipantIdentity, opts ...psrpc.RequestOption) (*livekit5.RemoveParticipantResponse, error) {
+	return client.RequestSingle[*livekit5.RemoveParticipantResponse](ctx, c.client, "RemoveParticipant", []string{string(participant)}, req, opts...)
 }
 
-func (c *participantClient[ParticipantTopicType]) MutePublishedTrack(ctx context.Context, participant ParticipantTopicType, req *livekit6.MuteRoomTrackRequest, opts ...psrpc.RequestOption) (*livekit6.MuteRoomTrackResponse, error) {
-	return client.RequestSingle[*livekit6.MuteRoomTrackResponse](ctx, c.client, "MutePublishedTrack", []string{string(participant)}, req, opts...)
+func (c *participantClient[ParticipantTopicType]) MutePublishedTrack(ctx context.Context, participant ParticipantTopicType, req *livekit5.MuteRoomTrackRequest, opts ...psrpc.RequestOption) (*livekit5.MuteRoomTrackResponse, error) {
+	return client.RequestSingle[*livekit5.MuteRoomTrackResponse](ctx, c.client, "MutePublishedTrack", []string{string(participant)}, req, opts...)
 }
 
-func (c *participantClient[ParticipantTopicType]) UpdateParticipant(ctx context.Context, participant ParticipantTopicType, req *livekit6.UpdateParticipantRequest, opts ...psrpc.RequestOption) (*livekit1.ParticipantInfo, error) {
+func (c *participantClient[ParticipantTopicType]) UpdateParticipant(ctx context.Context, participant ParticipantTopicType, req *livekit5.UpdateParticipantRequest, opts ...psrpc.RequestOption) (*livekit1.ParticipantInfo, error) {
 	return client.RequestSingle[*livekit1.ParticipantInfo](ctx, c.client, "UpdateParticipant", []string{string(participant)}, req, opts...)
 }
 
-func (c *participantClient[ParticipantTopicType]) UpdateSubscriptions(ctx context.Context, participant ParticipantTopicType, req *livekit6.UpdateSubscriptionsRequest, opts ...psrpc.RequestOption) (*livekit6.UpdateSubscriptionsResponse, error) {
-	return client.RequestSingle[*livekit6.UpdateSubscriptionsResponse](ctx, c.client, "UpdateSubscriptions", []string{string(participant)}, req, opts...)
+func (c *participantClient[ParticipantTopicType]) UpdateSubscriptions(ctx context.Context, participant ParticipantTopicType, req *livekit5.UpdateSubscriptionsRequest, opts ...psrpc.RequestOption) (*livekit5.UpdateSubscriptionsResponse, error) {
+	return client.RequestSingle[*livekit5.UpdateSubscriptionsResponse](ctx, c.client, "UpdateSubscriptions", []string{string(participant)}, req, opts...)
+}
+
+func (c *participantClient[ParticipantTopicType]) ForwardParticipant(ctx context.Context, participant ParticipantTopicType, req *livekit5.ForwardParticipantRequest, opts ...psrpc.RequestOption) (*livekit5.ForwardParticipantResponse, error) {
+	return client.RequestSingle[*livekit5.ForwardParticipantResponse](ctx, c.client, "ForwardParticipant", []string{string(participant)}, req, opts...)
 }
 
-func (c *participantClient[ParticipantTopicType]) ForwardParticipant(ctx context.Context, participant ParticipantTopicType, req *livekit6.ForwardParticipantRequest, opts ...psrpc.RequestOption) (*livekit6.ForwardParticipantResponse, error) {
-	return client.RequestSingle[*livekit6.ForwardParticipantResponse](ctx, c.client, "ForwardParticipant", []string{string(participant)}, req, opts...)
+func (c *participantClient[ParticipantTopicType]) MoveParticipant(ctx context.Context, participant ParticipantTopicType, req *livekit5.MoveParticipantRequest, opts ...psrpc.RequestOption) (*livekit5.MoveParticipantResponse, error) {
+	return client.RequestSingle[*livekit5.MoveParticipantResponse](ctx, c.client, "MoveParticipant", []string{string(participant)}, req, opts...)
 }
 
 func (s *participantClient[ParticipantTopicType]) Close() {
@@ -157,6 +168,7 @@ func NewParticipantServer[ParticipantTopicType ~string](svc ParticipantServerImp
 	sd.RegisterMethod("UpdateParticipant", false, false, true, true)
 	sd.RegisterMethod("UpdateSubscriptions", false, false, true, true)
 	sd.RegisterMethod("ForwardParticipant", false, false, true, true)
+	sd.RegisterMethod("MoveParticipant", false, false, true, true)
 	return &participantServer[ParticipantTopicType]{
 		svc: svc,
 		rpc: s,
@@ -203,6 +215,14 @@ func (s *participantServer[ParticipantTopicType]) DeregisterForwardParticipantTo
 	s.rpc.DeregisterHandler("ForwardParticipant", []string{string(participant)})
 }
 
+func (s *participantServer[ParticipantTopicType]) RegisterMoveParticipantTopic(participant ParticipantTopicType) error {
+	return server.RegisterHandler(s.rpc, "MoveParticipant", []string{string(participant)}, s.svc.MoveParticipant, nil)
+}
+
+func (s *participantServer[ParticipantTopicType]) DeregisterMoveParticipantTopic(participant ParticipantTopicType) {
+	s.rpc.DeregisterHandler("MoveParticipant", []string{string(participant)})
+}
+
 func (s *participantServer[ParticipantTopicType]) allParticipantTopicRegisterers() server.RegistererSlice {
 	return server.RegistererSlice{
 		server.NewRegisterer(s.RegisterRemoveParticipantTopic, s.DeregisterRemoveParticipantTopic),
@@ -210,6 +230,7 @@ func (s *participantServer[ParticipantTopicType]) allParticipantTopicRegisterers
 		server.NewRegisterer(s.RegisterUpdateParticipantTopic, s.DeregisterUpdateParticipantTopic),
 		server.NewRegisterer(s.RegisterUpdateSubscriptionsTopic, s.DeregisterUpdateSubscriptionsTopic),
 		server.NewRegisterer(s.RegisterForwardParticipantTopic, s.DeregisterForwardParticipantTopic),
+		server.NewRegisterer(s.RegisterMoveParticipantTopic, s.DeregisterMoveParticipantTopic),
 	}
 }
 
@@ -229,26 +250,27 @@ func (s *participantServer[ParticipantTopicType]) Kill() {
 	s.rpc.Close(true)
 }
 
-var psrpcFileDescriptor6 = []byte{
-	// 316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcb, 0x4a, 0xc3, 0x40,
-	0x14, 0x25, 0x88, 0x2e, 0xa6, 0x08, 0x76, 0x54, 0x28, 0xc1, 0x47, 0x5f, 0xeb, 0x04, 0xf4, 0x0f,
-	0x5c, 0x08, 0x2e, 0x84, 0x12, 0x75, 0xe3, 0x46, 0x92, 0xc9, 0xd5, 0x0e, 0x4d, 0x72, 0xc7, 0x99,
-	0x9b, 0x4a, 0x57, 0x2e, 0x04, 0xc1, 0x9d, 0xdf, 0xe2, 0x17, 0x4a, 0x9b, 0x26, 0x99, 0xb6, 0x58,
-	0xcc, 0x72, 0xce, 0x39, 0xf7, 0x9c, 0xfb, 0x18, 0x76, 0xac, 0x95, 0xf0, 0x55, 0xa8, 0x49, 0x0a,
-	0xa9, 0xc2, 0x8c, 0x3c, 0xa5, 0x91, 0x90, 0xef, 0x68, 0x25, 0xdc, 0x7d, 0x54, 0x24, 0x31, 0x33,
-	0x05, 0xe6, 0x1e, 0x25, 0x72, 0x0a, 0x13, 0x49, 0x4f, 0x29, 0xc6, 0x90, 0x94, 0x28, 0x2f, 0x51,
-	0x8d, 0x98, 0x16, 0xd8, 0xc5, 0xf7, 0x2e, 0x6b, 0x8d, 0x6a, 0x4f, 0xfe, 0xce, 0xda, 0x01, 0xa4,
-	0x38, 0x05, 0x1b, 0xec, 0x7a, 0xcb, 0x4a, 0x2f, 0x40, 0x4c, 0x2d, 0xe6, 0x26, 0x86, 0x8c, 0x24,
-	0xcd, 0xdc, 0x7e, 0xad, 0x58, 0xaf, 0x0e, 0xc0, 0x28, 0xcc, 0x0c, 0xf4, 0x87, 0x3f, 0x5f, 0x4e,
-	0xf7, 0xc0, 0x71, 0x4f, 0x58, 0xcb, 0x9a, 0x82, 0xdb, 0x8f, 0x8e, 0xc3, 0x67, 0x8c, 0xdf, 0xe6,
-	0x04, 0xa3, 0x3c, 0x4a, 0xa4, 0x19, 0x43, 0x7c, 0xaf, 0x43, 0x31, 0xe1, 0xa7, 0x95, 0xff, 0x9c,
-	0x9c, 0x77, 0xb1, 0xc0, 0x03, 0x78, 0xcd, 0xc1, 0x90, 0x7b, 0xf6, 0x17, 0xdd, 0x28, 0x7a, 0xca,
-	0xda, 0x0f, 0x2a, 0x0e, 0x69, 0x65, 0xf6, 0x5e, 0x65, 0xbd, 0xc1, 0x95, 0xe9, 0x9d, 0x4a, 0x62,
-	0xaf, 0x26, 0x7b, 0xc6, 0x7f, 0xe6, 0x7e, 0x3a, 0xec, 0xb0, 0x30, 0xbf, 0xcb, 0x23, 0x23, 0xb4,
-	0x2c, 0x6e, 0xc9, 0x07, 0x6b, 0xd1, 0x2b, 0x6c, 0x19, 0x3e, 0xdc, 0x2e, 0x6a, 0xb4, 0x80, 0x0f,
-	0x87, 0xf1, 0x6b, 0xd4, 0x6f, 0xa1, 0x8e, 0xed, 0x15, 0xd4, 0xc7, 0xdd, 0x24, 0xcb, 0x36, 0x06,
-	0x5b, 0x35, 0x4d, 0xba, 0xb8, 0xea, 0x3d, 0x9e, 0xbf, 0x48, 0x1a, 0xe7, 0x91, 0x27, 0x30, 0xf5,
-	0x97, 0xb6, 0xfe, 0xe2, 0xbb, 0x0a, 0x4c, 0x7c, 0xad, 0x44, 0xb4, 0xb7, 0x78, 0x5d, 0xfe, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0x09, 0x26, 0xa2, 0x60, 0x13, 0x03, 0x00, 0x00,
+var psrpcFileDescriptor7 = []byte{
+	// 334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x4b, 0xfb, 0x40,
+	0x10, 0x25, 0xfc, 0xa0, 0x87, 0x2d, 0x3f, 0xb4, 0xab, 0x42, 0x09, 0x6a, 0xff, 0x9e, 0x13, 0xd0,
+	0x6f, 0xe0, 0x41, 0xf0, 0x50, 0x28, 0x51, 0x2f, 0x5e, 0x24, 0xd9, 0xac, 0x76, 0x69, 0x92, 0x59,
+	0x77, 0x27, 0x95, 0x7a, 0xf1, 0x20, 0x08, 0x82, 0x9f, 0xc6, 0x4f, 0x28, 0x4d, 0x9a, 0x64, 0x9b,
+	0xd2, 0x62, 0x8e, 0xfb, 0xde, 0xdb, 0xf7, 0x66, 0x67, 0x66, 0xc9, 0x89, 0x92, 0xcc, 0x95, 0xbe,
+	0x42, 0xc1, 0x84, 0xf4, 0x13, 0x74, 0xa4, 0x02, 0x04, 0xfa, 0x4f, 0x49, 0x66, 0xff, 0x07, 0x89,
+	0x02, 0x12, 0x9d, 0x63, 0xf6, 0x71, 0x24, 0x16, 0x7c, 0x2e, 0xf0, 0x31, 0x86, 0x90, 0x47, 0x05,
+	0x4a, 0x0b, 0x54, 0x01, 0xc4, 0x39, 0x76, 0xf1, 0xdd, 0x22, 0xed, 0x69, 0xe5, 0x49, 0xdf, 0x49,
+	0xc7, 0xe3, 0x31, 0x2c, 0xb8, 0x09, 0xf6, 0x9d, 0xf5, 0x4d, 0xc7, 0x03, 0x88, 0x0d, 0xe6, 0x26,
+	0xe4, 0x09, 0x0a, 0x5c, 0xda, 0xc3, 0x4a, 0x51, 0xbf, 0xed, 0x71, 0x2d, 0x21, 0xd1, 0x7c, 0x38,
+	0xfe, 0xf9, 0xb2, 0xfa, 0x87, 0x96, 0x7d, 0x4a, 0xda, 0xc6, 0x2b, 0xa8, 0x79, 0xe8, 0x5a, 0x74,
+	0x49, 0xe8, 0x24, 0x45, 0x3e, 0x4d, 0x83, 0x48, 0xe8, 0x19, 0x0f, 0xef, 0x94, 0xcf, 0xe6, 0xf4,
+	0xac, 0xf4, 0x5f, 0x91, 0xab, 0x2a, 0x32, 0xdc, 0xe3, 0x2f, 0x29, 0xd7, 0x68, 0x9f, 0xef, 0xa2,
+	0x1b, 0x45, 0x2f, 0x48, 0xe7, 0x5e, 0x86, 0x3e, 0x6e, 0xbc, 0x7d, 0x50, 0x5a, 0x6f, 0x71, 0x45,
+	0x7a, 0xb7, 0x94, 0x98, 0xad, 0x49, 0x9e, 0xe0, 0x8f, 0xb9, 0x9f, 0x16, 0x39, 0xca, 0xcd, 0x6f,
+	0xd3, 0x40, 0x33, 0x25, 0xf2, 0x59, 0xd2, 0x51, 0x2d, 0x7a, 0x83, 0x2d, 0xc2, 0xc7, 0xfb, 0x45,
+	0x8d, 0x1a, 0xf0, 0x61, 0x11, 0x7a, 0x0d, 0xea, 0xd5, 0x57, 0xa1, 0xd9, 0x82, 0x6a, 0xb8, 0xdb,
+	0x64, 0x51, 0xc6, 0x68, 0xaf, 0xa6, 0x51, 0x15, 0x6f, 0xe4, 0x60, 0x52, 0x5b, 0xc0, 0x5e, 0x35,
+	0xdf, 0xfa, 0x72, 0xe5, 0xf1, 0xfd, 0xdd, 0x82, 0x26, 0xd9, 0x57, 0x83, 0x87, 0xde, 0xb3, 0xc0,
+	0x59, 0x1a, 0x38, 0x0c, 0x62, 0x77, 0xed, 0xe9, 0x66, 0x5f, 0x85, 0x41, 0xe4, 0x2a, 0xc9, 0x82,
+	0x56, 0x76, 0xba, 0xfc, 0x1d, 0x00, 0x30, 0x94, 0x6b, 0x3a, 0x8f, 0x03, 0x00, 0x00,
 }
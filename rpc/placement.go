@@ -0,0 +1,177 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"math/rand/v2"
+
+	"github.com/zeebo/xxh3"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/psrpc"
+)
+
+// SelectionStrategy picks the server handling a request among the servers that claimed it, for
+// methods with affinity. Claims are filtered by the MinimumAffinity of the request.
+type SelectionStrategy interface {
+	Select(req proto.Message, claims []*psrpc.Claim) (string, error)
+}
+
+type SelectionStrategyFunc func(req proto.Message, claims []*psrpc.Claim) (string, error)
+
+func (f SelectionStrategyFunc) Select(req proto.Message, claims []*psrpc.Claim) (string, error) {
+	return f(req, claims)
+}
+
+// WithSelectionStrategies sets the strategy of client rpcs by method, like CallPolicies methods are
+// keyed by service name or service and method names joined by a dot. The selection options of the
+// request still apply, and a SelectionFunc set by the caller takes precedence.
+func WithSelectionStrategies(strategies map[string]SelectionStrategy) psrpc.ClientOption {
+	return psrpc.WithClientRPCInterceptors(func(rpcInfo psrpc.RPCInfo, next psrpc.ClientRPCHandler) psrpc.ClientRPCHandler {
+		s, ok := strategies[rpcInfo.Service+"."+rpcInfo.Method]
+		if !ok {
+			s, ok = strategies[rpcInfo.Service]
+		}
+		if !ok {
+			return next
+		}
+		return func(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) (proto.Message, error) {
+			return next(ctx, req, append(opts, withSelectionStrategy(s, req))...)
+		}
+	})
+}
+
+func withSelectionStrategy(s SelectionStrategy, req proto.Message) psrpc.RequestOption {
+	return func(o *psrpc.RequestOpts) {
+		if o.SelectionOpts.SelectionFunc == nil {
+			o.SelectionOpts.SelectionFunc = func(claims []*psrpc.Claim) (string, error) {
+				return s.Select(req, claims)
+			}
+		}
+	}
+}
+
+func errNoClaims() error {
+	return psrpc.NewErrorf(psrpc.Unavailable, "no servers available")
+}
+
+// HighestAffinity selects the server with the highest affinity, breaking ties randomly.
+func HighestAffinity() SelectionStrategy {
+	return SelectionStrategyFunc(func(_ proto.Message, claims []*psrpc.Claim) (string, error) {
+		var selected string
+		var best float32
+		var ties int
+		for _, c := range claims {
+			switch {
+			case selected == "" || c.Affinity > best:
+				selected, best, ties = c.ServerID, c.Affinity, 1
+			case c.Affinity == best:
+				// reservoir sampling among the servers with the best affinity
+				if ties++; rand.IntN(ties) == 0 {
+					selected = c.ServerID
+				}
+			}
+		}
+		if selected == "" {
+			return "", errNoClaims()
+		}
+		return selected, nil
+	})
+}
+
+// WeightedRandom selects a server with a probability proportional to its affinity, spreading load
+// across servers where HighestAffinity would pile it on the least loaded one.
+func WeightedRandom() SelectionStrategy {
+	return SelectionStrategyFunc(func(req proto.Message, claims []*psrpc.Claim) (string, error) {
+		var total float64
+		for _, c := range claims {
+			total += float64(max(c.Affinity, 0))
+		}
+		if total == 0 {
+			return HighestAffinity().Select(req, claims)
+		}
+
+		r := rand.Float64() * total
+		for _, c := range claims {
+			if r -= float64(max(c.Affinity, 0)); r < 0 {
+				return c.ServerID, nil
+			}
+		}
+		return claims[len(claims)-1].ServerID, nil
+	})
+}
+
+// StickyHash selects the same server for requests with the same key, e.g. a room name, as long as it
+// keeps claiming them, using rendezvous hashing so that few keys move when servers come and go.
+// Requests without a key fall back to HighestAffinity.
+func StickyHash[T proto.Message](key func(req T) string) SelectionStrategy {
+	return SelectionStrategyFunc(func(req proto.Message, claims []*psrpc.Claim) (string, error) {
+		r, ok := req.(T)
+		if !ok {
+			return HighestAffinity().Select(req, claims)
+		}
+		k := key(r)
+		if k == "" {
+			return HighestAffinity().Select(req, claims)
+		}
+
+		var selected string
+		var best uint64
+		for _, c := range claims {
+			if h := xxh3.HashString(k + "\x00" + c.ServerID); selected == "" || h > best {
+				selected, best = c.ServerID, h
+			}
+		}
+		if selected == "" {
+			return "", errNoClaims()
+		}
+		return selected, nil
+	})
+}
+
+// AffinityFunc scores how well suited the server is to handle a request, as returned by the
+// affinity methods of psrpc server implementations. Servers with a negative affinity do not claim requests.
+type AffinityFunc[T proto.Message] func(ctx context.Context, req T) float32
+
+// RegionAffinity scores requests for the region of the server 1, and others remote.
+func RegionAffinity[T proto.Message](region string, regionOf func(req T) string, remote float32) AffinityFunc[T] {
+	return func(ctx context.Context, req T) float32 {
+		if r := regionOf(req); r == "" || r == region {
+			return 1
+		}
+		return remote
+	}
+}
+
+// LoadAffinity scores requests by the spare capacity of the server, given its load from 0 to 1.
+func LoadAffinity[T proto.Message](load func() float32) AffinityFunc[T] {
+	return func(ctx context.Context, req T) float32 {
+		return min(max(1-load(), 0), 1)
+	}
+}
+
+// CombineAffinity multiplies the scores, any scorer returning 0 or less keeps the server from claiming the request.
+func CombineAffinity[T proto.Message](fns ...AffinityFunc[T]) AffinityFunc[T] {
+	return func(ctx context.Context, req T) float32 {
+		score := float32(1)
+		for _, fn := range fns {
+			if score *= fn(ctx, req); score <= 0 {
+				return -1
+			}
+		}
+		return score
+	}
+}
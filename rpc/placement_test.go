@@ -0,0 +1,120 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/psrpc"
+)
+
+func TestSelectionStrategies(t *testing.T) {
+	claims := []*psrpc.Claim{
+		{ServerID: "a", Affinity: 0.2},
+		{ServerID: "b", Affinity: 0.8},
+		{ServerID: "c", Affinity: 0.5},
+	}
+
+	t.Run("highest affinity", func(t *testing.T) {
+		id, err := HighestAffinity().Select(nil, claims)
+		require.NoError(t, err)
+		require.Equal(t, "b", id)
+
+		_, err = HighestAffinity().Select(nil, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("weighted random", func(t *testing.T) {
+		counts := map[string]int{}
+		for range 1000 {
+			id, err := WeightedRandom().Select(nil, claims)
+			require.NoError(t, err)
+			counts[id]++
+		}
+		require.Greater(t, counts["b"], counts["c"])
+		require.Greater(t, counts["c"], counts["a"])
+		require.Positive(t, counts["a"])
+	})
+
+	t.Run("sticky hash", func(t *testing.T) {
+		s := StickyHash(func(req *livekit.CreateRoomRequest) string { return req.Name })
+
+		// keys stay on their server when another server goes away
+		moved := 0
+		for i := range 100 {
+			req := &livekit.CreateRoomRequest{Name: fmt.Sprintf("room-%d", i)}
+			before, err := s.Select(req, claims)
+			require.NoError(t, err)
+			again, err := s.Select(req, claims)
+			require.NoError(t, err)
+			require.Equal(t, before, again)
+
+			after, err := s.Select(req, claims[1:])
+			require.NoError(t, err)
+			if before != "a" {
+				require.Equal(t, before, after)
+			} else {
+				moved++
+			}
+		}
+		require.Positive(t, moved)
+
+		// other requests fall back to the highest affinity
+		id, err := s.Select(&emptypb.Empty{}, claims)
+		require.NoError(t, err)
+		require.Equal(t, "b", id)
+	})
+
+	t.Run("client option", func(t *testing.T) {
+		var selected string
+		opt := WithSelectionStrategies(map[string]SelectionStrategy{
+			"Room": HighestAffinity(),
+		})
+		var o psrpc.ClientOpts
+		opt(&o)
+		require.Len(t, o.RpcInterceptors, 1)
+
+		h := o.RpcInterceptors[0](psrpc.RPCInfo{Service: "Room", Method: "DeleteRoom"}, func(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) (proto.Message, error) {
+			var ro psrpc.RequestOpts
+			for _, opt := range opts {
+				opt(&ro)
+			}
+			selected, _ = ro.SelectionOpts.SelectionFunc(claims)
+			return nil, nil
+		})
+		_, _ = h(context.Background(), &emptypb.Empty{})
+		require.Equal(t, "b", selected)
+	})
+}
+
+func TestAffinityFuncs(t *testing.T) {
+	region := RegionAffinity("us-east", func(req *livekit.CreateRoomRequest) string { return req.Metadata }, 0.5)
+	load := float32(0.25)
+	fn := CombineAffinity(region, LoadAffinity[*livekit.CreateRoomRequest](func() float32 { return load }))
+
+	ctx := context.Background()
+	require.Equal(t, float32(0.75), fn(ctx, &livekit.CreateRoomRequest{Metadata: "us-east"}))
+	require.Equal(t, float32(0.375), fn(ctx, &livekit.CreateRoomRequest{Metadata: "eu-west"}))
+
+	load = 1
+	require.Less(t, fn(ctx, &livekit.CreateRoomRequest{Metadata: "us-east"}), float32(0))
+}
@@ -0,0 +1,179 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/utils"
+	"github.com/livekit/psrpc"
+	"github.com/livekit/psrpc/pkg/middleware"
+)
+
+// CallPolicy configures calls to psrpc methods. Zero fields are inherited, see CallPolicies.
+type CallPolicy struct {
+	// timeout of each attempt, requests with an explicit timeout keep theirs
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+	// attempts on timeouts and unavailable servers, including the first
+	MaxAttempts int `yaml:"max_attempts,omitempty"`
+	// added to the timeout of each retry, as in PSRPCConfig
+	Backoff time.Duration `yaml:"backoff,omitempty"`
+	// when set, attempts are started every HedgeDelay until one succeeds instead of retrying after
+	// a failure, for idempotent methods where latency matters more than load.
+	// Ignored unless MaxAttempts is greater than 1
+	HedgeDelay time.Duration `yaml:"hedge_delay,omitempty"`
+}
+
+func (p CallPolicy) IsZero() bool {
+	return p == CallPolicy{}
+}
+
+func (p CallPolicy) merge(o CallPolicy) CallPolicy {
+	if o.Timeout != 0 {
+		p.Timeout = o.Timeout
+	}
+	if o.MaxAttempts != 0 {
+		p.MaxAttempts = o.MaxAttempts
+	}
+	if o.Backoff != 0 {
+		p.Backoff = o.Backoff
+	}
+	if o.HedgeDelay != 0 {
+		p.HedgeDelay = o.HedgeDelay
+	}
+	return p
+}
+
+// CallPolicies declares the call policies of clients by service and method. Methods inherit the
+// policy of their service, which inherits Default.
+//
+//	default:
+//	  timeout: 3s
+//	services:
+//	  IOInfo:
+//	    max_attempts: 3
+//	  IOInfo.GetEgress:
+//	    hedge_delay: 500ms
+type CallPolicies struct {
+	Default CallPolicy `yaml:"default,omitempty"`
+	// keyed by service name, or service and method names joined by a dot
+	Services map[string]CallPolicy `yaml:"services,omitempty"`
+}
+
+func (p CallPolicies) IsZero() bool {
+	return p.Default.IsZero() && len(p.Services) == 0
+}
+
+func (p CallPolicies) Policy(service, method string) CallPolicy {
+	return p.Default.
+		merge(p.Services[service]).
+		merge(p.Services[service+"."+method])
+}
+
+// WithCallPolicies applies the policies to the rpcs and multirpcs of a client. Multirpcs are
+// neither retried nor hedged, only their timeout applies.
+func WithCallPolicies(policies CallPolicies) psrpc.ClientOption {
+	return psrpc.WithClientOptions(
+		psrpc.WithClientRPCInterceptors(newClientRPCPolicyInterceptor(policies)),
+		psrpc.WithClientMultiRPCInterceptors(newMultiRPCPolicyInterceptor(policies)),
+	)
+}
+
+func withPolicyTimeout(policy CallPolicy, opts []psrpc.RequestOption) []psrpc.RequestOption {
+	if policy.Timeout == 0 {
+		return opts
+	}
+	// options apply in order, explicit timeouts of the caller override the policy
+	return append([]psrpc.RequestOption{psrpc.WithRequestTimeout(policy.Timeout)}, opts...)
+}
+
+func newClientRPCPolicyInterceptor(policies CallPolicies) psrpc.ClientRPCInterceptor {
+	return func(rpcInfo psrpc.RPCInfo, next psrpc.ClientRPCHandler) psrpc.ClientRPCHandler {
+		policy := policies.Policy(rpcInfo.Service, rpcInfo.Method)
+		switch {
+		case policy.IsZero():
+			return next
+
+		case policy.MaxAttempts > 1 && policy.HedgeDelay > 0:
+			timeout := policy.Timeout
+			if timeout == 0 {
+				timeout = DefaultPSRPCConfig.Timeout
+			}
+			return func(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) (proto.Message, error) {
+				return utils.HedgeCall(ctx, utils.HedgeParams[proto.Message]{
+					Timeout:       timeout + time.Duration(policy.MaxAttempts-1)*policy.HedgeDelay,
+					RetryDelay:    policy.HedgeDelay,
+					MaxAttempts:   policy.MaxAttempts,
+					IsRecoverable: isRetryableError,
+					Func: func(ctx context.Context) (proto.Message, error) {
+						return next(ctx, req, withPolicyTimeout(CallPolicy{Timeout: timeout}, opts)...)
+					},
+				})
+			}
+
+		case policy.MaxAttempts > 1:
+			// the retry interceptor sets the timeout of each attempt
+			return middleware.NewRPCRetryInterceptor(middleware.RetryOptions{
+				MaxAttempts: policy.MaxAttempts,
+				Timeout:     policy.Timeout,
+				Backoff:     policy.Backoff,
+			})(rpcInfo, next)
+
+		default:
+			return func(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) (proto.Message, error) {
+				return next(ctx, req, withPolicyTimeout(policy, opts)...)
+			}
+		}
+	}
+}
+
+// isRetryableError matches the errors retried by the psrpc retry middleware.
+// Context errors are terminal, psrpc reports its own timeouts as psrpc errors.
+func isRetryableError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var psrpcErr psrpc.Error
+	if !errors.As(err, &psrpcErr) {
+		return true
+	}
+	return psrpcErr.Code() == psrpc.DeadlineExceeded || psrpcErr.Code() == psrpc.Unavailable
+}
+
+func newMultiRPCPolicyInterceptor(policies CallPolicies) psrpc.ClientMultiRPCInterceptor {
+	return func(rpcInfo psrpc.RPCInfo, next psrpc.ClientMultiRPCHandler) psrpc.ClientMultiRPCHandler {
+		policy := policies.Policy(rpcInfo.Service, rpcInfo.Method)
+		if policy.Timeout == 0 {
+			return next
+		}
+		return &multiRPCPolicyInterceptor{
+			ClientMultiRPCHandler: next,
+			policy:                policy,
+		}
+	}
+}
+
+type multiRPCPolicyInterceptor struct {
+	psrpc.ClientMultiRPCHandler
+	policy CallPolicy
+}
+
+func (r *multiRPCPolicyInterceptor) Send(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) error {
+	return r.ClientMultiRPCHandler.Send(ctx, req, withPolicyTimeout(r.policy, opts)...)
+}
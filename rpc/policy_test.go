@@ -0,0 +1,130 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/livekit/psrpc"
+)
+
+func TestCallPolicies(t *testing.T) {
+	policies := CallPolicies{
+		Default: CallPolicy{Timeout: time.Second},
+		Services: map[string]CallPolicy{
+			"IOInfo":           {MaxAttempts: 3},
+			"IOInfo.GetEgress": {Timeout: 50 * time.Millisecond, HedgeDelay: 10 * time.Millisecond},
+		},
+	}
+
+	t.Run("inheritance", func(t *testing.T) {
+		require.Equal(t, CallPolicy{Timeout: time.Second}, policies.Policy("Room", "DeleteRoom"))
+		require.Equal(t, CallPolicy{Timeout: time.Second, MaxAttempts: 3}, policies.Policy("IOInfo", "UpdateEgress"))
+		require.Equal(t, CallPolicy{Timeout: 50 * time.Millisecond, MaxAttempts: 3, HedgeDelay: 10 * time.Millisecond}, policies.Policy("IOInfo", "GetEgress"))
+	})
+
+	interceptor := newClientRPCPolicyInterceptor(policies)
+	requestTimeout := func(opts []psrpc.RequestOption) time.Duration {
+		var o psrpc.RequestOpts
+		for _, opt := range opts {
+			opt(&o)
+		}
+		return o.Timeout
+	}
+
+	t.Run("timeout", func(t *testing.T) {
+		var timeout time.Duration
+		h := interceptor(psrpc.RPCInfo{Service: "Room", Method: "DeleteRoom"}, func(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) (proto.Message, error) {
+			timeout = requestTimeout(opts)
+			return &emptypb.Empty{}, nil
+		})
+
+		_, err := h(context.Background(), &emptypb.Empty{})
+		require.NoError(t, err)
+		require.Equal(t, time.Second, timeout)
+
+		_, err = h(context.Background(), &emptypb.Empty{}, psrpc.WithRequestTimeout(time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, time.Minute, timeout)
+	})
+
+	t.Run("retries", func(t *testing.T) {
+		var attempts atomic.Int32
+		h := interceptor(psrpc.RPCInfo{Service: "IOInfo", Method: "UpdateEgress"}, func(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) (proto.Message, error) {
+			if attempts.Inc() < 3 {
+				return nil, psrpc.NewErrorf(psrpc.Unavailable, "unavailable")
+			}
+			return &emptypb.Empty{}, nil
+		})
+
+		_, err := h(context.Background(), &emptypb.Empty{})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, attempts.Load())
+
+		attempts.Store(0)
+		h = interceptor(psrpc.RPCInfo{Service: "IOInfo", Method: "UpdateEgress"}, func(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) (proto.Message, error) {
+			attempts.Inc()
+			return nil, psrpc.NewErrorf(psrpc.NotFound, "not found")
+		})
+		_, err = h(context.Background(), &emptypb.Empty{})
+		require.Error(t, err)
+		require.EqualValues(t, 1, attempts.Load())
+	})
+
+	t.Run("hedging", func(t *testing.T) {
+		var attempts atomic.Int32
+		h := interceptor(psrpc.RPCInfo{Service: "IOInfo", Method: "GetEgress"}, func(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) (proto.Message, error) {
+			require.Equal(t, 50*time.Millisecond, requestTimeout(opts))
+			if attempts.Inc() == 1 {
+				// the first attempt stalls, the hedged one answers
+				<-ctx.Done()
+				return nil, psrpc.NewError(psrpc.DeadlineExceeded, ctx.Err())
+			}
+			return &emptypb.Empty{}, nil
+		})
+
+		_, err := h(context.Background(), &emptypb.Empty{})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, attempts.Load())
+	})
+
+	t.Run("context errors", func(t *testing.T) {
+		require.True(t, isRetryableError(psrpc.ErrRequestTimedOut))
+		require.True(t, isRetryableError(errors.New("unavailable")))
+		require.False(t, isRetryableError(context.Canceled))
+		require.False(t, isRetryableError(fmt.Errorf("request failed: %w", context.DeadlineExceeded)))
+
+		for _, ctxErr := range []error{context.Canceled, context.DeadlineExceeded} {
+			var attempts atomic.Int32
+			h := interceptor(psrpc.RPCInfo{Service: "IOInfo", Method: "GetEgress"}, func(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) (proto.Message, error) {
+				attempts.Inc()
+				return nil, ctxErr
+			})
+
+			_, err := h(context.Background(), &emptypb.Empty{})
+			require.ErrorIs(t, err, ctxErr)
+			require.EqualValues(t, 1, attempts.Load())
+		}
+	})
+}
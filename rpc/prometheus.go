@@ -0,0 +1,210 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/psrpc"
+)
+
+const prometheusCodeOK = "ok"
+
+type PrometheusMetricsParams struct {
+	// defaults to prometheus.DefaultRegisterer
+	Registerer prometheus.Registerer
+	// defaults to livekit
+	Namespace   string
+	ConstLabels prometheus.Labels
+	// handling time buckets in seconds, defaults to prometheus.DefBuckets
+	Buckets []float64
+}
+
+// PrometheusMetrics are rpc metrics in the style of grpc-prometheus, counting started and handled
+// requests by error code, requests in flight, and handling time. Unlike PSRPCMetricsObserver they are
+// registered against the given registry, use ClientOption and ServerOption to instrument clients and servers.
+type PrometheusMetrics struct {
+	started  *prometheus.CounterVec
+	handled  *prometheus.CounterVec
+	inFlight *prometheus.GaugeVec
+	duration *prometheus.HistogramVec
+}
+
+func NewPrometheusMetrics(params PrometheusMetricsParams) (*PrometheusMetrics, error) {
+	if params.Registerer == nil {
+		params.Registerer = prometheus.DefaultRegisterer
+	}
+	if params.Namespace == "" {
+		params.Namespace = livekitNamespace
+	}
+	if params.Buckets == nil {
+		params.Buckets = prometheus.DefBuckets
+	}
+
+	labels := []string{"role", "kind", "service", "method"}
+	m := &PrometheusMetrics{
+		started: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   params.Namespace,
+			Subsystem:   "psrpc",
+			Name:        "started_total",
+			Help:        "Total number of rpcs started.",
+			ConstLabels: params.ConstLabels,
+		}, labels),
+		handled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   params.Namespace,
+			Subsystem:   "psrpc",
+			Name:        "handled_total",
+			Help:        "Total number of rpcs completed, by error code.",
+			ConstLabels: params.ConstLabels,
+		}, append(labels, "code")),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace:   params.Namespace,
+			Subsystem:   "psrpc",
+			Name:        "in_flight",
+			Help:        "Number of rpcs in flight.",
+			ConstLabels: params.ConstLabels,
+		}, labels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   params.Namespace,
+			Subsystem:   "psrpc",
+			Name:        "handling_seconds",
+			Help:        "Time to complete rpcs.",
+			ConstLabels: params.ConstLabels,
+			Buckets:     params.Buckets,
+		}, labels),
+	}
+
+	for _, c := range []prometheus.Collector{m.started, m.handled, m.inFlight, m.duration} {
+		if err := params.Registerer.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func (m *PrometheusMetrics) ClientOption() psrpc.ClientOption {
+	return psrpc.WithClientOptions(
+		psrpc.WithClientRPCInterceptors(m.clientRPCInterceptor),
+		psrpc.WithClientMultiRPCInterceptors(m.multiRPCInterceptor),
+	)
+}
+
+func (m *PrometheusMetrics) ServerOption() psrpc.ServerOption {
+	return psrpc.WithServerRPCInterceptors(m.serverRPCInterceptor)
+}
+
+type prometheusRequest struct {
+	m      *PrometheusMetrics
+	labels []string
+	start  time.Time
+}
+
+func (m *PrometheusMetrics) start(role, kind string, info psrpc.RPCInfo) prometheusRequest {
+	labels := []string{role, kind, info.Service, info.Method}
+	m.started.WithLabelValues(labels...).Inc()
+	m.inFlight.WithLabelValues(labels...).Inc()
+	return prometheusRequest{m, labels, time.Now()}
+}
+
+func (r prometheusRequest) done(code string) {
+	r.m.inFlight.WithLabelValues(r.labels...).Dec()
+	r.m.handled.WithLabelValues(append(r.labels, code)...).Inc()
+	r.m.duration.WithLabelValues(r.labels...).Observe(time.Since(r.start).Seconds())
+}
+
+func prometheusErrorCode(err error) string {
+	if err == nil {
+		return prometheusCodeOK
+	}
+	var psrpcErr psrpc.Error
+	if errors.As(err, &psrpcErr) && psrpcErr.Code() != psrpc.OK {
+		return string(psrpcErr.Code())
+	}
+	return string(psrpc.Unknown)
+}
+
+func (m *PrometheusMetrics) clientRPCInterceptor(info psrpc.RPCInfo, next psrpc.ClientRPCHandler) psrpc.ClientRPCHandler {
+	return func(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) (proto.Message, error) {
+		r := m.start("client", "rpc", info)
+		res, err := next(ctx, req, opts...)
+		r.done(prometheusErrorCode(err))
+		return res, err
+	}
+}
+
+func (m *PrometheusMetrics) serverRPCInterceptor(ctx context.Context, req proto.Message, info psrpc.RPCInfo, handler psrpc.ServerRPCHandler) (proto.Message, error) {
+	r := m.start("server", "rpc", info)
+	res, err := handler(ctx, req)
+	r.done(prometheusErrorCode(err))
+	return res, err
+}
+
+func (m *PrometheusMetrics) multiRPCInterceptor(info psrpc.RPCInfo, next psrpc.ClientMultiRPCHandler) psrpc.ClientMultiRPCHandler {
+	return &prometheusMultiRPCInterceptor{
+		ClientMultiRPCHandler: next,
+		m:                     m,
+		info:                  info,
+	}
+}
+
+// prometheusMultiRPCInterceptor reports a multirpc as ok when any server responded, otherwise with the
+// code of the last error, or deadline_exceeded when no server responded at all.
+type prometheusMultiRPCInterceptor struct {
+	psrpc.ClientMultiRPCHandler
+	m             *PrometheusMetrics
+	info          psrpc.RPCInfo
+	req           *prometheusRequest
+	responseCount int
+	lastErr       error
+}
+
+func (r *prometheusMultiRPCInterceptor) Send(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) error {
+	pr := r.m.start("client", "multirpc", r.info)
+	r.req = &pr
+	err := r.ClientMultiRPCHandler.Send(ctx, req, opts...)
+	if err != nil {
+		r.lastErr = err
+	}
+	return err
+}
+
+func (r *prometheusMultiRPCInterceptor) Recv(msg proto.Message, err error) {
+	if err != nil {
+		r.lastErr = err
+	} else {
+		r.responseCount++
+	}
+	r.ClientMultiRPCHandler.Recv(msg, err)
+}
+
+func (r *prometheusMultiRPCInterceptor) Close() {
+	if r.req != nil {
+		switch {
+		case r.responseCount != 0:
+			r.req.done(prometheusCodeOK)
+		case r.lastErr != nil:
+			r.req.done(prometheusErrorCode(r.lastErr))
+		default:
+			r.req.done(string(psrpc.DeadlineExceeded))
+		}
+		r.req = nil
+	}
+	r.ClientMultiRPCHandler.Close()
+}
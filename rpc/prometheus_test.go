@@ -0,0 +1,94 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/livekit/psrpc"
+)
+
+func TestPrometheusMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m, err := NewPrometheusMetrics(PrometheusMetricsParams{Registerer: reg})
+	require.NoError(t, err)
+
+	_, err = NewPrometheusMetrics(PrometheusMetricsParams{Registerer: reg})
+	require.Error(t, err)
+
+	info := psrpc.RPCInfo{Service: "Room", Method: "DeleteRoom"}
+
+	t.Run("rpc", func(t *testing.T) {
+		var inFlight float64
+		client := m.clientRPCInterceptor(info, func(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) (proto.Message, error) {
+			return m.serverRPCInterceptor(ctx, req, info, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+				inFlight = testutil.ToFloat64(m.inFlight.WithLabelValues("server", "rpc", "Room", "DeleteRoom"))
+				if req.(*emptypb.Empty) == nil {
+					return nil, psrpc.NewErrorf(psrpc.NotFound, "room not found")
+				}
+				return &emptypb.Empty{}, nil
+			})
+		})
+
+		_, err := client(context.Background(), &emptypb.Empty{})
+		require.NoError(t, err)
+		require.Equal(t, 1.0, inFlight)
+		_, err = client(context.Background(), (*emptypb.Empty)(nil))
+		require.Error(t, err)
+
+		for _, role := range []string{"client", "server"} {
+			require.Equal(t, 2.0, testutil.ToFloat64(m.started.WithLabelValues(role, "rpc", "Room", "DeleteRoom")))
+			require.Equal(t, 0.0, testutil.ToFloat64(m.inFlight.WithLabelValues(role, "rpc", "Room", "DeleteRoom")))
+			require.Equal(t, 1.0, testutil.ToFloat64(m.handled.WithLabelValues(role, "rpc", "Room", "DeleteRoom", "ok")))
+			require.Equal(t, 1.0, testutil.ToFloat64(m.handled.WithLabelValues(role, "rpc", "Room", "DeleteRoom", "not_found")))
+		}
+	})
+
+	t.Run("multirpc", func(t *testing.T) {
+		send := func(responses ...error) {
+			h := m.multiRPCInterceptor(info, nopMultiRPCHandler{})
+			require.NoError(t, h.Send(context.Background(), &emptypb.Empty{}))
+			for _, err := range responses {
+				h.Recv(&emptypb.Empty{}, err)
+			}
+			h.Close()
+		}
+
+		send(nil, psrpc.NewErrorf(psrpc.Unavailable, "unavailable"))
+		send(psrpc.NewErrorf(psrpc.Unavailable, "unavailable"))
+		send()
+
+		labels := []string{"client", "multirpc", "Room", "DeleteRoom"}
+		require.Equal(t, 3.0, testutil.ToFloat64(m.started.WithLabelValues(labels...)))
+		require.Equal(t, 1.0, testutil.ToFloat64(m.handled.WithLabelValues(append(labels, "ok")...)))
+		require.Equal(t, 1.0, testutil.ToFloat64(m.handled.WithLabelValues(append(labels, "unavailable")...)))
+		require.Equal(t, 1.0, testutil.ToFloat64(m.handled.WithLabelValues(append(labels, "deadline_exceeded")...)))
+	})
+}
+
+type nopMultiRPCHandler struct{}
+
+func (nopMultiRPCHandler) Send(context.Context, proto.Message, ...psrpc.RequestOption) error {
+	return nil
+}
+func (nopMultiRPCHandler) Recv(proto.Message, error) {}
+func (nopMultiRPCHandler) Close()                    {}
@@ -0,0 +1,173 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+const (
+	// TopicWildcard matches any value of a region topic segment, see RegionTopic.Matches.
+	TopicWildcard = "*"
+
+	regionTopicSep = "."
+	regionTopicEq  = "="
+
+	regionTopicRegion  = "region"
+	regionTopicNode    = "node"
+	regionTopicProject = "project"
+)
+
+var ErrInvalidRegionTopic = errors.New("invalid region topic")
+
+// RegionTopic addresses a region, optionally narrowed to a node or a project in that region.
+// It formats as labeled segments, e.g. region=us-east.node=ND_xxx, so that topics built by
+// different services for the same target are identical and can be parsed back.
+type RegionTopic struct {
+	Region    string
+	NodeID    livekit.NodeID
+	ProjectID string
+}
+
+func NewRegionTopic(region string) RegionTopic {
+	return RegionTopic{Region: region}
+}
+
+func (t RegionTopic) WithNode(nodeID livekit.NodeID) RegionTopic {
+	t.NodeID = nodeID
+	return t
+}
+
+func (t RegionTopic) WithProject(projectID string) RegionTopic {
+	t.ProjectID = projectID
+	return t
+}
+
+// AnyRegion returns the topic matching the same node and project in every region.
+func (t RegionTopic) AnyRegion() RegionTopic {
+	t.Region = TopicWildcard
+	return t
+}
+
+func (t RegionTopic) AnyNode() RegionTopic {
+	t.NodeID = TopicWildcard
+	return t
+}
+
+func (t RegionTopic) AnyProject() RegionTopic {
+	t.ProjectID = TopicWildcard
+	return t
+}
+
+func (t RegionTopic) Validate() error {
+	if t.Region == "" {
+		return fmt.Errorf("%w: missing region", ErrInvalidRegionTopic)
+	}
+	for _, s := range t.segments() {
+		if strings.Contains(s[1], regionTopicSep) || strings.Contains(s[1], regionTopicEq) {
+			return fmt.Errorf("%w: invalid %s %q", ErrInvalidRegionTopic, s[0], s[1])
+		}
+		if s[1] != TopicWildcard && strings.Contains(s[1], TopicWildcard) {
+			return fmt.Errorf("%w: partial wildcard in %s %q", ErrInvalidRegionTopic, s[0], s[1])
+		}
+	}
+	return nil
+}
+
+// IsPattern returns true if any segment is a wildcard, patterns are not valid topics to publish to.
+func (t RegionTopic) IsPattern() bool {
+	for _, s := range t.segments() {
+		if s[1] == TopicWildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns true if the topic is t, or is matched by the wildcards of t. Segments absent from t
+// must be absent from the topic.
+func (t RegionTopic) Matches(topic RegionTopic) bool {
+	return matchRegionTopicSegment(t.Region, topic.Region) &&
+		matchRegionTopicSegment(string(t.NodeID), string(topic.NodeID)) &&
+		matchRegionTopicSegment(t.ProjectID, topic.ProjectID)
+}
+
+func matchRegionTopicSegment(pattern, value string) bool {
+	if pattern == TopicWildcard {
+		return value != ""
+	}
+	return pattern == value
+}
+
+// String formats the topic, use Validate first when segments come from user input.
+func (t RegionTopic) String() string {
+	var sb strings.Builder
+	for i, s := range t.segments() {
+		if i > 0 {
+			sb.WriteString(regionTopicSep)
+		}
+		sb.WriteString(s[0])
+		sb.WriteString(regionTopicEq)
+		sb.WriteString(s[1])
+	}
+	return sb.String()
+}
+
+func (t RegionTopic) segments() [][2]string {
+	segments := make([][2]string, 0, 3)
+	segments = append(segments, [2]string{regionTopicRegion, t.Region})
+	if t.NodeID != "" {
+		segments = append(segments, [2]string{regionTopicNode, string(t.NodeID)})
+	}
+	if t.ProjectID != "" {
+		segments = append(segments, [2]string{regionTopicProject, t.ProjectID})
+	}
+	return segments
+}
+
+func ParseRegionTopic(s string) (RegionTopic, error) {
+	var t RegionTopic
+	var last int
+	for i, segment := range strings.Split(s, regionTopicSep) {
+		k, v, ok := strings.Cut(segment, regionTopicEq)
+		if !ok || v == "" {
+			return RegionTopic{}, fmt.Errorf("%w: invalid segment %q", ErrInvalidRegionTopic, segment)
+		}
+
+		var order int
+		switch k {
+		case regionTopicRegion:
+			t.Region = v
+		case regionTopicNode:
+			t.NodeID, order = livekit.NodeID(v), 1
+		case regionTopicProject:
+			t.ProjectID, order = v, 2
+		default:
+			return RegionTopic{}, fmt.Errorf("%w: unknown segment %q", ErrInvalidRegionTopic, k)
+		}
+		if (i == 0) != (order == 0) || (i > 0 && order <= last) {
+			return RegionTopic{}, fmt.Errorf("%w: unexpected segment %q", ErrInvalidRegionTopic, k)
+		}
+		last = order
+	}
+	if err := t.Validate(); err != nil {
+		return RegionTopic{}, err
+	}
+	return t, nil
+}
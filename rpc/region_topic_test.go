@@ -0,0 +1,76 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+func TestRegionTopic(t *testing.T) {
+	t.Run("format and parse", func(t *testing.T) {
+		cases := []struct {
+			topic RegionTopic
+			str   string
+		}{
+			{NewRegionTopic("us-east"), "region=us-east"},
+			{NewRegionTopic("us-east").WithNode("ND_1"), "region=us-east.node=ND_1"},
+			{NewRegionTopic("us-east").WithProject("p_1"), "region=us-east.project=p_1"},
+			{NewRegionTopic("us-east").WithNode("ND_1").WithProject("p_1"), "region=us-east.node=ND_1.project=p_1"},
+			{NewRegionTopic("").AnyRegion().WithProject("p_1"), "region=*.project=p_1"},
+		}
+		for _, c := range cases {
+			require.NoError(t, c.topic.Validate())
+			require.Equal(t, c.str, c.topic.String())
+			parsed, err := ParseRegionTopic(c.str)
+			require.NoError(t, err)
+			require.Equal(t, c.topic, parsed)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, s := range []string{
+			"",
+			"node=ND_1",
+			"region=",
+			"region=us-east.region=us-west",
+			"region=us-east.project=p_1.node=ND_1",
+			"region=us-east.shard=1",
+			"region=us*",
+		} {
+			_, err := ParseRegionTopic(s)
+			require.ErrorIs(t, err, ErrInvalidRegionTopic, s)
+		}
+
+		require.ErrorIs(t, NewRegionTopic("us.east").Validate(), ErrInvalidRegionTopic)
+		require.ErrorIs(t, NewRegionTopic("us-east").WithProject("a=b").Validate(), ErrInvalidRegionTopic)
+	})
+
+	t.Run("matches", func(t *testing.T) {
+		topic := NewRegionTopic("us-east").WithNode(livekit.NodeID("ND_1")).WithProject("p_1")
+
+		require.True(t, topic.Matches(topic))
+		require.False(t, topic.IsPattern())
+		require.True(t, topic.AnyRegion().Matches(topic))
+		require.True(t, topic.AnyRegion().AnyNode().Matches(topic))
+		require.True(t, topic.AnyRegion().IsPattern())
+		require.False(t, topic.AnyRegion().Matches(topic.WithProject("p_2")))
+		require.False(t, NewRegionTopic("us-east").WithNode("ND_1").Matches(topic))
+		require.False(t, NewRegionTopic("us-east").AnyProject().Matches(NewRegionTopic("us-east")))
+	})
+}
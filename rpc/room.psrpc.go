@@ -29,6 +29,8 @@ type RoomClient[RoomTopicType ~string] interface {
 
 	UpdateRoomMetadata(ctx context.Context, room RoomTopicType, req *livekit6.UpdateRoomMetadataRequest, opts ...psrpc.RequestOption) (*livekit1.Room, error)
 
+	RequestRoomPreview(ctx context.Context, room RoomTopicType, req *livekit6.RoomPreviewRequest, opts ...psrpc.RequestOption) (*livekit6.RoomPreviewResponse, error)
+
 	// Close immediately, without waiting for pending RPCs
 	Close()
 }
@@ -43,6 +45,8 @@ type RoomServerImpl interface {
 	SendData(context.Context, *livekit6.SendDataRequest) (*livekit6.SendDataResponse, error)
 
 	UpdateRoomMetadata(context.Context, *livekit6.UpdateRoomMetadataRequest) (*livekit1.Room, error)
+
+	RequestRoomPreview(context.Context, *livekit6.RoomPreviewRequest) (*livekit6.RoomPreviewResponse, error)
 }
 
 // =====================
@@ -56,6 +60,8 @@ type RoomServer[RoomTopicType ~string] interface {
 	DeregisterSendDataTopic(room RoomTopicType)
 	RegisterUpdateRoomMetadataTopic(room RoomTopicType) error
 	DeregisterUpdateRoomMetadataTopic(room RoomTopicType)
+	RegisterRequestRoomPreviewTopic(room RoomTopicType) error
+	DeregisterRequestRoomPreviewTopic(room RoomTopicType)
 	RegisterAllRoomTopics(room RoomTopicType) error
 	DeregisterAllRoomTopics(room RoomTopicType)
 
@@ -84,6 +90,7 @@ func NewRoomClient[RoomTopicType ~string](bus psrpc.MessageBus, opts ...psrpc.Cl
 	sd.RegisterMethod("DeleteRoom", false, false, true, true)
 	sd.RegisterMethod("SendData", false, false, true, true)
 	sd.RegisterMethod("UpdateRoomMetadata", false, false, true, true)
+	sd.RegisterMethod("RequestRoomPreview", false, false, true, true)
 
 	rpcClient, err := client.NewRPCClient(sd, bus, opts...)
 	if err != nil {
@@ -107,6 +114,10 @@ func (c *roomClient[RoomTopicType]) UpdateRoomMetadata(ctx context.Context, room
 	return client.RequestSingle[*livekit1.Room](ctx, c.client, "UpdateRoomMetadata", []string{string(room)}, req, opts...)
 }
 
+func (c *roomClient[RoomTopicType]) RequestRoomPreview(ctx context.Context, room RoomTopicType, req *livekit6.RoomPreviewRequest, opts ...psrpc.RequestOption) (*livekit6.RoomPreviewResponse, error) {
+	return client.RequestSingle[*livekit6.RoomPreviewResponse](ctx, c.client, "RequestRoomPreview", []string{string(room)}, req, opts...)
+}
+
 func (s *roomClient[RoomTopicType]) Close() {
 	s.client.Close()
 }
@@ -133,6 +144,7 @@ func NewRoomServer[RoomTopicType ~string](svc RoomServerImpl, bus psrpc.MessageB
 	sd.RegisterMethod("DeleteRoom", false, false, true, true)
 	sd.RegisterMethod("SendData", false, false, true, true)
 	sd.RegisterMethod("UpdateRoomMetadata", false, false, true, true)
+	sd.RegisterMethod("RequestRoomPreview", false, false, true, true)
 	return &roomServer[RoomTopicType]{
 		svc: svc,
 		rpc: s,
@@ -163,11 +175,20 @@ func (s *roomServer[RoomTopicType]) DeregisterUpdateRoomMetadataTopic(room RoomT
 	s.rpc.DeregisterHandler("UpdateRoomMetadata", []string{string(room)})
 }
 
+func (s *roomServer[RoomTopicType]) RegisterRequestRoomPreviewTopic(room RoomTopicType) error {
+	return server.RegisterHandler(s.rpc, "RequestRoomPreview", []string{string(room)}, s.svc.RequestRoomPreview, nil)
+}
+
+func (s *roomServer[RoomTopicType]) DeregisterRequestRoomPreviewTopic(room RoomTopicType) {
+	s.rpc.DeregisterHandler("RequestRoomPreview", []string{string(room)})
+}
+
 func (s *roomServer[RoomTopicType]) allRoomTopicRegisterers() server.RegistererSlice {
 	return server.RegistererSlice{
 		server.NewRegisterer(s.RegisterDeleteRoomTopic, s.DeregisterDeleteRoomTopic),
 		server.NewRegisterer(s.RegisterSendDataTopic, s.DeregisterSendDataTopic),
 		server.NewRegisterer(s.RegisterUpdateRoomMetadataTopic, s.DeregisterUpdateRoomMetadataTopic),
+		server.NewRegisterer(s.RegisterRequestRoomPreviewTopic, s.DeregisterRequestRoomPreviewTopic),
 	}
 }
 
@@ -187,21 +208,22 @@ func (s *roomServer[RoomTopicType]) Kill() {
 	s.rpc.Close(true)
 }
 
-var psrpcFileDescriptor7 = []byte{
-	// 230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2b, 0x2a, 0x48, 0xd6,
-	0x2f, 0xca, 0xcf, 0xcf, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2e, 0x2a, 0x48, 0x96,
-	0xe2, 0xcd, 0x2f, 0x28, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0x88, 0x49, 0x89, 0xe4, 0x64, 0x96, 0xa5,
-	0x66, 0x67, 0x96, 0xc4, 0xe7, 0xe6, 0xa7, 0xa4, 0xe6, 0xc0, 0x44, 0x85, 0x60, 0xa2, 0x08, 0xdd,
-	0x46, 0xf3, 0x99, 0xb8, 0x58, 0x82, 0xf2, 0xf3, 0x73, 0x85, 0x62, 0xb9, 0xb8, 0x5c, 0x52, 0x73,
-	0x52, 0x4b, 0x52, 0xc1, 0x3c, 0x29, 0x3d, 0xa8, 0x5a, 0x3d, 0x84, 0x60, 0x50, 0x6a, 0x61, 0x69,
-	0x6a, 0x71, 0x89, 0x94, 0x34, 0x56, 0xb9, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x25, 0xb1, 0x4d,
-	0x9d, 0x8c, 0x42, 0x02, 0x8c, 0x52, 0x7c, 0x5c, 0x2c, 0x20, 0x5b, 0x84, 0xc0, 0xa4, 0x04, 0xa3,
-	0x50, 0x38, 0x17, 0x47, 0x70, 0x6a, 0x5e, 0x8a, 0x4b, 0x62, 0x49, 0xa2, 0x90, 0x04, 0xdc, 0x00,
-	0x98, 0x10, 0xcc, 0x68, 0x49, 0x2c, 0x32, 0x04, 0x0c, 0x8e, 0xe7, 0x12, 0x0a, 0x2d, 0x48, 0x49,
-	0x84, 0x38, 0xc3, 0x37, 0xb5, 0x24, 0x31, 0x05, 0x64, 0x85, 0x12, 0xdc, 0x20, 0x4c, 0x49, 0x98,
-	0x65, 0xbc, 0x70, 0x35, 0x20, 0x59, 0x5c, 0x16, 0x38, 0x29, 0x46, 0xc9, 0xa7, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0xb5, 0xe8, 0x83, 0x43, 0x2f, 0x39, 0x3f, 0x47,
-	0xbf, 0xa8, 0x20, 0x39, 0x89, 0x0d, 0xcc, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x87,
-	0xc0, 0x3a, 0x9b, 0x01, 0x00, 0x00,
+var psrpcFileDescriptor8 = []byte{
+	// 253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xf1, 0x4a, 0xc3, 0x30,
+	0x10, 0xc6, 0x29, 0x1b, 0x22, 0x07, 0x1b, 0x72, 0x88, 0xcc, 0x4c, 0x10, 0xf7, 0x00, 0x2d, 0xe8,
+	0x1b, 0xc8, 0xfe, 0x15, 0x64, 0x22, 0x82, 0x20, 0xa3, 0x4b, 0x8f, 0x19, 0x6c, 0x7b, 0x31, 0xbd,
+	0xcd, 0x57, 0xd0, 0xd7, 0xf1, 0x09, 0xa5, 0x59, 0xd2, 0x21, 0x56, 0xfd, 0x27, 0x70, 0xdf, 0x77,
+	0xdf, 0xef, 0x4b, 0x08, 0x8c, 0x9d, 0xd5, 0x99, 0x63, 0xae, 0x52, 0xeb, 0x58, 0x18, 0x07, 0xce,
+	0x6a, 0x35, 0x62, 0x2b, 0x86, 0xeb, 0x66, 0xa7, 0xa9, 0xe3, 0xd2, 0x6c, 0xe9, 0xc5, 0xc8, 0xb2,
+	0xe2, 0x82, 0xca, 0xa8, 0x62, 0x54, 0xf7, 0xe9, 0xcb, 0xf7, 0x01, 0x0c, 0x17, 0xcc, 0x15, 0x3e,
+	0x01, 0xcc, 0xa9, 0x24, 0x21, 0x3f, 0xa9, 0x34, 0xec, 0xa6, 0x7b, 0x71, 0x41, 0xaf, 0x1b, 0x6a,
+	0x44, 0x4d, 0x7b, 0xbd, 0xc6, 0x72, 0xdd, 0xd0, 0xec, 0xe4, 0xf3, 0x23, 0xc1, 0xa3, 0x44, 0x8d,
+	0x61, 0xd8, 0xb6, 0xa0, 0x3f, 0x27, 0x09, 0x3e, 0xc0, 0xe1, 0x1d, 0xd5, 0xc5, 0x3c, 0x97, 0x1c,
+	0x27, 0x1d, 0x20, 0x4a, 0x11, 0x7d, 0xda, 0xe3, 0xfc, 0x03, 0x5e, 0x02, 0xde, 0xdb, 0x22, 0xdf,
+	0x5d, 0xe3, 0x86, 0x24, 0x2f, 0xda, 0x8a, 0x59, 0x07, 0xfa, 0x69, 0xc6, 0xb2, 0x51, 0xb7, 0xd3,
+	0xba, 0xbf, 0x16, 0xac, 0x01, 0x43, 0xa2, 0x5d, 0xbb, 0x75, 0xb4, 0x35, 0xf4, 0x86, 0xd3, 0x6f,
+	0xe1, 0xa0, 0x46, 0xf2, 0x59, 0xbf, 0xf9, 0xf7, 0x4b, 0xae, 0x2f, 0x1e, 0xcf, 0xd7, 0x46, 0x9e,
+	0x37, 0xab, 0x54, 0x73, 0x95, 0x05, 0x42, 0xe6, 0xbf, 0x49, 0x73, 0x99, 0x39, 0xab, 0x57, 0x07,
+	0x7e, 0xba, 0xfa, 0x1a, 0x00, 0x0e, 0x45, 0x52, 0xac, 0x04, 0x02, 0x00, 0x00,
 }
@@ -145,7 +145,7 @@ func (s *roomManagerServer[NodeIdTopicType]) Kill() {
 	s.rpc.Close(true)
 }
 
-var psrpcFileDescriptor8 = []byte{
+var psrpcFileDescriptor9 = []byte{
 	// 185 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0xcd, 0x4d, 0x0a, 0xc2, 0x30,
 	0x10, 0x05, 0x60, 0x8a, 0xa2, 0x10, 0x29, 0x68, 0xb0, 0xa0, 0xd9, 0x88, 0x1e, 0x20, 0x01, 0xbd,
@@ -31,6 +31,22 @@ type FakeTypedRoomClient struct {
 		result1 *livekit.DeleteRoomResponse
 		result2 error
 	}
+	RequestRoomPreviewStub        func(context.Context, rpc.RoomTopic, *livekit.RoomPreviewRequest, ...psrpc.RequestOption) (*livekit.RoomPreviewResponse, error)
+	requestRoomPreviewMutex       sync.RWMutex
+	requestRoomPreviewArgsForCall []struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.RoomPreviewRequest
+		arg4 []psrpc.RequestOption
+	}
+	requestRoomPreviewReturns struct {
+		result1 *livekit.RoomPreviewResponse
+		result2 error
+	}
+	requestRoomPreviewReturnsOnCall map[int]struct {
+		result1 *livekit.RoomPreviewResponse
+		result2 error
+	}
 	SendDataStub        func(context.Context, rpc.RoomTopic, *livekit.SendDataRequest, ...psrpc.RequestOption) (*livekit.SendDataResponse, error)
 	sendDataMutex       sync.RWMutex
 	sendDataArgsForCall []struct {
@@ -158,6 +174,73 @@ func (fake *FakeTypedRoomClient) DeleteRoomReturnsOnCall(i int, result1 *livekit
 	}{result1, result2}
 }
 
+func (fake *FakeTypedRoomClient) RequestRoomPreview(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.RoomPreviewRequest, arg4 ...psrpc.RequestOption) (*livekit.RoomPreviewResponse, error) {
+	fake.requestRoomPreviewMutex.Lock()
+	ret, specificReturn := fake.requestRoomPreviewReturnsOnCall[len(fake.requestRoomPreviewArgsForCall)]
+	fake.requestRoomPreviewArgsForCall = append(fake.requestRoomPreviewArgsForCall, struct {
+		arg1 context.Context
+		arg2 rpc.RoomTopic
+		arg3 *livekit.RoomPreviewRequest
+		arg4 []psrpc.RequestOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RequestRoomPreviewStub
+	fakeReturns := fake.requestRoomPreviewReturns
+	fake.recordInvocation("RequestRoomPreview", []interface{}{arg1, arg2, arg3, arg4})
+	fake.requestRoomPreviewMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTypedRoomClient) RequestRoomPreviewCallCount() int {
+	fake.requestRoomPreviewMutex.RLock()
+	defer fake.requestRoomPreviewMutex.RUnlock()
+	return len(fake.requestRoomPreviewArgsForCall)
+}
+
+func (fake *FakeTypedRoomClient) RequestRoomPreviewCalls(stub func(context.Context, rpc.RoomTopic, *livekit.RoomPreviewRequest, ...psrpc.RequestOption) (*livekit.RoomPreviewResponse, error)) {
+	fake.requestRoomPreviewMutex.Lock()
+	defer fake.requestRoomPreviewMutex.Unlock()
+	fake.RequestRoomPreviewStub = stub
+}
+
+func (fake *FakeTypedRoomClient) RequestRoomPreviewArgsForCall(i int) (context.Context, rpc.RoomTopic, *livekit.RoomPreviewRequest, []psrpc.RequestOption) {
+	fake.requestRoomPreviewMutex.RLock()
+	defer fake.requestRoomPreviewMutex.RUnlock()
+	argsForCall := fake.requestRoomPreviewArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTypedRoomClient) RequestRoomPreviewReturns(result1 *livekit.RoomPreviewResponse, result2 error) {
+	fake.requestRoomPreviewMutex.Lock()
+	defer fake.requestRoomPreviewMutex.Unlock()
+	fake.RequestRoomPreviewStub = nil
+	fake.requestRoomPreviewReturns = struct {
+		result1 *livekit.RoomPreviewResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTypedRoomClient) RequestRoomPreviewReturnsOnCall(i int, result1 *livekit.RoomPreviewResponse, result2 error) {
+	fake.requestRoomPreviewMutex.Lock()
+	defer fake.requestRoomPreviewMutex.Unlock()
+	fake.RequestRoomPreviewStub = nil
+	if fake.requestRoomPreviewReturnsOnCall == nil {
+		fake.requestRoomPreviewReturnsOnCall = make(map[int]struct {
+			result1 *livekit.RoomPreviewResponse
+			result2 error
+		})
+	}
+	fake.requestRoomPreviewReturnsOnCall[i] = struct {
+		result1 *livekit.RoomPreviewResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTypedRoomClient) SendData(arg1 context.Context, arg2 rpc.RoomTopic, arg3 *livekit.SendDataRequest, arg4 ...psrpc.RequestOption) (*livekit.SendDataResponse, error) {
 	fake.sendDataMutex.Lock()
 	ret, specificReturn := fake.sendDataReturnsOnCall[len(fake.sendDataArgsForCall)]
@@ -299,6 +382,8 @@ func (fake *FakeTypedRoomClient) Invocations() map[string][][]interface{} {
 	defer fake.closeMutex.RUnlock()
 	fake.deleteRoomMutex.RLock()
 	defer fake.deleteRoomMutex.RUnlock()
+	fake.requestRoomPreviewMutex.RLock()
+	defer fake.requestRoomPreviewMutex.RUnlock()
 	fake.sendDataMutex.RLock()
 	defer fake.sendDataMutex.RUnlock()
 	fake.updateRoomMetadataMutex.RLock()
@@ -143,7 +143,7 @@ func (s *signalServer[NodeIdTopicType]) Kill() {
 	s.rpc.Close(true)
 }
 
-var psrpcFileDescriptor9 = []byte{
+var psrpcFileDescriptor10 = []byte{
 	// 313 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x4f, 0x4e, 0xf3, 0x30,
 	0x10, 0xc5, 0xe5, 0x2f, 0x69, 0xbf, 0xe2, 0x52, 0xa9, 0xb8, 0xd0, 0x9a, 0x48, 0x88, 0xd0, 0x55,
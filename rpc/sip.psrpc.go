@@ -149,7 +149,7 @@ func (s *sIPInternalServer) Kill() {
 	s.rpc.Close(true)
 }
 
-var psrpcFileDescriptor10 = []byte{
+var psrpcFileDescriptor11 = []byte{
 	// 984 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x72, 0xdb, 0x36,
 	0x10, 0x1e, 0x5a, 0x89, 0x7f, 0x56, 0xd6, 0x8f, 0x21, 0xcb, 0x81, 0xe9, 0x26, 0x51, 0x9d, 0x66,
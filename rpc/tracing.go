@@ -0,0 +1,194 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/psrpc"
+	"github.com/livekit/psrpc/pkg/metadata"
+)
+
+const tracerName = "github.com/livekit/protocol/rpc"
+
+var (
+	psrpcTopicKey         = attribute.Key("rpc.psrpc.topic")
+	psrpcErrorCodeKey     = attribute.Key("rpc.psrpc.error_code")
+	psrpcResponseCountKey = attribute.Key("rpc.psrpc.response_count")
+	psrpcErrorCountKey    = attribute.Key("rpc.psrpc.error_count")
+)
+
+// WithClientTracing creates a client span per rpc and propagates its context to the server in the
+// request metadata. Defaults to the global tracer provider and the W3C trace context propagator.
+// Streams are not traced.
+func WithClientTracing(tp trace.TracerProvider, propagator propagation.TextMapPropagator) psrpc.ClientOption {
+	t, p := newTracing(tp, propagator)
+	return psrpc.WithClientOptions(
+		psrpc.WithClientRPCInterceptors(newClientRPCTracingInterceptor(t, p)),
+		psrpc.WithClientMultiRPCInterceptors(newMultiRPCTracingInterceptor(t, p)),
+	)
+}
+
+// WithServerTracing creates a server span per rpc, continuing the trace of the client when propagated.
+func WithServerTracing(tp trace.TracerProvider, propagator propagation.TextMapPropagator) psrpc.ServerOption {
+	t, p := newTracing(tp, propagator)
+	return psrpc.WithServerRPCInterceptors(newServerRPCTracingInterceptor(t, p))
+}
+
+func newTracing(tp trace.TracerProvider, propagator propagation.TextMapPropagator) (trace.Tracer, propagation.TextMapPropagator) {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	if propagator == nil {
+		propagator = propagation.TraceContext{}
+	}
+	return tp.Tracer(tracerName), propagator
+}
+
+func rpcSpanAttributes(rpcInfo psrpc.RPCInfo) (string, []attribute.KeyValue) {
+	attrs := []attribute.KeyValue{
+		semconv.RPCSystemKey.String("psrpc"),
+		semconv.RPCService(rpcInfo.Service),
+		semconv.RPCMethod(rpcInfo.Method),
+	}
+	if len(rpcInfo.Topic) != 0 {
+		attrs = append(attrs, psrpcTopicKey.StringSlice(rpcInfo.Topic))
+	}
+	return rpcInfo.Service + "/" + rpcInfo.Method, attrs
+}
+
+func injectTraceContext(ctx context.Context, propagator propagation.TextMapPropagator) context.Context {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return ctx
+	}
+	kv := make([]string, 0, 2*len(carrier))
+	for k, v := range carrier {
+		kv = append(kv, k, v)
+	}
+	return metadata.AppendMetadataToOutgoingContext(ctx, kv...)
+}
+
+func extractTraceContext(ctx context.Context, propagator propagation.TextMapPropagator) context.Context {
+	head := metadata.IncomingHeader(ctx)
+	if head == nil {
+		return ctx
+	}
+	return propagator.Extract(ctx, propagation.MapCarrier(head.Metadata))
+}
+
+func recordRPCError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	code := psrpc.Unknown
+	var psrpcErr psrpc.Error
+	if errors.As(err, &psrpcErr) {
+		code = psrpcErr.Code()
+	}
+	span.SetAttributes(psrpcErrorCodeKey.String(string(code)))
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+func newClientRPCTracingInterceptor(t trace.Tracer, p propagation.TextMapPropagator) psrpc.ClientRPCInterceptor {
+	return func(rpcInfo psrpc.RPCInfo, next psrpc.ClientRPCHandler) psrpc.ClientRPCHandler {
+		name, attrs := rpcSpanAttributes(rpcInfo)
+		return func(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) (proto.Message, error) {
+			ctx, span := t.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+			defer span.End()
+
+			res, err := next(injectTraceContext(ctx, p), req, opts...)
+			recordRPCError(span, err)
+			return res, err
+		}
+	}
+}
+
+func newServerRPCTracingInterceptor(t trace.Tracer, p propagation.TextMapPropagator) psrpc.ServerRPCInterceptor {
+	return func(ctx context.Context, req proto.Message, rpcInfo psrpc.RPCInfo, handler psrpc.ServerRPCHandler) (proto.Message, error) {
+		name, attrs := rpcSpanAttributes(rpcInfo)
+		ctx, span := t.Start(extractTraceContext(ctx, p), name, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(attrs...))
+		defer span.End()
+
+		res, err := handler(ctx, req)
+		recordRPCError(span, err)
+		return res, err
+	}
+}
+
+func newMultiRPCTracingInterceptor(t trace.Tracer, p propagation.TextMapPropagator) psrpc.ClientMultiRPCInterceptor {
+	return func(rpcInfo psrpc.RPCInfo, next psrpc.ClientMultiRPCHandler) psrpc.ClientMultiRPCHandler {
+		name, attrs := rpcSpanAttributes(rpcInfo)
+		return &multiRPCTracingInterceptor{
+			ClientMultiRPCHandler: next,
+			tracer:                t,
+			propagator:            p,
+			name:                  name,
+			attrs:                 attrs,
+		}
+	}
+}
+
+type multiRPCTracingInterceptor struct {
+	psrpc.ClientMultiRPCHandler
+	tracer        trace.Tracer
+	propagator    propagation.TextMapPropagator
+	name          string
+	attrs         []attribute.KeyValue
+	span          trace.Span
+	responseCount int
+	errorCount    int
+}
+
+func (r *multiRPCTracingInterceptor) Send(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) error {
+	ctx, r.span = r.tracer.Start(ctx, r.name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(r.attrs...))
+	err := r.ClientMultiRPCHandler.Send(injectTraceContext(ctx, r.propagator), req, opts...)
+	recordRPCError(r.span, err)
+	return err
+}
+
+func (r *multiRPCTracingInterceptor) Recv(msg proto.Message, err error) {
+	if err != nil {
+		r.errorCount++
+		if r.span != nil {
+			r.span.RecordError(err)
+		}
+	} else {
+		r.responseCount++
+	}
+	r.ClientMultiRPCHandler.Recv(msg, err)
+}
+
+func (r *multiRPCTracingInterceptor) Close() {
+	if r.span != nil {
+		r.span.SetAttributes(
+			psrpcResponseCountKey.Int(r.responseCount),
+			psrpcErrorCountKey.Int(r.errorCount),
+		)
+		r.span.End()
+	}
+	r.ClientMultiRPCHandler.Close()
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/livekit/psrpc"
+	"github.com/livekit/psrpc/pkg/metadata"
+)
+
+func TestTracingInterceptors(t *testing.T) {
+	rpcInfo := psrpc.RPCInfo{Service: "Room", Method: "DeleteRoom", Topic: []string{"room"}}
+	newClient := func(handlerErr error) (psrpc.ClientRPCHandler, *tracetest.SpanRecorder) {
+		sr := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+		tr, p := newTracing(tp, propagation.TraceContext{})
+
+		server := newServerRPCTracingInterceptor(tr, p)
+		// pass the outgoing metadata to the server like the bus does
+		return newClientRPCTracingInterceptor(tr, p)(rpcInfo, func(ctx context.Context, req proto.Message, opts ...psrpc.RequestOption) (proto.Message, error) {
+			ctx = metadata.NewContextWithIncomingHeader(context.Background(), &metadata.Header{
+				Metadata: metadata.OutgoingContextMetadata(ctx),
+			})
+			return server(ctx, req, rpcInfo, func(ctx context.Context, req proto.Message) (proto.Message, error) {
+				return &emptypb.Empty{}, handlerErr
+			})
+		}), sr
+	}
+
+	t.Run("propagates trace", func(t *testing.T) {
+		client, sr := newClient(nil)
+		_, err := client(context.Background(), &emptypb.Empty{})
+		require.NoError(t, err)
+
+		spans := sr.Ended()
+		require.Len(t, spans, 2)
+		serverSpan, clientSpan := spans[0], spans[1]
+		require.Equal(t, trace.SpanKindServer, serverSpan.SpanKind())
+		require.Equal(t, trace.SpanKindClient, clientSpan.SpanKind())
+		require.Equal(t, "Room/DeleteRoom", clientSpan.Name())
+		require.Equal(t, clientSpan.SpanContext().TraceID(), serverSpan.SpanContext().TraceID())
+		require.Equal(t, clientSpan.SpanContext().SpanID(), serverSpan.Parent().SpanID())
+		require.Contains(t, clientSpan.Attributes(), psrpcTopicKey.StringSlice([]string{"room"}))
+		require.Equal(t, codes.Unset, clientSpan.Status().Code)
+	})
+
+	t.Run("records error code", func(t *testing.T) {
+		client, sr := newClient(psrpc.NewErrorf(psrpc.NotFound, "room not found"))
+		_, err := client(context.Background(), &emptypb.Empty{})
+		require.Error(t, err)
+
+		require.Len(t, sr.Ended(), 2)
+		for _, span := range sr.Ended() {
+			require.Equal(t, codes.Error, span.Status().Code)
+			require.Contains(t, span.Attributes(), psrpcErrorCodeKey.String(string(psrpc.NotFound)))
+		}
+	})
+}
@@ -31,6 +31,8 @@ type PSRPCConfig struct {
 	Timeout     time.Duration `yaml:"timeout,omitempty"`
 	Backoff     time.Duration `yaml:"backoff,omitempty"`
 	BufferSize  int           `yaml:"buffer_size,omitempty"`
+	// per service and method overrides of the timeout and retries
+	Policies CallPolicies `yaml:"policies,omitempty"`
 }
 
 var DefaultPSRPCConfig = PSRPCConfig{
@@ -72,7 +74,16 @@ func (p *ClientParams) Options() []psrpc.ClientOption {
 	if p.Logger != nil {
 		opts = append(opts, WithClientLogger(p.Logger))
 	}
-	if p.MaxAttempts != 0 || p.Timeout != 0 || p.Backoff != 0 {
+	if !p.Policies.IsZero() {
+		// the client wide settings are the default policy, so that retries are not nested
+		policies := p.Policies
+		policies.Default = CallPolicy{
+			Timeout:     p.Timeout,
+			MaxAttempts: p.MaxAttempts,
+			Backoff:     p.Backoff,
+		}.merge(policies.Default)
+		opts = append(opts, WithCallPolicies(policies))
+	} else if p.MaxAttempts != 0 || p.Timeout != 0 || p.Backoff != 0 {
 		opts = append(opts, middleware.WithRPCRetries(middleware.RetryOptions{
 			MaxAttempts: p.MaxAttempts,
 			Timeout:     p.Timeout,
@@ -0,0 +1,98 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/psrpc"
+)
+
+type testWebhookDispatcher struct {
+	name     string
+	err      error
+	received chan string
+}
+
+func (d *testWebhookDispatcher) DispatchEvent(_ context.Context, req *DispatchWebhookEventRequest) (*emptypb.Empty, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	d.received <- d.name + "/" + req.Event.Id
+	return &emptypb.Empty{}, nil
+}
+
+func TestWebhookDispatch(t *testing.T) {
+	require.Equal(t, WebhookEndpointID("https://example.com/hook"), WebhookEndpointID("https://example.com/hook"))
+	require.NotEqual(t, WebhookEndpointID("https://example.com/hook"), WebhookEndpointID("https://example.com/other"))
+	require.Len(t, WebhookEndpointID("https://example.com/hook"), 16)
+
+	bus := psrpc.NewLocalMessageBus()
+	received := make(chan string, 10)
+	newDispatcher := func(d *testWebhookDispatcher, projectID, url string) {
+		srv, err := NewWebhookDispatchServer(d, bus)
+		require.NoError(t, err)
+		t.Cleanup(srv.Shutdown)
+		require.NoError(t, srv.RegisterDispatchEventTopic(projectID, WebhookEndpointID(url)))
+	}
+	newDispatcher(&testWebhookDispatcher{name: "a", received: received}, "p1", "https://a.example.com")
+	newDispatcher(&testWebhookDispatcher{name: "b", received: received}, "p1", "https://b.example.com")
+	newDispatcher(&testWebhookDispatcher{name: "c", received: received}, "p2", "https://a.example.com")
+	newDispatcher(&testWebhookDispatcher{err: psrpc.NewErrorf(psrpc.ResourceExhausted, "queue is full")}, "p3", "https://a.example.com")
+
+	client, err := NewWebhookDispatchClient(bus)
+	require.NoError(t, err)
+	defer client.Close()
+
+	dispatch := func(projectID, url, id string) error {
+		_, err := client.DispatchEvent(context.Background(), projectID, WebhookEndpointID(url), &DispatchWebhookEventRequest{
+			ProjectId:  projectID,
+			EndpointId: WebhookEndpointID(url),
+			Url:        url,
+			Event:      &livekit.WebhookEvent{Event: "room_started", Id: id},
+		}, psrpc.WithRequestTimeout(100*time.Millisecond))
+		return err
+	}
+
+	t.Run("routing", func(t *testing.T) {
+		// events go to the dispatcher of their project and endpoint only
+		require.NoError(t, dispatch("p1", "https://a.example.com", "EV_1"))
+		require.Equal(t, "a/EV_1", <-received)
+		require.NoError(t, dispatch("p1", "https://b.example.com", "EV_2"))
+		require.Equal(t, "b/EV_2", <-received)
+		require.NoError(t, dispatch("p2", "https://a.example.com", "EV_3"))
+		require.Equal(t, "c/EV_3", <-received)
+		require.Empty(t, received)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		var psrpcErr psrpc.Error
+		err := dispatch("p3", "https://a.example.com", "EV_4")
+		require.ErrorAs(t, err, &psrpcErr)
+		require.Equal(t, psrpc.ResourceExhausted, psrpcErr.Code())
+
+		// no dispatcher is subscribed to the endpoint
+		err = dispatch("p2", "https://b.example.com", "EV_5")
+		require.ErrorAs(t, err, &psrpcErr)
+		require.Equal(t, psrpc.Unavailable, psrpcErr.Code())
+		require.Empty(t, received)
+	})
+}
@@ -0,0 +1,159 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deprecation tracks the use of deprecated protocol features, so operators can follow
+// the migration away from them before they are removed.
+package deprecation
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+)
+
+type Usage string
+
+const (
+	UsageRead  Usage = "read"
+	UsageWrite Usage = "write"
+)
+
+// Feature describes a deprecated feature, e.g. a field.
+type Feature struct {
+	// full name of the field or message, e.g. "livekit.WebhookEvent.num_dropped"
+	Name    string
+	Message string
+	// the feature may stop working after Sunset, zero when not scheduled
+	Sunset time.Time
+	// minimum client version without the deprecated behavior
+	MinVersion string
+}
+
+// Notice returns the notice sent to clients using the feature.
+func (f Feature) Notice() *livekit.DeprecationNotice {
+	n := &livekit.DeprecationNotice{
+		Feature:    f.Name,
+		Message:    f.Message,
+		MinVersion: f.MinVersion,
+	}
+	if !f.Sunset.IsZero() {
+		n.SunsetAt = f.Sunset.UnixMilli()
+	}
+	return n
+}
+
+var WebhookNumDropped = Feature{
+	Name:    "livekit.WebhookEvent.num_dropped",
+	Message: "use the webhook_info metrics to track dropped events",
+}
+
+type FeatureStats struct {
+	Feature string
+	Reads   uint64
+	Writes  uint64
+}
+
+type usageKey struct {
+	feature string
+	usage   Usage
+}
+
+// Tracker counts the uses of deprecated features and logs the first use of each.
+// It implements prometheus.Collector and must be registered to be exported.
+type Tracker struct {
+	mu     sync.Mutex
+	counts map[usageKey]uint64
+
+	usageTotal *prometheus.CounterVec
+}
+
+var _ prometheus.Collector = (*Tracker)(nil)
+
+// Default tracks the uses reported through Read and Write.
+var Default = NewTracker(nil)
+
+func NewTracker(constLabels prometheus.Labels) *Tracker {
+	return &Tracker{
+		counts: make(map[usageKey]uint64),
+		usageTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   "livekit",
+			Subsystem:   "deprecation",
+			Name:        "usage_total",
+			ConstLabels: constLabels,
+		}, []string{"feature", "usage"}),
+	}
+}
+
+// Read records a read of the deprecated feature with the default tracker.
+func Read(feature Feature) {
+	Default.Observe(feature, UsageRead)
+}
+
+// Write records a write of the deprecated feature with the default tracker.
+func Write(feature Feature) {
+	Default.Observe(feature, UsageWrite)
+}
+
+func (t *Tracker) Observe(feature Feature, usage Usage) {
+	key := usageKey{feature.Name, usage}
+	t.mu.Lock()
+	t.counts[key]++
+	first := t.counts[key] == 1
+	t.mu.Unlock()
+
+	t.usageTotal.WithLabelValues(feature.Name, string(usage)).Inc()
+	if first {
+		logger.Infow("deprecated feature in use", "feature", feature.Name, "usage", usage, "sunset", feature.Sunset)
+	}
+}
+
+// Stats returns the number of uses of each feature, sorted by feature name.
+func (t *Tracker) Stats() []FeatureStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	byFeature := make(map[string]*FeatureStats)
+	for key, count := range t.counts {
+		s := byFeature[key.feature]
+		if s == nil {
+			s = &FeatureStats{Feature: key.feature}
+			byFeature[key.feature] = s
+		}
+		switch key.usage {
+		case UsageRead:
+			s.Reads += count
+		case UsageWrite:
+			s.Writes += count
+		}
+	}
+	stats := make([]FeatureStats, 0, len(byFeature))
+	for _, s := range byFeature {
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Feature < stats[j].Feature })
+	return stats
+}
+
+func (t *Tracker) Describe(ch chan<- *prometheus.Desc) {
+	t.usageTotal.Describe(ch)
+}
+
+func (t *Tracker) Collect(ch chan<- prometheus.Metric) {
+	t.usageTotal.Collect(ch)
+}
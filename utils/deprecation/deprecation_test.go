@@ -0,0 +1,57 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deprecation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker(t *testing.T) {
+	tr := NewTracker(nil)
+	other := Feature{Name: "livekit.Other.field"}
+
+	tr.Observe(WebhookNumDropped, UsageWrite)
+	tr.Observe(WebhookNumDropped, UsageWrite)
+	tr.Observe(WebhookNumDropped, UsageRead)
+	tr.Observe(other, UsageRead)
+
+	require.Equal(t, []FeatureStats{
+		{Feature: "livekit.Other.field", Reads: 1},
+		{Feature: WebhookNumDropped.Name, Reads: 1, Writes: 2},
+	}, tr.Stats())
+	require.Equal(t, 2.0, testutil.ToFloat64(tr.usageTotal.WithLabelValues(WebhookNumDropped.Name, "write")))
+	require.NoError(t, prometheus.NewRegistry().Register(tr))
+}
+
+func TestFeatureNotice(t *testing.T) {
+	sunset := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := Feature{Name: "livekit.Foo.bar", Message: "use baz", Sunset: sunset, MinVersion: "2.0.0"}
+	n := f.Notice()
+	require.Equal(t, "livekit.Foo.bar", n.Feature)
+	require.Equal(t, "2.0.0", n.MinVersion)
+	require.True(t, n.SunsetTime().Equal(sunset))
+	require.False(t, n.IsSunset(sunset.Add(-time.Second)))
+	require.True(t, n.IsSunset(sunset))
+
+	n = Feature{Name: "livekit.Foo.bar"}.Notice()
+	require.Zero(t, n.SunsetAt)
+	require.True(t, n.SunsetTime().IsZero())
+	require.False(t, n.IsSunset(time.Now()))
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"sync"
+
+	"github.com/livekit/protocol/utils/options"
+)
+
+// Topic names an event stream on a Bus and fixes the type of its events.
+type Topic[V any] struct {
+	name string
+}
+
+func NewTopic[V any](name string) Topic[V] {
+	return Topic[V]{name}
+}
+
+func (t Topic[V]) String() string {
+	return t.name
+}
+
+// Bus routes events to subscribers by topic. Unlike Emitter, topics with different
+// event types can share a bus, topics with the same name and different types are distinct.
+type Bus struct {
+	options Options
+	mu      sync.Mutex
+	topics  map[any]topicObserverList
+}
+
+type topicObserverList interface {
+	Len() int
+}
+
+func NewBus(opts ...Option) *Bus {
+	o := DefaultOptions()
+	options.Apply(&o, opts)
+	return &Bus{
+		options: o,
+		topics:  map[any]topicObserverList{},
+	}
+}
+
+func Publish[V any](b *Bus, t Topic[V], v V) {
+	b.mu.Lock()
+	l, ok := b.topics[t]
+	b.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	l.(*ObserverList[V]).Emit(v)
+}
+
+// Subscribe returns an observer for the events published to t. opts override the bus
+// options for this subscriber, e.g. to set its queue size or slow consumer policy.
+func Subscribe[V any](b *Bus, t Topic[V], opts ...Option) Observer[V] {
+	o := b.options
+	options.Apply(&o, opts)
+
+	b.mu.Lock()
+	lo := getOrCreateTopic(b, t).observeWithOptions(make(chan V, o.QueueSize), o)
+	b.mu.Unlock()
+
+	return &busObserver[V]{b, t, lo}
+}
+
+// SubscribeFunc calls f with the events published to t and returns a func to unsubscribe.
+func SubscribeFunc[V any](b *Bus, t Topic[V], f func(V)) func() {
+	b.mu.Lock()
+	lo := getOrCreateTopic(b, t).on(f)
+	b.mu.Unlock()
+
+	return (&busObserver[V]{b, t, lo}).Stop
+}
+
+func getOrCreateTopic[V any](b *Bus, t Topic[V]) *ObserverList[V] {
+	l, ok := b.topics[t]
+	if !ok {
+		l = newObserverList[V](b.options)
+		b.topics[t] = l
+	}
+	return l.(*ObserverList[V])
+}
+
+// Topics returns the names of the topics with subscribers.
+func (b *Bus) Topics() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	names := make([]string, 0, len(b.topics))
+	for t := range b.topics {
+		names = append(names, t.(interface{ String() string }).String())
+	}
+	return names
+}
+
+func (b *Bus) cleanUpTopic(t any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	l, ok := b.topics[t]
+	if ok && l.Len() == 0 {
+		delete(b.topics, t)
+	}
+}
+
+type busObserver[V any] struct {
+	b *Bus
+	t Topic[V]
+	Observer[V]
+}
+
+func (o *busObserver[V]) Stop() {
+	o.Observer.Stop()
+	o.b.cleanUpTopic(o.t)
+}
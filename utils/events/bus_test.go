@@ -0,0 +1,138 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBus(t *testing.T) {
+	t.Run("typed topics", func(t *testing.T) {
+		b := NewBus()
+		ints := NewTopic[int]("a")
+		strs := NewTopic[string]("a")
+
+		io := Subscribe(b, ints)
+		so := Subscribe(b, strs)
+
+		Publish(b, ints, 1)
+		Publish(b, strs, "x")
+		require.Equal(t, 1, <-io.Events())
+		require.Equal(t, "x", <-so.Events())
+		require.Equal(t, []string{"a", "a"}, b.Topics())
+
+		io.Stop()
+		so.Stop()
+		require.Empty(t, b.Topics())
+
+		// publishing without subscribers is a no-op
+		Publish(b, ints, 2)
+	})
+
+	t.Run("subscribe func", func(t *testing.T) {
+		b := NewBus(WithBlocking())
+		topic := NewTopic[int]("a")
+
+		var got []int
+		stop := SubscribeFunc(b, topic, func(v int) { got = append(got, v) })
+		Publish(b, topic, 1)
+		Publish(b, topic, 2)
+		stop()
+		Publish(b, topic, 3)
+		require.Equal(t, []int{1, 2}, got)
+	})
+
+	t.Run("drop newest", func(t *testing.T) {
+		b := NewBus()
+		topic := NewTopic[int]("a")
+		o := Subscribe(b, topic, WithQueueSize(2))
+		defer o.Stop()
+
+		for i := range 4 {
+			Publish(b, topic, i)
+		}
+		require.Equal(t, 0, <-o.Events())
+		require.Equal(t, 1, <-o.Events())
+		requireEmpty(t, o.Events())
+	})
+
+	t.Run("drop oldest", func(t *testing.T) {
+		b := NewBus()
+		topic := NewTopic[int]("a")
+		o := Subscribe(b, topic, WithQueueSize(2), WithSlowConsumerPolicy(DropOldest))
+		defer o.Stop()
+
+		for i := range 4 {
+			Publish(b, topic, i)
+		}
+		require.Equal(t, 2, <-o.Events())
+		require.Equal(t, 3, <-o.Events())
+		requireEmpty(t, o.Events())
+	})
+
+	t.Run("disconnect", func(t *testing.T) {
+		b := NewBus()
+		topic := NewTopic[int]("a")
+		slow := Subscribe(b, topic, WithQueueSize(1), WithSlowConsumerPolicy(Disconnect))
+		fast := Subscribe(b, topic, WithQueueSize(4))
+		defer slow.Stop()
+		defer fast.Stop()
+
+		for i := range 3 {
+			Publish(b, topic, i)
+		}
+		require.Equal(t, 0, <-slow.Events())
+		_, ok := <-slow.Events()
+		require.False(t, ok)
+
+		for i := range 3 {
+			require.Equal(t, i, <-fast.Events())
+		}
+	})
+
+	t.Run("block", func(t *testing.T) {
+		b := NewBus()
+		topic := NewTopic[int]("a")
+		o := Subscribe(b, topic, WithQueueSize(1), WithSlowConsumerPolicy(Block))
+
+		done := make(chan struct{})
+		go func() {
+			Publish(b, topic, 0)
+			Publish(b, topic, 1)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			require.Fail(t, "expected publish to block")
+		case <-time.After(50 * time.Millisecond):
+		}
+		require.Equal(t, 0, <-o.Events())
+		<-done
+		require.Equal(t, 1, <-o.Events())
+		o.Stop()
+	})
+}
+
+func requireEmpty[V any](t *testing.T, ch <-chan V) {
+	select {
+	case <-ch:
+		require.Fail(t, "expected no event")
+	default:
+	}
+}
@@ -15,6 +15,7 @@
 package events
 
 import (
+	"fmt"
 	"sync"
 
 	"golang.org/x/exp/maps"
@@ -25,10 +26,41 @@ import (
 
 const DefaultQueueSize = 16
 
+// SlowConsumerPolicy controls what happens to events for an observer whose queue is full.
+type SlowConsumerPolicy int
+
+const (
+	// DropNewest discards the event being emitted.
+	DropNewest SlowConsumerPolicy = iota
+	// DropOldest discards the oldest queued event to make room.
+	DropOldest
+	// Block waits for the observer to make room, stalling the emitter.
+	Block
+	// Disconnect closes the observer channel, the observer receives no further events.
+	Disconnect
+)
+
+func (p SlowConsumerPolicy) String() string {
+	switch p {
+	case DropNewest:
+		return "DropNewest"
+	case DropOldest:
+		return "DropOldest"
+	case Block:
+		return "Block"
+	case Disconnect:
+		return "Disconnect"
+	default:
+		return fmt.Sprintf("SlowConsumerPolicy(%d)", int(p))
+	}
+}
+
 type Options struct {
 	QueueSize int
 	Blocking  bool
-	Logger    logger.Logger
+	// Policy applies to channel observers, Blocking takes precedence.
+	Policy SlowConsumerPolicy
+	Logger logger.Logger
 }
 
 type Option func(o *Options)
@@ -45,6 +77,12 @@ func WithBlocking() Option {
 	}
 }
 
+func WithSlowConsumerPolicy(p SlowConsumerPolicy) Option {
+	return func(o *Options) {
+		o.Policy = p
+	}
+}
+
 func WithLogger(l logger.Logger) Option {
 	return func(o *Options) {
 		o.Logger = l
@@ -211,16 +249,31 @@ func (l *ObserverList[V]) Observe() Observer[V] {
 }
 
 func (l *ObserverList[V]) observe(ch chan V) *eventObserverListObserver[V] {
+	return l.observeWithOptions(ch, l.options)
+}
+
+func (l *ObserverList[V]) observeWithOptions(ch chan V, opts Options) *eventObserverListObserver[V] {
 	o := &eventObserverListObserver[V]{l: l}
 
-	if l.options.Blocking {
+	switch {
+	case opts.Blocking || opts.Policy == Block:
 		o.Observer = &blockingObserver[V]{
 			done: make(chan struct{}),
 			ch:   ch,
 		}
-	} else {
+	case opts.Policy == DropOldest:
+		o.Observer = &dropOldestObserver[V]{
+			logger: opts.Logger,
+			ch:     ch,
+		}
+	case opts.Policy == Disconnect:
+		o.Observer = &disconnectingObserver[V]{
+			logger: opts.Logger,
+			ch:     ch,
+		}
+	default:
 		o.Observer = &nonblockingObserver[V]{
-			logger: l.options.Logger,
+			logger: opts.Logger,
 			ch:     ch,
 		}
 	}
@@ -296,6 +349,66 @@ func (o *nonblockingObserver[V]) Events() <-chan V {
 	return o.ch
 }
 
+type dropOldestObserver[V any] struct {
+	logger logger.Logger
+	ch     chan V
+}
+
+func (o *dropOldestObserver[V]) emit(v V) {
+	for {
+		select {
+		case o.ch <- v:
+			return
+		default:
+		}
+
+		select {
+		case <-o.ch:
+			o.logger.Warnw("dropped oldest event from observer queue", nil)
+		default:
+		}
+	}
+}
+
+func (o *dropOldestObserver[V]) Stop() {}
+
+func (o *dropOldestObserver[V]) Events() <-chan V {
+	return o.ch
+}
+
+type disconnectingObserver[V any] struct {
+	logger logger.Logger
+	mu     sync.Mutex
+	closed bool
+	ch     chan V
+}
+
+func (o *disconnectingObserver[V]) emit(v V) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return
+	}
+
+	select {
+	case o.ch <- v:
+	default:
+		o.closed = true
+		close(o.ch)
+		o.logger.Warnw("disconnected slow observer", nil)
+	}
+}
+
+func (o *disconnectingObserver[V]) Stop() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.closed = true
+}
+
+func (o *disconnectingObserver[V]) Events() <-chan V {
+	return o.ch
+}
+
 type blockingObserver[V any] struct {
 	done chan struct{}
 	ch   chan V
@@ -0,0 +1,176 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guid
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+	mrand "math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+)
+
+const (
+	// DefaultAlphabet is the alphabet of the ids of New, excluding lookalike characters
+	DefaultAlphabet = shortuuid.DefaultAlphabet
+	// CrockfordAlphabet is the base32 alphabet of ULIDs
+	CrockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+	// sortable ids encode the time in milliseconds on 48 bits, like ULIDs, valid until year 10889
+	timeBits = 48
+)
+
+var (
+	ErrInvalidAlphabet = errors.New("invalid id alphabet")
+	ErrInvalidSize     = errors.New("invalid id size")
+	ErrInvalidID       = errors.New("invalid id")
+)
+
+// ULID generates ids in the ULID format, with a 48 bit time followed by 80 random bits.
+var ULID = GeneratorOptions{
+	Size:     16,
+	Alphabet: CrockfordAlphabet,
+	Sortable: true,
+}
+
+// GeneratorOptions configures the ids of a Generator.
+//
+// The random part of ids holds Size*log2(len(Alphabet)) bits of entropy, and the chance of a collision
+// between n ids is about n²/2^(bits+1). The 12 characters of the default alphabet hold 70 bits,
+// so a collision is expected once about 2^35 (34 billion) ids share a prefix. Sortable ids only
+// collide when created in the same millisecond, making collisions even less likely.
+type GeneratorOptions struct {
+	// length of the random part of ids, defaults to Size
+	Size int
+	// characters of ids, defaults to DefaultAlphabet. it must be in ascending order for sortable ids
+	Alphabet string
+	// true to start ids with their creation time, so they sort by creation time to the millisecond
+	Sortable bool
+}
+
+// Generator creates ids with custom lengths and alphabets, following the prefix conventions of New.
+type Generator struct {
+	size      int
+	alphabet  string
+	sortable  bool
+	timeChars int
+	rngs      sync.Pool
+}
+
+func NewGenerator(opts GeneratorOptions) (*Generator, error) {
+	if opts.Size == 0 {
+		opts.Size = Size
+	}
+	if opts.Size < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidSize, opts.Size)
+	}
+	if opts.Alphabet == "" {
+		opts.Alphabet = DefaultAlphabet
+	}
+	if err := validateAlphabet(opts.Alphabet, opts.Sortable); err != nil {
+		return nil, err
+	}
+
+	g := &Generator{
+		size:     opts.Size,
+		alphabet: opts.Alphabet,
+		sortable: opts.Sortable,
+	}
+	if opts.Sortable {
+		g.timeChars = int(math.Ceil(timeBits / math.Log2(float64(len(opts.Alphabet)))))
+	}
+	g.rngs.New = func() any {
+		var seed [32]byte
+		_, _ = rand.Read(seed[:])
+		return mrand.New(mrand.NewChaCha8(seed))
+	}
+	return g, nil
+}
+
+func validateAlphabet(alphabet string, sorted bool) error {
+	if len(alphabet) < 2 {
+		return fmt.Errorf("%w: fewer than 2 characters", ErrInvalidAlphabet)
+	}
+	var seen [256]bool
+	for i := 0; i < len(alphabet); i++ {
+		c := alphabet[i]
+		if c >= 0x80 {
+			return fmt.Errorf("%w: non ascii character", ErrInvalidAlphabet)
+		}
+		if seen[c] {
+			return fmt.Errorf("%w: duplicate character %q", ErrInvalidAlphabet, c)
+		}
+		seen[c] = true
+		if sorted && i > 0 && alphabet[i-1] > c {
+			return fmt.Errorf("%w: sortable ids require characters in ascending order", ErrInvalidAlphabet)
+		}
+	}
+	return nil
+}
+
+// New returns an id starting with the prefix.
+func (g *Generator) New(prefix string) string {
+	return g.NewAt(prefix, time.Now())
+}
+
+// NewAt returns an id starting with the prefix, created at t for sortable ids.
+func (g *Generator) NewAt(prefix string, t time.Time) string {
+	n := uint64(len(g.alphabet))
+
+	b := make([]byte, len(prefix)+g.timeChars+g.size)
+	copy(b, prefix)
+	if g.sortable {
+		ms := uint64(t.UnixMilli())
+		for i := len(prefix) + g.timeChars - 1; i >= len(prefix); i-- {
+			b[i] = g.alphabet[ms%n]
+			ms /= n
+		}
+	}
+
+	rng := g.rngs.Get().(*mrand.Rand)
+	for i := len(prefix) + g.timeChars; i < len(b); i++ {
+		b[i] = g.alphabet[rng.Uint64N(n)]
+	}
+	g.rngs.Put(rng)
+	return string(b)
+}
+
+// Time returns the creation time of a sortable id.
+func (g *Generator) Time(id string) (time.Time, error) {
+	if !g.sortable {
+		return time.Time{}, fmt.Errorf("%w: not sortable", ErrInvalidID)
+	}
+	end := len(id) - g.size
+	start := end - g.timeChars
+	if start < 0 {
+		return time.Time{}, fmt.Errorf("%w: too short", ErrInvalidID)
+	}
+
+	n := uint64(len(g.alphabet))
+	var ms uint64
+	for i := start; i < end; i++ {
+		v := strings.IndexByte(g.alphabet, id[i])
+		if v < 0 {
+			return time.Time{}, fmt.Errorf("%w: invalid character %q", ErrInvalidID, id[i])
+		}
+		ms = ms*n + uint64(v)
+	}
+	return time.UnixMilli(int64(ms)), nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guid
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator(t *testing.T) {
+	t.Run("custom", func(t *testing.T) {
+		g, err := NewGenerator(GeneratorOptions{Size: 20, Alphabet: "0123456789abcdef"})
+		require.NoError(t, err)
+
+		id := g.New(RoomPrefix)
+		require.Len(t, id, len(RoomPrefix)+20)
+		require.True(t, strings.HasPrefix(id, RoomPrefix))
+		require.Empty(t, strings.Trim(id[len(RoomPrefix):], "0123456789abcdef"))
+		require.NotEqual(t, id, g.New(RoomPrefix))
+
+		_, err = g.Time(id)
+		require.ErrorIs(t, err, ErrInvalidID)
+	})
+
+	t.Run("ulid", func(t *testing.T) {
+		g, err := NewGenerator(ULID)
+		require.NoError(t, err)
+
+		now := time.UnixMilli(time.Now().UnixMilli())
+		var ids []string
+		for i := range 10 {
+			ids = append(ids, g.NewAt(EgressPrefix, now.Add(time.Duration(i)*time.Millisecond)))
+		}
+		require.Len(t, ids[0], len(EgressPrefix)+26)
+		require.True(t, sort.StringsAreSorted(ids))
+
+		created, err := g.Time(ids[3])
+		require.NoError(t, err)
+		require.True(t, now.Add(3*time.Millisecond).Equal(created))
+	})
+
+	t.Run("sortable default alphabet", func(t *testing.T) {
+		g, err := NewGenerator(GeneratorOptions{Sortable: true})
+		require.NoError(t, err)
+
+		a := g.NewAt(TrackPrefix, time.UnixMilli(1000))
+		b := g.NewAt(TrackPrefix, time.UnixMilli(1001))
+		require.Less(t, a, b)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := NewGenerator(GeneratorOptions{Alphabet: "a"})
+		require.ErrorIs(t, err, ErrInvalidAlphabet)
+		_, err = NewGenerator(GeneratorOptions{Alphabet: "abca"})
+		require.ErrorIs(t, err, ErrInvalidAlphabet)
+		_, err = NewGenerator(GeneratorOptions{Alphabet: "ba", Sortable: true})
+		require.ErrorIs(t, err, ErrInvalidAlphabet)
+		_, err = NewGenerator(GeneratorOptions{Size: -1})
+		require.ErrorIs(t, err, ErrInvalidSize)
+	})
+}
@@ -36,6 +36,7 @@ const (
 	RoomPrefix               = "RM_"
 	NodePrefix               = "ND_"
 	ParticipantPrefix        = "PA_"
+	ParticipantSessionPrefix = "PS_"
 	TrackPrefix              = "TR_"
 	APIKeyPrefix             = "API"
 	EgressPrefix             = "EG_"
@@ -54,6 +55,8 @@ const (
 	HostedAgentRegionPrefix  = "HAR_"
 	HostedAgentVersionPrefix = "HAV_"
 	HostedAgentSecretPrefix  = "HAS_"
+	WebhookEventPrefix       = "EV_"
+	AccessTokenPrefix        = "AT_"
 )
 
 var guidGeneratorPool = sync.Pool{
@@ -0,0 +1,113 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwstats
+
+import (
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/mackerelio/go-osstat/memory"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+// HostStats is the load of the host, see HostStatsCollector.
+type HostStats struct {
+	// share of the cpus available to the process in use, from 0 to 1
+	CPULoad float64
+	NumCPU  float64
+	// in bytes, zero when not supported by the platform
+	MemoryTotal uint64
+	MemoryUsed  uint64
+	Goroutines  int
+	SampledAt   time.Time
+}
+
+func (s *HostStats) ToProto() *livekit.HostStats {
+	return &livekit.HostStats{
+		CpuLoad:     float32(s.CPULoad),
+		NumCpus:     float32(s.NumCPU),
+		MemoryTotal: s.MemoryTotal,
+		MemoryUsed:  s.MemoryUsed,
+		Goroutines:  uint32(s.Goroutines),
+		SampledAt:   s.SampledAt.UnixMilli(),
+	}
+}
+
+// HostStatsCollector samples the cpu, memory and goroutines of the host every second,
+// for agents and workers to report their load uniformly.
+type HostStatsCollector struct {
+	cpu      *CPUStats
+	onUpdate func(*HostStats)
+
+	mu    sync.Mutex
+	stats HostStats
+}
+
+// NewHostStatsCollector starts sampling, calling onUpdate with each sample when set.
+func NewHostStatsCollector(onUpdate func(*HostStats)) (*HostStatsCollector, error) {
+	c := &HostStatsCollector{
+		onUpdate: onUpdate,
+	}
+	cpu, err := NewCPUStats(c.sample)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.cpu = cpu
+	c.stats = c.read(cpu.NumCPU())
+	c.mu.Unlock()
+	return c, nil
+}
+
+// Stats returns the last sample.
+func (c *HostStatsCollector) Stats() HostStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+func (c *HostStatsCollector) Stop() {
+	c.cpu.Stop()
+}
+
+func (c *HostStatsCollector) sample(idle float64) {
+	c.mu.Lock()
+	numCPU := c.cpu.NumCPU()
+	stats := c.read(numCPU)
+	if numCPU > 0 {
+		stats.CPULoad = min(max(1-idle/numCPU, 0), 1)
+	}
+	c.stats = stats
+	c.mu.Unlock()
+
+	if c.onUpdate != nil {
+		c.onUpdate(&stats)
+	}
+}
+
+func (c *HostStatsCollector) read(numCPU float64) HostStats {
+	stats := HostStats{
+		NumCPU:     numCPU,
+		Goroutines: runtime.NumGoroutine(),
+		SampledAt:  time.Now(),
+	}
+	if m, err := memory.Get(); err == nil {
+		stats.MemoryTotal = m.Total
+		stats.MemoryUsed = m.Used
+	}
+	return stats
+}
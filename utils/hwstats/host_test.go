@@ -0,0 +1,48 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwstats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostStatsCollector(t *testing.T) {
+	updates := make(chan HostStats, 10)
+	c, err := NewHostStatsCollector(func(s *HostStats) {
+		updates <- *s
+	})
+	require.NoError(t, err)
+	defer c.Stop()
+
+	stats := c.Stats()
+	require.Positive(t, stats.NumCPU)
+	require.Positive(t, stats.Goroutines)
+
+	select {
+	case stats = <-updates:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no update")
+	}
+	require.GreaterOrEqual(t, stats.CPULoad, 0.0)
+	require.LessOrEqual(t, stats.CPULoad, 1.0)
+
+	p := stats.ToProto()
+	require.Equal(t, uint32(stats.Goroutines), p.Goroutines)
+	require.Equal(t, stats.MemoryUsed, p.MemoryUsed)
+	require.Equal(t, stats.SampledAt.UnixMilli(), p.SampledAt)
+}
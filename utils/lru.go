@@ -0,0 +1,200 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type LRUCacheParams[K comparable, V any] struct {
+	// maximum number of entries, unlimited when zero
+	MaxSize int
+	// lifetime of entries set without one, unlimited when zero
+	TTL time.Duration
+	// called with the entries evicted to make room or expired, outside of the cache lock
+	OnEvict func(key K, value V)
+	// defaults to the system clock
+	Clock Clock
+}
+
+type lruEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+// LRUCache is a cache of up to MaxSize entries evicting the least recently used ones, with per-entry TTLs.
+// Expired entries are removed when accessed, or by Prune.
+type LRUCache[K comparable, V any] struct {
+	params LRUCacheParams[K, V]
+
+	mu      sync.Mutex
+	entries map[K]*list.Element
+	order   *list.List
+}
+
+func NewLRUCache[K comparable, V any](params LRUCacheParams[K, V]) *LRUCache[K, V] {
+	if params.Clock == nil {
+		params.Clock = SystemClock{}
+	}
+	return &LRUCache[K, V]{
+		params:  params,
+		entries: make(map[K]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Get returns the value of the key, and marks it as recently used.
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	now := c.params.Clock.Now()
+
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if !ok {
+		c.mu.Unlock()
+		var zero V
+		return zero, false
+	}
+	entry := e.Value.(*lruEntry[K, V])
+	if entry.expired(now) {
+		c.remove(e)
+		c.mu.Unlock()
+		c.evicted(entry)
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(e)
+	// SetWithTTL replaces the value of existing entries
+	value := entry.value
+	c.mu.Unlock()
+	return value, true
+}
+
+// Peek returns the value of the key, without marking it as recently used.
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+	now := c.params.Clock.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		if entry := e.Value.(*lruEntry[K, V]); !entry.expired(now) {
+			return entry.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Set adds or replaces the value of the key, with the default TTL.
+func (c *LRUCache[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.params.TTL)
+}
+
+// SetWithTTL adds or replaces the value of the key, expiring after ttl, or never when ttl is zero.
+func (c *LRUCache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	var expires time.Time
+	if ttl > 0 {
+		expires = c.params.Clock.Now().Add(ttl)
+	}
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		entry := e.Value.(*lruEntry[K, V])
+		entry.value = value
+		entry.expires = expires
+		c.order.MoveToFront(e)
+		c.mu.Unlock()
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry[K, V]{key, value, expires})
+
+	var evicted []*lruEntry[K, V]
+	for c.params.MaxSize > 0 && c.order.Len() > c.params.MaxSize {
+		e := c.order.Back()
+		c.remove(e)
+		evicted = append(evicted, e.Value.(*lruEntry[K, V]))
+	}
+	c.mu.Unlock()
+
+	for _, entry := range evicted {
+		c.evicted(entry)
+	}
+}
+
+// Delete removes the key, returning true if it was cached. OnEvict is not called for deleted entries.
+func (c *LRUCache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if ok {
+		c.remove(e)
+	}
+	return ok
+}
+
+// Prune removes the expired entries, returning their number.
+func (c *LRUCache[K, V]) Prune() int {
+	now := c.params.Clock.Now()
+
+	c.mu.Lock()
+	var expired []*lruEntry[K, V]
+	for e := c.order.Front(); e != nil; {
+		next := e.Next()
+		if entry := e.Value.(*lruEntry[K, V]); entry.expired(now) {
+			c.remove(e)
+			expired = append(expired, entry)
+		}
+		e = next
+	}
+	c.mu.Unlock()
+
+	for _, entry := range expired {
+		c.evicted(entry)
+	}
+	return len(expired)
+}
+
+// Purge removes all entries. OnEvict is not called for purged entries.
+func (c *LRUCache[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[K]*list.Element)
+	c.order.Init()
+}
+
+// Len returns the number of entries, including expired entries not removed yet.
+func (c *LRUCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *LRUCache[K, V]) remove(e *list.Element) {
+	c.order.Remove(e)
+	delete(c.entries, e.Value.(*lruEntry[K, V]).key)
+}
+
+func (c *LRUCache[K, V]) evicted(entry *lruEntry[K, V]) {
+	if c.params.OnEvict != nil {
+		c.params.OnEvict(entry.key, entry.value)
+	}
+}
+
+func (e *lruEntry[K, V]) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCache(t *testing.T) {
+	t.Run("size", func(t *testing.T) {
+		var evicted []string
+		c := NewLRUCache(LRUCacheParams[string, int]{
+			MaxSize: 2,
+			OnEvict: func(key string, value int) {
+				evicted = append(evicted, key)
+			},
+		})
+		c.Set("a", 1)
+		c.Set("b", 2)
+		_, ok := c.Get("a")
+		require.True(t, ok)
+
+		c.Set("c", 3)
+		require.Equal(t, []string{"b"}, evicted)
+		require.Equal(t, 2, c.Len())
+
+		// peeking does not mark entries as used
+		_, ok = c.Peek("a")
+		require.True(t, ok)
+		c.Set("d", 4)
+		require.Equal(t, []string{"b", "a"}, evicted)
+
+		require.True(t, c.Delete("c"))
+		require.False(t, c.Delete("c"))
+		require.Equal(t, []string{"b", "a"}, evicted)
+	})
+
+	t.Run("ttl", func(t *testing.T) {
+		clock := &SimulatedClock{}
+		var evicted []string
+		c := NewLRUCache(LRUCacheParams[string, int]{
+			TTL:   time.Minute,
+			Clock: clock,
+			OnEvict: func(key string, value int) {
+				evicted = append(evicted, key)
+			},
+		})
+		c.Set("a", 1)
+		c.SetWithTTL("b", 2, time.Hour)
+		c.SetWithTTL("c", 3, 2*time.Minute)
+
+		clock.Add(time.Minute)
+		_, ok := c.Get("a")
+		require.False(t, ok)
+		v, ok := c.Get("b")
+		require.True(t, ok)
+		require.Equal(t, 2, v)
+
+		clock.Add(time.Minute)
+		require.Equal(t, 2, c.Len())
+		require.Equal(t, 1, c.Prune())
+		require.Equal(t, []string{"a", "c"}, evicted)
+		require.Equal(t, 1, c.Len())
+
+		// replacing an entry resets its ttl
+		c.Set("b", 4)
+		clock.Add(time.Hour)
+		_, ok = c.Peek("b")
+		require.False(t, ok)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		c := NewLRUCache(LRUCacheParams[string, int]{})
+		c.Set("a", 0)
+		var wg sync.WaitGroup
+		for i := range 2 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := range 1000 {
+					if i == 0 {
+						c.Set("a", j)
+					} else {
+						_, ok := c.Get("a")
+						require.True(t, ok)
+					}
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
@@ -16,6 +16,7 @@ package utils
 
 import (
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/livekit/protocol/livekit"
@@ -201,9 +202,16 @@ func (m *MetricsBatchBuilder) AddEventMetric(em EventMetric) error {
 	return nil
 }
 
+// SetSampling records the sampling applied to the samples and events of the batch, see TelemetrySampler.
+func (m *MetricsBatchBuilder) SetSampling(sampling *livekit.MetricsSampling) {
+	m.MetricsBatch.Sampling = sampling
+}
+
 func (m *MetricsBatchBuilder) Merge(other *livekit.MetricsBatch) {
 	// Timestamp and NormalizedTimestamp are not merged
 
+	m.mergeSampling(other.Sampling)
+
 	for _, optsm := range other.TimeSeries {
 		ptsm := &livekit.TimeSeriesMetric{
 			Samples: optsm.Samples,
@@ -274,6 +282,29 @@ func (m *MetricsBatchBuilder) Merge(other *livekit.MetricsBatch) {
 	}
 }
 
+func (m *MetricsBatchBuilder) mergeSampling(other *livekit.MetricsSampling) {
+	if len(other.GetLabels()) == 0 {
+		return
+	}
+	if m.MetricsBatch.Sampling == nil {
+		m.MetricsBatch.Sampling = &livekit.MetricsSampling{PolicyId: other.PolicyId}
+	}
+
+	// senders may have applied different policies, totals are still additive
+	sampling := m.MetricsBatch.Sampling
+	for _, ol := range other.Labels {
+		idx := slices.IndexFunc(sampling.Labels, func(l *livekit.MetricLabelSampling) bool {
+			return l.Label == ol.Label
+		})
+		if idx < 0 {
+			sampling.Labels = append(sampling.Labels, &livekit.MetricLabelSampling{Label: ol.Label})
+			idx = len(sampling.Labels) - 1
+		}
+		sampling.Labels[idx].Total += ol.Total
+		sampling.Labels[idx].Sent += ol.Sent
+	}
+}
+
 func (m *MetricsBatchBuilder) IsEmpty() bool {
 	return len(m.MetricsBatch.TimeSeries) == 0 && len(m.MetricsBatch.Events) == 0
 }
@@ -0,0 +1,140 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"math/bits"
+	"sync/atomic"
+)
+
+type mpscSlot[T any] struct {
+	seq   atomic.Uint64
+	value T
+}
+
+// MPSCQueue is a bounded lock-free queue for many producers and a single consumer, see
+// Vyukov's bounded MPMC queue. Producers claim slots with a CAS on the tail and never block,
+// the consumer only parks when the queue is empty, which avoids the channel lock under contention.
+type MPSCQueue[T any] struct {
+	_       [64]byte
+	tail    atomic.Uint64
+	_       [56]byte
+	head    atomic.Uint64
+	_       [56]byte
+	waiting atomic.Bool
+	signal  chan struct{}
+	mask    uint64
+	slots   []mpscSlot[T]
+}
+
+// NewMPSCQueue creates a queue holding up to capacity items, rounded up to a power of two.
+func NewMPSCQueue[T any](capacity int) *MPSCQueue[T] {
+	capacity = 1 << bits.Len(uint(max(capacity, 2)-1))
+	q := &MPSCQueue[T]{
+		signal: make(chan struct{}, 1),
+		mask:   uint64(capacity - 1),
+		slots:  make([]mpscSlot[T], capacity),
+	}
+	for i := range q.slots {
+		q.slots[i].seq.Store(uint64(i))
+	}
+	return q
+}
+
+// Push adds v to the queue, it returns false if the queue is full. Safe for concurrent use.
+func (q *MPSCQueue[T]) Push(v T) bool {
+	pos := q.tail.Load()
+	for {
+		s := &q.slots[pos&q.mask]
+		diff := int64(s.seq.Load() - pos)
+		switch {
+		case diff == 0:
+			if q.tail.CompareAndSwap(pos, pos+1) {
+				s.value = v
+				s.seq.Store(pos + 1)
+				if q.waiting.CompareAndSwap(true, false) {
+					q.signal <- struct{}{}
+				}
+				return true
+			}
+			pos = q.tail.Load()
+		case diff < 0:
+			// the consumer has not released the slot from the previous lap
+			return false
+		default:
+			pos = q.tail.Load()
+		}
+	}
+}
+
+// Pop removes the oldest item, it returns false if the queue is empty. Only one goroutine may consume.
+func (q *MPSCQueue[T]) Pop() (T, bool) {
+	pos := q.head.Load()
+	s := &q.slots[pos&q.mask]
+	if s.seq.Load() != pos+1 {
+		var zero T
+		return zero, false
+	}
+
+	v := s.value
+	var zero T
+	s.value = zero
+	q.head.Store(pos + 1)
+	s.seq.Store(pos + q.mask + 1)
+	return v, true
+}
+
+// PopWait removes the oldest item, waiting for one to be pushed. It returns false if done is closed first.
+func (q *MPSCQueue[T]) PopWait(done <-chan struct{}) (T, bool) {
+	for {
+		if v, ok := q.Pop(); ok {
+			return v, true
+		}
+
+		q.waiting.Store(true)
+		// a push between the failed pop and setting the flag would not signal
+		if v, ok := q.Pop(); ok {
+			if !q.waiting.CompareAndSwap(true, false) {
+				// consume the signal of a concurrent push
+				<-q.signal
+			}
+			return v, true
+		}
+
+		select {
+		case <-q.signal:
+		case <-done:
+			if !q.waiting.CompareAndSwap(true, false) {
+				<-q.signal
+			}
+			var zero T
+			return zero, false
+		}
+	}
+}
+
+// Len returns the number of queued items, including pushes still in progress.
+func (q *MPSCQueue[T]) Len() int {
+	head := q.head.Load()
+	tail := q.tail.Load()
+	if tail < head {
+		return 0
+	}
+	return int(tail - head)
+}
+
+func (q *MPSCQueue[T]) Cap() int {
+	return len(q.slots)
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMPSCQueue(t *testing.T) {
+	t.Run("bounded", func(t *testing.T) {
+		q := NewMPSCQueue[int](3)
+		require.Equal(t, 4, q.Cap())
+
+		for i := range 4 {
+			require.True(t, q.Push(i))
+		}
+		require.False(t, q.Push(4))
+		require.Equal(t, 4, q.Len())
+
+		for i := range 4 {
+			v, ok := q.Pop()
+			require.True(t, ok)
+			require.Equal(t, i, v)
+			require.True(t, q.Push(4+i))
+		}
+		for i := range 4 {
+			v, ok := q.Pop()
+			require.True(t, ok)
+			require.Equal(t, 4+i, v)
+		}
+		_, ok := q.Pop()
+		require.False(t, ok)
+		require.Equal(t, 0, q.Len())
+	})
+
+	t.Run("concurrent producers", func(t *testing.T) {
+		const producers = 8
+		const items = 10000
+
+		q := NewMPSCQueue[[2]int](64)
+		var wg sync.WaitGroup
+		for p := range producers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range items {
+					for !q.Push([2]int{p, i}) {
+						runtime.Gosched()
+					}
+				}
+			}()
+		}
+
+		// items of each producer are received once and in order
+		next := make([]int, producers)
+		for range producers * items {
+			v, ok := q.PopWait(nil)
+			require.True(t, ok)
+			require.Equal(t, next[v[0]], v[1])
+			next[v[0]]++
+		}
+		wg.Wait()
+		_, ok := q.Pop()
+		require.False(t, ok)
+	})
+
+	t.Run("pop wait", func(t *testing.T) {
+		q := NewMPSCQueue[int](4)
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			q.Push(1)
+		}()
+		v, ok := q.PopWait(nil)
+		require.True(t, ok)
+		require.Equal(t, 1, v)
+
+		done := make(chan struct{})
+		time.AfterFunc(10*time.Millisecond, func() { close(done) })
+		_, ok = q.PopWait(done)
+		require.False(t, ok)
+
+		// the queue is usable after an abandoned wait
+		require.True(t, q.Push(2))
+		v, ok = q.PopWait(nil)
+		require.True(t, ok)
+		require.Equal(t, 2, v)
+	})
+}
+
+func BenchmarkMPSCQueue(b *testing.B) {
+	q := NewMPSCQueue[int](1024)
+	done := make(chan struct{})
+	go func() {
+		for {
+			if _, ok := q.PopWait(done); !ok {
+				return
+			}
+		}
+	}()
+	defer close(done)
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			for !q.Push(1) {
+				runtime.Gosched()
+			}
+		}
+	})
+}
+
+func BenchmarkChannelQueue(b *testing.B) {
+	ch := make(chan int, 1024)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ch:
+			case <-done:
+				return
+			}
+		}
+	}()
+	defer close(done)
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			ch <- 1
+		}
+	})
+}
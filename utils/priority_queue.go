@@ -0,0 +1,252 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gammazero/deque"
+)
+
+type PriorityQueueParams struct {
+	// capacity of each priority class, from the highest priority to the lowest. unlimited when zero
+	Capacities []int
+	// maximum number of jobs queued over all classes, unlimited when zero. when reached, a job evicts the oldest
+	// job of the lowest class below its own. if there is none, the job is dropped, see MaxOverflow
+	MaxQueued int
+	// number of class 0 jobs queued over MaxQueued when there is no job to evict, unlimited when negative.
+	// when zero, class 0 jobs are dropped like the others
+	MaxOverflow int
+	// number of jobs of higher priority classes run while a queued job of a lower class waits,
+	// before running the lower class job. lower classes wait indefinitely when zero
+	StarvationLimit int
+	// defaults to NoopQueueMetrics
+	Metrics QueueMetrics
+}
+
+type queuedJob struct {
+	run      func()
+	drop     func()
+	queuedAt time.Time
+}
+
+// PriorityQueue runs jobs one at a time, from the highest priority class with queued jobs,
+// and in submission order within a class.
+type PriorityQueue struct {
+	params PriorityQueueParams
+
+	mu       sync.Mutex
+	wake     *sync.Cond
+	queues   []deque.Deque[queuedJob]
+	skipped  []int
+	queued   int
+	draining bool
+	killed   bool
+	done     chan struct{}
+}
+
+func NewPriorityQueue(params PriorityQueueParams) *PriorityQueue {
+	if params.Metrics == nil {
+		params.Metrics = NoopQueueMetrics
+	}
+	q := &PriorityQueue{
+		params:  params,
+		queues:  make([]deque.Deque[queuedJob], len(params.Capacities)),
+		skipped: make([]int, len(params.Capacities)),
+		done:    make(chan struct{}),
+	}
+	q.wake = sync.NewCond(&q.mu)
+	go q.run()
+	return q
+}
+
+// Enqueue queues the job in the priority class, 0 being the highest. It returns false if the class is full
+// or out of range, or the queue is stopping.
+func (q *PriorityQueue) Enqueue(class int, job func()) bool {
+	return q.EnqueueWithDrop(class, job, nil)
+}
+
+// EnqueueWithDrop queues the job like Enqueue. drop, when set, is called instead of job if the job is evicted
+// by a job of a higher class or discarded by Kill.
+func (q *PriorityQueue) EnqueueWithDrop(class int, job, drop func()) bool {
+	q.mu.Lock()
+	if !q.canEnqueue(class) {
+		q.mu.Unlock()
+		q.params.Metrics.JobDropped(false)
+		return false
+	}
+
+	var evicted *queuedJob
+	if q.params.MaxQueued > 0 && q.queued >= q.params.MaxQueued {
+		if e, ok := q.evict(class); ok {
+			evicted = &e
+		} else if class != 0 || !q.canOverflow() {
+			q.mu.Unlock()
+			q.params.Metrics.JobDropped(false)
+			return false
+		}
+	}
+
+	q.queues[class].PushBack(queuedJob{job, drop, time.Now()})
+	q.queued++
+	q.params.Metrics.JobQueued()
+	q.wake.Signal()
+	q.mu.Unlock()
+
+	if evicted != nil {
+		q.params.Metrics.JobDropped(true)
+		if evicted.drop != nil {
+			evicted.drop()
+		}
+	}
+	return true
+}
+
+func (q *PriorityQueue) canEnqueue(class int) bool {
+	if q.draining || q.killed || class < 0 || class >= len(q.queues) {
+		return false
+	}
+	c := q.params.Capacities[class]
+	return c <= 0 || q.queues[class].Len() < c
+}
+
+func (q *PriorityQueue) canOverflow() bool {
+	return q.params.MaxOverflow < 0 || q.queued < q.params.MaxQueued+q.params.MaxOverflow
+}
+
+// evict removes the oldest job of the lowest class below class
+func (q *PriorityQueue) evict(class int) (queuedJob, bool) {
+	job, evicted := EvictLowest(q.queues, class)
+	if evicted == -1 {
+		return queuedJob{}, false
+	}
+	q.queued--
+	if q.queues[evicted].Len() == 0 {
+		q.skipped[evicted] = 0
+	}
+	return job, true
+}
+
+// EvictLowest removes the oldest value of the lowest priority class below class from queues, ordered from
+// the highest priority class to the lowest. It returns the class of the removed value, or -1 if there is none.
+func EvictLowest[T any](queues []deque.Deque[T], class int) (T, int) {
+	for i := len(queues) - 1; i > class; i-- {
+		if queues[i].Len() > 0 {
+			return queues[i].PopFront(), i
+		}
+	}
+	var zero T
+	return zero, -1
+}
+
+// Len returns the number of queued jobs of the priority class.
+func (q *PriorityQueue) Len(class int) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if class < 0 || class >= len(q.queues) {
+		return 0
+	}
+	return q.queues[class].Len()
+}
+
+func (q *PriorityQueue) run() {
+	defer close(q.done)
+	for {
+		q.mu.Lock()
+		for q.queued == 0 && !q.draining && !q.killed {
+			q.wake.Wait()
+		}
+		if q.killed || q.queued == 0 {
+			var dropped []queuedJob
+			for i := range q.queues {
+				for q.queues[i].Len() > 0 {
+					dropped = append(dropped, q.queues[i].PopFront())
+				}
+			}
+			q.queued = 0
+			q.mu.Unlock()
+
+			for _, job := range dropped {
+				q.params.Metrics.JobDropped(true)
+				if job.drop != nil {
+					job.drop()
+				}
+			}
+			return
+		}
+		job := q.pop()
+		q.mu.Unlock()
+
+		start := time.Now()
+		q.params.Metrics.JobStarted(start.Sub(job.queuedAt))
+		job.run()
+		q.params.Metrics.JobProcessed(time.Since(start))
+	}
+}
+
+// pop returns the job of the highest priority class, unless a lower class waited for StarvationLimit jobs
+func (q *PriorityQueue) pop() queuedJob {
+	class := -1
+	if q.params.StarvationLimit > 0 {
+		for i := len(q.queues) - 1; i >= 0; i-- {
+			if q.queues[i].Len() > 0 && q.skipped[i] >= q.params.StarvationLimit {
+				class = i
+				break
+			}
+		}
+	}
+	if class == -1 {
+		for i := range q.queues {
+			if q.queues[i].Len() > 0 {
+				class = i
+				break
+			}
+		}
+	}
+
+	q.skipped[class] = 0
+	for i := class + 1; i < len(q.queues); i++ {
+		if q.queues[i].Len() > 0 {
+			q.skipped[i]++
+		}
+	}
+	q.queued--
+	return q.queues[class].PopFront()
+}
+
+// Drain runs the queued jobs and stops the queue, rejecting new jobs.
+func (q *PriorityQueue) Drain() {
+	q.mu.Lock()
+	q.draining = true
+	q.wake.Signal()
+	q.mu.Unlock()
+	<-q.done
+}
+
+// Kill stops the queue once the running job returns, dropping the queued jobs and calling their drop callbacks.
+func (q *PriorityQueue) Kill() {
+	q.KillAsync()
+	<-q.done
+}
+
+// KillAsync stops the queue like Kill without waiting for the running job to return.
+// No queued job is started once it has returned.
+func (q *PriorityQueue) KillAsync() {
+	q.mu.Lock()
+	q.killed = true
+	q.wake.Signal()
+	q.mu.Unlock()
+}
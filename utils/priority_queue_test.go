@@ -0,0 +1,167 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	t.Run("priority", func(t *testing.T) {
+		q := NewPriorityQueue(PriorityQueueParams{Capacities: []int{0, 2}})
+
+		var order []string
+		block := make(chan struct{})
+		require.True(t, q.Enqueue(1, func() { <-block }))
+		require.Eventually(t, func() bool { return q.Len(1) == 0 }, time.Second, time.Millisecond)
+
+		require.True(t, q.Enqueue(1, func() { order = append(order, "low1") }))
+		require.True(t, q.Enqueue(1, func() { order = append(order, "low2") }))
+		require.False(t, q.Enqueue(1, func() { order = append(order, "low3") }))
+		require.True(t, q.Enqueue(0, func() { order = append(order, "high1") }))
+		require.True(t, q.Enqueue(0, func() { order = append(order, "high2") }))
+
+		close(block)
+		q.Drain()
+		require.Equal(t, []string{"high1", "high2", "low1", "low2"}, order)
+		require.False(t, q.Enqueue(0, func() {}))
+	})
+
+	t.Run("starvation", func(t *testing.T) {
+		q := NewPriorityQueue(PriorityQueueParams{Capacities: []int{0, 0, 0}, StarvationLimit: 2})
+
+		var order []string
+		block := make(chan struct{})
+		require.True(t, q.Enqueue(0, func() { <-block }))
+		require.Eventually(t, func() bool { return q.Len(0) == 0 }, time.Second, time.Millisecond)
+
+		require.True(t, q.Enqueue(2, func() { order = append(order, "low") }))
+		require.True(t, q.Enqueue(1, func() { order = append(order, "normal") }))
+		for _, name := range []string{"high1", "high2", "high3", "high4"} {
+			require.True(t, q.Enqueue(0, func() { order = append(order, name) }))
+		}
+
+		close(block)
+		q.Drain()
+		require.Equal(t, []string{"high1", "high2", "low", "normal", "high3", "high4"}, order)
+	})
+
+	t.Run("max queued", func(t *testing.T) {
+		q := NewPriorityQueue(PriorityQueueParams{Capacities: []int{0, 0, 0}, MaxQueued: 2, MaxOverflow: 2})
+
+		var order, dropped []string
+		block := make(chan struct{})
+		require.True(t, q.Enqueue(1, func() { <-block }))
+		require.Eventually(t, func() bool { return q.Len(1) == 0 }, time.Second, time.Millisecond)
+
+		enqueue := func(class int, name string) bool {
+			return q.EnqueueWithDrop(class, func() { order = append(order, name) }, func() { dropped = append(dropped, name) })
+		}
+		require.True(t, enqueue(2, "low1"))
+		require.True(t, enqueue(1, "normal1"))
+		// evicts the oldest job of the lowest class
+		require.True(t, enqueue(1, "normal2"))
+		// nothing of a lower class to evict
+		require.False(t, enqueue(1, "normal3"))
+		require.True(t, enqueue(0, "high1"))
+		require.True(t, enqueue(0, "high2"))
+		// class 0 is queued over the limit, up to MaxOverflow
+		require.True(t, enqueue(0, "high3"))
+		require.True(t, enqueue(0, "high4"))
+		require.False(t, enqueue(0, "high5"))
+
+		close(block)
+		q.Drain()
+		require.Equal(t, []string{"high1", "high2", "high3", "high4"}, order)
+		require.Equal(t, []string{"low1", "normal1", "normal2"}, dropped)
+	})
+
+	t.Run("max overflow", func(t *testing.T) {
+		for _, c := range []struct {
+			maxOverflow int
+			queued      int
+		}{
+			{maxOverflow: 0, queued: 2},
+			{maxOverflow: 1, queued: 3},
+			{maxOverflow: -1, queued: 5},
+		} {
+			q := NewPriorityQueue(PriorityQueueParams{Capacities: []int{0}, MaxQueued: 2, MaxOverflow: c.maxOverflow})
+
+			block := make(chan struct{})
+			require.True(t, q.Enqueue(0, func() { <-block }))
+			require.Eventually(t, func() bool { return q.Len(0) == 0 }, time.Second, time.Millisecond)
+
+			for i := 0; i < 5; i++ {
+				require.Equal(t, i < c.queued, q.Enqueue(0, func() {}))
+			}
+			require.Equal(t, c.queued, q.Len(0))
+
+			close(block)
+			q.Kill()
+		}
+	})
+
+	t.Run("class out of range", func(t *testing.T) {
+		q := NewPriorityQueue(PriorityQueueParams{Capacities: []int{0}})
+		defer q.Kill()
+
+		require.False(t, q.Enqueue(-1, func() {}))
+		require.False(t, q.Enqueue(1, func() {}))
+		require.Zero(t, q.Len(1))
+	})
+
+	t.Run("kill", func(t *testing.T) {
+		q := NewPriorityQueue(PriorityQueueParams{Capacities: []int{0}})
+
+		block := make(chan struct{})
+		require.True(t, q.Enqueue(0, func() { <-block }))
+		require.Eventually(t, func() bool { return q.Len(0) == 0 }, time.Second, time.Millisecond)
+
+		var ran bool
+		require.True(t, q.Enqueue(0, func() { ran = true }))
+		q.KillAsync()
+		close(block)
+		q.Kill()
+		require.False(t, ran)
+	})
+
+	t.Run("kill drops queued jobs", func(t *testing.T) {
+		q := NewPriorityQueue(PriorityQueueParams{Capacities: []int{0, 0}})
+
+		block := make(chan struct{})
+		require.True(t, q.Enqueue(0, func() { <-block }))
+		require.Eventually(t, func() bool { return q.Len(0) == 0 }, time.Second, time.Millisecond)
+
+		var ran, dropped []string
+		for _, name := range []string{"high", "low"} {
+			class := 0
+			if name == "low" {
+				class = 1
+			}
+			require.True(t, q.EnqueueWithDrop(class, func() { ran = append(ran, name) }, func() { dropped = append(dropped, name) }))
+		}
+
+		q.KillAsync()
+		close(block)
+		q.Kill()
+
+		require.Empty(t, ran)
+		require.Equal(t, []string{"high", "low"}, dropped)
+		require.False(t, q.Enqueue(0, func() {}))
+	})
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package protoenum provides helpers for all generated protobuf enums.
+//
+// Services may receive enum values added in newer versions of the protocol. These are kept as their
+// numeric value, and Parse, String and Convert round trip them, so intermediaries pass them on unchanged.
+package protoenum
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var ErrUnknownName = errors.New("unknown enum name")
+
+type Enum interface {
+	~int32
+	protoreflect.Enum
+}
+
+var (
+	aliasMu sync.RWMutex
+	aliases = make(map[protoreflect.FullName]map[string]protoreflect.EnumNumber)
+)
+
+func descriptor[E Enum]() protoreflect.EnumDescriptor {
+	var e E
+	return e.Descriptor()
+}
+
+// RegisterAlias makes Parse accept alias, case insensitively, as the name of the value.
+func RegisterAlias[E Enum](alias string, v E) {
+	d := descriptor[E]()
+	aliasMu.Lock()
+	defer aliasMu.Unlock()
+	m := aliases[d.FullName()]
+	if m == nil {
+		m = make(map[string]protoreflect.EnumNumber)
+		aliases[d.FullName()] = m
+	}
+	m[strings.ToUpper(alias)] = protoreflect.EnumNumber(v)
+}
+
+// Parse returns the value with the name. Names are case insensitive, may omit the prefix shared by all values
+// of the enum (e.g. STARTING for EGRESS_STARTING), and may be registered aliases.
+// Numbers are accepted as well, including ones unknown to this version of the protocol.
+func Parse[E Enum](s string) (E, error) {
+	d := descriptor[E]()
+	s = strings.TrimSpace(s)
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		return E(n), nil
+	}
+
+	name := strings.ToUpper(s)
+	if v := d.Values().ByName(protoreflect.Name(name)); v != nil {
+		return E(v.Number()), nil
+	}
+	if prefix := commonPrefix(d); prefix != "" {
+		if v := d.Values().ByName(protoreflect.Name(prefix + name)); v != nil {
+			return E(v.Number()), nil
+		}
+	}
+
+	aliasMu.RLock()
+	n, ok := aliases[d.FullName()][name]
+	aliasMu.RUnlock()
+	if ok {
+		return E(n), nil
+	}
+	return 0, fmt.Errorf("%w: %s %q", ErrUnknownName, d.Name(), s)
+}
+
+// IsKnown reports whether the value is defined in this version of the protocol.
+func IsKnown[E Enum](v E) bool {
+	return descriptor[E]().Values().ByNumber(protoreflect.EnumNumber(v)) != nil
+}
+
+// Values returns the values of the enum in declaration order, e.g. for listing them in UIs.
+// Aliased numbers are only listed once.
+func Values[E Enum]() []E {
+	values := descriptor[E]().Values()
+	res := make([]E, 0, values.Len())
+	seen := make(map[protoreflect.EnumNumber]struct{}, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		n := values.Get(i).Number()
+		if _, ok := seen[n]; ok {
+			continue
+		}
+		seen[n] = struct{}{}
+		res = append(res, E(n))
+	}
+	return res
+}
+
+// String returns the name of the value, or its number when it is unknown.
+func String[E Enum](v E) string {
+	if ev := descriptor[E]().Values().ByNumber(protoreflect.EnumNumber(v)); ev != nil {
+		return string(ev.Name())
+	}
+	return strconv.Itoa(int(v))
+}
+
+// Convert maps a value to the enum with the same value names, e.g. between livekit and rpc types.
+// Values unknown to From keep their number. Known values without a counterpart in To map to its zero value.
+func Convert[To, From Enum](v From) To {
+	ev := descriptor[From]().Values().ByNumber(protoreflect.EnumNumber(v))
+	if ev == nil {
+		return To(v)
+	}
+	if tv := descriptor[To]().Values().ByName(ev.Name()); tv != nil {
+		return To(tv.Number())
+	}
+	return 0
+}
+
+// KnownOr returns the value when it is known, and fallback otherwise, for consumers which need to act on it.
+func KnownOr[E Enum](v E, fallback E) E {
+	if IsKnown(v) {
+		return v
+	}
+	return fallback
+}
+
+var prefixes sync.Map
+
+// commonPrefix returns the underscore terminated prefix shared by all value names
+func commonPrefix(d protoreflect.EnumDescriptor) string {
+	if p, ok := prefixes.Load(d.FullName()); ok {
+		return p.(string)
+	}
+	values := d.Values()
+	var prefix string
+	if values.Len() > 1 {
+		prefix = string(values.Get(0).Name())
+		for i := 1; i < values.Len() && prefix != ""; i++ {
+			name := string(values.Get(i).Name())
+			for !strings.HasPrefix(name, prefix) {
+				prefix = prefix[:len(prefix)-1]
+			}
+		}
+		prefix = prefix[:strings.LastIndex(prefix, "_")+1]
+	}
+	prefixes.Store(d.FullName(), prefix)
+	return prefix
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protoenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+func TestParse(t *testing.T) {
+	for _, s := range []string{"EGRESS_COMPLETE", "egress_complete", "complete", " Complete ", "3"} {
+		v, err := Parse[livekit.EgressStatus](s)
+		require.NoError(t, err, s)
+		require.Equal(t, livekit.EgressStatus_EGRESS_COMPLETE, v, s)
+	}
+
+	_, err := Parse[livekit.EgressStatus]("done")
+	require.ErrorIs(t, err, ErrUnknownName)
+	RegisterAlias("done", livekit.EgressStatus_EGRESS_COMPLETE)
+	v, err := Parse[livekit.EgressStatus]("DONE")
+	require.NoError(t, err)
+	require.Equal(t, livekit.EgressStatus_EGRESS_COMPLETE, v)
+
+	// enums without a shared prefix
+	track, err := Parse[livekit.TrackType]("video")
+	require.NoError(t, err)
+	require.Equal(t, livekit.TrackType_VIDEO, track)
+}
+
+func TestUnknownValues(t *testing.T) {
+	// a value added by a newer version of the protocol
+	unknown := livekit.EgressStatus(42)
+	require.False(t, IsKnown(unknown))
+	require.True(t, IsKnown(livekit.EgressStatus_EGRESS_ACTIVE))
+
+	require.Equal(t, "42", String(unknown))
+	v, err := Parse[livekit.EgressStatus](String(unknown))
+	require.NoError(t, err)
+	require.Equal(t, unknown, v)
+
+	require.Equal(t, livekit.EgressStatus_EGRESS_ACTIVE, Convert[livekit.EgressStatus](livekit.EgressStatus_EGRESS_ACTIVE))
+	require.Equal(t, livekit.EgressStatus(42), Convert[livekit.EgressStatus](unknown))
+	// names missing from the target enum map to its zero value
+	require.Equal(t, livekit.EgressStatus_EGRESS_STARTING, Convert[livekit.EgressStatus](livekit.TrackType_VIDEO))
+	require.Equal(t, livekit.EgressStatus_EGRESS_FAILED, KnownOr(unknown, livekit.EgressStatus_EGRESS_FAILED))
+
+	// unknown values survive intermediaries relaying the message
+	data, err := proto.Marshal(&livekit.EgressInfo{Status: unknown})
+	require.NoError(t, err)
+	var info livekit.EgressInfo
+	require.NoError(t, proto.Unmarshal(data, &info))
+	require.Equal(t, unknown, info.Status)
+}
+
+func TestValues(t *testing.T) {
+	values := Values[livekit.TrackType]()
+	require.Equal(t, []livekit.TrackType{livekit.TrackType_AUDIO, livekit.TrackType_VIDEO, livekit.TrackType_DATA}, values)
+}
@@ -0,0 +1,38 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build race
+
+package protopool
+
+import (
+	"fmt"
+	"sync"
+)
+
+// checks tracks the messages acquired from a pool, to catch messages released twice
+// or released to another pool
+type checks struct {
+	acquiredMessages sync.Map
+}
+
+func (c *checks) acquired(m any) {
+	c.acquiredMessages.Store(m, struct{}{})
+}
+
+func (c *checks) released(m any) {
+	if _, ok := c.acquiredMessages.LoadAndDelete(m); !ok {
+		panic(fmt.Sprintf("protopool: %T released twice, or not acquired from this pool", m))
+	}
+}
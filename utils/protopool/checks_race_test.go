@@ -0,0 +1,38 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build race
+
+package protopool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+func TestChecks(t *testing.T) {
+	m := DataPackets.Acquire()
+	DataPackets.Release(m)
+	require.Panics(t, func() { DataPackets.Release(m) })
+
+	require.Panics(t, func() { DataPackets.Release(&livekit.DataPacket{}) })
+
+	p := New[livekit.DataPacket]()
+	m = DataPackets.Acquire()
+	require.Panics(t, func() { p.Release(m) })
+	DataPackets.Release(m)
+}
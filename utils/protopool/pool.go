@@ -0,0 +1,92 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package protopool recycles generated messages used in hot paths, to reduce allocations.
+//
+// Messages must not be used after being released, including the messages they reference,
+// which may be referenced by the next user of the message. Builds with -race check that
+// messages are released once, and only to the pool they were acquired from.
+package protopool
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+// Message is a pointer to a generated message type T.
+type Message[T any] interface {
+	*T
+	proto.Message
+}
+
+// Pool recycles messages of type T.
+type Pool[T any, P Message[T]] struct {
+	pool   sync.Pool
+	checks checks
+}
+
+func New[T any, P Message[T]]() *Pool[T, P] {
+	return &Pool[T, P]{
+		pool: sync.Pool{
+			New: func() any {
+				return P(new(T))
+			},
+		},
+	}
+}
+
+// Acquire returns an empty message.
+func (p *Pool[T, P]) Acquire() P {
+	m := p.pool.Get().(P)
+	p.checks.acquired(m)
+	return m
+}
+
+// Release resets the message and returns it to the pool.
+func (p *Pool[T, P]) Release(m P) {
+	if m == nil {
+		return
+	}
+	p.checks.released(m)
+	proto.Reset(m)
+	p.pool.Put(m)
+}
+
+// Get returns an empty message, released by its Recycle method.
+func (p *Pool[T, P]) Get() Recyclable[T, P] {
+	return Recyclable[T, P]{
+		Message: p.Acquire(),
+		pool:    p,
+	}
+}
+
+// Recyclable is a pooled message, to be recycled once it is not used anymore.
+type Recyclable[T any, P Message[T]] struct {
+	Message P
+	pool    *Pool[T, P]
+}
+
+// Recycle releases the message to its pool.
+func (r Recyclable[T, P]) Recycle() {
+	r.pool.Release(r.Message)
+}
+
+var (
+	DataPackets     = New[livekit.DataPacket]()
+	SignalRequests  = New[livekit.SignalRequest]()
+	SignalResponses = New[livekit.SignalResponse]()
+)
@@ -0,0 +1,49 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protopool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+func TestPool(t *testing.T) {
+	m := DataPackets.Acquire()
+	m.ParticipantIdentity = "p1"
+	m.Value = &livekit.DataPacket_User{User: &livekit.UserPacket{Payload: []byte("payload")}}
+	DataPackets.Release(m)
+
+	// released messages are reset
+	require.Empty(t, m.ParticipantIdentity)
+	require.Nil(t, m.Value)
+
+	r := SignalRequests.Get()
+	require.NotNil(t, r.Message)
+	r.Message.Message = &livekit.SignalRequest_Ping{Ping: 1}
+	r.Recycle()
+	require.Nil(t, r.Message.Message)
+
+	for range 10 {
+		m := SignalResponses.Acquire()
+		require.Nil(t, m.Message)
+		m.Message = &livekit.SignalResponse_Pong{Pong: 1}
+		SignalResponses.Release(m)
+	}
+
+	DataPackets.Release(nil)
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// QueueMetrics receives the events of the jobs of a queue or worker pool.
+// The depth of the queue is the number of jobs queued but neither started nor dropped.
+type QueueMetrics interface {
+	// a job was queued
+	JobQueued()
+	// a queued job started running after waiting
+	JobStarted(waited time.Duration)
+	// a job finished running
+	JobProcessed(d time.Duration)
+	// a job was dropped, e.g. as the queue was full. queued is true for jobs dropped after being queued
+	JobDropped(queued bool)
+}
+
+type noopQueueMetrics struct{}
+
+func (noopQueueMetrics) JobQueued()                 {}
+func (noopQueueMetrics) JobStarted(time.Duration)   {}
+func (noopQueueMetrics) JobProcessed(time.Duration) {}
+func (noopQueueMetrics) JobDropped(bool)            {}
+
+// NoopQueueMetrics discards measurements, it is used by queues without metrics.
+var NoopQueueMetrics QueueMetrics = noopQueueMetrics{}
+
+// PrometheusQueueMetrics exports the measurements of queues as Prometheus metrics, labeled by queue.
+// It implements prometheus.Collector and must be registered to be exported.
+type PrometheusQueueMetrics struct {
+	depth     *prometheus.GaugeVec
+	waited    *prometheus.HistogramVec
+	processed *prometheus.HistogramVec
+	dropped   *prometheus.CounterVec
+}
+
+func NewPrometheusQueueMetrics(namespace, subsystem string, constLabels prometheus.Labels) *PrometheusQueueMetrics {
+	return &PrometheusQueueMetrics{
+		depth: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Subsystem:   subsystem,
+			Name:        "queue_depth",
+			ConstLabels: constLabels,
+		}, []string{"queue"}),
+		waited: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   namespace,
+			Subsystem:   subsystem,
+			Name:        "queue_wait_seconds",
+			ConstLabels: constLabels,
+			Buckets:     prometheus.ExponentialBuckets(0.001, 4, 8),
+		}, []string{"queue"}),
+		processed: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   namespace,
+			Subsystem:   subsystem,
+			Name:        "queue_processing_seconds",
+			ConstLabels: constLabels,
+			Buckets:     prometheus.ExponentialBuckets(0.001, 4, 8),
+		}, []string{"queue"}),
+		dropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   subsystem,
+			Name:        "queue_dropped_total",
+			ConstLabels: constLabels,
+		}, []string{"queue"}),
+	}
+}
+
+// Queue returns the metrics of the named queue.
+func (m *PrometheusQueueMetrics) Queue(name string) QueueMetrics {
+	return &prometheusQueue{
+		depth:     m.depth.WithLabelValues(name),
+		waited:    m.waited.WithLabelValues(name),
+		processed: m.processed.WithLabelValues(name),
+		dropped:   m.dropped.WithLabelValues(name),
+	}
+}
+
+func (m *PrometheusQueueMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.depth.Describe(ch)
+	m.waited.Describe(ch)
+	m.processed.Describe(ch)
+	m.dropped.Describe(ch)
+}
+
+func (m *PrometheusQueueMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.depth.Collect(ch)
+	m.waited.Collect(ch)
+	m.processed.Collect(ch)
+	m.dropped.Collect(ch)
+}
+
+type prometheusQueue struct {
+	depth     prometheus.Gauge
+	waited    prometheus.Observer
+	processed prometheus.Observer
+	dropped   prometheus.Counter
+}
+
+func (q *prometheusQueue) JobQueued() {
+	q.depth.Inc()
+}
+
+func (q *prometheusQueue) JobStarted(waited time.Duration) {
+	q.depth.Dec()
+	q.waited.Observe(waited.Seconds())
+}
+
+func (q *prometheusQueue) JobProcessed(d time.Duration) {
+	q.processed.Observe(d.Seconds())
+}
+
+func (q *prometheusQueue) JobDropped(queued bool) {
+	if queued {
+		q.depth.Dec()
+	}
+	q.dropped.Inc()
+}
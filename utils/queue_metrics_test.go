@@ -0,0 +1,45 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusQueueMetrics(t *testing.T) {
+	m := NewPrometheusQueueMetrics("livekit", "test", nil)
+	q := NewPriorityQueue(PriorityQueueParams{
+		Capacities: []int{1},
+		Metrics:    m.Queue("jobs"),
+	})
+
+	block := make(chan struct{})
+	require.True(t, q.Enqueue(0, func() { <-block }))
+	require.Eventually(t, func() bool { return q.Len(0) == 0 }, time.Second, time.Millisecond)
+	require.True(t, q.Enqueue(0, func() {}))
+	require.False(t, q.Enqueue(0, func() {}))
+
+	require.Equal(t, 1.0, testutil.ToFloat64(m.depth.WithLabelValues("jobs")))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.dropped.WithLabelValues("jobs")))
+
+	close(block)
+	q.Drain()
+	require.Equal(t, 0.0, testutil.ToFloat64(m.depth.WithLabelValues("jobs")))
+	require.Equal(t, 2, testutil.CollectAndCount(m, "livekit_test_queue_wait_seconds", "livekit_test_queue_processing_seconds"))
+}
@@ -24,6 +24,7 @@
 package utils
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -79,13 +80,66 @@ func (lb *LeakyBucket) Take() time.Time {
 	lb.mutex.Lock()
 	defer lb.mutex.Unlock()
 
+	if sleepFor := lb.reserve(lb.clock.Now()); sleepFor > 0 {
+		lb.clock.Sleep(sleepFor)
+	}
+	return lb.last
+}
+
+// Wait blocks like Take, returning early with the context error when ctx is done.
+// The request is counted even when Wait returns early. The wait uses the clock of the bucket.
+//
+// Wait is THREAD SAFE and BLOCKING.
+func (lb *LeakyBucket) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	lb.mutex.Lock()
+	sleepFor := lb.reserve(lb.clock.Now())
+	lb.mutex.Unlock()
+	if sleepFor <= 0 {
+		return nil
+	}
+
+	return lb.sleep(ctx, sleepFor)
+}
+
+// sleep waits for d on the clock of the bucket, returning early with the context error when ctx is done
+func (lb *LeakyBucket) sleep(ctx context.Context, d time.Duration) error {
+	var c <-chan time.Time
+	switch clock := lb.clock.(type) {
+	case SystemClock, *SystemClock:
+		t := time.NewTimer(d)
+		defer t.Stop()
+		c = t.C
+	case interface{ After(time.Duration) <-chan time.Time }:
+		c = clock.After(d)
+	default:
+		done := make(chan time.Time, 1)
+		go func() {
+			clock.Sleep(d)
+			done <- clock.Now()
+		}()
+		c = done
+	}
+
+	select {
+	case <-c:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reserve accounts for a request made at now, and returns how long to sleep before making it
+func (lb *LeakyBucket) reserve(now time.Time) time.Duration {
 	cfg := lb.cfg.Load()
-	now := lb.clock.Now()
 
 	// If this is our first request, then we allow it.
 	if lb.last.IsZero() {
 		lb.last = now
-		return lb.last
+		return 0
 	}
 
 	// sleepFor calculates how much time we should sleep based on
@@ -103,12 +157,11 @@ func (lb *LeakyBucket) Take() time.Time {
 
 	// If sleepFor is positive, then we should sleep now.
 	if lb.sleepFor > 0 {
-		lb.clock.Sleep(lb.sleepFor)
-		lb.last = now.Add(lb.sleepFor)
+		sleepFor := lb.sleepFor
+		lb.last = now.Add(sleepFor)
 		lb.sleepFor = 0
-	} else {
-		lb.last = now
+		return sleepFor
 	}
-
-	return lb.last
+	lb.last = now
+	return 0
 }
@@ -24,6 +24,7 @@
 package utils
 
 import (
+	"context"
 	"sync"
 	"testing"
 	"time"
@@ -310,6 +311,29 @@ func TestRateLimiter(t *testing.T) {
 	})
 }
 
+func TestLeakyBucketWait(t *testing.T) {
+	lb := NewLeakyBucket(1, 0, SystemClock{})
+	require.NoError(t, lb.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, lb.Wait(ctx), context.DeadlineExceeded)
+
+	// the wait follows the clock of the bucket
+	clk := &SimulatedClock{}
+	clk.Set(time.Now())
+	lb = NewLeakyBucket(1, 0, clk)
+	require.NoError(t, lb.Wait(context.Background()))
+	done := make(chan error, 1)
+	go func() { done <- lb.Wait(context.Background()) }()
+	require.Never(t, func() bool { return len(done) != 0 }, 20*time.Millisecond, time.Millisecond)
+	require.Eventually(t, func() bool {
+		clk.Add(100 * time.Millisecond)
+		return len(done) != 0
+	}, time.Second, time.Millisecond)
+	require.NoError(t, <-done)
+}
+
 func TestDelayedRateLimiter(t *testing.T) {
 	t.Skip(UnstableTest)
 	runTest(t, func(r testRunner) {
@@ -16,21 +16,16 @@ package utils
 
 import (
 	"fmt"
-	"regexp"
-	"strings"
-)
 
-// rtmp urls must be of format rtmp(s)://{host}(/{path})/{app}/{stream_key}( live=1)
-var rtmpRegexp = regexp.MustCompile("^(rtmps?:\\/\\/)(.*\\/)(.*\\/)(\\S*)( live=1)?$")
+	"github.com/livekit/protocol/utils/streamurl"
+)
 
 func RedactStreamKey(url string) (string, bool) {
-	match := rtmpRegexp.FindStringSubmatch(url)
-	if len(match) != 6 {
+	prefix, key, suffix, ok := streamurl.SplitStreamKey(url)
+	if !ok {
 		return url, false
 	}
-
-	match[4] = RedactIdentifier(match[4])
-	return strings.Join(match[1:], ""), true
+	return prefix + RedactIdentifier(key) + suffix, true
 }
 
 func RedactIdentifier(identifier string) string {
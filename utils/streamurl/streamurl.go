@@ -0,0 +1,36 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package streamurl parses the stream urls of egress outputs, without dependencies so that logger can redact them.
+package streamurl
+
+import (
+	"regexp"
+	"strings"
+)
+
+// rtmp urls must be of format rtmp(s)://{host}(/{path})/{app}/{stream_key}( live=1)
+var rtmpRegexp = regexp.MustCompile("^(rtmps?:\\/\\/.*\\/.*\\/)(\\S*)( live=1)?$")
+
+// SplitStreamKey splits an rtmp url around its stream key, and returns false for other urls.
+func SplitStreamKey(url string) (prefix, key, suffix string, ok bool) {
+	if !strings.HasPrefix(url, "rtmp") {
+		return "", "", "", false
+	}
+	match := rtmpRegexp.FindStringSubmatch(url)
+	if match == nil {
+		return "", "", "", false
+	}
+	return match[1], match[2], match[3], true
+}
@@ -0,0 +1,34 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamurl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitStreamKey(t *testing.T) {
+	prefix, key, suffix, ok := SplitStreamKey("rtmps://foo.bar.com/app/secret_stream_key live=1")
+	require.True(t, ok)
+	require.Equal(t, "rtmps://foo.bar.com/app/", prefix)
+	require.Equal(t, "secret_stream_key", key)
+	require.Equal(t, " live=1", suffix)
+
+	for _, url := range []string{"srt://srt.example.com:9000", "rtmp://localhost", "https://example.com/app/key"} {
+		_, _, _, ok = SplitStreamKey(url)
+		require.False(t, ok, url)
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+type labelSampling struct {
+	total      uint64
+	sent       uint64
+	burst      uint32
+	burstStart time.Time
+}
+
+// TelemetrySampler applies a TelemetrySamplingPolicy to recorded samples and counts
+// how many were sent, for reporting in MetricsBatch.
+// Samples with custom labels are sampled with the default rule, under METRIC_LABEL_PREDEFINED_MAX_VALUE.
+type TelemetrySampler struct {
+	mu     sync.Mutex
+	policy *livekit.TelemetrySamplingPolicy
+	labels map[livekit.MetricLabel]*labelSampling
+	rand   func() float32
+}
+
+func NewTelemetrySampler(policy *livekit.TelemetrySamplingPolicy) *TelemetrySampler {
+	return &TelemetrySampler{
+		policy: policy,
+		labels: make(map[livekit.MetricLabel]*labelSampling),
+		rand:   rand.Float32,
+	}
+}
+
+// SetPolicy replaces the policy. Counts of the previous policy are kept until the next report.
+func (s *TelemetrySampler) SetPolicy(policy *livekit.TelemetrySamplingPolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policy = policy
+	for _, l := range s.labels {
+		l.burst = 0
+		l.burstStart = time.Time{}
+	}
+}
+
+// Sample records a sample of the label and reports whether it should be sent.
+func (s *TelemetrySampler) Sample(label livekit.MetricLabel, now time.Time) bool {
+	label = min(label, livekit.MetricLabel_METRIC_LABEL_PREDEFINED_MAX_VALUE)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l := s.labels[label]
+	if l == nil {
+		l = &labelSampling{}
+		s.labels[label] = l
+	}
+	l.total++
+
+	if s.sample(l, label, now) {
+		l.sent++
+		return true
+	}
+	return false
+}
+
+func (s *TelemetrySampler) sample(l *labelSampling, label livekit.MetricLabel, now time.Time) bool {
+	if s.policy == nil || s.policy.IsExpired(now) {
+		return true
+	}
+	rule := s.policy.Rule(label)
+	if rule == nil {
+		return true
+	}
+
+	if rule.Burst > 0 {
+		if interval := rule.BurstInterval(); interval > 0 && now.Sub(l.burstStart) >= interval {
+			l.burst = 0
+			l.burstStart = now
+		}
+		if l.burst < rule.Burst {
+			l.burst++
+			return true
+		}
+	}
+
+	rate := rule.Rate()
+	return rate >= 1 || (rate > 0 && s.rand() < rate)
+}
+
+// Report returns the sampling since the last report and resets the counts.
+// It returns nil when all samples were sent.
+func (s *TelemetrySampler) Report() *livekit.MetricsSampling {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var res *livekit.MetricsSampling
+	for label, l := range s.labels {
+		if l.sent != l.total {
+			if res == nil {
+				res = &livekit.MetricsSampling{PolicyId: s.policy.GetPolicyId()}
+			}
+			res.Labels = append(res.Labels, &livekit.MetricLabelSampling{
+				Label: label,
+				Total: l.total,
+				Sent:  l.sent,
+			})
+		}
+		l.total = 0
+		l.sent = 0
+	}
+	if res != nil {
+		slices.SortFunc(res.Labels, func(a, b *livekit.MetricLabelSampling) int {
+			return int(a.Label) - int(b.Label)
+		})
+	}
+	return res
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+func TestTelemetrySampler(t *testing.T) {
+	now := time.Now()
+	policy := &livekit.TelemetrySamplingPolicy{
+		PolicyId:    "incident",
+		DefaultRule: &livekit.TelemetrySamplingRule{SampleRate: proto.Float32(0)},
+		Rules: []*livekit.TelemetrySamplingRule{
+			{Label: livekit.MetricLabel_PUBLISHER_RTT, SampleRate: proto.Float32(0.5)},
+			{Label: livekit.MetricLabel_SUBSCRIBER_RTT, SampleRate: proto.Float32(0), Burst: 2, BurstIntervalMs: 1000},
+		},
+		ExpiresAt: now.Add(time.Minute).UnixMilli(),
+	}
+
+	t.Run("no policy", func(t *testing.T) {
+		s := NewTelemetrySampler(nil)
+		for range 10 {
+			require.True(t, s.Sample(livekit.MetricLabel_PUBLISHER_RTT, now))
+		}
+		require.Nil(t, s.Report())
+	})
+
+	t.Run("rates", func(t *testing.T) {
+		s := NewTelemetrySampler(policy)
+		r := float32(0)
+		s.rand = func() float32 {
+			r += 0.25
+			if r >= 1 {
+				r = 0
+			}
+			return r
+		}
+		for range 8 {
+			s.Sample(livekit.MetricLabel_PUBLISHER_RTT, now)
+			s.Sample(livekit.MetricLabel_SERVER_MESH_RTT, now)
+		}
+		sampling := s.Report()
+		require.True(t, proto.Equal(&livekit.MetricsSampling{
+			PolicyId: "incident",
+			Labels: []*livekit.MetricLabelSampling{
+				{Label: livekit.MetricLabel_PUBLISHER_RTT, Total: 8, Sent: 4},
+				{Label: livekit.MetricLabel_SERVER_MESH_RTT, Total: 8, Sent: 0},
+			},
+		}, sampling))
+		require.Equal(t, 2.0, sampling.Scale(livekit.MetricLabel_PUBLISHER_RTT))
+		require.Equal(t, 1.0, sampling.Scale(livekit.MetricLabel_SUBSCRIBER_RTT))
+
+		// counts are reset after reporting
+		require.Nil(t, s.Report())
+	})
+
+	t.Run("burst", func(t *testing.T) {
+		s := NewTelemetrySampler(policy)
+		require.True(t, s.Sample(livekit.MetricLabel_SUBSCRIBER_RTT, now))
+		require.True(t, s.Sample(livekit.MetricLabel_SUBSCRIBER_RTT, now))
+		require.False(t, s.Sample(livekit.MetricLabel_SUBSCRIBER_RTT, now))
+		require.True(t, s.Sample(livekit.MetricLabel_SUBSCRIBER_RTT, now.Add(time.Second)))
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		s := NewTelemetrySampler(policy)
+		require.False(t, s.Sample(livekit.MetricLabel_SERVER_MESH_RTT, now))
+		require.True(t, s.Sample(livekit.MetricLabel_SERVER_MESH_RTT, now.Add(time.Minute)))
+	})
+}
+
+func TestMetricsBatchBuilderSampling(t *testing.T) {
+	mbb := NewMetricsBatchBuilder()
+	mbb.SetSampling(&livekit.MetricsSampling{
+		PolicyId: "incident",
+		Labels:   []*livekit.MetricLabelSampling{{Label: livekit.MetricLabel_PUBLISHER_RTT, Total: 10, Sent: 5}},
+	})
+	mbb.Merge(&livekit.MetricsBatch{
+		Sampling: &livekit.MetricsSampling{
+			PolicyId: "incident",
+			Labels: []*livekit.MetricLabelSampling{
+				{Label: livekit.MetricLabel_PUBLISHER_RTT, Total: 10, Sent: 1},
+				{Label: livekit.MetricLabel_SUBSCRIBER_RTT, Total: 4, Sent: 2},
+			},
+		},
+	})
+	require.True(t, proto.Equal(&livekit.MetricsSampling{
+		PolicyId: "incident",
+		Labels: []*livekit.MetricLabelSampling{
+			{Label: livekit.MetricLabel_PUBLISHER_RTT, Total: 20, Sent: 6},
+			{Label: livekit.MetricLabel_SUBSCRIBER_RTT, Total: 4, Sent: 2},
+		},
+	}, mbb.ToProto().Sampling))
+}
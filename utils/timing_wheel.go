@@ -0,0 +1,265 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"math/bits"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultTimingWheelTick   = 10 * time.Millisecond
+	DefaultTimingWheelSlots  = 64
+	DefaultTimingWheelLevels = 4
+)
+
+type TimingWheelParams struct {
+	// resolution of the wheel, timers fire up to one tick late
+	Tick time.Duration
+	// slots per level, rounded up to a power of two
+	Slots int
+	// number of levels, timers beyond Tick * Slots^Levels are rescheduled as the wheel turns
+	Levels int
+	// defaults to the system clock
+	Clock Clock
+}
+
+// TimingWheel schedules timeouts in a hierarchy of wheels, see Varghese & Lauck. Adding and
+// stopping timers is O(1) and the wheel runs on a single goroutine, which makes it cheaper than
+// time.AfterFunc for large numbers of mostly cancelled timeouts.
+//
+// Callbacks run on the wheel goroutine and should hand off anything slow.
+type TimingWheel struct {
+	params TimingWheelParams
+	bits   uint
+	mask   uint64
+	start  time.Time
+	done   chan struct{}
+	stop   sync.Once
+
+	mu     sync.Mutex
+	tick   uint64
+	levels [][]timingWheelSlot
+	len    int
+}
+
+type timingWheelSlot struct {
+	head *TimingWheelTimer
+}
+
+type TimingWheelTimer struct {
+	w          *TimingWheel
+	f          func()
+	expires    uint64
+	slot       *timingWheelSlot
+	next, prev *TimingWheelTimer
+}
+
+func NewTimingWheel(params TimingWheelParams) *TimingWheel {
+	if params.Tick <= 0 {
+		params.Tick = DefaultTimingWheelTick
+	}
+	if params.Slots <= 1 {
+		params.Slots = DefaultTimingWheelSlots
+	}
+	if params.Levels <= 0 {
+		params.Levels = DefaultTimingWheelLevels
+	}
+	if params.Clock == nil {
+		params.Clock = SystemClock{}
+	}
+
+	b := uint(bits.Len(uint(params.Slots - 1)))
+	params.Slots = 1 << b
+	// keep the span of the wheel within the tick counter
+	params.Levels = min(params.Levels, int(63/b))
+
+	levels := make([][]timingWheelSlot, params.Levels)
+	for i := range levels {
+		levels[i] = make([]timingWheelSlot, params.Slots)
+	}
+
+	w := &TimingWheel{
+		params: params,
+		bits:   b,
+		mask:   uint64(params.Slots - 1),
+		start:  params.Clock.Now(),
+		done:   make(chan struct{}),
+		levels: levels,
+	}
+	go w.run()
+	return w
+}
+
+// AfterFunc calls f once d has elapsed, unless the timer is stopped first.
+func (w *TimingWheel) AfterFunc(d time.Duration, f func()) *TimingWheelTimer {
+	t := &TimingWheelTimer{w: w, f: f}
+	expires := w.expiresAt(d)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	t.expires = max(expires, w.tick+1)
+	w.add(t)
+	w.len++
+	return t
+}
+
+// Len returns the number of pending timers.
+func (w *TimingWheel) Len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.len
+}
+
+// Stop stops the wheel, pending timers never fire.
+func (w *TimingWheel) Stop() {
+	w.stop.Do(func() {
+		close(w.done)
+	})
+}
+
+// Stop prevents the timer from firing, it returns false if the timer already fired or was stopped.
+func (t *TimingWheelTimer) Stop() bool {
+	t.w.mu.Lock()
+	defer t.w.mu.Unlock()
+	if t.slot == nil {
+		return false
+	}
+	t.w.remove(t)
+	t.w.len--
+	return true
+}
+
+// Reset reschedules the timer to fire once d has elapsed, it returns false if the timer had
+// already fired or was stopped.
+func (t *TimingWheelTimer) Reset(d time.Duration) bool {
+	w := t.w
+	expires := w.expiresAt(d)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	active := t.slot != nil
+	if active {
+		w.remove(t)
+	} else {
+		w.len++
+	}
+	t.expires = max(expires, w.tick+1)
+	w.add(t)
+	return active
+}
+
+func (w *TimingWheel) expiresAt(d time.Duration) uint64 {
+	elapsed := w.params.Clock.Now().Sub(w.start) + d
+	if elapsed <= 0 {
+		return 0
+	}
+	return uint64((elapsed + w.params.Tick - 1) / w.params.Tick)
+}
+
+func (w *TimingWheel) run() {
+	ticker := time.NewTicker(w.params.Tick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+			w.advance(uint64(w.params.Clock.Now().Sub(w.start) / w.params.Tick))
+		}
+	}
+}
+
+// advance processes the ticks up to and including tick, firing the expired timers.
+func (w *TimingWheel) advance(tick uint64) {
+	for {
+		w.mu.Lock()
+		if w.tick >= tick {
+			w.mu.Unlock()
+			return
+		}
+		w.tick++
+		w.cascade()
+
+		var fs []func()
+		slot := &w.levels[0][w.tick&w.mask]
+		for t := slot.head; t != nil; t = slot.head {
+			w.remove(t)
+			fs = append(fs, t.f)
+		}
+		w.len -= len(fs)
+		w.mu.Unlock()
+
+		for _, f := range fs {
+			f()
+		}
+	}
+}
+
+// cascade moves the timers of the slots that came due on the upper levels down the hierarchy.
+func (w *TimingWheel) cascade() {
+	for l := 1; l < len(w.levels); l++ {
+		if w.tick&(uint64(1)<<(w.bits*uint(l))-1) != 0 {
+			return
+		}
+
+		slot := &w.levels[l][(w.tick>>(w.bits*uint(l)))&w.mask]
+		for t := slot.head; t != nil; t = slot.head {
+			w.remove(t)
+			w.add(t)
+		}
+	}
+}
+
+func (w *TimingWheel) add(t *TimingWheelTimer) {
+	expires := t.expires
+	delta := max(expires, w.tick) - w.tick
+
+	l := 0
+	for ; l < len(w.levels)-1; l++ {
+		if delta < uint64(1)<<(w.bits*uint(l+1)) {
+			break
+		}
+	}
+	if span := uint64(1) << (w.bits * uint(len(w.levels))); delta >= span {
+		// beyond the top level, park the timer in its furthest slot to be re-added when it comes due
+		expires = w.tick + span - 1
+	}
+
+	slot := &w.levels[l][(expires>>(w.bits*uint(l)))&w.mask]
+	t.slot = slot
+	t.prev = nil
+	t.next = slot.head
+	if slot.head != nil {
+		slot.head.prev = t
+	}
+	slot.head = t
+}
+
+func (w *TimingWheel) remove(t *TimingWheelTimer) {
+	if t.prev != nil {
+		t.prev.next = t.next
+	} else {
+		t.slot.head = t.next
+	}
+	if t.next != nil {
+		t.next.prev = t.prev
+	}
+	t.slot = nil
+	t.next = nil
+	t.prev = nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimingWheel(t *testing.T) {
+	newWheel := func() (*TimingWheel, *manualClock) {
+		clock := &manualClock{now: time.Now()}
+		w := NewTimingWheel(TimingWheelParams{
+			Tick:   10 * time.Millisecond,
+			Slots:  64,
+			Levels: 3,
+			Clock:  clock,
+		})
+		t.Cleanup(w.Stop)
+		return w, clock
+	}
+
+	step := func(w *TimingWheel, clock *manualClock, d time.Duration) {
+		clock.Add(d)
+		w.advance(uint64(clock.Now().Sub(w.start) / w.params.Tick))
+	}
+
+	t.Run("fires across levels", func(t *testing.T) {
+		w, clock := newWheel()
+		start := clock.Now()
+
+		var mu sync.Mutex
+		fired := map[time.Duration]time.Duration{}
+		// the last timeout is beyond the span of the wheel
+		timeouts := []time.Duration{
+			5 * time.Millisecond,
+			640 * time.Millisecond,
+			time.Second,
+			time.Minute + 5*time.Millisecond,
+			30 * time.Minute,
+			2 * time.Hour,
+		}
+		for _, d := range timeouts {
+			w.AfterFunc(d, func() {
+				mu.Lock()
+				defer mu.Unlock()
+				fired[d] = clock.Now().Sub(start)
+			})
+		}
+		require.Equal(t, len(timeouts), w.Len())
+
+		for range 2 * time.Hour / w.params.Tick {
+			step(w, clock, w.params.Tick)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, fired, len(timeouts))
+		for d, at := range fired {
+			require.GreaterOrEqual(t, at, d, d)
+			require.Less(t, at, d+w.params.Tick, d)
+		}
+		require.Equal(t, 0, w.Len())
+	})
+
+	t.Run("stop and reset", func(t *testing.T) {
+		w, clock := newWheel()
+
+		var mu sync.Mutex
+		var fired []string
+		fire := func(name string) func() {
+			return func() {
+				mu.Lock()
+				defer mu.Unlock()
+				fired = append(fired, name)
+			}
+		}
+
+		a := w.AfterFunc(100*time.Millisecond, fire("a"))
+		b := w.AfterFunc(100*time.Millisecond, fire("b"))
+		c := w.AfterFunc(100*time.Millisecond, fire("c"))
+		require.True(t, a.Stop())
+		require.False(t, a.Stop())
+		require.True(t, b.Reset(time.Second))
+		require.Equal(t, 2, w.Len())
+
+		step(w, clock, 500*time.Millisecond)
+		mu.Lock()
+		require.Equal(t, []string{"c"}, fired)
+		mu.Unlock()
+		require.False(t, c.Stop())
+
+		step(w, clock, 500*time.Millisecond)
+		mu.Lock()
+		require.Equal(t, []string{"c", "b"}, fired)
+		mu.Unlock()
+
+		// a fired or stopped timer is rescheduled by reset
+		require.False(t, a.Reset(100*time.Millisecond))
+		require.Equal(t, 1, w.Len())
+		step(w, clock, 100*time.Millisecond)
+		mu.Lock()
+		require.Equal(t, []string{"c", "b", "a"}, fired)
+		mu.Unlock()
+	})
+
+	t.Run("system clock", func(t *testing.T) {
+		w := NewTimingWheel(TimingWheelParams{Tick: time.Millisecond})
+		defer w.Stop()
+
+		done := make(chan struct{})
+		w.AfterFunc(20*time.Millisecond, func() { close(done) })
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			require.Fail(t, "timer did not fire")
+		}
+	})
+}
+
+// manualClock avoids the scheduler yields of the mock clock, the tests step through hours of ticks.
+type manualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *manualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *manualClock) Sleep(d time.Duration) {
+	c.Add(d)
+}
+
+func (c *manualClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func BenchmarkTimingWheel(b *testing.B) {
+	w := NewTimingWheel(TimingWheelParams{})
+	defer w.Stop()
+
+	b.ReportAllocs()
+	for range b.N {
+		w.AfterFunc(time.Minute, func() {}).Stop()
+	}
+}
+
+func BenchmarkAfterFunc(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		time.AfterFunc(time.Minute, func() {}).Stop()
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package urlguard validates user supplied URLs before the server connects to them,
+// to prevent them from being used to reach internal services (SSRF).
+package urlguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var (
+	ErrInvalidURL        = errors.New("invalid url")
+	ErrSchemeNotAllowed  = errors.New("url scheme not allowed")
+	ErrHostNotAllowed    = errors.New("url host not allowed")
+	ErrAddressNotAllowed = errors.New("address not allowed")
+)
+
+var DefaultSchemes = []string{"https", "http"}
+
+type Config struct {
+	// schemes accepted by Validate when the caller does not pass its own, defaults to DefaultSchemes
+	AllowedSchemes []string `yaml:"allowed_schemes,omitempty"`
+	// hosts which are allowed regardless of the address they resolve to. entries starting with "*." match subdomains
+	AllowedHosts []string `yaml:"allowed_hosts,omitempty"`
+	// hosts which are always rejected, with the same syntax as AllowedHosts
+	DeniedHosts []string `yaml:"denied_hosts,omitempty"`
+	// ranges which are allowed even though they are private, e.g. a receiver in the same VPC
+	AllowedCIDRs []string `yaml:"allowed_cidrs,omitempty"`
+	// additional ranges to reject
+	DeniedCIDRs []string `yaml:"denied_cidrs,omitempty"`
+	// disables the private, loopback and link local range checks
+	AllowPrivate bool `yaml:"allow_private,omitempty"`
+}
+
+// reserved ranges not covered by the netip.Addr helpers
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// Guard checks URLs and the addresses they connect to.
+// A nil Guard allows everything, so it can be left unset when protection is not needed.
+type Guard struct {
+	config  Config
+	allowed []netip.Prefix
+	denied  []netip.Prefix
+}
+
+func New(config Config) (*Guard, error) {
+	g := &Guard{config: config}
+	for _, c := range config.AllowedCIDRs {
+		p, err := netip.ParsePrefix(c)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed cidr %q: %w", c, err)
+		}
+		g.allowed = append(g.allowed, p.Masked())
+	}
+	for _, c := range config.DeniedCIDRs {
+		p, err := netip.ParsePrefix(c)
+		if err != nil {
+			return nil, fmt.Errorf("invalid denied cidr %q: %w", c, err)
+		}
+		g.denied = append(g.denied, p.Masked())
+	}
+	if len(g.config.AllowedSchemes) == 0 {
+		g.config.AllowedSchemes = DefaultSchemes
+	}
+	return g, nil
+}
+
+// Validate checks the scheme and host of rawURL. When schemes are passed, they replace the configured ones.
+// Host names are not resolved here, connections should go through DialContext or Transport,
+// which check the address actually dialed and are therefore not affected by DNS rebinding.
+func (g *Guard) Validate(rawURL string, schemes ...string) error {
+	if g == nil {
+		return nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidURL, err)
+	}
+	if len(schemes) == 0 {
+		schemes = g.config.AllowedSchemes
+	}
+	if !slices.Contains(schemes, strings.ToLower(u.Scheme)) {
+		return fmt.Errorf("%w: %q", ErrSchemeNotAllowed, u.Scheme)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return fmt.Errorf("%w: missing host", ErrInvalidURL)
+	}
+	return g.CheckHost(host)
+}
+
+// CheckHost checks a host name or IP literal against the configured lists.
+func (g *Guard) CheckHost(host string) error {
+	if g == nil {
+		return nil
+	}
+
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if matchHost(g.config.DeniedHosts, host) {
+		return fmt.Errorf("%w: %s", ErrHostNotAllowed, host)
+	}
+	if matchHost(g.config.AllowedHosts, host) {
+		return nil
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return g.CheckAddr(addr)
+	}
+	if !g.config.AllowPrivate && (host == "localhost" || strings.HasSuffix(host, ".localhost")) {
+		return fmt.Errorf("%w: %s", ErrHostNotAllowed, host)
+	}
+	return nil
+}
+
+// CheckAddr checks an IP address against the denied and private ranges.
+func (g *Guard) CheckAddr(addr netip.Addr) error {
+	if g == nil {
+		return nil
+	}
+
+	addr = addr.Unmap()
+	for _, p := range g.allowed {
+		if p.Contains(addr) {
+			return nil
+		}
+	}
+	for _, p := range g.denied {
+		if p.Contains(addr) {
+			return fmt.Errorf("%w: %s", ErrAddressNotAllowed, addr)
+		}
+	}
+	if !g.config.AllowPrivate && isPrivate(addr) {
+		return fmt.Errorf("%w: %s", ErrAddressNotAllowed, addr)
+	}
+	return nil
+}
+
+// DialContext dials like net.Dialer, rejecting connections to addresses not allowed by the guard.
+// The check runs on the resolved address right before connecting.
+func (g *Guard) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	return g.Dialer(&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}).DialContext(ctx, network, address)
+}
+
+// Dialer returns a copy of d which rejects connections to addresses not allowed by the guard.
+func (g *Guard) Dialer(d *net.Dialer) *net.Dialer {
+	dialer := *d
+	if g == nil {
+		return &dialer
+	}
+
+	check := func(address string) error {
+		ap, err := netip.ParseAddrPort(address)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrAddressNotAllowed, address)
+		}
+		return g.CheckAddr(ap.Addr())
+	}
+
+	// net.Dialer ignores Control when ControlContext is set, so wrap whichever hook is in use
+	if controlContext := d.ControlContext; controlContext != nil {
+		dialer.ControlContext = func(ctx context.Context, network, address string, c syscall.RawConn) error {
+			if err := check(address); err != nil {
+				return err
+			}
+			return controlContext(ctx, network, address, c)
+		}
+		return &dialer
+	}
+
+	control := d.Control
+	dialer.Control = func(network, address string, c syscall.RawConn) error {
+		if err := check(address); err != nil {
+			return err
+		}
+		if control != nil {
+			return control(network, address, c)
+		}
+		return nil
+	}
+	return &dialer
+}
+
+// Transport returns an http.Transport dialing through the guard.
+// Proxies are disabled, since the guard would only see the address of the proxy.
+func (g *Guard) Transport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = nil
+	t.DialContext = g.DialContext
+	return t
+}
+
+func matchHost(patterns []string, host string) bool {
+	for _, p := range patterns {
+		p = strings.ToLower(p)
+		if suffix, ok := strings.CutPrefix(p, "*"); ok {
+			if strings.HasSuffix(host, suffix) {
+				return true
+			}
+		} else if p == host {
+			return true
+		}
+	}
+	return false
+}
+
+func isPrivate(addr netip.Addr) bool {
+	if addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified() {
+		return true
+	}
+	for _, p := range reservedPrefixes {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package urlguard
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuard(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		g, err := New(Config{
+			AllowedHosts: []string{"internal.example.com"},
+			DeniedHosts:  []string{"*.blocked.com"},
+			DeniedCIDRs:  []string{"203.0.113.0/24"},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, g.Validate("https://example.com/hook"))
+		require.NoError(t, g.Validate("http://8.8.8.8:8080"))
+		require.NoError(t, g.Validate("http://internal.example.com"))
+		require.NoError(t, g.Validate("rtmp://live.example.com/app/key", "rtmp", "rtmps"))
+
+		require.ErrorIs(t, g.Validate("ftp://example.com"), ErrSchemeNotAllowed)
+		require.ErrorIs(t, g.Validate("https://example.com", "rtmp"), ErrSchemeNotAllowed)
+		require.ErrorIs(t, g.Validate("https://"), ErrInvalidURL)
+		require.ErrorIs(t, g.Validate("https://a.blocked.com"), ErrHostNotAllowed)
+		require.ErrorIs(t, g.Validate("http://localhost:7880"), ErrHostNotAllowed)
+		require.ErrorIs(t, g.Validate("http://LOCALHOST."), ErrHostNotAllowed)
+		require.ErrorIs(t, g.Validate("http://203.0.113.7"), ErrAddressNotAllowed)
+
+		for _, u := range []string{
+			"http://127.0.0.1",
+			"http://10.1.2.3",
+			"http://172.16.0.1",
+			"http://192.168.1.1",
+			"http://169.254.169.254/latest/meta-data",
+			"http://100.64.0.1",
+			"http://0.0.0.0",
+			"http://[::1]",
+			"http://[fd00::1]",
+			"http://[fe80::1]",
+			"http://[::ffff:127.0.0.1]",
+		} {
+			require.ErrorIs(t, g.Validate(u), ErrAddressNotAllowed, u)
+		}
+	})
+
+	t.Run("allowed ranges", func(t *testing.T) {
+		g, err := New(Config{AllowedCIDRs: []string{"10.0.0.0/16"}})
+		require.NoError(t, err)
+		require.NoError(t, g.CheckAddr(netip.MustParseAddr("10.0.1.1")))
+		require.ErrorIs(t, g.CheckAddr(netip.MustParseAddr("10.1.0.1")), ErrAddressNotAllowed)
+
+		g, err = New(Config{AllowPrivate: true})
+		require.NoError(t, err)
+		require.NoError(t, g.Validate("http://localhost"))
+		require.NoError(t, g.Validate("http://10.1.0.1"))
+
+		_, err = New(Config{DeniedCIDRs: []string{"nope"}})
+		require.Error(t, err)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var g *Guard
+		require.NoError(t, g.Validate("gopher://127.0.0.1"))
+		require.NoError(t, g.CheckAddr(netip.MustParseAddr("127.0.0.1")))
+	})
+
+	t.Run("dialer", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer l.Close()
+
+		g, err := New(Config{})
+		require.NoError(t, err)
+		_, err = g.DialContext(context.Background(), "tcp", l.Addr().String())
+		require.ErrorIs(t, err, ErrAddressNotAllowed)
+
+		g, err = New(Config{AllowedCIDRs: []string{"127.0.0.1/32"}})
+		require.NoError(t, err)
+		conn, err := g.DialContext(context.Background(), "tcp", l.Addr().String())
+		require.NoError(t, err)
+		_ = conn.Close()
+
+		// hooks of the caller still run after the check
+		var called int
+		d := g.Dialer(&net.Dialer{
+			ControlContext: func(ctx context.Context, network, address string, c syscall.RawConn) error {
+				called++
+				return nil
+			},
+		})
+		conn, err = d.DialContext(context.Background(), "tcp", l.Addr().String())
+		require.NoError(t, err)
+		_ = conn.Close()
+		require.Equal(t, 1, called)
+
+		g, err = New(Config{})
+		require.NoError(t, err)
+		d = g.Dialer(d)
+		_, err = d.DialContext(context.Background(), "tcp", l.Addr().String())
+		require.ErrorIs(t, err, ErrAddressNotAllowed)
+		require.Equal(t, 1, called)
+	})
+}
@@ -14,17 +14,27 @@
 
 package utils
 
-import "sync"
+import (
+	"sync"
+	"time"
+)
 
 type WorkerGroup struct {
+	// when set, receives the processing time of workers
+	Metrics QueueMetrics
+
 	wg sync.WaitGroup
 }
 
 func (w *WorkerGroup) Go(fn func()) {
 	w.wg.Add(1)
 	go func() {
+		defer w.wg.Done()
+		if w.Metrics != nil {
+			start := time.Now()
+			defer func() { w.Metrics.JobProcessed(time.Since(start)) }()
+		}
 		fn()
-		w.wg.Done()
 	}()
 }
 
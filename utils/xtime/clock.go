@@ -0,0 +1,118 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xtime
+
+import (
+	"sync"
+	"time"
+
+	"github.com/livekit/protocol/utils"
+	"github.com/livekit/protocol/utils/mono"
+)
+
+// MonoClock is a wall clock that never goes backwards, it is not affected by adjustments of the system clock
+// after the process started. Times can be adjusted to a reference clock with a SkewEstimator.
+type MonoClock struct {
+	// estimates the offset to the reference clock, or nil to use the local clock
+	Skew *SkewEstimator
+}
+
+var _ utils.Clock = (*MonoClock)(nil)
+
+func (c *MonoClock) Now() time.Time {
+	now := mono.Now()
+	if c.Skew != nil {
+		now = c.Skew.ToReference(now)
+	}
+	return now
+}
+
+func (c *MonoClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+const DefaultSkewSamples = 8
+
+type skewSample struct {
+	offset time.Duration
+	rtt    time.Duration
+}
+
+// SkewEstimator estimates the offset of a reference clock, e.g. the clock of a server, from exchanges
+// timestamped by both clocks like NTP. The offset of the exchange with the lowest round trip time
+// among the recent ones is used, as it is the least affected by network delays.
+type SkewEstimator struct {
+	size int
+
+	mu      sync.Mutex
+	samples []skewSample
+	next    int
+}
+
+// NewSkewEstimator returns an estimator using the last size exchanges, DefaultSkewSamples when size is zero.
+func NewSkewEstimator(size int) *SkewEstimator {
+	if size <= 0 {
+		size = DefaultSkewSamples
+	}
+	return &SkewEstimator{size: size}
+}
+
+// AddSample adds an exchange sent at localSent and answered at localReceived, by the local clock,
+// and timestamped at reference by the reference clock.
+func (e *SkewEstimator) AddSample(localSent, reference, localReceived time.Time) {
+	rtt := localReceived.Sub(localSent)
+	if rtt < 0 {
+		return
+	}
+	s := skewSample{
+		// assumes symmetric network delays
+		offset: reference.Sub(localSent.Add(rtt / 2)),
+		rtt:    rtt,
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.samples) < e.size {
+		e.samples = append(e.samples, s)
+	} else {
+		e.samples[e.next] = s
+		e.next = (e.next + 1) % e.size
+	}
+}
+
+// Offset returns the estimated offset of the reference clock, and the round trip time of the exchange
+// it was measured with, which bounds its error. It returns false without samples.
+func (e *SkewEstimator) Offset() (offset, rtt time.Duration, ok bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i, s := range e.samples {
+		if i == 0 || s.rtt < rtt {
+			offset, rtt = s.offset, s.rtt
+		}
+	}
+	return offset, rtt, len(e.samples) != 0
+}
+
+// ToReference converts a local time to the reference clock.
+func (e *SkewEstimator) ToReference(t time.Time) time.Time {
+	offset, _, _ := e.Offset()
+	return t.Add(offset)
+}
+
+// FromReference converts a time of the reference clock to the local clock.
+func (e *SkewEstimator) FromReference(t time.Time) time.Time {
+	offset, _, _ := e.Offset()
+	return t.Add(-offset)
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package xtime converts the unix timestamps of protos, estimates clock skew, and provides a monotonic wall clock.
+package xtime
+
+import (
+	"fmt"
+	"time"
+)
+
+// Unit is the unit of a unix timestamp. Protos use seconds (e.g. WebhookEvent.CreatedAt),
+// milliseconds and nanoseconds, the unit of a field is documented in its proto.
+type Unit int
+
+const (
+	Seconds Unit = iota
+	Milliseconds
+	Microseconds
+	Nanoseconds
+)
+
+func (u Unit) String() string {
+	switch u {
+	case Seconds:
+		return "s"
+	case Milliseconds:
+		return "ms"
+	case Microseconds:
+		return "us"
+	case Nanoseconds:
+		return "ns"
+	default:
+		return fmt.Sprintf("Unit(%d)", int(u))
+	}
+}
+
+// Duration returns the duration of one unit.
+func (u Unit) Duration() time.Duration {
+	switch u {
+	case Seconds:
+		return time.Second
+	case Milliseconds:
+		return time.Millisecond
+	case Microseconds:
+		return time.Microsecond
+	default:
+		return time.Nanosecond
+	}
+}
+
+// FromUnix returns the time of a unix timestamp in the unit. Zero timestamps, unset in protos, return the zero time.
+func FromUnix(v int64, unit Unit) time.Time {
+	if v == 0 {
+		return time.Time{}
+	}
+	switch unit {
+	case Seconds:
+		return time.Unix(v, 0)
+	case Milliseconds:
+		return time.UnixMilli(v)
+	case Microseconds:
+		return time.UnixMicro(v)
+	default:
+		return time.Unix(0, v)
+	}
+}
+
+// ToUnix returns the unix timestamp of t in the unit, truncated. The zero time returns 0.
+func ToUnix(t time.Time, unit Unit) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	switch unit {
+	case Seconds:
+		return t.Unix()
+	case Milliseconds:
+		return t.UnixMilli()
+	case Microseconds:
+		return t.UnixMicro()
+	default:
+		return t.UnixNano()
+	}
+}
+
+// Convert converts a unix timestamp between units, truncating when converting to a coarser unit.
+func Convert(v int64, from, to Unit) int64 {
+	switch {
+	case from == to:
+		return v
+	case from < to:
+		return v * int64(from.Duration()/to.Duration())
+	default:
+		return v / int64(to.Duration()/from.Duration())
+	}
+}
+
+// GuessUnit infers the unit of a unix timestamp from its magnitude, for timestamps between 1973 and 2286.
+// It is meant to detect unit mistakes, the unit of a field should be known.
+func GuessUnit(v int64) Unit {
+	if v < 0 {
+		v = -v
+	}
+	switch {
+	case v < 1e11:
+		return Seconds
+	case v < 1e14:
+		return Milliseconds
+	case v < 1e17:
+		return Microseconds
+	default:
+		return Nanoseconds
+	}
+}
+
+func FromUnixSeconds(v int64) time.Time { return FromUnix(v, Seconds) }
+func FromUnixMillis(v int64) time.Time  { return FromUnix(v, Milliseconds) }
+func FromUnixNanos(v int64) time.Time   { return FromUnix(v, Nanoseconds) }
+
+func ToUnixSeconds(t time.Time) int64 { return ToUnix(t, Seconds) }
+func ToUnixMillis(t time.Time) int64  { return ToUnix(t, Milliseconds) }
+func ToUnixNanos(t time.Time) int64   { return ToUnix(t, Nanoseconds) }
@@ -0,0 +1,74 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnix(t *testing.T) {
+	ts := time.Date(2025, 3, 1, 12, 0, 0, 123456789, time.UTC)
+
+	require.Equal(t, ts.Unix(), ToUnixSeconds(ts))
+	require.Equal(t, ts.UnixMilli(), ToUnixMillis(ts))
+	require.Equal(t, ts.UnixNano(), ToUnixNanos(ts))
+	require.Zero(t, ToUnixMillis(time.Time{}))
+
+	require.True(t, FromUnixMillis(ts.UnixMilli()).Equal(ts.Truncate(time.Millisecond)))
+	require.True(t, FromUnix(ts.UnixMicro(), Microseconds).Equal(ts.Truncate(time.Microsecond)))
+	require.True(t, FromUnixSeconds(0).IsZero())
+
+	require.Equal(t, ts.UnixMilli(), Convert(ts.UnixNano(), Nanoseconds, Milliseconds))
+	require.Equal(t, ts.Unix()*1e9, Convert(ts.Unix(), Seconds, Nanoseconds))
+	require.Equal(t, int64(42), Convert(42, Microseconds, Microseconds))
+
+	for _, unit := range []Unit{Seconds, Milliseconds, Microseconds, Nanoseconds} {
+		require.Equal(t, unit, GuessUnit(ToUnix(ts, unit)), unit.String())
+	}
+}
+
+func TestSkewEstimator(t *testing.T) {
+	e := NewSkewEstimator(2)
+	_, _, ok := e.Offset()
+	require.False(t, ok)
+
+	local := time.Unix(1000, 0)
+	// the reference is 5s ahead, with 100ms to reach it and 300ms to answer
+	e.AddSample(local, local.Add(5*time.Second+100*time.Millisecond), local.Add(400*time.Millisecond))
+	offset, rtt, ok := e.Offset()
+	require.True(t, ok)
+	require.Equal(t, 400*time.Millisecond, rtt)
+	require.Equal(t, 4900*time.Millisecond, offset)
+
+	// a faster exchange is more accurate
+	e.AddSample(local, local.Add(5*time.Second+10*time.Millisecond), local.Add(20*time.Millisecond))
+	offset, rtt, _ = e.Offset()
+	require.Equal(t, 20*time.Millisecond, rtt)
+	require.Equal(t, 5*time.Second, offset)
+	require.Equal(t, local.Add(5*time.Second), e.ToReference(local))
+	require.Equal(t, local, e.FromReference(local.Add(5*time.Second)))
+
+	// old samples are replaced
+	e.AddSample(local, local.Add(time.Second+50*time.Millisecond), local.Add(100*time.Millisecond))
+	e.AddSample(local, local.Add(time.Second+50*time.Millisecond), local.Add(100*time.Millisecond))
+	offset, _, _ = e.Offset()
+	require.Equal(t, time.Second, offset)
+
+	c := &MonoClock{Skew: e}
+	require.WithinDuration(t, time.Now().Add(time.Second), c.Now(), 100*time.Millisecond)
+}
@@ -0,0 +1,226 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+)
+
+// brokerMessage is an encoded webhook event, ready to be published to a message broker.
+type brokerMessage struct {
+	Payload []byte
+	// signed token for the payload, empty when no API key is set
+	Token string
+}
+
+type brokerPublisher interface {
+	Publish(ctx context.Context, event *livekit.WebhookEvent, msg *brokerMessage) error
+}
+
+type brokerNotifierParams struct {
+	Logger logger.Logger
+	Config URLNotifierConfig
+	// identifies the destination in logs and WebhookInfo, e.g. kafka://topic
+	Destination string
+	APIKey      string
+	APISecret   string
+	FieldsHook  func(whi *livekit.WebhookInfo)
+	// serializes the message payload, defaults to ProtoJSONEncoder
+	Encoder Encoder
+	FilterParams
+	DedupeParams
+	JournalParams
+	ProvenanceParams
+}
+
+// brokerNotifier is a QueuedNotifier publishing events to a message broker.
+// It shares queueing, filtering and processed hook semantics with URLNotifier.
+type brokerNotifier struct {
+	mu            sync.RWMutex
+	params        brokerNotifierParams
+	publisher     brokerPublisher
+	queue         *deliveryQueue
+	processedHook func(ctx context.Context, whi *livekit.WebhookInfo)
+	filter        *filter
+	deduper       *deduper
+	journaler     *journaler
+	canary        *canary
+}
+
+func newBrokerNotifier(params brokerNotifierParams, publisher brokerPublisher) *brokerNotifier {
+	if params.Config.NumWorkers == 0 {
+		params.Config.NumWorkers = DefaultURLNotifierConfig.NumWorkers
+	}
+	if params.Config.QueueSize == 0 {
+		params.Config.QueueSize = DefaultURLNotifierConfig.QueueSize
+	}
+	if params.Config.Priorities == nil {
+		params.Config.Priorities = DefaultEventPriorities
+	}
+	if params.Logger == nil {
+		params.Logger = logger.GetLogger()
+	}
+
+	n := &brokerNotifier{
+		params:    params,
+		publisher: publisher,
+		filter:    newFilter(params.FilterParams),
+		deduper:   newDeduper(params.DedupeParams, params.Destination),
+		journaler: newJournaler(params.JournalParams, params.Destination),
+	}
+	n.queue = newDeliveryQueue(deliveryQueueParams{
+		Logger:      params.Logger,
+		Config:      params.Config,
+		Destination: params.Destination,
+		Deduper:     n.deduper,
+		Send:        n.publish,
+		Processed:   n.processed,
+	})
+	n.canary = n.newCanary()
+	return n
+}
+
+func (n *brokerNotifier) SetKeys(apiKey, apiSecret string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.params.APIKey = apiKey
+	n.params.APISecret = apiSecret
+}
+
+func (n *brokerNotifier) SetFilter(params FilterParams) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.filter.SetFilter(params)
+}
+
+func (n *brokerNotifier) RegisterProcessedHook(hook func(ctx context.Context, whi *livekit.WebhookInfo)) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.processedHook = hook
+}
+
+func (n *brokerNotifier) getProcessedHook() func(ctx context.Context, whi *livekit.WebhookInfo) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.processedHook
+}
+
+func (n *brokerNotifier) QueueNotify(ctx context.Context, event *livekit.WebhookEvent) error {
+	event = syncedEvent(event)
+	if !n.filter.IsAllowed(event) {
+		return nil
+	}
+	event = n.params.stampProvenance(event)
+
+	if n.deduper.IsDuplicate(ctx, event) {
+		n.params.Logger.Debugw("skipped duplicate webhook", logFields(event, n.params.Destination)...)
+		return nil
+	}
+
+	n.queue.Enqueue(ctx, event, true)
+	return nil
+}
+
+// Replay re-enqueues journaled events created between from and to which pass the filter.
+// Events are replayed once each, regardless of the number of recorded delivery attempts.
+// It returns the number of replayed events.
+func (n *brokerNotifier) Replay(ctx context.Context, from, to time.Time, params FilterParams) (int, error) {
+	events, err := n.journaler.Events(ctx, from, to, newFilter(params))
+	if err != nil {
+		return 0, err
+	}
+	for _, event := range events {
+		n.queue.Enqueue(ctx, event, false)
+	}
+	return len(events), nil
+}
+
+func (n *brokerNotifier) Stop(force bool) {
+	n.canary.Stop()
+	n.queue.Stop(force)
+}
+
+// StopContext drains queued events until ctx is done. Deliveries still pending at that point,
+// including ones in flight, are abandoned. It returns the number of abandoned events.
+func (n *brokerNotifier) StopContext(ctx context.Context) int {
+	n.canary.Stop()
+	return n.queue.StopContext(ctx)
+}
+
+func (n *brokerNotifier) publish(ctx context.Context, event *livekit.WebhookEvent) error {
+	// set dropped count
+	setNumDropped(event, n.queue.dropped.Swap(0))
+	return n.publishMessage(ctx, event)
+}
+
+// publishMessage encodes, signs and publishes the event.
+func (n *brokerNotifier) publishMessage(ctx context.Context, event *livekit.WebhookEvent) error {
+	encoded, _, err := marshalEvent(n.params.Encoder, event)
+	if err != nil {
+		return err
+	}
+	msg := &brokerMessage{Payload: encoded}
+
+	n.mu.RLock()
+	apiKey := n.params.APIKey
+	apiSecret := n.params.APISecret
+	n.mu.RUnlock()
+
+	if apiKey != "" {
+		if msg.Token, err = signPayload(encoded, SigningKey{APIKey: apiKey, APISecret: apiSecret}); err != nil {
+			return err
+		}
+	}
+	return n.publisher.Publish(ctx, event, msg)
+}
+
+func (n *brokerNotifier) processed(
+	ctx context.Context,
+	event *livekit.WebhookEvent,
+	queuedAt time.Time,
+	queueDuration time.Duration,
+	sentAt time.Time,
+	sendDuration time.Duration,
+	isDropped bool,
+	sendError error,
+) {
+	ph := n.getProcessedHook()
+	if ph == nil && !n.journaler.Enabled() {
+		return
+	}
+
+	whi := webhookInfo(
+		event,
+		queuedAt,
+		queueDuration,
+		sentAt,
+		sendDuration,
+		n.params.Destination,
+		isDropped,
+		sendError,
+	)
+	if n.params.FieldsHook != nil {
+		n.params.FieldsHook(whi)
+	}
+	n.journaler.Record(ctx, event, whi)
+	if ph != nil {
+		ph(ctx, whi)
+	}
+}
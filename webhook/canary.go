@@ -0,0 +1,265 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/frostbyte73/core"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/utils/guid"
+)
+
+const canaryTimeout = 10 * time.Second
+
+// CanaryStats reports the outcome of the webhook_canary events sent to a destination.
+type CanaryStats struct {
+	Sent        int64
+	Failed      int64
+	LastSentAt  time.Time
+	LastSuccess time.Time
+	LastLatency time.Duration
+	LastError   error
+}
+
+// Healthy returns true if the latest canary was delivered.
+func (s CanaryStats) Healthy() bool {
+	return s.Sent > 0 && s.LastError == nil
+}
+
+// HealthChecker is implemented by notifiers which report whether they can deliver events.
+type HealthChecker interface {
+	HealthCheck(ctx context.Context) error
+}
+
+type canaryParams struct {
+	Logger logger.Logger
+	// identifies the destination in logs
+	Destination string
+	// no canary is sent when zero
+	Interval time.Duration
+	// delivers the event synchronously, returning the send latency when it is known
+	Send func(ctx context.Context, event *livekit.WebhookEvent) (time.Duration, error)
+	// reports the result of a canary
+	Processed func(ctx context.Context, event *livekit.WebhookEvent, sentAt time.Time, latency time.Duration, err error)
+	// canceled when pending deliveries are abandoned, aborting a canary in flight
+	AbandonCtx context.Context
+}
+
+// canary periodically sends a signed webhook_canary event to a notifier destination, bypassing its queue
+// and filters, and records the results.
+type canary struct {
+	params canaryParams
+
+	mu    sync.RWMutex
+	stats CanaryStats
+	done  core.Fuse
+}
+
+func newCanary(params canaryParams) *canary {
+	c := &canary{params: params}
+	if params.Interval > 0 {
+		go c.run()
+	}
+	return c
+}
+
+func (c *canary) Stats() CanaryStats {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.stats
+}
+
+func (c *canary) Stop() {
+	c.done.Break()
+}
+
+// HealthCheck returns an error when stopped is set or, when an interval is set,
+// the latest canary could not be delivered.
+func (c *canary) HealthCheck(stopped bool) error {
+	if stopped {
+		return ErrNotifierStopped
+	}
+	if c.params.Interval <= 0 {
+		return nil
+	}
+	stats := c.Stats()
+	if stats.Sent == 0 || stats.Healthy() {
+		return nil
+	}
+	return fmt.Errorf("%w: %w", ErrCanaryFailed, stats.LastError)
+}
+
+func (c *canary) run() {
+	ticker := time.NewTicker(c.params.Interval)
+	defer ticker.Stop()
+
+	done := c.done.Watch()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			c.send()
+		}
+	}
+}
+
+func (c *canary) send() {
+	ctx, cancel := context.WithTimeout(c.params.AbandonCtx, canaryTimeout)
+	defer cancel()
+
+	event := &livekit.WebhookEvent{
+		Event: EventWebhookCanary,
+		Id:    guid.New(guid.WebhookEventPrefix),
+	}
+	sentAt := time.Now()
+	event.SetCreatedAt(sentAt)
+
+	latency, err := c.params.Send(ctx, event)
+	if latency == 0 {
+		latency = time.Since(sentAt)
+	}
+
+	c.mu.Lock()
+	c.stats.Sent++
+	c.stats.LastSentAt = sentAt
+	c.stats.LastLatency = latency
+	c.stats.LastError = err
+	if err != nil {
+		c.stats.Failed++
+	} else {
+		c.stats.LastSuccess = sentAt
+	}
+	c.mu.Unlock()
+
+	if err != nil {
+		c.params.Logger.Warnw("webhook canary failed", err, logFields(event, c.params.Destination)...)
+	}
+	c.params.Processed(ctx, event, sentAt, latency, err)
+}
+
+// ---------------------------------
+
+// CanaryStats returns the canary results, when CanaryInterval is set.
+func (n *URLNotifier) CanaryStats() CanaryStats {
+	return n.canary.Stats()
+}
+
+// HealthCheck returns an error when the notifier has been stopped or, when CanaryInterval is set,
+// the latest canary could not be delivered.
+func (n *URLNotifier) HealthCheck(ctx context.Context) error {
+	return n.canary.HealthCheck(n.queue.Stopped())
+}
+
+func (n *URLNotifier) newCanary() *canary {
+	return newCanary(canaryParams{
+		Logger:      n.params.Logger,
+		Destination: n.params.URL,
+		Interval:    n.params.Config.CanaryInterval,
+		Send: func(ctx context.Context, event *livekit.WebhookEvent) (time.Duration, error) {
+			res, err := n.sendSync(ctx, event)
+			if res != nil {
+				return res.Latency, err
+			}
+			return 0, err
+		},
+		Processed: func(ctx context.Context, event *livekit.WebhookEvent, sentAt time.Time, latency time.Duration, err error) {
+			n.processed(ctx, event, time.Time{}, 0, sentAt, latency, false, err)
+		},
+		AbandonCtx: n.queue.pending.ctx,
+	})
+}
+
+// CanaryStats returns the canary results, when CanaryInterval is set.
+func (r *ResourceURLNotifier) CanaryStats() CanaryStats {
+	return r.canary.Stats()
+}
+
+// HealthCheck returns an error when the notifier has been stopped or, when CanaryInterval is set,
+// the latest canary could not be delivered.
+func (r *ResourceURLNotifier) HealthCheck(ctx context.Context) error {
+	return r.canary.HealthCheck(r.closed.IsBroken())
+}
+
+func (r *ResourceURLNotifier) newCanary() *canary {
+	return newCanary(canaryParams{
+		Logger:      r.params.Logger,
+		Destination: r.params.URL,
+		Interval:    r.params.Config.CanaryInterval,
+		Send: func(ctx context.Context, event *livekit.WebhookEvent) (time.Duration, error) {
+			return 0, r.send(ctx, event)
+		},
+		Processed: func(ctx context.Context, event *livekit.WebhookEvent, sentAt time.Time, latency time.Duration, err error) {
+			r.processed(ctx, event, time.Time{}, 0, sentAt, latency, false, err)
+		},
+		AbandonCtx: r.pending.ctx,
+	})
+}
+
+// CanaryStats returns the canary results, when CanaryInterval is set.
+func (n *brokerNotifier) CanaryStats() CanaryStats {
+	return n.canary.Stats()
+}
+
+// HealthCheck returns an error when the notifier has been stopped or, when CanaryInterval is set,
+// the latest canary could not be published.
+func (n *brokerNotifier) HealthCheck(ctx context.Context) error {
+	return n.canary.HealthCheck(n.queue.Stopped())
+}
+
+func (n *brokerNotifier) newCanary() *canary {
+	return newCanary(canaryParams{
+		Logger:      n.params.Logger,
+		Destination: n.params.Destination,
+		Interval:    n.params.Config.CanaryInterval,
+		Send: func(ctx context.Context, event *livekit.WebhookEvent) (time.Duration, error) {
+			return 0, n.publishMessage(ctx, event)
+		},
+		Processed: func(ctx context.Context, event *livekit.WebhookEvent, sentAt time.Time, latency time.Duration, err error) {
+			n.processed(ctx, event, time.Time{}, 0, sentAt, latency, false, err)
+		},
+		AbandonCtx: n.queue.pending.ctx,
+	})
+}
+
+// CanaryStats returns the canary results, when CanaryInterval is set.
+func (n *GRPCNotifier) CanaryStats() CanaryStats {
+	return n.canary.Stats()
+}
+
+// HealthCheck returns an error when the notifier has been stopped or, when CanaryInterval is set,
+// the latest canary was not acked by the receiver.
+func (n *GRPCNotifier) HealthCheck(ctx context.Context) error {
+	return n.canary.HealthCheck(n.closing.IsBroken())
+}
+
+func (n *GRPCNotifier) newCanary() *canary {
+	return newCanary(canaryParams{
+		Logger:      n.params.Logger,
+		Destination: n.params.Target,
+		Interval:    n.params.Config.CanaryInterval,
+		Send:        n.sendCanary,
+		// the delivery reports the canary to the processed hook once acked or dropped
+		Processed: func(context.Context, *livekit.WebhookEvent, time.Time, time.Duration, error) {},
+		// the canary is dropped together with the queue when killed
+		AbandonCtx: context.Background(),
+	})
+}
@@ -17,23 +17,38 @@ package webhook
 import "errors"
 
 var (
-	ErrNoAuthHeader    = errors.New("authorization header could not be found")
-	ErrSecretNotFound  = errors.New("API secret could not be found")
-	ErrInvalidChecksum = errors.New("could not verify authenticity of message")
+	ErrNoAuthHeader       = errors.New("authorization header could not be found")
+	ErrSecretNotFound     = errors.New("API secret could not be found")
+	ErrInvalidChecksum    = errors.New("could not verify authenticity of message")
+	ErrUnexpectedStatus   = errors.New("unexpected response status")
+	ErrSigningKeyNotFound = errors.New("signing key could not be found")
+	ErrNotifierStopped    = errors.New("notifier is stopped")
+	ErrCanaryFailed       = errors.New("webhook canary failed")
 )
 
 const authHeader = "Authorization"
 
 const (
-	EventRoomStarted       = "room_started"
-	EventRoomFinished      = "room_finished"
-	EventParticipantJoined = "participant_joined"
-	EventParticipantLeft   = "participant_left"
-	EventTrackPublished    = "track_published"
-	EventTrackUnpublished  = "track_unpublished"
-	EventEgressStarted     = "egress_started"
-	EventEgressUpdated     = "egress_updated"
-	EventEgressEnded       = "egress_ended"
-	EventIngressStarted    = "ingress_started"
-	EventIngressEnded      = "ingress_ended"
+	EventRoomStarted            = "room_started"
+	EventRoomFinished           = "room_finished"
+	EventParticipantJoined      = "participant_joined"
+	EventParticipantLeft        = "participant_left"
+	EventParticipantReconnected = "participant_reconnected"
+	EventParticipantMoved       = "participant_moved"
+	EventTrackPublished         = "track_published"
+	EventTrackUnpublished       = "track_unpublished"
+	EventTrackMuted             = "track_muted"
+	EventTrackUnmuted           = "track_unmuted"
+	EventEgressStarted          = "egress_started"
+	EventEgressUpdated          = "egress_updated"
+	EventEgressEnded            = "egress_ended"
+	EventIngressStarted         = "ingress_started"
+	EventIngressEnded           = "ingress_ended"
+	EventSIPCallStarted         = "sip_call_started"
+	EventSIPCallEnded           = "sip_call_ended"
+	EventSIPCallFailed          = "sip_call_failed"
+	EventAgentJobStarted        = "agent_job_started"
+	EventAgentJobEnded          = "agent_job_ended"
+	EventWebhookTest            = "webhook_test"
+	EventWebhookCanary          = "webhook_canary"
 )
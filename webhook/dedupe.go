@@ -0,0 +1,160 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/utils"
+)
+
+const (
+	defaultDedupeTTL = 5 * time.Minute
+	// keys beyond this are forgotten before their ttl, least recently set first
+	memoryDedupeMaxSize = 100_000
+)
+
+// DedupeStore records keys of webhook events that have already been queued.
+type DedupeStore interface {
+	// SetIfNotExists records the key for the ttl and reports whether it was not recorded already.
+	SetIfNotExists(ctx context.Context, key string, ttl time.Duration) (bool, error)
+	// Delete forgets the key, so that it can be recorded again.
+	Delete(ctx context.Context, key string) error
+}
+
+type DedupeParams struct {
+	// when set, events with an ID that was queued within DedupeTTL are dropped
+	DedupeStore DedupeStore
+	DedupeTTL   time.Duration
+}
+
+type deduper struct {
+	params DedupeParams
+	url    string
+}
+
+func newDeduper(params DedupeParams, url string) *deduper {
+	if params.DedupeTTL == 0 {
+		params.DedupeTTL = defaultDedupeTTL
+	}
+	return &deduper{
+		params: params,
+		url:    url,
+	}
+}
+
+// IsDuplicate returns true if the event was already seen by this notifier.
+// Store errors are logged and the event is treated as new.
+func (d *deduper) IsDuplicate(ctx context.Context, event *livekit.WebhookEvent) bool {
+	if d.params.DedupeStore == nil || event.Id == "" {
+		return false
+	}
+
+	ok, err := d.params.DedupeStore.SetIfNotExists(ctx, d.key(event), d.params.DedupeTTL)
+	if err != nil {
+		logger.Warnw("webhook dedupe failed", err, "event", event.Event, "id", event.Id)
+		return false
+	}
+	return !ok
+}
+
+// Forget removes the event recorded by IsDuplicate, so that a dropped event can be queued again.
+func (d *deduper) Forget(ctx context.Context, event *livekit.WebhookEvent) {
+	if d.params.DedupeStore == nil || event.Id == "" {
+		return
+	}
+
+	// the event may be dropped after the caller has gone away
+	if err := d.params.DedupeStore.Delete(context.WithoutCancel(ctx), d.key(event)); err != nil {
+		logger.Warnw("webhook dedupe forget failed", err, "event", event.Event, "id", event.Id)
+	}
+}
+
+// events are delivered to every URL, so keys are scoped per notifier
+func (d *deduper) key(event *livekit.WebhookEvent) string {
+	return d.url + "|" + event.Id
+}
+
+// ---------------------------------
+
+type MemoryDedupeStore struct {
+	// serializes the check and set of keys
+	mu        sync.Mutex
+	keys      *utils.LRUCache[string, struct{}]
+	lastPrune time.Time
+}
+
+func NewMemoryDedupeStore() *MemoryDedupeStore {
+	return &MemoryDedupeStore{
+		keys: utils.NewLRUCache(utils.LRUCacheParams[string, struct{}]{
+			MaxSize: memoryDedupeMaxSize,
+		}),
+	}
+}
+
+func (s *MemoryDedupeStore) SetIfNotExists(_ context.Context, key string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// expired keys are only removed when accessed, sweep them at most once per ttl
+	if now.Sub(s.lastPrune) >= ttl {
+		s.keys.Prune()
+		s.lastPrune = now
+	}
+
+	if _, ok := s.keys.Peek(key); ok {
+		return false, nil
+	}
+	s.keys.SetWithTTL(key, struct{}{}, ttl)
+	return true, nil
+}
+
+func (s *MemoryDedupeStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys.Delete(key)
+	return nil
+}
+
+// ---------------------------------
+
+type RedisDedupeStore struct {
+	rc     redis.UniversalClient
+	prefix string
+}
+
+func NewRedisDedupeStore(rc redis.UniversalClient, prefix string) *RedisDedupeStore {
+	return &RedisDedupeStore{
+		rc:     rc,
+		prefix: prefix,
+	}
+}
+
+func (s *RedisDedupeStore) SetIfNotExists(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return s.rc.SetNX(ctx, s.prefix+key, 1, ttl).Result()
+}
+
+func (s *RedisDedupeStore) Delete(ctx context.Context, key string) error {
+	return s.rc.Del(ctx, s.prefix+key).Err()
+}
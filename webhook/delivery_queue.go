@@ -0,0 +1,177 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/utils"
+)
+
+type deliveryQueueParams struct {
+	Logger       logger.Logger
+	Config       URLNotifierConfig
+	QueueMetrics utils.QueueMetrics
+	// identifies the destination in logs
+	Destination string
+	// forgets deduped events which are dropped before being delivered
+	Deduper *deduper
+	Send    func(ctx context.Context, event *livekit.WebhookEvent) error
+	// reports delivered and dropped events
+	Processed func(
+		ctx context.Context,
+		event *livekit.WebhookEvent,
+		queuedAt time.Time,
+		queueDuration time.Duration,
+		sentAt time.Time,
+		sendDuration time.Duration,
+		isDropped bool,
+		sendError error,
+	)
+}
+
+// deliveryQueue delivers events in the background by priority, dropping the ones which are stale
+// by the time they are sent. It is shared by the URL and broker notifiers.
+type deliveryQueue struct {
+	params deliveryQueueParams
+	pool   *priorityPool
+	// submitted events that were not processed yet
+	pending *pendingDeliveries
+	// events dropped since the last delivery, see setNumDropped
+	dropped atomic.Int32
+}
+
+func newDeliveryQueue(params deliveryQueueParams) *deliveryQueue {
+	return &deliveryQueue{
+		params:  params,
+		pool:    newPriorityPool(params.Config.NumWorkers, params.Config.QueueSize, params.Config.MaxHighPriorityOverflow, params.QueueMetrics),
+		pending: newPendingDeliveries(),
+	}
+}
+
+// Enqueue submits the event to the pool. deduped events are forgotten by the deduper when dropped,
+// including when they are abandoned by Stop or StopContext.
+// The event is delivered with the values and deadline of ctx, and dropped once the deadline has passed.
+func (q *deliveryQueue) Enqueue(ctx context.Context, event *livekit.WebhookEvent, deduped bool) {
+	enqueuedAt := time.Now()
+
+	// keep trace values and deadline of the caller, but not its cancellation
+	sendCtx, cancel := detachedContext(ctx)
+
+	forget := func() {
+		if deduped && q.params.Deduper != nil {
+			q.params.Deduper.Forget(ctx, event)
+		}
+	}
+
+	drop := func() {
+		q.pending.Dec()
+		cancel()
+		q.dropped.Inc()
+		forget()
+
+		fields := contextLogFields(ctx, event, q.params.Destination)
+		if q.pending.Abandoned() {
+			fields = append(fields, "reason", "abandoned")
+		}
+		q.params.Logger.Infow("dropped webhook", fields...)
+
+		q.params.Processed(ctx, event, time.Time{}, 0, time.Time{}, 0, true, nil)
+	}
+
+	key := eventKey(event)
+	priority := eventPriority(q.params.Config.Priorities, event.Event)
+	q.pending.Inc()
+	if !q.pool.Submit(key, priority, priorityJob{drop: drop, run: func() {
+		defer q.pending.Dec()
+		defer cancel()
+		defer context.AfterFunc(q.pending.ctx, cancel)()
+
+		fields := contextLogFields(sendCtx, event, q.params.Destination)
+
+		queueDuration := time.Since(enqueuedAt)
+		fields = append(fields, "queueDuration", queueDuration)
+
+		if reason := q.staleReason(sendCtx, queueDuration); reason != "" {
+			q.dropped.Inc()
+			forget()
+
+			fields = append(fields, "reason", reason)
+			q.params.Logger.Infow("dropped webhook", fields...)
+
+			q.params.Processed(sendCtx, event, enqueuedAt, queueDuration, time.Time{}, 0, true, nil)
+			return
+		}
+
+		sendStart := time.Now()
+		err := q.params.Send(sendCtx, event)
+		sendDuration := time.Since(sendStart)
+		fields = append(fields, "sendDuration", sendDuration)
+		if err != nil {
+			q.params.Logger.Warnw("failed to send webhook", err, fields...)
+			q.dropped.Add(event.NumDropped + 1)
+			if q.pending.Abandoned() {
+				// the delivery was interrupted by StopContext
+				forget()
+			}
+		} else {
+			q.params.Logger.Infow("sent webhook", fields...)
+		}
+		q.params.Processed(sendCtx, event, enqueuedAt, queueDuration, sendStart, sendDuration, false, err)
+	}}) {
+		drop()
+	}
+}
+
+func (q *deliveryQueue) staleReason(ctx context.Context, queueDuration time.Duration) string {
+	if q.pending.Abandoned() {
+		return "abandoned"
+	}
+	if q.params.Config.MaxAge > 0 && queueDuration > q.params.Config.MaxAge {
+		return "age"
+	}
+	if ctx.Err() != nil {
+		return "deadline"
+	}
+	return ""
+}
+
+func (q *deliveryQueue) Stop(force bool) {
+	if force {
+		q.pool.Kill()
+	} else {
+		q.pool.Drain()
+	}
+}
+
+// StopContext drains queued events until ctx is done. Deliveries still pending at that point,
+// including ones in flight, are abandoned. It returns the number of abandoned events.
+func (q *deliveryQueue) StopContext(ctx context.Context) int {
+	abandoned := q.pending.StopContext(ctx, q.pool.Drain, q.pool.Kill)
+	if abandoned > 0 {
+		q.params.Logger.Infow("abandoned webhooks", "count", abandoned, "url", q.params.Destination)
+	}
+	return abandoned
+}
+
+// Stopped returns true once the queue no longer accepts events.
+func (q *deliveryQueue) Stopped() bool {
+	return q.pool.Stopped()
+}
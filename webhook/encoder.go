@@ -0,0 +1,63 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+// use a custom mime type to ensure signature is checked prior to parsing
+const defaultContentType = "application/webhook+json"
+
+// Encoder serializes webhook events into request payloads, e.g. to strip fields or use a custom schema.
+// It is used by the URL, broker and gRPC notifiers. The signature is computed over the encoded payload.
+// Receivers of payloads which are not protojson encoded WebhookEvents pass the matching decoder WithDecoder.
+type Encoder interface {
+	Marshal(event *livekit.WebhookEvent) (data []byte, contentType string, err error)
+}
+
+// EncoderFunc adapts a function to an Encoder.
+type EncoderFunc func(event *livekit.WebhookEvent) ([]byte, string, error)
+
+func (f EncoderFunc) Marshal(event *livekit.WebhookEvent) ([]byte, string, error) {
+	return f(event)
+}
+
+// ProtoJSONEncoder is the default Encoder.
+type ProtoJSONEncoder struct{}
+
+func (ProtoJSONEncoder) Marshal(event *livekit.WebhookEvent) ([]byte, string, error) {
+	data, err := protojson.Marshal(event)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, defaultContentType, nil
+}
+
+func marshalEvent(e Encoder, event *livekit.WebhookEvent) ([]byte, string, error) {
+	if e == nil {
+		e = ProtoJSONEncoder{}
+	}
+	data, contentType, err := e.Marshal(event)
+	if err != nil {
+		return nil, "", err
+	}
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+	return data, contentType, nil
+}
@@ -14,32 +14,149 @@
 
 package webhook
 
-import "slices"
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+)
 
 type filter struct {
 	params FilterParams
+
+	includeRooms      []*regexp.Regexp
+	excludeRooms      []*regexp.Regexp
+	includeIdentities []*regexp.Regexp
+	excludeIdentities []*regexp.Regexp
 }
 
 func newFilter(params FilterParams) *filter {
-	return &filter{
-		params: params,
-	}
+	f := &filter{}
+	f.SetFilter(params)
+	return f
 }
 
 func (f *filter) SetFilter(params FilterParams) {
 	f.params = params
+	f.includeRooms = compilePatterns(params.IncludeRooms)
+	f.excludeRooms = compilePatterns(params.ExcludeRooms)
+	f.includeIdentities = compilePatterns(params.IncludeParticipantIdentities)
+	f.excludeIdentities = compilePatterns(params.ExcludeParticipantIdentities)
+}
+
+func (f *filter) IsAllowed(event *livekit.WebhookEvent) bool {
+	if !isAllowed(f.params.IncludeEvents, f.params.ExcludeEvents, event.Event) {
+		return false
+	}
+
+	if roomName := eventRoomName(event); roomName != "" {
+		if !isMatchAllowed(f.includeRooms, f.excludeRooms, roomName) {
+			return false
+		}
+	}
+
+	if event.Participant != nil {
+		if !isMatchAllowed(f.includeIdentities, f.excludeIdentities, event.Participant.Identity) {
+			return false
+		}
+		if !isAllowed(f.params.IncludeParticipantKinds, f.params.ExcludeParticipantKinds, event.Participant.Kind) {
+			return false
+		}
+	}
+
+	return true
 }
 
-func (f *filter) IsAllowed(event string) bool {
+func isAllowed[T comparable](include, exclude []T, v T) bool {
 	// includes get higher precendence than excludes
-	if len(f.params.IncludeEvents) != 0 {
-		return slices.Contains(f.params.IncludeEvents, event)
+	if len(include) != 0 {
+		return slices.Contains(include, v)
 	}
 
-	if len(f.params.ExcludeEvents) != 0 {
-		return !slices.Contains(f.params.ExcludeEvents, event)
+	if len(exclude) != 0 {
+		return !slices.Contains(exclude, v)
 	}
 
 	// default allow
 	return true
 }
+
+func isMatchAllowed(include, exclude []*regexp.Regexp, s string) bool {
+	matches := func(re *regexp.Regexp) bool { return re.MatchString(s) }
+
+	// includes get higher precendence than excludes
+	if len(include) != 0 {
+		return slices.ContainsFunc(include, matches)
+	}
+
+	if len(exclude) != 0 {
+		return !slices.ContainsFunc(exclude, matches)
+	}
+
+	// default allow
+	return true
+}
+
+// compilePatterns converts filter patterns into regular expressions.
+// Patterns wrapped in slashes (e.g. "/^prod-[0-9]+$/") are used as regular expressions,
+// any other pattern is a glob where "*" matches any sequence of characters and "?" matches a single character.
+// Invalid patterns are logged and never match.
+func compilePatterns(patterns []string) []*regexp.Regexp {
+	if len(patterns) == 0 {
+		return nil
+	}
+	res := make([]*regexp.Regexp, 0, len(patterns))
+	for _, p := range patterns {
+		re, err := compilePattern(p)
+		if err != nil {
+			logger.Warnw("invalid webhook filter pattern", err, "pattern", p)
+			re = matchNothing
+		}
+		res = append(res, re)
+	}
+	return res
+}
+
+var matchNothing = regexp.MustCompile(`[^\s\S]`)
+
+func compilePattern(p string) (*regexp.Regexp, error) {
+	if len(p) > 1 && strings.HasPrefix(p, "/") && strings.HasSuffix(p, "/") {
+		return regexp.Compile(p[1 : len(p)-1])
+	}
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	for _, c := range p {
+		switch c {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
+
+func eventRoomName(event *livekit.WebhookEvent) string {
+	if event.Room != nil {
+		return event.Room.Name
+	}
+	if event.EgressInfo != nil {
+		return event.EgressInfo.RoomName
+	}
+	if event.IngressInfo != nil {
+		return event.IngressInfo.RoomName
+	}
+	if event.SipCall != nil {
+		return event.SipCall.RoomName
+	}
+	if event.AgentJob != nil && event.AgentJob.Room != nil {
+		return event.AgentJob.Room.Name
+	}
+	return ""
+}
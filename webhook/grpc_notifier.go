@@ -0,0 +1,539 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/frostbyte73/core"
+	"go.uber.org/atomic"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/rpc"
+)
+
+// number of reconnects attempted while stopping, before pending events are dropped
+const grpcDrainRetries = 3
+
+var (
+	errAckTimeout = errors.New("webhook ack timed out")
+	errDropped    = errors.New("webhook dropped")
+)
+
+type GRPCNotifierConfig struct {
+	QueueSize int `yaml:"queue_size,omitempty"`
+	// maximum number of deliveries waiting for an ack, further events wait in the queue
+	MaxInFlight int `yaml:"max_in_flight,omitempty"`
+	// the stream is reconnected when a delivery is not acked in time, and unacked deliveries are resent
+	AckTimeout       time.Duration `yaml:"ack_timeout,omitempty"`
+	ReconnectBackoff time.Duration `yaml:"reconnect_backoff,omitempty"`
+	// events queued for longer than MaxAge are dropped instead of being delivered late, 0 disables the limit
+	MaxAge time.Duration `yaml:"max_age,omitempty"`
+	// when set, a webhook_canary event is sent at this interval to monitor the receiver, see CanaryStats
+	CanaryInterval time.Duration `yaml:"canary_interval,omitempty"`
+}
+
+var DefaultGRPCNotifierConfig = GRPCNotifierConfig{
+	QueueSize:        100,
+	MaxInFlight:      10,
+	AckTimeout:       10 * time.Second,
+	ReconnectBackoff: time.Second,
+}
+
+type GRPCNotifierParams struct {
+	Logger logger.Logger
+	Config GRPCNotifierConfig
+	Client rpc.WebhookReceiverClient
+	// identifies the receiver in logs and WebhookInfo, e.g. grpc://receiver:9000
+	Target     string
+	APIKey     string
+	APISecret  string
+	FieldsHook func(whi *livekit.WebhookInfo)
+	// serializes the delivery payload, defaults to ProtoJSONEncoder
+	Encoder Encoder
+	FilterParams
+	DedupeParams
+	JournalParams
+	ProvenanceParams
+}
+
+// GRPCNotifier is a QueuedNotifier that pushes events to a WebhookReceiver service over a long-lived stream.
+// Deliveries are acked by the receiver. When the queue is full, QueueNotify blocks until there is room
+// or its context is done, instead of dropping the event.
+// Unacked deliveries are resent after the stream is reconnected, so receivers may see an event more than once.
+type GRPCNotifier struct {
+	mu            sync.RWMutex
+	params        GRPCNotifierParams
+	processedHook func(ctx context.Context, whi *livekit.WebhookInfo)
+	filter        *filter
+	deduper       *deduper
+	journaler     *journaler
+	dropped       atomic.Int32
+
+	queue   chan *grpcDelivery
+	senders sync.WaitGroup
+	closed  bool
+	// broken on Stop, releases callers blocked on a full queue
+	closing core.Fuse
+	// broken on forced Stop, abandons pending deliveries
+	killed core.Fuse
+	done   core.Fuse
+
+	canary *canary
+}
+
+type grpcDelivery struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	event    *livekit.WebhookEvent
+	queuedAt time.Time
+	sentAt   time.Time
+	// encoded on the first send, resent as is after a reconnect
+	delivery *rpc.WebhookDelivery
+	// when set, receives the outcome of the delivery
+	result chan error
+}
+
+func (d *grpcDelivery) done(err error) {
+	if d.result != nil {
+		d.result <- err
+	}
+}
+
+func NewGRPCNotifier(params GRPCNotifierParams) *GRPCNotifier {
+	if params.Config.QueueSize == 0 {
+		params.Config.QueueSize = DefaultGRPCNotifierConfig.QueueSize
+	}
+	if params.Config.MaxInFlight == 0 {
+		params.Config.MaxInFlight = DefaultGRPCNotifierConfig.MaxInFlight
+	}
+	if params.Config.AckTimeout == 0 {
+		params.Config.AckTimeout = DefaultGRPCNotifierConfig.AckTimeout
+	}
+	if params.Config.ReconnectBackoff == 0 {
+		params.Config.ReconnectBackoff = DefaultGRPCNotifierConfig.ReconnectBackoff
+	}
+	if params.Target == "" {
+		params.Target = "grpc"
+	}
+	if params.Logger == nil {
+		params.Logger = logger.GetLogger()
+	}
+
+	n := &GRPCNotifier{
+		params:    params,
+		filter:    newFilter(params.FilterParams),
+		deduper:   newDeduper(params.DedupeParams, params.Target),
+		journaler: newJournaler(params.JournalParams, params.Target),
+		queue:     make(chan *grpcDelivery, params.Config.QueueSize),
+	}
+	n.canary = n.newCanary()
+	go n.run()
+	return n
+}
+
+func (n *GRPCNotifier) SetKeys(apiKey, apiSecret string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.params.APIKey = apiKey
+	n.params.APISecret = apiSecret
+}
+
+func (n *GRPCNotifier) SetFilter(params FilterParams) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.filter.SetFilter(params)
+}
+
+func (n *GRPCNotifier) RegisterProcessedHook(hook func(ctx context.Context, whi *livekit.WebhookInfo)) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.processedHook = hook
+}
+
+func (n *GRPCNotifier) getProcessedHook() func(ctx context.Context, whi *livekit.WebhookInfo) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.processedHook
+}
+
+// QueueNotify queues the event, waiting for room in the queue until ctx is done.
+// An error is returned when the event could not be queued.
+func (n *GRPCNotifier) QueueNotify(ctx context.Context, event *livekit.WebhookEvent) error {
+	event = syncedEvent(event)
+	if !n.filter.IsAllowed(event) {
+		return nil
+	}
+	event = n.params.stampProvenance(event)
+
+	if n.deduper.IsDuplicate(ctx, event) {
+		n.params.Logger.Debugw("skipped duplicate webhook", logFields(event, n.params.Target)...)
+		return nil
+	}
+
+	if err := n.enqueue(ctx, event); err != nil {
+		n.deduper.Forget(ctx, event)
+		return err
+	}
+	return nil
+}
+
+// Replay re-enqueues journaled events created between from and to which pass the filter.
+// Events are replayed once each, regardless of the number of recorded delivery attempts.
+// It returns the number of replayed events.
+func (n *GRPCNotifier) Replay(ctx context.Context, from, to time.Time, params FilterParams) (int, error) {
+	events, err := n.journaler.Events(ctx, from, to, newFilter(params))
+	if err != nil {
+		return 0, err
+	}
+	for i, event := range events {
+		if err = n.enqueue(ctx, event); err != nil {
+			return i, err
+		}
+	}
+	return len(events), nil
+}
+
+// Stop stops accepting events. Unless forced, queued and unacked events are delivered first.
+// Pending events are dropped if the stream keeps failing while stopping.
+func (n *GRPCNotifier) Stop(force bool) {
+	n.canary.Stop()
+	if force {
+		n.killed.Break()
+	}
+
+	n.mu.Lock()
+	closed := n.closed
+	n.closed = true
+	n.mu.Unlock()
+
+	if !closed {
+		n.closing.Break()
+		n.senders.Wait()
+		close(n.queue)
+	}
+	<-n.done.Watch()
+}
+
+func (n *GRPCNotifier) enqueue(ctx context.Context, event *livekit.WebhookEvent) error {
+	return n.queueDelivery(ctx, &grpcDelivery{event: event})
+}
+
+// sendCanary queues the canary and waits until it is acked or dropped.
+func (n *GRPCNotifier) sendCanary(ctx context.Context, event *livekit.WebhookEvent) (time.Duration, error) {
+	d := &grpcDelivery{
+		event:  event,
+		result: make(chan error, 1),
+	}
+	if err := n.queueDelivery(ctx, d); err != nil {
+		return 0, err
+	}
+
+	select {
+	case err := <-d.result:
+		if d.sentAt.IsZero() {
+			return 0, err
+		}
+		return time.Since(d.sentAt), err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+func (n *GRPCNotifier) queueDelivery(ctx context.Context, d *grpcDelivery) error {
+	n.mu.RLock()
+	if n.closed {
+		n.mu.RUnlock()
+		return errClosed
+	}
+	n.senders.Add(1)
+	n.mu.RUnlock()
+	defer n.senders.Done()
+
+	d.queuedAt = time.Now()
+	// keep trace values and deadline of the caller, but not its cancellation
+	d.ctx, d.cancel = detachedContext(ctx)
+
+	select {
+	case n.queue <- d:
+		return nil
+	case <-n.closing.Watch():
+		n.drop(d, "closed")
+		return errClosed
+	case <-ctx.Done():
+		n.drop(d, "backpressure")
+		return ctx.Err()
+	}
+}
+
+func (n *GRPCNotifier) run() {
+	defer n.done.Break()
+
+	var pending []*grpcDelivery
+	drainFailures := 0
+	for {
+		var err error
+		pending, err = n.stream(pending)
+		if err == nil {
+			return
+		}
+
+		if n.closing.IsBroken() {
+			drainFailures++
+		}
+		if n.killed.IsBroken() || drainFailures > grpcDrainRetries {
+			n.params.Logger.Warnw("webhook stream failed while stopping", err, "target", n.params.Target)
+			n.dropAll(pending, "closed")
+			return
+		}
+
+		n.params.Logger.Warnw("webhook stream failed", err, "target", n.params.Target, "pending", len(pending))
+		select {
+		case <-time.After(n.params.Config.ReconnectBackoff):
+		case <-n.killed.Watch():
+		}
+	}
+}
+
+// stream delivers events over a single stream. It returns once the queue is closed and all deliveries are acked,
+// or with the unacked deliveries when the stream fails.
+func (n *GRPCNotifier) stream(pending []*grpcDelivery) ([]*grpcDelivery, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if n.killed.IsBroken() {
+		return pending, errClosed
+	}
+	stream, err := n.params.Client.StreamEvents(ctx)
+	if err != nil {
+		return pending, err
+	}
+
+	acks := make(chan *rpc.WebhookAck)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			ack, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case acks <- ack:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var seq uint64
+	var inFlight []*grpcDelivery
+	unacked := func() []*grpcDelivery {
+		return append(inFlight, pending...)
+	}
+	send := func(d *grpcDelivery) error {
+		seq++
+		if err := n.send(stream, seq, d); err != nil {
+			return err
+		}
+		inFlight = append(inFlight, d)
+		return nil
+	}
+
+	timer := time.NewTimer(n.params.Config.AckTimeout)
+	defer timer.Stop()
+
+	queue := n.queue
+	for {
+		// resend deliveries of the previous stream first
+		for len(pending) > 0 && len(inFlight) < n.params.Config.MaxInFlight {
+			d := pending[0]
+			pending = pending[1:]
+			if err = send(d); err != nil {
+				pending = append([]*grpcDelivery{d}, pending...)
+				return unacked(), err
+			}
+		}
+
+		if queue == nil && len(pending) == 0 && len(inFlight) == 0 {
+			_ = stream.CloseSend()
+			return nil, nil
+		}
+
+		var next <-chan *grpcDelivery
+		if len(pending) == 0 && len(inFlight) < n.params.Config.MaxInFlight {
+			next = queue
+		}
+		var ackTimeout <-chan time.Time
+		if len(inFlight) > 0 {
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(time.Until(inFlight[0].sentAt.Add(n.params.Config.AckTimeout)))
+			ackTimeout = timer.C
+		}
+
+		select {
+		case d, ok := <-next:
+			if !ok {
+				queue = nil
+				continue
+			}
+			if n.params.Config.MaxAge > 0 && time.Since(d.queuedAt) > n.params.Config.MaxAge {
+				n.drop(d, "age")
+				continue
+			}
+			if err = send(d); err != nil {
+				pending = append(pending, d)
+				return unacked(), err
+			}
+
+		case ack := <-acks:
+			// deliveries are acked in order, acks for earlier deliveries may be skipped
+			first := seq - uint64(len(inFlight)) + 1
+			if ack.Sequence < first || ack.Sequence > seq {
+				continue
+			}
+			i := int(ack.Sequence - first)
+			for _, d := range inFlight[:i] {
+				n.acked(d, "")
+			}
+			n.acked(inFlight[i], ack.Error)
+			inFlight = inFlight[i+1:]
+
+		case err = <-recvErr:
+			return unacked(), err
+
+		case <-ackTimeout:
+			return unacked(), errAckTimeout
+
+		case <-n.killed.Watch():
+			n.dropAll(unacked(), "killed")
+			return nil, nil
+		}
+	}
+}
+
+func (n *GRPCNotifier) send(stream rpc.WebhookReceiver_StreamEventsClient, seq uint64, d *grpcDelivery) error {
+	if d.delivery == nil {
+		// set dropped count
+		setNumDropped(d.event, n.dropped.Swap(0))
+		encoded, _, err := marshalEvent(n.params.Encoder, d.event)
+		if err != nil {
+			return err
+		}
+		d.delivery = &rpc.WebhookDelivery{Payload: encoded}
+
+		n.mu.RLock()
+		apiKey := n.params.APIKey
+		apiSecret := n.params.APISecret
+		n.mu.RUnlock()
+
+		if apiKey != "" {
+			if d.delivery.Token, err = signPayload(encoded, SigningKey{APIKey: apiKey, APISecret: apiSecret}); err != nil {
+				return err
+			}
+		}
+	}
+
+	d.delivery.Sequence = seq
+	d.sentAt = time.Now()
+	return stream.Send(d.delivery)
+}
+
+func (n *GRPCNotifier) acked(d *grpcDelivery, ackError string) {
+	defer d.cancel()
+
+	fields := logFields(d.event, n.params.Target)
+	queueDuration := d.sentAt.Sub(d.queuedAt)
+	sendDuration := time.Since(d.sentAt)
+	fields = append(fields, "queueDuration", queueDuration, "sendDuration", sendDuration)
+
+	var err error
+	if ackError != "" {
+		err = errors.New(ackError)
+		n.params.Logger.Warnw("webhook rejected by receiver", err, fields...)
+		n.dropped.Add(d.event.NumDropped + 1)
+	} else {
+		n.params.Logger.Infow("sent webhook", fields...)
+	}
+	n.processed(d.ctx, d.event, d.queuedAt, queueDuration, d.sentAt, sendDuration, false, err)
+	d.done(err)
+}
+
+func (n *GRPCNotifier) drop(d *grpcDelivery, reason string) {
+	defer d.cancel()
+
+	n.dropped.Inc()
+	queueDuration := time.Since(d.queuedAt)
+
+	fields := logFields(d.event, n.params.Target)
+	fields = append(fields, "queueDuration", queueDuration, "reason", reason)
+	n.params.Logger.Infow("dropped webhook", fields...)
+
+	n.processed(d.ctx, d.event, d.queuedAt, queueDuration, time.Time{}, 0, true, nil)
+	d.done(errDropped)
+}
+
+// dropAll drops pending deliveries and the remaining queue, which must be closing.
+func (n *GRPCNotifier) dropAll(pending []*grpcDelivery, reason string) {
+	for _, d := range pending {
+		n.drop(d, reason)
+	}
+	for d := range n.queue {
+		n.drop(d, reason)
+	}
+}
+
+func (n *GRPCNotifier) processed(
+	ctx context.Context,
+	event *livekit.WebhookEvent,
+	queuedAt time.Time,
+	queueDuration time.Duration,
+	sentAt time.Time,
+	sendDuration time.Duration,
+	isDropped bool,
+	sendError error,
+) {
+	ph := n.getProcessedHook()
+	if ph == nil && !n.journaler.Enabled() {
+		return
+	}
+
+	whi := webhookInfo(
+		event,
+		queuedAt,
+		queueDuration,
+		sentAt,
+		sendDuration,
+		n.params.Target,
+		isDropped,
+		sendError,
+	)
+	if n.params.FieldsHook != nil {
+		n.params.FieldsHook(whi)
+	}
+	n.journaler.Record(ctx, event, whi)
+	if ph != nil {
+		ph(ctx, whi)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/livekit/protocol/utils"
+)
+
+// IdempotencyState is the processing state of an event ID.
+type IdempotencyState int
+
+const (
+	// the event was not seen, and is now claimed by the caller
+	IdempotencyClaimed IdempotencyState = iota
+	// the event is being processed by another handler
+	IdempotencyInProgress
+	// the event was processed successfully
+	IdempotencyProcessed
+)
+
+// ErrIdempotencyClaimLost is returned by Complete when the lease of the claim expired and the event was claimed again.
+var ErrIdempotencyClaimLost = errors.New("idempotency claim lost")
+
+// IdempotencyStore tracks processed webhook events on the receiving side.
+// An event is claimed before its handler runs, and marked processed only when the handler succeeds.
+type IdempotencyStore interface {
+	// Claim atomically claims the event for processing for the duration of the lease,
+	// unless it is being processed or was processed already. The returned token identifies the claim.
+	Claim(ctx context.Context, id string, lease time.Duration) (IdempotencyState, string, error)
+	// Complete marks the event claimed with the token as processed for the ttl.
+	Complete(ctx context.Context, id, token string, ttl time.Duration) error
+	// Release drops the claim with the token after a failure, so that a retry can process the event.
+	// A claim taken over after the lease expired is left untouched.
+	Release(ctx context.Context, id, token string) error
+}
+
+func newIdempotencyToken() string {
+	return utils.NewGuid("")
+}
+
+// ---------------------------------
+
+type memoryIdempotencyEntry struct {
+	token     string
+	processed bool
+	expiresAt time.Time
+}
+
+type MemoryIdempotencyStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryIdempotencyEntry
+	swept   time.Time
+}
+
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{
+		entries: make(map[string]memoryIdempotencyEntry),
+	}
+}
+
+func (s *MemoryIdempotencyStore) Claim(_ context.Context, id string, lease time.Duration) (IdempotencyState, string, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	if e, ok := s.entries[id]; ok && now.Before(e.expiresAt) {
+		if e.processed {
+			return IdempotencyProcessed, "", nil
+		}
+		return IdempotencyInProgress, "", nil
+	}
+	token := newIdempotencyToken()
+	s.entries[id] = memoryIdempotencyEntry{token: token, expiresAt: now.Add(lease)}
+	return IdempotencyClaimed, token, nil
+}
+
+func (s *MemoryIdempotencyStore) Complete(_ context.Context, id, token string, ttl time.Duration) error {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.entries[id]; ok && !e.processed && e.token != token && now.Before(e.expiresAt) {
+		return ErrIdempotencyClaimLost
+	}
+	s.entries[id] = memoryIdempotencyEntry{processed: true, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+func (s *MemoryIdempotencyStore) Release(_ context.Context, id, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.entries[id]; ok && !e.processed && e.token == token {
+		delete(s.entries, id)
+	}
+	return nil
+}
+
+// sweep removes expired entries, at most once per second
+func (s *MemoryIdempotencyStore) sweep(now time.Time) {
+	if now.Sub(s.swept) < time.Second {
+		return
+	}
+	s.swept = now
+	for id, e := range s.entries {
+		if !now.Before(e.expiresAt) {
+			delete(s.entries, id)
+		}
+	}
+}
+
+// ---------------------------------
+
+const (
+	// claims are stored as the prefix followed by the claim token
+	redisIdempotencyClaimPrefix = "processing:"
+	redisIdempotencyProcessed   = "processed"
+)
+
+// releases the claim only while it is held by the token
+var redisIdempotencyRelease = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// marks the event processed unless it was claimed with another token
+var redisIdempotencyComplete = redis.NewScript(`
+local v = redis.call("GET", KEYS[1])
+if v == false or v == ARGV[1] or v == ARGV[2] then
+	redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+	return 1
+end
+return 0
+`)
+
+type RedisIdempotencyStore struct {
+	rc     redis.UniversalClient
+	prefix string
+}
+
+func NewRedisIdempotencyStore(rc redis.UniversalClient, prefix string) *RedisIdempotencyStore {
+	return &RedisIdempotencyStore{
+		rc:     rc,
+		prefix: prefix,
+	}
+}
+
+func (s *RedisIdempotencyStore) Claim(ctx context.Context, id string, lease time.Duration) (IdempotencyState, string, error) {
+	key := s.prefix + id
+	token := newIdempotencyToken()
+	ok, err := s.rc.SetNX(ctx, key, redisIdempotencyClaimPrefix+token, lease).Result()
+	if err != nil {
+		return 0, "", err
+	}
+	if ok {
+		return IdempotencyClaimed, token, nil
+	}
+
+	state, err := s.rc.Get(ctx, key).Result()
+	switch err {
+	case nil:
+	case redis.Nil:
+		// expired in between, report as in progress and let the sender retry
+		return IdempotencyInProgress, "", nil
+	default:
+		return 0, "", err
+	}
+	if state == redisIdempotencyProcessed {
+		return IdempotencyProcessed, "", nil
+	}
+	return IdempotencyInProgress, "", nil
+}
+
+func (s *RedisIdempotencyStore) Complete(ctx context.Context, id, token string, ttl time.Duration) error {
+	ok, err := redisIdempotencyComplete.Run(ctx, s.rc, []string{s.prefix + id}, redisIdempotencyClaimPrefix+token, redisIdempotencyProcessed, ttl.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+	if ok == 0 {
+		return ErrIdempotencyClaimLost
+	}
+	return nil
+}
+
+func (s *RedisIdempotencyStore) Release(ctx context.Context, id, token string) error {
+	return redisIdempotencyRelease.Run(ctx, s.rc, []string{s.prefix + id}, redisIdempotencyClaimPrefix+token).Err()
+}
@@ -0,0 +1,167 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gammazero/deque"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+)
+
+const defaultMemoryJournalSize = 10000
+
+// JournalEntry is a webhook event together with the outcome of one delivery attempt.
+type JournalEntry struct {
+	Event *livekit.WebhookEvent
+	Info  *livekit.WebhookInfo
+}
+
+// Journal records outgoing webhook events, so they can be replayed after receiver outages.
+type Journal interface {
+	Record(ctx context.Context, entry JournalEntry) error
+	// Query returns entries for the url with events created between from (inclusive) and to (exclusive), oldest first.
+	Query(ctx context.Context, url string, from, to time.Time) ([]JournalEntry, error)
+}
+
+type JournalParams struct {
+	// when set, every processed event is recorded along with its delivery outcome
+	Journal Journal
+}
+
+type Replayer interface {
+	Replay(ctx context.Context, from, to time.Time, params FilterParams) (int, error)
+}
+
+type journaler struct {
+	params JournalParams
+	url    string
+}
+
+func newJournaler(params JournalParams, url string) *journaler {
+	return &journaler{
+		params: params,
+		url:    url,
+	}
+}
+
+func (j *journaler) Enabled() bool {
+	return j.params.Journal != nil
+}
+
+// Record stores a copy of the event. Journal errors are logged, delivery is not affected.
+// Canary and test events are not recorded, they must not be replayed.
+func (j *journaler) Record(ctx context.Context, event *livekit.WebhookEvent, whi *livekit.WebhookInfo) {
+	if j.params.Journal == nil || isProbeEvent(event) {
+		return
+	}
+
+	err := j.params.Journal.Record(ctx, JournalEntry{
+		Event: proto.Clone(event).(*livekit.WebhookEvent),
+		Info:  whi,
+	})
+	if err != nil {
+		logger.Warnw("webhook journal failed", err, "event", event.Event, "id", event.Id)
+	}
+}
+
+// Events returns a copy of each unique journaled event within the range which passes the filter.
+func (j *journaler) Events(ctx context.Context, from, to time.Time, f *filter) ([]*livekit.WebhookEvent, error) {
+	if j.params.Journal == nil {
+		return nil, nil
+	}
+
+	entries, err := j.params.Journal.Query(ctx, j.url, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(entries))
+	events := make([]*livekit.WebhookEvent, 0, len(entries))
+	for _, e := range entries {
+		if e.Event.Id != "" {
+			if _, ok := seen[e.Event.Id]; ok {
+				continue
+			}
+			seen[e.Event.Id] = struct{}{}
+		}
+		if isProbeEvent(e.Event) || !f.IsAllowed(e.Event) {
+			continue
+		}
+		event := proto.Clone(e.Event).(*livekit.WebhookEvent)
+		event.NumDropped = 0
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// isProbeEvent returns true for events checking the destination rather than reporting activity
+func isProbeEvent(event *livekit.WebhookEvent) bool {
+	return event.Event == EventWebhookCanary || event.Event == EventWebhookTest
+}
+
+// ---------------------------------
+
+// MemoryJournal keeps the most recent entries in memory.
+type MemoryJournal struct {
+	mu      sync.Mutex
+	size    int
+	entries deque.Deque[JournalEntry]
+}
+
+// NewMemoryJournal creates a journal holding up to size entries, 0 uses the default size.
+func NewMemoryJournal(size int) *MemoryJournal {
+	if size <= 0 {
+		size = defaultMemoryJournalSize
+	}
+	return &MemoryJournal{
+		size: size,
+	}
+}
+
+func (j *MemoryJournal) Record(_ context.Context, entry JournalEntry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.entries.Len() == j.size {
+		j.entries.PopFront()
+	}
+	j.entries.PushBack(entry)
+	return nil
+}
+
+func (j *MemoryJournal) Query(_ context.Context, url string, from, to time.Time) ([]JournalEntry, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	var entries []JournalEntry
+	for i := range j.entries.Len() {
+		e := j.entries.At(i)
+		if e.Info.GetUrl() != url {
+			continue
+		}
+		createdAt := e.Event.CreatedAtTime()
+		if createdAt.Before(from) || !createdAt.Before(to) {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+)
+
+const (
+	kafkaHeaderEvent   = "event"
+	kafkaHeaderEventID = "event-id"
+)
+
+type KafkaNotifierConfig struct {
+	Topic string `yaml:"topic,omitempty"`
+	// when set, all events of a room are published with the room name as the key, and land in the same partition.
+	// otherwise events are keyed by resource (egress, ingress, room, participant or track)
+	PartitionByRoom bool `yaml:"partition_by_room,omitempty"`
+	NumWorkers      int  `yaml:"num_workers,omitempty"`
+	QueueSize       int  `yaml:"queue_size,omitempty"`
+	// events queued for longer than MaxAge are dropped, 0 disables the limit
+	MaxAge time.Duration `yaml:"max_age,omitempty"`
+	// when set, a webhook_canary event is published at this interval to monitor the topic, see CanaryStats
+	CanaryInterval time.Duration `yaml:"canary_interval,omitempty"`
+}
+
+// KafkaMessage is a webhook event to be produced to Kafka. Value is the JSON encoded event.
+// When an API key is set, the Authorization header carries a token signing the value, which can be verified as a webhook.
+type KafkaMessage struct {
+	Topic   string
+	Key     []byte
+	Value   []byte
+	Headers map[string]string
+}
+
+// KafkaProducer is implemented by an adapter around the Kafka client of choice.
+type KafkaProducer interface {
+	Produce(ctx context.Context, msg *KafkaMessage) error
+}
+
+type KafkaNotifierParams struct {
+	Producer   KafkaProducer
+	Logger     logger.Logger
+	Config     KafkaNotifierConfig
+	APIKey     string
+	APISecret  string
+	FieldsHook func(whi *livekit.WebhookInfo)
+	// serializes the message payload, defaults to ProtoJSONEncoder
+	Encoder Encoder
+	FilterParams
+	DedupeParams
+	JournalParams
+	ProvenanceParams
+}
+
+// KafkaNotifier is a QueuedNotifier that publishes events to a Kafka topic.
+// Events of a resource are published in order. Failed events are not retried beyond the retries of the producer.
+type KafkaNotifier struct {
+	*brokerNotifier
+}
+
+var _ QueuedNotifier = (*KafkaNotifier)(nil)
+
+func NewKafkaNotifier(params KafkaNotifierParams) *KafkaNotifier {
+	p := &kafkaPublisher{
+		producer: params.Producer,
+		config:   params.Config,
+	}
+	return &KafkaNotifier{
+		brokerNotifier: newBrokerNotifier(brokerNotifierParams{
+			Logger: params.Logger,
+			Config: URLNotifierConfig{
+				NumWorkers:     params.Config.NumWorkers,
+				QueueSize:      params.Config.QueueSize,
+				MaxAge:         params.Config.MaxAge,
+				CanaryInterval: params.Config.CanaryInterval,
+			},
+			Destination:      "kafka://" + params.Config.Topic,
+			APIKey:           params.APIKey,
+			APISecret:        params.APISecret,
+			FieldsHook:       params.FieldsHook,
+			Encoder:          params.Encoder,
+			FilterParams:     params.FilterParams,
+			DedupeParams:     params.DedupeParams,
+			JournalParams:    params.JournalParams,
+			ProvenanceParams: params.ProvenanceParams,
+		}, p),
+	}
+}
+
+type kafkaPublisher struct {
+	producer KafkaProducer
+	config   KafkaNotifierConfig
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, event *livekit.WebhookEvent, msg *brokerMessage) error {
+	key := eventKey(event)
+	if p.config.PartitionByRoom {
+		if roomName := eventRoomName(event); roomName != "" {
+			key = roomName
+		}
+	}
+
+	headers := map[string]string{
+		kafkaHeaderEvent:   event.Event,
+		kafkaHeaderEventID: event.Id,
+	}
+	if msg.Token != "" {
+		headers[authHeader] = msg.Token
+	}
+
+	return p.producer.Produce(ctx, &KafkaMessage{
+		Topic:   p.config.Topic,
+		Key:     []byte(key),
+		Value:   msg.Payload,
+		Headers: headers,
+	})
+}
@@ -0,0 +1,259 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+)
+
+const (
+	livekitNamespace = "livekit"
+	metricsSubsystem = "webhook"
+)
+
+// EventMetrics maintains a standard set of Prometheus metrics from received webhook events,
+// e.g. for deployments that are monitored through webhooks only.
+// It implements prometheus.Collector and must be registered to be exported.
+type EventMetrics struct {
+	mu    sync.Mutex
+	rooms map[string]map[string]struct{}
+	// participants of all rooms
+	numParticipants int
+
+	eventsTotal        *prometheus.CounterVec
+	roomsActive        prometheus.Gauge
+	participantsActive prometheus.Gauge
+	participantsJoined *prometheus.CounterVec
+	participantsLeft   *prometheus.CounterVec
+	tracksPublished    *prometheus.CounterVec
+	egressEnded        *prometheus.CounterVec
+	ingressEnded       *prometheus.CounterVec
+	sipCallsEnded      *prometheus.CounterVec
+	agentJobsEnded     *prometheus.CounterVec
+}
+
+func NewEventMetrics(constLabels prometheus.Labels) *EventMetrics {
+	return &EventMetrics{
+		rooms: make(map[string]map[string]struct{}),
+		eventsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   livekitNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "events_total",
+			ConstLabels: constLabels,
+		}, []string{"event"}),
+		roomsActive: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace:   livekitNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "rooms_active",
+			ConstLabels: constLabels,
+		}),
+		participantsActive: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace:   livekitNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "participants_active",
+			ConstLabels: constLabels,
+		}),
+		participantsJoined: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   livekitNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "participants_joined_total",
+			ConstLabels: constLabels,
+		}, []string{"kind"}),
+		participantsLeft: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   livekitNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "participants_left_total",
+			ConstLabels: constLabels,
+		}, []string{"kind"}),
+		tracksPublished: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   livekitNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "tracks_published_total",
+			ConstLabels: constLabels,
+		}, []string{"type"}),
+		egressEnded: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   livekitNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "egress_ended_total",
+			ConstLabels: constLabels,
+		}, []string{"status"}),
+		ingressEnded: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   livekitNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "ingress_ended_total",
+			ConstLabels: constLabels,
+		}, []string{"status"}),
+		sipCallsEnded: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   livekitNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "sip_calls_ended_total",
+			ConstLabels: constLabels,
+		}, []string{"direction", "status"}),
+		agentJobsEnded: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   livekitNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "agent_jobs_ended_total",
+			ConstLabels: constLabels,
+		}, []string{"status", "reason"}),
+	}
+}
+
+func (m *EventMetrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		m.eventsTotal,
+		m.roomsActive,
+		m.participantsActive,
+		m.participantsJoined,
+		m.participantsLeft,
+		m.tracksPublished,
+		m.egressEnded,
+		m.ingressEnded,
+		m.sipCallsEnded,
+		m.agentJobsEnded,
+	}
+}
+
+func (m *EventMetrics) Describe(ch chan<- *prometheus.Desc) {
+	for _, c := range m.collectors() {
+		c.Describe(ch)
+	}
+}
+
+func (m *EventMetrics) Collect(ch chan<- prometheus.Metric) {
+	for _, c := range m.collectors() {
+		c.Collect(ch)
+	}
+}
+
+// Observe updates metrics from a verified webhook event.
+// Active rooms and participants are tracked by sid, so redelivered events are not counted twice.
+func (m *EventMetrics) Observe(event *livekit.WebhookEvent) {
+	m.eventsTotal.WithLabelValues(event.Event).Inc()
+
+	switch event.Event {
+	case EventRoomStarted:
+		m.mu.Lock()
+		m.roomStarted(roomKey(event))
+		m.updateGauges()
+		m.mu.Unlock()
+
+	case EventRoomFinished:
+		m.mu.Lock()
+		key := roomKey(event)
+		m.numParticipants -= len(m.rooms[key])
+		delete(m.rooms, key)
+		m.updateGauges()
+		m.mu.Unlock()
+
+	case EventParticipantJoined:
+		if event.Participant == nil {
+			return
+		}
+		m.mu.Lock()
+		participants := m.roomStarted(roomKey(event))
+		if _, ok := participants[event.Participant.Sid]; !ok {
+			participants[event.Participant.Sid] = struct{}{}
+			m.numParticipants++
+			m.participantsJoined.WithLabelValues(event.Participant.Kind.String()).Inc()
+		}
+		m.updateGauges()
+		m.mu.Unlock()
+
+	case EventParticipantLeft:
+		if event.Participant == nil {
+			return
+		}
+		m.mu.Lock()
+		if participants, ok := m.rooms[roomKey(event)]; ok {
+			if _, ok := participants[event.Participant.Sid]; ok {
+				delete(participants, event.Participant.Sid)
+				m.numParticipants--
+				m.participantsLeft.WithLabelValues(event.Participant.Kind.String()).Inc()
+			}
+		}
+		m.updateGauges()
+		m.mu.Unlock()
+
+	case EventTrackPublished:
+		if event.Track != nil {
+			m.tracksPublished.WithLabelValues(event.Track.Type.String()).Inc()
+		}
+
+	case EventEgressEnded:
+		if event.EgressInfo != nil {
+			m.egressEnded.WithLabelValues(event.EgressInfo.Status.String()).Inc()
+		}
+
+	case EventIngressEnded:
+		if event.IngressInfo != nil && event.IngressInfo.State != nil {
+			m.ingressEnded.WithLabelValues(event.IngressInfo.State.Status.String()).Inc()
+		}
+
+	case EventSIPCallEnded, EventSIPCallFailed:
+		if event.SipCall != nil {
+			m.sipCallsEnded.WithLabelValues(event.SipCall.CallDirection.String(), event.SipCall.CallStatus.String()).Inc()
+		}
+
+	case EventAgentJobEnded:
+		if event.AgentJob != nil {
+			state := event.AgentJob.GetState()
+			m.agentJobsEnded.WithLabelValues(state.GetStatus().String(), state.GetTerminationReason().String()).Inc()
+		}
+	}
+}
+
+func (m *EventMetrics) roomStarted(key string) map[string]struct{} {
+	participants, ok := m.rooms[key]
+	if !ok {
+		participants = make(map[string]struct{})
+		m.rooms[key] = participants
+	}
+	return participants
+}
+
+func (m *EventMetrics) updateGauges() {
+	m.roomsActive.Set(float64(len(m.rooms)))
+	m.participantsActive.Set(float64(m.numParticipants))
+}
+
+func roomKey(event *livekit.WebhookEvent) string {
+	if event.Room == nil {
+		return ""
+	}
+	if event.Room.Sid != "" {
+		return event.Room.Sid
+	}
+	return event.Room.Name
+}
+
+// NewMetricsHandler returns a webhook endpoint that verifies incoming events and observes them with m.
+func NewMetricsHandler(provider auth.KeyProvider, m *EventMetrics) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event, err := ReceiveWebhookEvent(r, provider)
+		if err != nil {
+			logger.Debugw("could not receive webhook", err)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		m.Observe(event)
+	})
+}
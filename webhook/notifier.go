@@ -16,11 +16,23 @@ package webhook
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/go-retryablehttp"
+	"go.uber.org/atomic"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/livekit/protocol/auth"
 	"github.com/livekit/protocol/livekit"
 	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/utils/deprecation"
+	"github.com/livekit/protocol/utils/urlguard"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -29,6 +41,8 @@ type WebHookConfig struct {
 	APIKey              string                    `yaml:"api_key,omitempty"`
 	URLNotifier         URLNotifierConfig         `yaml:"url_notifier,omitempty"`
 	ResourceURLNotifier ResourceURLNotifierConfig `yaml:"resource_url_notifier,omitempty"`
+	// when set, webhook URLs and the addresses they resolve to are checked before sending
+	URLGuard *urlguard.Config `yaml:"url_guard,omitempty"`
 }
 
 var DefaultWebHookConfig = WebHookConfig{
@@ -44,19 +58,36 @@ type QueuedNotifier interface {
 	Stop(force bool)
 }
 
+// ContextStopper is implemented by notifiers which can drain queued events with a deadline.
+type ContextStopper interface {
+	// StopContext drains queued events until ctx is done. Deliveries still pending at that point,
+	// including ones in flight, are abandoned. It returns the number of abandoned events.
+	StopContext(ctx context.Context) int
+}
+
 type DefaultNotifier struct {
 	notifiers []QueuedNotifier
 }
 
 func NewDefaultNotifier(config WebHookConfig, apiSecret string) QueuedNotifier {
 	n := &DefaultNotifier{}
+	var guard *urlguard.Guard
+	if config.URLGuard != nil {
+		var err error
+		if guard, err = urlguard.New(*config.URLGuard); err != nil {
+			// sending without the guard could reach the addresses it was configured to protect
+			logger.Errorw("invalid webhook url guard, webhooks are disabled", err)
+			return n
+		}
+	}
 	for _, url := range config.URLs {
 		u := NewResourceURLNotifier(ResourceURLNotifierParams{
-			URL:       url,
-			Logger:    logger.GetLogger().WithComponent("webhook"),
-			APIKey:    config.APIKey,
-			APISecret: apiSecret,
-			Config:    config.ResourceURLNotifier,
+			HTTPClientParams: HTTPClientParams{URLGuard: guard},
+			URL:              url,
+			Logger:           logger.GetLogger().WithComponent("webhook"),
+			APIKey:           config.APIKey,
+			APISecret:        apiSecret,
+			Config:           config.ResourceURLNotifier,
 		})
 		n.notifiers = append(n.notifiers, u)
 	}
@@ -75,6 +106,40 @@ func (n *DefaultNotifier) Stop(force bool) {
 	wg.Wait()
 }
 
+// StopContext stops the notifiers in parallel, draining queued events until ctx is done.
+// Notifiers which are not ContextStopper are stopped with force once ctx is done.
+// It returns the total number of abandoned events.
+func (n *DefaultNotifier) StopContext(ctx context.Context) int {
+	var abandoned atomic.Int32
+	wg := sync.WaitGroup{}
+	for _, u := range n.notifiers {
+		wg.Add(1)
+		go func(u QueuedNotifier) {
+			defer wg.Done()
+			if s, ok := u.(ContextStopper); ok {
+				abandoned.Add(int32(s.StopContext(ctx)))
+				return
+			}
+			newPendingDeliveries().StopContext(ctx, func() { u.Stop(false) }, func() { u.Stop(true) })
+		}(u)
+	}
+	wg.Wait()
+	return int(abandoned.Load())
+}
+
+// HealthCheck returns the errors of the notifiers which are HealthChecker, see URLNotifier.HealthCheck.
+func (n *DefaultNotifier) HealthCheck(ctx context.Context) error {
+	var errs []error
+	for _, u := range n.notifiers {
+		if h, ok := u.(HealthChecker); ok {
+			if err := h.HealthCheck(ctx); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func (n *DefaultNotifier) QueueNotify(ctx context.Context, event *livekit.WebhookEvent) error {
 	for _, u := range n.notifiers {
 		if err := u.QueueNotify(ctx, event); err != nil {
@@ -84,6 +149,21 @@ func (n *DefaultNotifier) QueueNotify(ctx context.Context, event *livekit.Webhoo
 	return nil
 }
 
+// Replay replays journaled events on each notifier which supports it, and returns the total number of replayed events.
+func (n *DefaultNotifier) Replay(ctx context.Context, from, to time.Time, params FilterParams) (int, error) {
+	replayed := 0
+	for _, u := range n.notifiers {
+		if r, ok := u.(Replayer); ok {
+			c, err := r.Replay(ctx, from, to, params)
+			replayed += c
+			if err != nil {
+				return replayed, err
+			}
+		}
+	}
+	return replayed, nil
+}
+
 func (n *DefaultNotifier) RegisterProcessedHook(hook func(ctx context.Context, whi *livekit.WebhookInfo)) {
 	for _, u := range n.notifiers {
 		u.RegisterProcessedHook(hook)
@@ -109,11 +189,53 @@ type HTTPClientParams struct {
 	RetryWaitMax  time.Duration
 	MaxRetries    int
 	ClientTimeout time.Duration
+	// when set, the webhook URL and the addresses it resolves to are checked before sending
+	URLGuard *urlguard.Guard
+	// when set, requests are authenticated with an OAuth2 token
+	OAuth2 *OAuth2Config
+}
+
+func (p HTTPClientParams) newClient() *retryablehttp.Client {
+	rhc := retryablehttp.NewClient()
+	if p.RetryWaitMin > 0 {
+		rhc.RetryWaitMin = p.RetryWaitMin
+	}
+	if p.RetryWaitMax > 0 {
+		rhc.RetryWaitMax = p.RetryWaitMax
+	}
+	if p.MaxRetries > 0 {
+		rhc.RetryMax = p.MaxRetries
+	}
+	if p.ClientTimeout > 0 {
+		rhc.HTTPClient.Timeout = p.ClientTimeout
+	}
+	if p.URLGuard != nil {
+		rhc.HTTPClient.Transport = p.URLGuard.Transport()
+		rhc.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+			// a blocked address will not become allowed by retrying
+			if errors.Is(err, urlguard.ErrAddressNotAllowed) {
+				return false, err
+			}
+			return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+		}
+	}
+	rhc.Logger = &logAdapter{}
+	return rhc
 }
 
 type FilterParams struct {
 	IncludeEvents []string
 	ExcludeEvents []string
+
+	// room name and participant identity filters accept globs (e.g. "prod-*")
+	// or regular expressions wrapped in slashes (e.g. "/^prod-[0-9]+$/").
+	// events without a room or participant are not affected by these filters.
+	IncludeRooms                 []string
+	ExcludeRooms                 []string
+	IncludeParticipantIdentities []string
+	ExcludeParticipantIdentities []string
+	IncludeParticipantKinds      []livekit.ParticipantInfo_Kind
+	ExcludeParticipantKinds      []livekit.ParticipantInfo_Kind
 }
 
 // ---------------------------------
@@ -124,6 +246,85 @@ func (l *logAdapter) Printf(string, ...interface{}) {}
 
 // ---------------------------------
 
+// setNumDropped sets the deprecated dropped count, tracking its use while receivers migrate to webhook_info metrics
+func setNumDropped(event *livekit.WebhookEvent, dropped int32) {
+	event.NumDropped = dropped
+	if dropped != 0 {
+		deprecation.Write(deprecation.WebhookNumDropped)
+	}
+}
+
+// syncedEvent returns a copy of the event with its payload and legacy fields synced, the event of the caller may be
+// shared with other notifiers
+func syncedEvent(event *livekit.WebhookEvent) *livekit.WebhookEvent {
+	event = proto.Clone(event).(*livekit.WebhookEvent)
+	event.SyncPayload()
+	return event
+}
+
+// signPayload returns a token carrying the checksum of the payload, as verified by Receive.
+func signPayload(encoded []byte, key SigningKey) (string, error) {
+	sum := sha256.Sum256(encoded)
+	b64 := base64.StdEncoding.EncodeToString(sum[:])
+
+	at := auth.NewAccessToken(key.APIKey, key.APISecret).
+		SetKeyID(key.ID).
+		SetValidFor(5 * time.Minute).
+		SetSha256(b64)
+	return at.ToJWT()
+}
+
+// detachedContext returns a context with the values and deadline of ctx,
+// which is not canceled together with ctx.
+func detachedContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	detached := context.WithoutCancel(ctx)
+	if deadline, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(detached, deadline)
+	}
+	return context.WithCancel(detached)
+}
+
+// pendingDeliveries counts the queued and in flight deliveries of a notifier,
+// which are abandoned when it cannot drain them before a deadline.
+type pendingDeliveries struct {
+	atomic.Int32
+	// canceled when pending deliveries are abandoned
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newPendingDeliveries() *pendingDeliveries {
+	p := &pendingDeliveries{}
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	return p
+}
+
+func (p *pendingDeliveries) Abandoned() bool {
+	return p.ctx.Err() != nil
+}
+
+// StopContext runs drain until ctx is done. It then runs kill and abandons the pending deliveries,
+// without waiting for drain to return. kill must not wait for deliveries in flight, and must keep
+// queued ones from starting so that they are dropped rather than abandoned.
+// It returns the number of abandoned deliveries.
+func (p *pendingDeliveries) StopContext(ctx context.Context, drain, kill func()) int {
+	drained := make(chan struct{})
+	go func() {
+		drain()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return 0
+	case <-ctx.Done():
+		abandoned := int(p.Load())
+		kill()
+		p.cancel()
+		return abandoned
+	}
+}
+
 func eventKey(event *livekit.WebhookEvent) string {
 	if event.EgressInfo != nil {
 		return event.EgressInfo.EgressId
@@ -131,6 +332,12 @@ func eventKey(event *livekit.WebhookEvent) string {
 	if event.IngressInfo != nil {
 		return event.IngressInfo.IngressId
 	}
+	if event.SipCall != nil {
+		return event.SipCall.CallId
+	}
+	if event.AgentJob != nil {
+		return event.AgentJob.Id
+	}
 	if event.Room != nil {
 		return event.Room.Name
 	}
@@ -140,10 +347,19 @@ func eventKey(event *livekit.WebhookEvent) string {
 	if event.Track != nil {
 		return event.Track.Sid
 	}
-	logger.Warnw("webhook using default event", nil, "event", logger.Proto(event))
+	logger.Warnw("webhook using default event", nil, "event", logger.Proto(event.Redacted()))
 	return "default"
 }
 
+// contextLogFields returns the log fields of the event, with the request id of the context queueing it
+func contextLogFields(ctx context.Context, event *livekit.WebhookEvent, url string) []interface{} {
+	fields := logFields(event, url)
+	if requestID := logger.RequestIDFromContext(ctx); requestID != "" {
+		fields = append(fields, "requestID", requestID)
+	}
+	return fields
+}
+
 func logFields(event *livekit.WebhookEvent, url string) []interface{} {
 	fields := make([]interface{}, 0, 20)
 	fields = append(fields,
@@ -151,6 +367,7 @@ func logFields(event *livekit.WebhookEvent, url string) []interface{} {
 		"id", event.Id,
 		"webhookTime", event.CreatedAt,
 		"url", url,
+		"payload", redactedPayload{event},
 	)
 
 	if event.Room != nil {
@@ -190,9 +407,48 @@ func logFields(event *livekit.WebhookEvent, url string) []interface{} {
 			}
 		}
 	}
+	if event.SipCall != nil {
+		fields = append(fields,
+			"sipCallID", event.SipCall.CallId,
+			"status", event.SipCall.CallStatus,
+		)
+		if event.SipCall.Error != "" {
+			fields = append(fields, "error", event.SipCall.Error)
+		}
+	}
+	if event.AgentJob != nil {
+		fields = append(fields,
+			"jobID", event.AgentJob.Id,
+			"agentName", event.AgentJob.AgentName,
+		)
+		if state := event.AgentJob.State; state != nil {
+			fields = append(fields, "status", state.Status)
+			if state.TerminationReason != livekit.JobTerminationReason_JTR_UNSPECIFIED {
+				fields = append(fields, "reason", state.TerminationReason)
+			}
+			if state.Error != "" {
+				fields = append(fields, "error", state.Error)
+			}
+		}
+	}
 	return fields
 }
 
+// redactedPayload logs the payload of an event without metadata and credentials. The event is only
+// redacted when the log entry is written.
+type redactedPayload struct {
+	event *livekit.WebhookEvent
+}
+
+func (p redactedPayload) MarshalLogObject(e zapcore.ObjectEncoder) error {
+	m := p.event.Redacted().ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("payload"))
+	if fd == nil {
+		return nil
+	}
+	return logger.Proto(m.Get(fd).Message().Interface()).MarshalLogObject(e)
+}
+
 func webhookInfo(
 	event *livekit.WebhookEvent,
 	queuedAt time.Time,
@@ -206,7 +462,7 @@ func webhookInfo(
 	whi := &livekit.WebhookInfo{
 		EventId:         event.Id,
 		Event:           event.Event,
-		CreatedAt:       timestamppb.New(time.Unix(event.CreatedAt, 0)),
+		CreatedAt:       timestamppb.New(event.CreatedAtTime()),
 		QueuedAt:        timestamppb.New(queuedAt),
 		QueueDurationNs: queueDuration.Nanoseconds(),
 		SentAt:          timestamppb.New(sentAt),
@@ -249,6 +505,21 @@ func webhookInfo(
 			}
 		}
 	}
+	if event.SipCall != nil {
+		whi.SipCallId = event.SipCall.CallId
+		whi.ServiceStatus = event.SipCall.CallStatus.String()
+		if event.SipCall.Error != "" {
+			whi.ServiceErrorCode = int32(event.SipCall.CallStatusCode.GetCode())
+			whi.ServiceError = event.SipCall.Error
+		}
+	}
+	if event.AgentJob != nil {
+		whi.AgentJobId = event.AgentJob.Id
+		if state := event.AgentJob.State; state != nil {
+			whi.ServiceStatus = state.Status.String()
+			whi.ServiceError = state.Error
+		}
+	}
 	if sendError != nil {
 		whi.SendError = sendError.Error()
 	}
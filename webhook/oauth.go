@@ -0,0 +1,168 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// carries the LiveKit token when the Authorization header is used for OAuth2
+	signatureHeader = "X-LiveKit-Signature"
+
+	// tokens are refreshed this long before they expire
+	oauth2ExpiryDelta = 30 * time.Second
+	oauth2Timeout     = 10 * time.Second
+)
+
+var (
+	ErrOAuth2Token = errors.New("could not get oauth2 token")
+)
+
+// OAuth2Config enables the OAuth2 client credentials flow for webhook requests.
+// The access token is sent in the Authorization header.
+type OAuth2Config struct {
+	TokenURL     string   `yaml:"token_url,omitempty"`
+	ClientID     string   `yaml:"client_id,omitempty"`
+	ClientSecret string   `yaml:"client_secret,omitempty"`
+	Scopes       []string `yaml:"scopes,omitempty"`
+	// additional parameters of the token request, e.g. audience
+	EndpointParams map[string]string `yaml:"endpoint_params,omitempty"`
+	// when set, the LiveKit token is still sent, in the X-LiveKit-Signature header
+	KeepSignature bool `yaml:"keep_signature,omitempty"`
+}
+
+// oauth2TokenSource fetches and caches client credentials tokens.
+type oauth2TokenSource struct {
+	config OAuth2Config
+	client *http.Client
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+func newOAuth2TokenSource(config *OAuth2Config) *oauth2TokenSource {
+	if config == nil || config.TokenURL == "" {
+		return nil
+	}
+	return &oauth2TokenSource{
+		config: *config,
+		client: &http.Client{Timeout: oauth2Timeout},
+	}
+}
+
+// Token returns the cached token, or requests a new one when it is about to expire.
+func (s *oauth2TokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expires.IsZero() || time.Until(s.expires) > oauth2ExpiryDelta) {
+		return s.token, nil
+	}
+
+	token, expiresIn, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.token = token
+	s.expires = time.Time{}
+	if expiresIn > 0 {
+		s.expires = time.Now().Add(expiresIn)
+	}
+	return s.token, nil
+}
+
+// Invalidate drops the cached token, e.g. after it was rejected by the receiver.
+func (s *oauth2TokenSource) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = ""
+}
+
+func (s *oauth2TokenSource) fetch(ctx context.Context) (string, time.Duration, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(s.config.Scopes) > 0 {
+		form.Set("scope", strings.Join(s.config.Scopes, " "))
+	}
+	for k, v := range s.config.EndpointParams {
+		form.Set(k, v)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", s.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	req.SetBasicAuth(url.QueryEscape(s.config.ClientID), url.QueryEscape(s.config.ClientSecret))
+	req.Header.Set("content-type", "application/x-www-form-urlencoded")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: %w", ErrOAuth2Token, err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: %w", ErrOAuth2Token, err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("%w: %s", ErrOAuth2Token, res.Status)
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err = json.Unmarshal(body, &token); err != nil {
+		return "", 0, fmt.Errorf("%w: %w", ErrOAuth2Token, err)
+	}
+	if token.AccessToken == "" {
+		return "", 0, fmt.Errorf("%w: empty access token", ErrOAuth2Token)
+	}
+	if token.TokenType != "" && !strings.EqualFold(token.TokenType, "bearer") {
+		return "", 0, fmt.Errorf("%w: unsupported token type %q", ErrOAuth2Token, token.TokenType)
+	}
+	return token.AccessToken, time.Duration(token.ExpiresIn) * time.Second, nil
+}
+
+// authorize sets the authentication headers of a webhook request.
+// signature is the LiveKit token carrying the checksum of the payload.
+func authorize(ctx context.Context, h http.Header, ts *oauth2TokenSource, signature string) error {
+	if ts == nil {
+		h.Set(authHeader, signature)
+		return nil
+	}
+
+	token, err := ts.Token(ctx)
+	if err != nil {
+		return err
+	}
+	h.Set(authHeader, "Bearer "+token)
+	if ts.config.KeepSignature {
+		h.Set(signatureHeader, signature)
+	}
+	return nil
+}
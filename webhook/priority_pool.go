@@ -0,0 +1,176 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"hash/fnv"
+	"slices"
+	"sync"
+
+	"github.com/frostbyte73/core"
+
+	"github.com/livekit/protocol/utils"
+)
+
+type EventPriority int
+
+const (
+	EventPriorityLow    EventPriority = -1
+	EventPriorityNormal EventPriority = 0
+	// high priority events are queued over the queue size when there is nothing to evict,
+	// see MaxHighPriorityOverflow
+	EventPriorityHigh EventPriority = 1
+
+	numPriorities = 3
+)
+
+// DefaultEventPriorities delivers lifecycle events before track events when the queue is contended.
+// Events not listed have normal priority.
+var DefaultEventPriorities = map[string]EventPriority{
+	EventRoomStarted:      EventPriorityHigh,
+	EventRoomFinished:     EventPriorityHigh,
+	EventEgressStarted:    EventPriorityHigh,
+	EventEgressEnded:      EventPriorityHigh,
+	EventIngressStarted:   EventPriorityHigh,
+	EventIngressEnded:     EventPriorityHigh,
+	EventSIPCallStarted:   EventPriorityHigh,
+	EventSIPCallEnded:     EventPriorityHigh,
+	EventSIPCallFailed:    EventPriorityHigh,
+	EventAgentJobStarted:  EventPriorityHigh,
+	EventAgentJobEnded:    EventPriorityHigh,
+	EventTrackPublished:   EventPriorityLow,
+	EventTrackUnpublished: EventPriorityLow,
+	EventTrackMuted:       EventPriorityLow,
+	EventTrackUnmuted:     EventPriorityLow,
+}
+
+func eventPriority(priorities map[string]EventPriority, event string) EventPriority {
+	p := priorities[event]
+	switch {
+	case p < EventPriorityLow:
+		return EventPriorityLow
+	case p > EventPriorityHigh:
+		return EventPriorityHigh
+	default:
+		return p
+	}
+}
+
+type priorityJob struct {
+	run func()
+	// called instead of run when the job is evicted by a job with a higher priority
+	drop func()
+}
+
+// priorityPool is a keyed worker pool like core.QueuePool, running the queued jobs of each worker
+// by priority, then in submission order. When a worker queue is full, a job evicts the oldest queued job
+// with a lower priority. High priority jobs are queued even if there is none, by up to maxOverflow jobs.
+type priorityPool struct {
+	mu        sync.Mutex
+	queueSize int
+	// unlimited when zero
+	maxOverflow int
+	metrics     utils.QueueMetrics
+	workers     []*utils.PriorityQueue
+	drain       core.Fuse
+	kill        core.Fuse
+}
+
+func newPriorityPool(numWorkers, queueSize, maxOverflow int, metrics utils.QueueMetrics) *priorityPool {
+	if metrics == nil {
+		metrics = utils.NoopQueueMetrics
+	}
+	return &priorityPool{
+		queueSize:   queueSize,
+		maxOverflow: maxOverflow,
+		metrics:     metrics,
+		workers:     make([]*utils.PriorityQueue, numWorkers),
+	}
+}
+
+func (p *priorityPool) Submit(key string, priority EventPriority, job priorityJob) bool {
+	p.mu.Lock()
+	if p.drain.IsBroken() || p.kill.IsBroken() {
+		p.mu.Unlock()
+		p.metrics.JobDropped(false)
+		return false
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	idx := int(h.Sum32() % uint32(len(p.workers)))
+	w := p.workers[idx]
+	if w == nil {
+		w = utils.NewPriorityQueue(utils.PriorityQueueParams{
+			Capacities:  make([]int, numPriorities),
+			MaxQueued:   p.queueSize,
+			MaxOverflow: p.overflow(),
+			Metrics:     p.metrics,
+		})
+		p.workers[idx] = w
+	}
+	p.mu.Unlock()
+
+	// classes run from the highest priority down
+	return w.EnqueueWithDrop(int(EventPriorityHigh-priority), job.run, job.drop)
+}
+
+// overflow returns the MaxOverflow of worker queues, which are unlimited when negative
+func (p *priorityPool) overflow() int {
+	if p.maxOverflow <= 0 {
+		return -1
+	}
+	return p.maxOverflow
+}
+
+// Stopped returns true once the pool has been drained or killed and no longer accepts jobs.
+func (p *priorityPool) Stopped() bool {
+	return p.drain.IsBroken() || p.kill.IsBroken()
+}
+
+// Drain runs the queued jobs and waits for them to complete. Jobs submitted while draining are dropped.
+func (p *priorityPool) Drain() {
+	p.mu.Lock()
+	p.drain.Break()
+	workers := slices.Clone(p.workers)
+	p.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, w := range workers {
+		if w != nil {
+			wg.Add(1)
+			go func(w *utils.PriorityQueue) {
+				defer wg.Done()
+				w.Drain()
+			}(w)
+		}
+	}
+	wg.Wait()
+}
+
+// Kill drops the queued jobs without waiting for running jobs to complete. No queued job is started
+// once it has returned.
+func (p *priorityPool) Kill() {
+	p.kill.Once(func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		for _, w := range p.workers {
+			if w != nil {
+				w.KillAsync()
+			}
+		}
+	})
+}
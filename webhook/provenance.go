@@ -0,0 +1,69 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"cmp"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+// Provenance identifies where an event was emitted.
+type Provenance struct {
+	Region    string
+	NodeID    string
+	ProjectID string
+}
+
+type ProvenanceParams struct {
+	// set on events which do not have their own, so that consumers of several regions can attribute them
+	Provenance Provenance
+	// when set, resolves the provenance of each event, e.g. the project of its room on multi tenant
+	// deployments. Fields it leaves empty fall back to Provenance.
+	ProvenanceHook func(event *livekit.WebhookEvent) Provenance
+}
+
+// stampProvenance returns the event with its empty provenance fields set. The event is cloned when
+// a field is set, as it may be shared with other notifiers.
+func (p ProvenanceParams) stampProvenance(event *livekit.WebhookEvent) *livekit.WebhookEvent {
+	prov := p.Provenance
+	if p.ProvenanceHook != nil {
+		h := p.ProvenanceHook(event)
+		prov.Region = cmp.Or(h.Region, prov.Region)
+		prov.NodeID = cmp.Or(h.NodeID, prov.NodeID)
+		prov.ProjectID = cmp.Or(h.ProjectID, prov.ProjectID)
+	}
+
+	setRegion := event.Region == "" && prov.Region != ""
+	setNodeID := event.NodeId == "" && prov.NodeID != ""
+	setProjectID := event.ProjectId == "" && prov.ProjectID != ""
+	if !setRegion && !setNodeID && !setProjectID {
+		return event
+	}
+
+	event = proto.Clone(event).(*livekit.WebhookEvent)
+	if setRegion {
+		event.Region = prov.Region
+	}
+	if setNodeID {
+		event.NodeId = prov.NodeID
+	}
+	if setProjectID {
+		event.ProjectId = prov.ProjectID
+	}
+	return event
+}
@@ -0,0 +1,183 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+)
+
+// Pub/Sub ordering key modes
+const (
+	PubSubOrderingNone     = ""
+	PubSubOrderingRoom     = "room"
+	PubSubOrderingResource = "resource"
+)
+
+// event derived attributes which can be attached to Pub/Sub messages
+const (
+	PubSubAttributeEvent               = "event"
+	PubSubAttributeEventID             = "event_id"
+	PubSubAttributeRoomName            = "room_name"
+	PubSubAttributeRoomSID             = "room_sid"
+	PubSubAttributeParticipantIdentity = "participant_identity"
+	PubSubAttributeEgressID            = "egress_id"
+	PubSubAttributeIngressID           = "ingress_id"
+)
+
+var defaultPubSubAttributes = []string{PubSubAttributeEvent, PubSubAttributeEventID, PubSubAttributeRoomName}
+
+type PubSubNotifierConfig struct {
+	Topic string `yaml:"topic,omitempty"`
+	// one of room, resource or empty for unordered delivery.
+	// ordering must also be enabled on the publisher
+	OrderingKey string `yaml:"ordering_key,omitempty"`
+	// event derived attributes to attach, defaults to event, event_id and room_name
+	Attributes []string      `yaml:"attributes,omitempty"`
+	NumWorkers int           `yaml:"num_workers,omitempty"`
+	QueueSize  int           `yaml:"queue_size,omitempty"`
+	MaxAge     time.Duration `yaml:"max_age,omitempty"`
+	// when set, a webhook_canary event is published at this interval to monitor the topic, see CanaryStats
+	CanaryInterval time.Duration `yaml:"canary_interval,omitempty"`
+}
+
+// PubSubMessage is a webhook event to be published to Pub/Sub. Data is the JSON encoded event.
+// When an API key is set, the Authorization attribute carries a token signing the data, which can be verified as a webhook.
+type PubSubMessage struct {
+	Topic       string
+	OrderingKey string
+	Data        []byte
+	Attributes  map[string]string
+}
+
+// PubSubPublisher is implemented by an adapter around the Pub/Sub client, publishing and waiting for the result.
+type PubSubPublisher interface {
+	Publish(ctx context.Context, msg *PubSubMessage) error
+}
+
+type PubSubNotifierParams struct {
+	Publisher PubSubPublisher
+	Logger    logger.Logger
+	Config    PubSubNotifierConfig
+	APIKey    string
+	APISecret string
+	// sets additional attributes, after the configured ones
+	AttributesHook func(event *livekit.WebhookEvent, attrs map[string]string)
+	FieldsHook     func(whi *livekit.WebhookInfo)
+	// serializes the message payload, defaults to ProtoJSONEncoder
+	Encoder Encoder
+	FilterParams
+	DedupeParams
+	JournalParams
+	ProvenanceParams
+}
+
+// PubSubNotifier is a QueuedNotifier that publishes events to a Google Pub/Sub topic.
+type PubSubNotifier struct {
+	*brokerNotifier
+}
+
+var _ QueuedNotifier = (*PubSubNotifier)(nil)
+
+func NewPubSubNotifier(params PubSubNotifierParams) *PubSubNotifier {
+	if len(params.Config.Attributes) == 0 {
+		params.Config.Attributes = defaultPubSubAttributes
+	}
+	p := &pubSubPublisher{
+		publisher: params.Publisher,
+		config:    params.Config,
+		hook:      params.AttributesHook,
+	}
+	return &PubSubNotifier{
+		brokerNotifier: newBrokerNotifier(brokerNotifierParams{
+			Logger: params.Logger,
+			Config: URLNotifierConfig{
+				NumWorkers:     params.Config.NumWorkers,
+				QueueSize:      params.Config.QueueSize,
+				MaxAge:         params.Config.MaxAge,
+				CanaryInterval: params.Config.CanaryInterval,
+			},
+			Destination:      "pubsub://" + params.Config.Topic,
+			APIKey:           params.APIKey,
+			APISecret:        params.APISecret,
+			FieldsHook:       params.FieldsHook,
+			Encoder:          params.Encoder,
+			FilterParams:     params.FilterParams,
+			DedupeParams:     params.DedupeParams,
+			JournalParams:    params.JournalParams,
+			ProvenanceParams: params.ProvenanceParams,
+		}, p),
+	}
+}
+
+type pubSubPublisher struct {
+	publisher PubSubPublisher
+	config    PubSubNotifierConfig
+	hook      func(event *livekit.WebhookEvent, attrs map[string]string)
+}
+
+func (p *pubSubPublisher) Publish(ctx context.Context, event *livekit.WebhookEvent, msg *brokerMessage) error {
+	var orderingKey string
+	switch p.config.OrderingKey {
+	case PubSubOrderingRoom:
+		orderingKey = eventRoomName(event)
+	case PubSubOrderingResource:
+		orderingKey = eventKey(event)
+	}
+
+	attrs := make(map[string]string, len(p.config.Attributes)+1)
+	for _, name := range p.config.Attributes {
+		if v := pubSubAttribute(event, name); v != "" {
+			attrs[name] = v
+		}
+	}
+	if p.hook != nil {
+		p.hook(event, attrs)
+	}
+	if msg.Token != "" {
+		attrs[authHeader] = msg.Token
+	}
+
+	return p.publisher.Publish(ctx, &PubSubMessage{
+		Topic:       p.config.Topic,
+		OrderingKey: orderingKey,
+		Data:        msg.Payload,
+		Attributes:  attrs,
+	})
+}
+
+func pubSubAttribute(event *livekit.WebhookEvent, name string) string {
+	switch name {
+	case PubSubAttributeEvent:
+		return event.Event
+	case PubSubAttributeEventID:
+		return event.Id
+	case PubSubAttributeRoomName:
+		return eventRoomName(event)
+	case PubSubAttributeRoomSID:
+		return event.Room.GetSid()
+	case PubSubAttributeParticipantIdentity:
+		return event.Participant.GetIdentity()
+	case PubSubAttributeEgressID:
+		return event.EgressInfo.GetEgressId()
+	case PubSubAttributeIngressID:
+		return event.IngressInfo.GetIngressId()
+	default:
+		return ""
+	}
+}
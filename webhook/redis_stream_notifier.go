@@ -0,0 +1,150 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	lkredis "github.com/livekit/protocol/redis"
+)
+
+const (
+	defaultRedisStream       = "livekit:webhooks"
+	defaultRedisStreamMaxLen = 10000
+)
+
+// fields of stream entries
+const (
+	RedisStreamFieldEvent = "event"
+	RedisStreamFieldID    = "id"
+	RedisStreamFieldData  = "data"
+	// signed token for data, set when an API key is configured. it can be verified as a webhook Authorization header
+	RedisStreamFieldToken = "token"
+)
+
+type RedisStreamNotifierConfig struct {
+	// stream key, defaults to livekit:webhooks
+	Stream string `yaml:"stream,omitempty"`
+	// approximate maximum length of the stream, older entries are trimmed. defaults to 10000, -1 disables trimming
+	MaxLen     int64         `yaml:"max_len,omitempty"`
+	NumWorkers int           `yaml:"num_workers,omitempty"`
+	QueueSize  int           `yaml:"queue_size,omitempty"`
+	MaxAge     time.Duration `yaml:"max_age,omitempty"`
+	// when set, a webhook_canary event is added at this interval to monitor the stream, see CanaryStats
+	CanaryInterval time.Duration `yaml:"canary_interval,omitempty"`
+}
+
+type RedisStreamNotifierParams struct {
+	// redis client to use, created from Redis when not set
+	Client     redis.UniversalClient
+	Redis      *lkredis.RedisConfig
+	Logger     logger.Logger
+	Config     RedisStreamNotifierConfig
+	APIKey     string
+	APISecret  string
+	FieldsHook func(whi *livekit.WebhookInfo)
+	// serializes the message payload, defaults to ProtoJSONEncoder
+	Encoder Encoder
+	FilterParams
+	DedupeParams
+	JournalParams
+	ProvenanceParams
+}
+
+// RedisStreamNotifier is a QueuedNotifier that adds events to a Redis Stream.
+type RedisStreamNotifier struct {
+	*brokerNotifier
+}
+
+var _ QueuedNotifier = (*RedisStreamNotifier)(nil)
+
+func NewRedisStreamNotifier(params RedisStreamNotifierParams) (*RedisStreamNotifier, error) {
+	if params.Client == nil {
+		rc, err := lkredis.GetRedisClient(params.Redis)
+		if err != nil {
+			return nil, err
+		}
+		if rc == nil {
+			return nil, lkredis.ErrNotConfigured
+		}
+		params.Client = rc
+	}
+	if params.Config.Stream == "" {
+		params.Config.Stream = defaultRedisStream
+	}
+	if params.Config.MaxLen == 0 {
+		params.Config.MaxLen = defaultRedisStreamMaxLen
+	}
+
+	p := &redisStreamPublisher{
+		rc:     params.Client,
+		config: params.Config,
+	}
+	return &RedisStreamNotifier{
+		brokerNotifier: newBrokerNotifier(brokerNotifierParams{
+			Logger: params.Logger,
+			Config: URLNotifierConfig{
+				NumWorkers:     params.Config.NumWorkers,
+				QueueSize:      params.Config.QueueSize,
+				MaxAge:         params.Config.MaxAge,
+				CanaryInterval: params.Config.CanaryInterval,
+			},
+			Destination:      "redis://" + params.Config.Stream,
+			APIKey:           params.APIKey,
+			APISecret:        params.APISecret,
+			FieldsHook:       params.FieldsHook,
+			Encoder:          params.Encoder,
+			FilterParams:     params.FilterParams,
+			DedupeParams:     params.DedupeParams,
+			JournalParams:    params.JournalParams,
+			ProvenanceParams: params.ProvenanceParams,
+		}, p),
+	}, nil
+}
+
+type redisStreamPublisher struct {
+	rc     redis.UniversalClient
+	config RedisStreamNotifierConfig
+}
+
+func (p *redisStreamPublisher) Publish(ctx context.Context, event *livekit.WebhookEvent, msg *brokerMessage) error {
+	return p.rc.XAdd(ctx, redisStreamArgs(p.config, event, msg)).Err()
+}
+
+func redisStreamArgs(config RedisStreamNotifierConfig, event *livekit.WebhookEvent, msg *brokerMessage) *redis.XAddArgs {
+	values := []any{
+		RedisStreamFieldEvent, event.Event,
+		RedisStreamFieldID, event.Id,
+		RedisStreamFieldData, msg.Payload,
+	}
+	if msg.Token != "" {
+		values = append(values, RedisStreamFieldToken, msg.Token)
+	}
+
+	args := &redis.XAddArgs{
+		Stream: config.Stream,
+		Values: values,
+	}
+	if config.MaxLen > 0 {
+		args.MaxLen = config.MaxLen
+		args.Approx = true
+	}
+	return args
+}
@@ -22,21 +22,28 @@ import (
 
 	"github.com/gammazero/deque"
 	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/utils"
 )
 
 var (
 	errQueueFull   = errors.New("queue is full")
 	errQueueClosed = errors.New("queue is closed")
+	errEvicted     = errors.New("evicted by a higher priority event")
+	errDiscarded   = errors.New("discarded by a forced stop")
 )
 
 type item struct {
 	ctx      context.Context
 	queuedAt time.Time
 	event    *livekit.WebhookEvent
+	// submission order over all priorities
+	seq uint64
 }
 
 type resourceQueueParams struct {
 	MaxDepth int
+	// number of high priority events queued over MaxDepth when there is nothing to evict, unlimited when zero
+	MaxHighPriorityOverflow int
 
 	Poster poster
 }
@@ -44,19 +51,26 @@ type resourceQueueParams struct {
 type resourceQueue struct {
 	params resourceQueueParams
 
-	mu    sync.Mutex
-	items deque.Deque[*item]
-	cond  *sync.Cond
+	mu sync.Mutex
+	// queued items by priority, from the highest to the lowest
+	items    [numPriorities]deque.Deque[*item]
+	numItems int
+	nextSeq  uint64
+	cond     *sync.Cond
 
 	closed bool
 	drain  bool
+	done   chan struct{}
 }
 
 func newResourceQueue(params resourceQueueParams) *resourceQueue {
 	r := &resourceQueue{
 		params: params,
+		done:   make(chan struct{}),
+	}
+	for i := range r.items {
+		r.items[i].SetBaseCap(int(min(params.MaxDepth, 16)))
 	}
-	r.items.SetBaseCap(int(min(params.MaxDepth, 16)))
 	r.cond = sync.NewCond(&r.mu)
 
 	go r.worker()
@@ -72,34 +86,70 @@ func (r *resourceQueue) Stop(force bool) {
 		r.drain = !force
 
 		r.cond.Broadcast()
+	} else if force {
+		// stops a drain in progress
+		r.drain = false
 	}
 }
 
-func (r *resourceQueue) Enqueue(ctx context.Context, whEvent *livekit.WebhookEvent) error {
-	return r.EnqueueAt(ctx, time.Now(), whEvent)
+// Done is closed once the queue is stopped and done draining.
+func (r *resourceQueue) Done() <-chan struct{} {
+	return r.done
+}
+
+func (r *resourceQueue) Enqueue(ctx context.Context, priority EventPriority, whEvent *livekit.WebhookEvent) (*item, error) {
+	return r.EnqueueAt(ctx, time.Now(), priority, whEvent)
 }
 
-func (r *resourceQueue) EnqueueAt(ctx context.Context, at time.Time, whEvent *livekit.WebhookEvent) error {
+// EnqueueAt queues the event, returning the queued item evicted in its place, if any. When the queue is full,
+// the event evicts the oldest queued event with the lowest priority below its own. High priority events are
+// queued over MaxDepth if there is none, see MaxHighPriorityOverflow. Events are delivered in submission order.
+func (r *resourceQueue) EnqueueAt(ctx context.Context, at time.Time, priority EventPriority, whEvent *livekit.WebhookEvent) (*item, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	if r.closed {
-		return errQueueClosed
+		return nil, errQueueClosed
 	}
 
-	if r.items.Len() >= r.params.MaxDepth {
-		return errQueueFull
+	class := int(EventPriorityHigh - priority)
+	var evicted *item
+	if r.numItems >= r.params.MaxDepth {
+		if it, c := utils.EvictLowest(r.items[:], class); c != -1 {
+			evicted = it
+			r.numItems--
+		} else if priority != EventPriorityHigh || !r.canOverflow() {
+			return nil, errQueueFull
+		}
 	}
 
-	r.items.PushBack(&item{ctx, at, whEvent})
+	r.items[class].PushBack(&item{ctx, at, whEvent, r.nextSeq})
+	r.nextSeq++
+	r.numItems++
 	r.cond.Broadcast()
-	return nil
+	return evicted, nil
+}
+
+func (r *resourceQueue) canOverflow() bool {
+	return r.params.MaxHighPriorityOverflow <= 0 || r.numItems < r.params.MaxDepth+r.params.MaxHighPriorityOverflow
+}
+
+// pop removes the oldest queued item
+func (r *resourceQueue) pop() *item {
+	class := -1
+	for i := range r.items {
+		if r.items[i].Len() > 0 && (class == -1 || r.items[i].Front().seq < r.items[class].Front().seq) {
+			class = i
+		}
+	}
+	r.numItems--
+	return r.items[class].PopFront()
 }
 
 func (r *resourceQueue) flush() {
 	r.mu.Lock()
-	for r.items.Len() > 0 {
-		item := r.items.PopFront()
+	for r.drain && r.numItems > 0 {
+		item := r.pop()
 		r.mu.Unlock()
 
 		r.params.Poster.Process(item.ctx, item.queuedAt, item.event)
@@ -109,7 +159,22 @@ func (r *resourceQueue) flush() {
 	r.mu.Unlock()
 }
 
+// discard drops the items left by a forced stop
+func (r *resourceQueue) discard() {
+	r.mu.Lock()
+	var items []*item
+	for r.numItems > 0 {
+		items = append(items, r.pop())
+	}
+	r.mu.Unlock()
+
+	for _, item := range items {
+		r.params.Poster.Drop(item.ctx, item.event, errDiscarded)
+	}
+}
+
 func (r *resourceQueue) worker() {
+	defer close(r.done)
 	for {
 		r.mu.Lock()
 		for {
@@ -118,16 +183,17 @@ func (r *resourceQueue) worker() {
 				if r.drain {
 					r.flush()
 				}
+				r.discard()
 				return
 			}
 
-			if r.items.Len() != 0 {
+			if r.numItems != 0 {
 				break
 			}
 			r.cond.Wait()
 		}
 
-		item := r.items.PopFront()
+		item := r.pop()
 		r.mu.Unlock()
 
 		r.params.Poster.Process(item.ctx, item.queuedAt, item.event)
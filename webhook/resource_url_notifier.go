@@ -17,17 +17,14 @@ package webhook
 import (
 	"bytes"
 	"context"
-	"crypto/sha256"
-	"encoding/base64"
 	"errors"
+	"net/http"
 	"sync"
 	"time"
 
 	"github.com/frostbyte73/core"
 	"github.com/hashicorp/go-retryablehttp"
-	"google.golang.org/protobuf/encoding/protojson"
 
-	"github.com/livekit/protocol/auth"
 	"github.com/livekit/protocol/livekit"
 	"github.com/livekit/protocol/logger"
 	"github.com/livekit/protocol/utils"
@@ -44,6 +41,14 @@ var (
 type ResourceURLNotifierConfig struct {
 	MaxAge   time.Duration `yaml:"max_age,omitempty"`
 	MaxDepth int           `yaml:"max_depth,omitempty"`
+	// number of high priority events queued over MaxDepth when there is no lower priority event to evict,
+	// unlimited when zero, so that lifecycle events are never dropped
+	MaxHighPriorityOverflow int `yaml:"max_high_priority_overflow,omitempty"`
+	// priorities by event type, lower priority events of a resource are dropped first when its queue is full,
+	// defaults to DefaultEventPriorities
+	Priorities map[string]EventPriority `yaml:"priorities,omitempty"`
+	// when set, a webhook_canary event is sent at this interval to monitor the endpoint, see CanaryStats
+	CanaryInterval time.Duration `yaml:"canary_interval,omitempty"`
 }
 
 var DefaultResourceURLNotifierConfig = ResourceURLNotifierConfig{
@@ -53,6 +58,8 @@ var DefaultResourceURLNotifierConfig = ResourceURLNotifierConfig{
 
 type poster interface {
 	Process(ctx context.Context, queuedAt time.Time, event *livekit.WebhookEvent)
+	// called instead of Process for queued events which are not delivered
+	Drop(ctx context.Context, event *livekit.WebhookEvent, reason error)
 }
 
 type resourceQueueInfo struct {
@@ -63,21 +70,28 @@ type resourceQueueInfo struct {
 
 type ResourceURLNotifierParams struct {
 	HTTPClientParams
-	Logger     logger.Logger
-	Timeout    time.Duration
-	Config     ResourceURLNotifierConfig
-	URL        string
-	APIKey     string
-	APISecret  string
+	Logger    logger.Logger
+	Timeout   time.Duration
+	Config    ResourceURLNotifierConfig
+	URL       string
+	APIKey    string
+	APISecret string
+	// when set, events it resolves a key for are signed with that key instead of APIKey and APISecret
+	SigningKeyResolver SigningKeyResolver
+	// serializes the request payload, defaults to ProtoJSONEncoder
+	Encoder    Encoder
 	FieldsHook func(whi *livekit.WebhookInfo)
 	FilterParams
+	DedupeParams
+	JournalParams
+	ProvenanceParams
 }
 
 // ResourceURLNotifier is a QueuedNotifier that sends a POST request to a Webhook URL.
 // It queues up events per resource (could be egress, ingress, room, participant, track, etc.)
-//   to avoid blocking events of one resource blocking another resource's event(s).
+// to avoid blocking events of one resource blocking another resource's event(s).
 // It will retry on failure, and will drop events if notification fall too far behind,
-//   either in age or queue depth.
+// either in age or queue depth.
 type ResourceURLNotifier struct {
 	mu            sync.RWMutex
 	params        ResourceURLNotifierParams
@@ -87,7 +101,13 @@ type ResourceURLNotifier struct {
 	resourceQueues            map[string]*resourceQueueInfo
 	resourceQueueTimeoutQueue utils.TimeoutQueue[*resourceQueueInfo]
 
-	filter *filter
+	filter    *filter
+	deduper   *deduper
+	journaler *journaler
+	oauth2    *oauth2TokenSource
+	// queued events that were not processed yet
+	pending *pendingDeliveries
+	canary  *canary
 
 	closed core.Fuse
 }
@@ -106,28 +126,22 @@ func NewResourceURLNotifier(params ResourceURLNotifierParams) *ResourceURLNotifi
 	if params.Config.MaxDepth == 0 {
 		params.Config.MaxDepth = DefaultResourceURLNotifierConfig.MaxDepth
 	}
-
-	rhc := retryablehttp.NewClient()
-	if params.RetryWaitMin > 0 {
-		rhc.RetryWaitMin = params.RetryWaitMin
-	}
-	if params.RetryWaitMax > 0 {
-		rhc.RetryWaitMax = params.RetryWaitMax
-	}
-	if params.MaxRetries > 0 {
-		rhc.RetryMax = params.MaxRetries
+	if params.Config.Priorities == nil {
+		params.Config.Priorities = DefaultEventPriorities
 	}
-	if params.ClientTimeout > 0 {
-		rhc.HTTPClient.Timeout = params.ClientTimeout
-	}
-	rhc.Logger = &logAdapter{}
+
 	r := &ResourceURLNotifier{
 		params:         params,
-		client:         rhc,
+		client:         params.HTTPClientParams.newClient(),
 		resourceQueues: make(map[string]*resourceQueueInfo),
 		filter:         newFilter(params.FilterParams),
+		deduper:        newDeduper(params.DedupeParams, params.URL),
+		journaler:      newJournaler(params.JournalParams, params.URL),
+		oauth2:         newOAuth2TokenSource(params.OAuth2),
+		pending:        newPendingDeliveries(),
 	}
 
+	r.canary = r.newCanary()
 	go r.sweeper()
 	return r
 }
@@ -160,22 +174,59 @@ func (r *ResourceURLNotifier) getProcessedHook() func(ctx context.Context, whi *
 }
 
 func (r *ResourceURLNotifier) QueueNotify(ctx context.Context, event *livekit.WebhookEvent) error {
-	if !r.filter.IsAllowed(event.Event) {
+	event = syncedEvent(event)
+	if !r.filter.IsAllowed(event) {
 		return nil
 	}
+	event = r.params.stampProvenance(event)
 
 	if r.closed.IsBroken() {
 		return errClosed
 	}
 
+	if r.deduper.IsDuplicate(ctx, event) {
+		r.params.Logger.Debugw("skipped duplicate webhook", logFields(event, r.params.URL)...)
+		return nil
+	}
+
+	if err := r.enqueue(ctx, event); err != nil {
+		r.deduper.Forget(ctx, event)
+		return err
+	}
+	return nil
+}
+
+// Replay re-enqueues journaled events created between from and to which pass the filter.
+// Events are replayed once each, regardless of the number of recorded delivery attempts.
+// It returns the number of replayed events.
+func (r *ResourceURLNotifier) Replay(ctx context.Context, from, to time.Time, params FilterParams) (int, error) {
+	if r.closed.IsBroken() {
+		return 0, errClosed
+	}
+
+	events, err := r.journaler.Events(ctx, from, to, newFilter(params))
+	if err != nil {
+		return 0, err
+	}
+	replayed := 0
+	for _, event := range events {
+		if err = r.enqueue(ctx, event); err == nil {
+			replayed++
+		}
+	}
+	return replayed, nil
+}
+
+func (r *ResourceURLNotifier) enqueue(ctx context.Context, event *livekit.WebhookEvent) error {
 	key := eventKey(event)
 
 	r.mu.Lock()
 	rqi := r.resourceQueues[key]
 	if rqi == nil || !r.resourceQueueTimeoutQueue.Reset(rqi.tqi) {
 		rq := newResourceQueue(resourceQueueParams{
-			MaxDepth: r.params.Config.MaxDepth,
-			Poster:   r,
+			MaxDepth:                r.params.Config.MaxDepth,
+			MaxHighPriorityOverflow: r.params.Config.MaxHighPriorityOverflow,
+			Poster:                  r,
 		})
 		rqi = &resourceQueueInfo{resourceQueue: rq, key: key}
 		rqi.tqi = &utils.TimeoutQueueItem[*resourceQueueInfo]{Value: rqi}
@@ -184,77 +235,91 @@ func (r *ResourceURLNotifier) QueueNotify(ctx context.Context, event *livekit.We
 	}
 	r.mu.Unlock()
 
-	err := rqi.resourceQueue.Enqueue(ctx, event)
+	priority := eventPriority(r.params.Config.Priorities, event.Event)
+	r.pending.Inc()
+	evicted, err := rqi.resourceQueue.Enqueue(ctx, priority, event)
+	if evicted != nil {
+		r.Drop(evicted.ctx, evicted.event, errEvicted)
+	}
 	if err != nil {
-		fields := logFields(event, r.params.URL)
-		fields = append(fields, "reason", err)
-		r.params.Logger.Infow("dropped webhook", fields...)
-
-		if ph := r.getProcessedHook(); ph != nil {
-			whi := webhookInfo(
-				event,
-				time.Time{},
-				0,
-				time.Time{},
-				0,
-				r.params.URL,
-				true,
-				nil,
-			)
-			if r.params.FieldsHook != nil {
-				r.params.FieldsHook(whi)
-			}
-			ph(ctx, whi)
-		}
+		r.Drop(ctx, event, err)
 	}
 	return err
 }
 
+// poster interface
+func (r *ResourceURLNotifier) Drop(ctx context.Context, event *livekit.WebhookEvent, reason error) {
+	defer r.pending.Dec()
+
+	fields := contextLogFields(ctx, event, r.params.URL)
+	fields = append(fields, "reason", reason)
+	r.params.Logger.Infow("dropped webhook", fields...)
+
+	r.processed(ctx, event, time.Time{}, 0, time.Time{}, 0, true, nil)
+}
+
 func (r *ResourceURLNotifier) Stop(force bool) {
+	for _, rq := range r.close() {
+		rq.Stop(force)
+	}
+}
+
+// StopContext drains queued events until ctx is done. Deliveries still pending at that point,
+// including ones in flight, are abandoned. It returns the number of abandoned events.
+func (r *ResourceURLNotifier) StopContext(ctx context.Context) int {
+	resourceQueues := r.close()
+	drain := func() {
+		for _, rq := range resourceQueues {
+			rq.Stop(false)
+		}
+		for _, rq := range resourceQueues {
+			<-rq.Done()
+		}
+	}
+	kill := func() {
+		for _, rq := range resourceQueues {
+			rq.Stop(true)
+		}
+	}
+
+	abandoned := r.pending.StopContext(ctx, drain, kill)
+	if abandoned > 0 {
+		r.params.Logger.Infow("abandoned webhooks", "count", abandoned, "url", r.params.URL)
+	}
+	return abandoned
+}
+
+// close stops accepting events and returns the resource queues to stop
+func (r *ResourceURLNotifier) close() map[string]*resourceQueueInfo {
 	r.closed.Break()
+	r.canary.Stop()
 
 	r.mu.Lock()
+	defer r.mu.Unlock()
 	resourceQueues := r.resourceQueues
 	r.resourceQueues = make(map[string]*resourceQueueInfo)
-	r.mu.Unlock()
-
-	for _, rq := range resourceQueues {
-		rq.Stop(force)
-	}
+	return resourceQueues
 }
 
 // poster interface
 func (r *ResourceURLNotifier) Process(ctx context.Context, queuedAt time.Time, event *livekit.WebhookEvent) {
-	fields := logFields(event, r.params.URL)
+	defer r.pending.Dec()
+
+	fields := contextLogFields(ctx, event, r.params.URL)
 
 	queueDuration := time.Since(queuedAt)
 	fields = append(fields, "queueDuration", queueDuration)
 
-	if queueDuration > r.params.Config.MaxAge {
-		fields = append(fields, "reason", "age")
+	if reason := r.staleReason(queueDuration); reason != "" {
+		fields = append(fields, "reason", reason)
 		r.params.Logger.Infow("dropped webhook", fields...)
 
-		if ph := r.getProcessedHook(); ph != nil {
-			whi := webhookInfo(
-				event,
-				queuedAt,
-				queueDuration,
-				time.Time{},
-				0,
-				r.params.URL,
-				true,
-				nil,
-			)
-			if r.params.FieldsHook != nil {
-				r.params.FieldsHook(whi)
-			}
-			ph(ctx, whi)
-		}
+		r.processed(ctx, event, queuedAt, queueDuration, time.Time{}, 0, true, nil)
 		return
 	}
 
 	sendStart := time.Now()
-	err := r.send(event)
+	err := r.send(r.pending.ctx, event)
 	sendDuration := time.Since(sendStart)
 	fields = append(fields, "sendDuration", sendDuration)
 	if err != nil {
@@ -262,58 +327,92 @@ func (r *ResourceURLNotifier) Process(ctx context.Context, queuedAt time.Time, e
 	} else {
 		r.params.Logger.Infow("sent webhook", fields...)
 	}
-	if ph := r.getProcessedHook(); ph != nil {
-		whi := webhookInfo(
-			event,
-			queuedAt,
-			queueDuration,
-			sendStart,
-			sendDuration,
-			r.params.URL,
-			false,
-			err,
-		)
-		if r.params.FieldsHook != nil {
-			r.params.FieldsHook(whi)
-		}
+	r.processed(ctx, event, queuedAt, queueDuration, sendStart, sendDuration, false, err)
+}
+
+func (r *ResourceURLNotifier) staleReason(queueDuration time.Duration) string {
+	if r.pending.Abandoned() {
+		return "abandoned"
+	}
+	if queueDuration > r.params.Config.MaxAge {
+		return "age"
+	}
+	return ""
+}
+
+func (r *ResourceURLNotifier) processed(
+	ctx context.Context,
+	event *livekit.WebhookEvent,
+	queuedAt time.Time,
+	queueDuration time.Duration,
+	sentAt time.Time,
+	sendDuration time.Duration,
+	isDropped bool,
+	sendError error,
+) {
+	ph := r.getProcessedHook()
+	if ph == nil && !r.journaler.Enabled() {
+		return
+	}
+
+	whi := webhookInfo(
+		event,
+		queuedAt,
+		queueDuration,
+		sentAt,
+		sendDuration,
+		r.params.URL,
+		isDropped,
+		sendError,
+	)
+	if r.params.FieldsHook != nil {
+		r.params.FieldsHook(whi)
+	}
+	r.journaler.Record(ctx, event, whi)
+	if ph != nil {
 		ph(ctx, whi)
 	}
 }
 
-func (r *ResourceURLNotifier) send(event *livekit.WebhookEvent) error {
-	encoded, err := protojson.Marshal(event)
-	if err != nil {
+func (r *ResourceURLNotifier) send(ctx context.Context, event *livekit.WebhookEvent) error {
+	if err := r.params.URLGuard.Validate(r.params.URL); err != nil {
 		return err
 	}
-	// sign payload
-	sum := sha256.Sum256(encoded)
-	b64 := base64.StdEncoding.EncodeToString(sum[:])
 
-	r.mu.RLock()
-	apiKey := r.params.APIKey
-	apiSecret := r.params.APISecret
-	r.mu.RUnlock()
-
-	at := auth.NewAccessToken(apiKey, apiSecret).
-		SetValidFor(5 * time.Minute).
-		SetSha256(b64)
-	token, err := at.ToJWT()
+	encoded, contentType, err := marshalEvent(r.params.Encoder, event)
 	if err != nil {
 		return err
 	}
-	req, err := retryablehttp.NewRequest("POST", r.params.URL, bytes.NewReader(encoded))
+	key, ok := SigningKey{}, false
+	if r.params.SigningKeyResolver != nil {
+		key, ok = r.params.SigningKeyResolver(event)
+	}
+	if !ok {
+		r.mu.RLock()
+		key = SigningKey{APIKey: r.params.APIKey, APISecret: r.params.APISecret}
+		r.mu.RUnlock()
+	}
+	token, err := signPayload(encoded, key)
+	if err != nil {
+		return err
+	}
+	req, err := retryablehttp.NewRequestWithContext(ctx, "POST", r.params.URL, bytes.NewReader(encoded))
 	if err != nil {
 		// ignore and continue
 		return err
 	}
-	req.Header.Set(authHeader, token)
-	// use a custom mime type to ensure signature is checked prior to parsing
-	req.Header.Set("content-type", "application/webhook+json")
+	if err = authorize(ctx, req.Header, r.oauth2, token); err != nil {
+		return err
+	}
+	req.Header.Set("content-type", contentType)
 	res, err := r.client.Do(req)
 	if err != nil {
 		return err
 	}
 	_ = res.Body.Close()
+	if res.StatusCode == http.StatusUnauthorized && r.oauth2 != nil {
+		r.oauth2.Invalidate()
+	}
 	return nil
 }
 
@@ -0,0 +1,156 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+)
+
+const (
+	defaultIdempotencyTTL   = 24 * time.Hour
+	defaultIdempotencyLease = time.Minute
+)
+
+var ErrEventInProgress = errors.New("event is being processed")
+
+type EventHandler func(ctx context.Context, event *livekit.WebhookEvent) error
+
+type RouterParams struct {
+	KeyProvider auth.KeyProvider
+	Logger      logger.Logger
+	// when set, events are processed at most once by a successful handler
+	IdempotencyStore IdempotencyStore
+	// how long processed event IDs are remembered, defaults to 24h
+	IdempotencyTTL time.Duration
+	// how long a handler may hold an event before another delivery can process it, defaults to 1m
+	IdempotencyLease time.Duration
+}
+
+// Router receives webhooks and dispatches them to handlers by event type.
+// Handler errors are returned to the sender with a 5xx status, so that the event is retried.
+type Router struct {
+	params RouterParams
+
+	mu             sync.RWMutex
+	handlers       map[string]EventHandler
+	defaultHandler EventHandler
+}
+
+func NewRouter(params RouterParams) *Router {
+	if params.Logger == nil {
+		params.Logger = logger.GetLogger()
+	}
+	if params.IdempotencyTTL == 0 {
+		params.IdempotencyTTL = defaultIdempotencyTTL
+	}
+	if params.IdempotencyLease == 0 {
+		params.IdempotencyLease = defaultIdempotencyLease
+	}
+	return &Router{
+		params:   params,
+		handlers: make(map[string]EventHandler),
+	}
+}
+
+// Handle registers the handler for an event type, e.g. EventRoomFinished.
+func (r *Router) Handle(event string, h EventHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[event] = h
+}
+
+// HandleDefault registers the handler for events without a specific handler.
+func (r *Router) HandleDefault(h EventHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.defaultHandler = h
+}
+
+func (r *Router) getHandler(event string) EventHandler {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if h, ok := r.handlers[event]; ok {
+		return h
+	}
+	return r.defaultHandler
+}
+
+// Dispatch runs the handler for the event. Events already processed are skipped,
+// and ErrEventInProgress is returned for events being processed by another delivery.
+func (r *Router) Dispatch(ctx context.Context, event *livekit.WebhookEvent) error {
+	h := r.getHandler(event.Event)
+	if h == nil {
+		return nil
+	}
+
+	store := r.params.IdempotencyStore
+	if store == nil || event.Id == "" {
+		return h(ctx, event)
+	}
+
+	state, token, err := store.Claim(ctx, event.Id, r.params.IdempotencyLease)
+	if err != nil {
+		return err
+	}
+	switch state {
+	case IdempotencyProcessed:
+		r.params.Logger.Debugw("skipped processed webhook", "event", event.Event, "id", event.Id)
+		return nil
+	case IdempotencyInProgress:
+		return ErrEventInProgress
+	}
+
+	if err = h(ctx, event); err != nil {
+		if rerr := store.Release(ctx, event.Id, token); rerr != nil {
+			r.params.Logger.Warnw("could not release webhook", rerr, "event", event.Event, "id", event.Id)
+		}
+		return err
+	}
+	if err = store.Complete(ctx, event.Id, token, r.params.IdempotencyTTL); errors.Is(err, ErrIdempotencyClaimLost) {
+		// the handler succeeded, the delivery holding the new claim completes the event
+		r.params.Logger.Warnw("webhook claim expired before the handler returned", err, "event", event.Event, "id", event.Id)
+		return nil
+	}
+	return err
+}
+
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	event, err := ReceiveWebhookEvent(req, r.params.KeyProvider)
+	if err != nil {
+		r.params.Logger.Debugw("could not receive webhook", err)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch err = r.Dispatch(req.Context(), event); {
+	case err == nil:
+		w.WriteHeader(http.StatusOK)
+	case errors.Is(err, ErrEventInProgress):
+		// ask the sender to retry, in case the other delivery fails
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	default:
+		r.params.Logger.Warnw("webhook handler failed", err, "event", event.Event, "id", event.Id)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/livekit/protocol/livekit"
+)
+
+// ParticipantSession groups the connections of a participant that belong to the same session.
+type ParticipantSession struct {
+	SessionID       string
+	RoomName        string
+	Identity        string
+	ParticipantSids []string
+	JoinedAt        time.Time
+	// zero while the session is active
+	LeftAt     time.Time
+	Reconnects int
+	TotalGap   time.Duration
+}
+
+func (s *ParticipantSession) Active() bool {
+	return s.LeftAt.IsZero()
+}
+
+func (s *ParticipantSession) currentSid() string {
+	return s.ParticipantSids[len(s.ParticipantSids)-1]
+}
+
+// SessionStitcher joins participant webhook events across reconnects,
+// so a reconnecting participant is accounted for as a single session.
+type SessionStitcher struct {
+	mu       sync.Mutex
+	sessions map[string]*ParticipantSession
+	bySid    map[string]*ParticipantSession
+}
+
+func NewSessionStitcher() *SessionStitcher {
+	return &SessionStitcher{
+		sessions: make(map[string]*ParticipantSession),
+		bySid:    make(map[string]*ParticipantSession),
+	}
+}
+
+// Observe applies a participant event and returns a copy of the affected session, or nil for other events.
+// Sessions are forgotten once the participant leaves, the returned copy has LeftAt set.
+func (s *SessionStitcher) Observe(event *livekit.WebhookEvent) *ParticipantSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var session *ParticipantSession
+	switch event.Event {
+	case EventParticipantJoined:
+		if event.Participant == nil {
+			return nil
+		}
+		session = s.getOrCreate(event, event.Participant.SessionId, event.Participant.Sid)
+
+	case EventParticipantReconnected:
+		r := event.ParticipantReconnect
+		if r == nil || r.ParticipantSid == "" {
+			return nil
+		}
+		session = s.bySid[r.PreviousParticipantSid]
+		if session == nil {
+			session = s.getOrCreate(event, r.SessionId, r.PreviousParticipantSid)
+		}
+		if !slices.Contains(session.ParticipantSids, r.ParticipantSid) {
+			session.ParticipantSids = append(session.ParticipantSids, r.ParticipantSid)
+			s.bySid[r.ParticipantSid] = session
+		}
+		session.Reconnects++
+		session.TotalGap += time.Duration(r.GapMs) * time.Millisecond
+
+	case EventParticipantMoved:
+		// the session continues in the destination room with a new connection
+		if event.Participant == nil || event.ParticipantMove == nil {
+			return nil
+		}
+		session = s.bySid[event.ParticipantMove.PreviousParticipantSid]
+		if session == nil {
+			session = s.getOrCreate(event, event.Participant.SessionId, event.Participant.Sid)
+		}
+		if !slices.Contains(session.ParticipantSids, event.Participant.Sid) {
+			session.ParticipantSids = append(session.ParticipantSids, event.Participant.Sid)
+			s.bySid[event.Participant.Sid] = session
+		}
+		if event.Room != nil {
+			session.RoomName = event.Room.Name
+		}
+
+	case EventParticipantLeft:
+		if event.Participant == nil {
+			return nil
+		}
+		session = s.bySid[event.Participant.Sid]
+		if session == nil {
+			return nil
+		}
+		delete(s.bySid, event.Participant.Sid)
+		// the previous connection of a reconnected participant leaving does not end the session
+		if session.currentSid() == event.Participant.Sid {
+			session.LeftAt = eventTime(event)
+			for _, sid := range session.ParticipantSids {
+				delete(s.bySid, sid)
+			}
+			delete(s.sessions, session.SessionID)
+		}
+
+	default:
+		return nil
+	}
+
+	res := *session
+	res.ParticipantSids = slices.Clone(session.ParticipantSids)
+	return &res
+}
+
+// Session returns a copy of the active session containing the participant sid.
+func (s *SessionStitcher) Session(participantSid string) (*ParticipantSession, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.bySid[participantSid]
+	if !ok {
+		return nil, false
+	}
+	res := *session
+	res.ParticipantSids = slices.Clone(session.ParticipantSids)
+	return &res, true
+}
+
+func (s *SessionStitcher) getOrCreate(event *livekit.WebhookEvent, sessionID, sid string) *ParticipantSession {
+	if session := s.bySid[sid]; session != nil {
+		return session
+	}
+	if sessionID == "" {
+		// participants without a session id can't be stitched, every connection is a session
+		sessionID = sid
+	}
+	session := s.sessions[sessionID]
+	if session == nil {
+		session = &ParticipantSession{
+			SessionID: sessionID,
+			JoinedAt:  eventTime(event),
+		}
+		if event.Room != nil {
+			session.RoomName = event.Room.Name
+		}
+		if event.Participant != nil {
+			session.Identity = event.Participant.Identity
+		}
+		s.sessions[sessionID] = session
+	}
+	if !slices.Contains(session.ParticipantSids, sid) {
+		session.ParticipantSids = append(session.ParticipantSids, sid)
+	}
+	s.bySid[sid] = session
+	return session
+}
+
+func eventTime(event *livekit.WebhookEvent) time.Time {
+	if t := event.CreatedAtTime(); !t.IsZero() {
+		return t
+	}
+	return time.Now()
+}
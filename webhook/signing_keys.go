@@ -0,0 +1,146 @@
+// Copyright 2025 LiveKit, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"github.com/livekit/protocol/auth"
+	"github.com/livekit/protocol/livekit"
+)
+
+// SigningKey is an API key/secret pair used to sign webhook requests.
+// ID is sent in the kid header of the token, letting receivers pick the secret during key rotation.
+type SigningKey struct {
+	ID        string `yaml:"id,omitempty"`
+	APIKey    string `yaml:"api_key,omitempty"`
+	APISecret string `yaml:"api_secret,omitempty"`
+}
+
+func activeSigningKey(keys []SigningKey, id string) (SigningKey, bool) {
+	if len(keys) == 0 {
+		return SigningKey{}, false
+	}
+	if id == "" {
+		return keys[0], true
+	}
+	for _, k := range keys {
+		if k.ID == id {
+			return k, true
+		}
+	}
+	return SigningKey{}, false
+}
+
+// SigningKeyResolver returns the key to sign an event with, overriding the notifier keys when ok is true.
+type SigningKeyResolver func(event *livekit.WebhookEvent) (key SigningKey, ok bool)
+
+// SigningKeyFromRoomConfiguration returns the webhook signing key selected by the room configuration.
+// The configuration only names the key, its secret is looked up in keys, which must only hold the keys
+// owned by the project of the room. Otherwise a room could select the key of another project.
+func SigningKeyFromRoomConfiguration(conf *livekit.RoomConfiguration, keys auth.KeyProvider) (SigningKey, bool) {
+	k := conf.GetWebhookSigningKey()
+	if k.GetApiKey() == "" || keys == nil {
+		return SigningKey{}, false
+	}
+	secrets := candidateSecrets(keys, k.ApiKey, k.KeyId)
+	if len(secrets) == 0 {
+		return SigningKey{}, false
+	}
+	return SigningKey{ID: k.KeyId, APIKey: k.ApiKey, APISecret: secrets[0]}, true
+}
+
+// RoomConfigurationResolver signs events with the key named by the configuration of their room,
+// getConfig returns nil for unknown rooms. roomKeys returns the keys owned by the project of the room,
+// or nil when it has none. Keys not found there fall back to the notifier keys.
+func RoomConfigurationResolver(
+	getConfig func(roomName string) *livekit.RoomConfiguration,
+	roomKeys func(roomName string) auth.KeyProvider,
+) SigningKeyResolver {
+	return func(event *livekit.WebhookEvent) (SigningKey, bool) {
+		roomName := eventRoomName(event)
+		if roomName == "" {
+			return SigningKey{}, false
+		}
+		return SigningKeyFromRoomConfiguration(getConfig(roomName), roomKeys(roomName))
+	}
+}
+
+// KeyIDProvider is implemented by key providers which can look up secrets by the kid header of the token.
+type KeyIDProvider interface {
+	GetSecretByKeyID(apiKey, kid string) string
+}
+
+// KeySet is a KeyProvider accepting webhooks signed with any of its keys.
+// Receivers list both the current and the next key while the sender rotates them.
+type KeySet struct {
+	keys []SigningKey
+}
+
+var _ auth.KeyProvider = (*KeySet)(nil)
+var _ KeyIDProvider = (*KeySet)(nil)
+
+func NewKeySet(keys ...SigningKey) *KeySet {
+	return &KeySet{keys: keys}
+}
+
+// GetSecret returns the secret of the first key with the API key.
+func (s *KeySet) GetSecret(apiKey string) string {
+	for _, k := range s.keys {
+		if k.APIKey == apiKey {
+			return k.APISecret
+		}
+	}
+	return ""
+}
+
+func (s *KeySet) GetSecretByKeyID(apiKey, kid string) string {
+	for _, k := range s.keys {
+		if k.ID == kid && k.APIKey == apiKey {
+			return k.APISecret
+		}
+	}
+	return ""
+}
+
+func (s *KeySet) NumKeys() int {
+	return len(s.keys)
+}
+
+// secrets returns the secrets a token with the API key and key id may be signed with
+func (s *KeySet) secrets(apiKey, kid string) []string {
+	var secrets []string
+	for _, k := range s.keys {
+		if k.APIKey == apiKey && (kid == "" || k.ID == kid) {
+			secrets = append(secrets, k.APISecret)
+		}
+	}
+	return secrets
+}
+
+// candidateSecrets returns the secrets to verify a token with, in order
+func candidateSecrets(provider auth.KeyProvider, apiKey, kid string) []string {
+	if s, ok := provider.(*KeySet); ok {
+		return s.secrets(apiKey, kid)
+	}
+	if kp, ok := provider.(KeyIDProvider); ok && kid != "" {
+		if secret := kp.GetSecretByKeyID(apiKey, kid); secret != "" {
+			return []string{secret}
+		}
+		return nil
+	}
+	if secret := provider.GetSecret(apiKey); secret != "" {
+		return []string{secret}
+	}
+	return nil
+}
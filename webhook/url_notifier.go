@@ -17,19 +17,19 @@ package webhook
 import (
 	"bytes"
 	"context"
-	"crypto/sha256"
-	"encoding/base64"
+	"fmt"
+	"net/http"
+	"slices"
 	"sync"
 	"time"
 
-	"github.com/frostbyte73/core"
 	"github.com/hashicorp/go-retryablehttp"
-	"go.uber.org/atomic"
-	"google.golang.org/protobuf/encoding/protojson"
 
-	"github.com/livekit/protocol/auth"
 	"github.com/livekit/protocol/livekit"
 	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/tracer"
+	"github.com/livekit/protocol/utils"
+	"github.com/livekit/protocol/utils/guid"
 )
 
 const (
@@ -40,6 +40,16 @@ const (
 type URLNotifierConfig struct {
 	NumWorkers int `yaml:"num_workers,omitempty"`
 	QueueSize  int `yaml:"queue_size,omitempty"`
+	// number of high priority events queued over QueueSize when there is no lower priority event to evict,
+	// unlimited when zero, so that lifecycle events are never dropped
+	MaxHighPriorityOverflow int `yaml:"max_high_priority_overflow,omitempty"`
+	// events queued for longer than MaxAge are dropped instead of being delivered late, 0 disables the limit
+	MaxAge time.Duration `yaml:"max_age,omitempty"`
+	// priorities by event type, higher priority events are delivered first when the queue is contended,
+	// defaults to DefaultEventPriorities
+	Priorities map[string]EventPriority `yaml:"priorities,omitempty"`
+	// when set, a webhook_canary event is sent at this interval to monitor the endpoint, see CanaryStats
+	CanaryInterval time.Duration `yaml:"canary_interval,omitempty"`
 }
 
 var DefaultURLNotifierConfig = URLNotifierConfig{
@@ -47,15 +57,34 @@ var DefaultURLNotifierConfig = URLNotifierConfig{
 	QueueSize:  100,
 }
 
+type SendTestResult struct {
+	StatusCode int
+	Latency    time.Duration
+}
+
 type URLNotifierParams struct {
 	HTTPClientParams
-	Logger     logger.Logger
-	Config     URLNotifierConfig
-	URL        string
-	APIKey     string
-	APISecret  string
+	Logger    logger.Logger
+	Config    URLNotifierConfig
+	URL       string
+	APIKey    string
+	APISecret string
+	// when set, requests are signed with the active key instead of APIKey and APISecret,
+	// and the token carries its id in the kid header
+	SigningKeys []SigningKey
+	// id of the signing key in use, defaults to the first one
+	ActiveKeyID string
+	// when set, events it resolves a key for are signed with that key instead, e.g. a per room secret
+	SigningKeyResolver SigningKeyResolver
+	// serializes the request payload, defaults to ProtoJSONEncoder
+	Encoder    Encoder
 	FieldsHook func(whi *livekit.WebhookInfo)
+	// receives the queueing and send times of events, and the events dropped, see utils.PrometheusQueueMetrics
+	QueueMetrics utils.QueueMetrics
 	FilterParams
+	DedupeParams
+	JournalParams
+	ProvenanceParams
 }
 
 // URLNotifier is a QueuedNotifier that sends a POST request to a Webhook URL.
@@ -64,10 +93,13 @@ type URLNotifier struct {
 	mu            sync.RWMutex
 	params        URLNotifierParams
 	client        *retryablehttp.Client
-	dropped       atomic.Int32
-	pool          core.QueuePool
+	queue         *deliveryQueue
 	processedHook func(ctx context.Context, whi *livekit.WebhookInfo)
 	filter        *filter
+	deduper       *deduper
+	journaler     *journaler
+	oauth2        *oauth2TokenSource
+	canary        *canary
 }
 
 func NewURLNotifier(params URLNotifierParams) *URLNotifier {
@@ -77,42 +109,63 @@ func NewURLNotifier(params URLNotifierParams) *URLNotifier {
 	if params.Config.QueueSize == 0 {
 		params.Config.QueueSize = DefaultURLNotifierConfig.QueueSize
 	}
+	if params.Config.Priorities == nil {
+		params.Config.Priorities = DefaultEventPriorities
+	}
 	if params.Logger == nil {
 		params.Logger = logger.GetLogger()
 	}
 
-	rhc := retryablehttp.NewClient()
-	if params.RetryWaitMin > 0 {
-		rhc.RetryWaitMin = params.RetryWaitMin
-	}
-	if params.RetryWaitMax > 0 {
-		rhc.RetryWaitMax = params.RetryWaitMax
-	}
-	if params.MaxRetries > 0 {
-		rhc.RetryMax = params.MaxRetries
-	}
-	if params.ClientTimeout > 0 {
-		rhc.HTTPClient.Timeout = params.ClientTimeout
-	}
 	n := &URLNotifier{
-		params: params,
-		client: rhc,
-		filter: newFilter(params.FilterParams),
+		params:    params,
+		client:    params.HTTPClientParams.newClient(),
+		filter:    newFilter(params.FilterParams),
+		deduper:   newDeduper(params.DedupeParams, params.URL),
+		journaler: newJournaler(params.JournalParams, params.URL),
+		oauth2:    newOAuth2TokenSource(params.OAuth2),
 	}
-	n.client.Logger = &logAdapter{}
-
-	n.pool = core.NewQueuePool(params.Config.NumWorkers, core.QueueWorkerParams{
-		QueueSize:    params.Config.QueueSize,
-		DropWhenFull: true,
+	n.queue = newDeliveryQueue(deliveryQueueParams{
+		Logger:       params.Logger,
+		Config:       params.Config,
+		QueueMetrics: params.QueueMetrics,
+		Destination:  params.URL,
+		Deduper:      n.deduper,
+		Send:         n.send,
+		Processed:    n.processed,
 	})
+	n.canary = n.newCanary()
 	return n
 }
 
+// SetKeys replaces the API key and secret. When SigningKeys is set, they replace those of the active
+// signing key, which keeps its id.
 func (n *URLNotifier) SetKeys(apiKey, apiSecret string) {
 	n.mu.Lock()
 	defer n.mu.Unlock()
 	n.params.APIKey = apiKey
 	n.params.APISecret = apiSecret
+
+	for i, k := range n.params.SigningKeys {
+		if k.ID == n.params.ActiveKeyID || n.params.ActiveKeyID == "" {
+			n.params.SigningKeys = slices.Clone(n.params.SigningKeys)
+			n.params.SigningKeys[i].APIKey = apiKey
+			n.params.SigningKeys[i].APISecret = apiSecret
+			break
+		}
+	}
+}
+
+// SetSigningKeys replaces the signing keys, e.g. to rotate them without recreating the notifier.
+// Receivers should accept the new key before it becomes active.
+func (n *URLNotifier) SetSigningKeys(keys []SigningKey, activeKeyID string) error {
+	if _, ok := activeSigningKey(keys, activeKeyID); !ok && len(keys) > 0 {
+		return ErrSigningKeyNotFound
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.params.SigningKeys = slices.Clone(keys)
+	n.params.ActiveKeyID = activeKeyID
+	return nil
 }
 
 func (n *URLNotifier) SetFilter(params FilterParams) {
@@ -134,114 +187,186 @@ func (n *URLNotifier) getProcessedHook() func(ctx context.Context, whi *livekit.
 }
 
 func (n *URLNotifier) QueueNotify(ctx context.Context, event *livekit.WebhookEvent) error {
-	if !n.filter.IsAllowed(event.Event) {
+	event = syncedEvent(event)
+	if !n.filter.IsAllowed(event) {
 		return nil
 	}
+	event = n.params.stampProvenance(event)
 
-	enqueuedAt := time.Now()
+	if n.deduper.IsDuplicate(ctx, event) {
+		n.params.Logger.Debugw("skipped duplicate webhook", logFields(event, n.params.URL)...)
+		return nil
+	}
 
-	key := eventKey(event)
-	if !n.pool.Submit(key, func() {
-		fields := logFields(event, n.params.URL)
+	n.queue.Enqueue(ctx, event, true)
+	return nil
+}
 
-		queueDuration := time.Since(enqueuedAt)
-		fields = append(fields, "queueDuration", queueDuration)
+// Replay re-enqueues journaled events created between from and to which pass the filter.
+// Events are replayed once each, regardless of the number of recorded delivery attempts.
+// It returns the number of replayed events.
+func (n *URLNotifier) Replay(ctx context.Context, from, to time.Time, params FilterParams) (int, error) {
+	events, err := n.journaler.Events(ctx, from, to, newFilter(params))
+	if err != nil {
+		return 0, err
+	}
+	for _, event := range events {
+		n.queue.Enqueue(ctx, event, false)
+	}
+	return len(events), nil
+}
 
-		sendStart := time.Now()
-		err := n.send(event)
-		sendDuration := time.Since(sendStart)
-		fields = append(fields, "sendDuration", sendDuration)
-		if err != nil {
-			n.params.Logger.Warnw("failed to send webhook", err, fields...)
-			n.dropped.Add(event.NumDropped + 1)
-		} else {
-			n.params.Logger.Infow("sent webhook", fields...)
-		}
-		if ph := n.getProcessedHook(); ph != nil {
-			whi := webhookInfo(
-				event,
-				enqueuedAt,
-				queueDuration,
-				sendStart,
-				sendDuration,
-				n.params.URL,
-				false,
-				err,
-			)
-			if n.params.FieldsHook != nil {
-				n.params.FieldsHook(whi)
-			}
-			ph(ctx, whi)
-		}
-	}) {
-		n.dropped.Inc()
-
-		fields := logFields(event, n.params.URL)
-		n.params.Logger.Infow("dropped webhook", fields...)
-
-		if ph := n.getProcessedHook(); ph != nil {
-			whi := webhookInfo(
-				event,
-				time.Time{},
-				0,
-				time.Time{},
-				0,
-				n.params.URL,
-				true,
-				nil,
-			)
-			if n.params.FieldsHook != nil {
-				n.params.FieldsHook(whi)
-			}
-			ph(ctx, whi)
-		}
+func (n *URLNotifier) processed(
+	ctx context.Context,
+	event *livekit.WebhookEvent,
+	queuedAt time.Time,
+	queueDuration time.Duration,
+	sentAt time.Time,
+	sendDuration time.Duration,
+	isDropped bool,
+	sendError error,
+) {
+	ph := n.getProcessedHook()
+	if ph == nil && !n.journaler.Enabled() {
+		return
+	}
+
+	whi := webhookInfo(
+		event,
+		queuedAt,
+		queueDuration,
+		sentAt,
+		sendDuration,
+		n.params.URL,
+		isDropped,
+		sendError,
+	)
+	if n.params.FieldsHook != nil {
+		n.params.FieldsHook(whi)
+	}
+	n.journaler.Record(ctx, event, whi)
+	if ph != nil {
+		ph(ctx, whi)
 	}
-	return nil
 }
 
 func (n *URLNotifier) Stop(force bool) {
-	if force {
-		n.pool.Kill()
-	} else {
-		n.pool.Drain()
-	}
+	n.canary.Stop()
+	n.queue.Stop(force)
 }
 
-func (n *URLNotifier) send(event *livekit.WebhookEvent) error {
-	// set dropped count
-	event.NumDropped = n.dropped.Swap(0)
-	encoded, err := protojson.Marshal(event)
-	if err != nil {
+// StopContext drains queued events until ctx is done. Deliveries still pending at that point,
+// including ones in flight, are abandoned. It returns the number of abandoned events.
+func (n *URLNotifier) StopContext(ctx context.Context) int {
+	n.canary.Stop()
+	return n.queue.StopContext(ctx)
+}
+
+func (n *URLNotifier) send(ctx context.Context, event *livekit.WebhookEvent) (err error) {
+	ctx, span := tracer.Start(ctx, "URLNotifier.send")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+	}()
+
+	if err = n.params.URLGuard.Validate(n.params.URL); err != nil {
 		return err
 	}
-	// sign payload
-	sum := sha256.Sum256(encoded)
-	b64 := base64.StdEncoding.EncodeToString(sum[:])
 
-	n.mu.RLock()
-	apiKey := n.params.APIKey
-	apiSecret := n.params.APISecret
-	n.mu.RUnlock()
-
-	at := auth.NewAccessToken(apiKey, apiSecret).
-		SetValidFor(5 * time.Minute).
-		SetSha256(b64)
-	token, err := at.ToJWT()
+	// set dropped count
+	setNumDropped(event, n.queue.dropped.Swap(0))
+	encoded, contentType, token, err := n.encode(event)
 	if err != nil {
 		return err
 	}
-	r, err := retryablehttp.NewRequest("POST", n.params.URL, bytes.NewReader(encoded))
+	r, err := retryablehttp.NewRequestWithContext(ctx, "POST", n.params.URL, bytes.NewReader(encoded))
 	if err != nil {
 		// ignore and continue
 		return err
 	}
-	r.Header.Set(authHeader, token)
-	// use a custom mime type to ensure signature is checked prior to parsing
-	r.Header.Set("content-type", "application/webhook+json")
+	if err = authorize(ctx, r.Header, n.oauth2, token); err != nil {
+		return err
+	}
+	r.Header.Set("content-type", contentType)
 	res, err := n.client.Do(r)
 	if err != nil {
 		return err
 	}
 	_ = res.Body.Close()
+	if res.StatusCode == http.StatusUnauthorized && n.oauth2 != nil {
+		n.oauth2.Invalidate()
+	}
 	return nil
 }
+
+// SendTest synchronously posts a signed webhook_test event to the URL, without retries.
+// It can be used to validate the endpoint configuration and key pair before going live.
+func (n *URLNotifier) SendTest(ctx context.Context) (*SendTestResult, error) {
+	event := &livekit.WebhookEvent{
+		Event: EventWebhookTest,
+		Id:    guid.New(guid.WebhookEventPrefix),
+	}
+	event.SetCreatedAt(time.Now())
+	return n.sendSync(ctx, event)
+}
+
+// sendSync posts the event without retries, and fails on non 2xx responses.
+func (n *URLNotifier) sendSync(ctx context.Context, event *livekit.WebhookEvent) (*SendTestResult, error) {
+	if err := n.params.URLGuard.Validate(n.params.URL); err != nil {
+		return nil, err
+	}
+	encoded, contentType, token, err := n.encode(event)
+	if err != nil {
+		return nil, err
+	}
+	r, err := http.NewRequestWithContext(ctx, "POST", n.params.URL, bytes.NewReader(encoded))
+	if err != nil {
+		return nil, err
+	}
+	if err = authorize(ctx, r.Header, n.oauth2, token); err != nil {
+		return nil, err
+	}
+	r.Header.Set("content-type", contentType)
+
+	start := time.Now()
+	res, err := n.client.HTTPClient.Do(r)
+	result := &SendTestResult{Latency: time.Since(start)}
+	if err != nil {
+		return result, err
+	}
+	_ = res.Body.Close()
+
+	result.StatusCode = res.StatusCode
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return result, fmt.Errorf("%w: %s", ErrUnexpectedStatus, res.Status)
+	}
+	return result, nil
+}
+
+func (n *URLNotifier) encode(event *livekit.WebhookEvent) ([]byte, string, string, error) {
+	encoded, contentType, err := marshalEvent(n.params.Encoder, event)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	key, ok := SigningKey{}, false
+	if n.params.SigningKeyResolver != nil {
+		key, ok = n.params.SigningKeyResolver(event)
+	}
+	if !ok {
+		n.mu.RLock()
+		key, ok = activeSigningKey(n.params.SigningKeys, n.params.ActiveKeyID)
+		if !ok {
+			key = SigningKey{APIKey: n.params.APIKey, APISecret: n.params.APISecret}
+		}
+		n.mu.RUnlock()
+	}
+
+	token, err := signPayload(encoded, key)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return encoded, contentType, token, nil
+}
@@ -19,40 +19,118 @@ import (
 	"encoding/base64"
 	"io"
 	"net/http"
+	"time"
 
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/livekit/protocol/auth"
 	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/rpc"
 )
 
+type receiveOptions struct {
+	leeway *time.Duration
+	decode func(data []byte) (*livekit.WebhookEvent, error)
+}
+
+func newReceiveOptions(opts []ReceiveOption) receiveOptions {
+	o := receiveOptions{decode: unmarshalEvent}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+type ReceiveOption func(o *receiveOptions)
+
+// WithLeeway sets the clock skew tolerated when validating the token of a webhook, defaults to auth.DefaultLeeway
+func WithLeeway(leeway time.Duration) ReceiveOption {
+	return func(o *receiveOptions) {
+		o.leeway = &leeway
+	}
+}
+
+// WithDecoder sets the function parsing verified payloads into events, for senders using a custom Encoder.
+// Defaults to parsing protojson encoded WebhookEvents.
+func WithDecoder(decode func(data []byte) (*livekit.WebhookEvent, error)) ReceiveOption {
+	return func(o *receiveOptions) {
+		o.decode = decode
+	}
+}
+
 // Receive reads and verifies incoming webhook is signed with key/secret pair
 // closes body after reading
-func Receive(r *http.Request, provider auth.KeyProvider) ([]byte, error) {
+func Receive(r *http.Request, provider auth.KeyProvider, opts ...ReceiveOption) ([]byte, error) {
 	defer r.Body.Close()
 	data, err := io.ReadAll(r.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	authToken := r.Header.Get(authHeader)
+	// the Authorization header carries an OAuth2 token instead when the signature header is set
+	authToken := r.Header.Get(signatureHeader)
 	if authToken == "" {
-		return nil, ErrNoAuthHeader
+		authToken = r.Header.Get(authHeader)
 	}
+	if err = verify(data, authToken, provider, opts...); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
 
-	v, err := auth.ParseAPIToken(authToken)
+// ReceiveWebhookEvent reads and verifies incoming webhook, and returns a parsed WebhookEvent
+func ReceiveWebhookEvent(r *http.Request, provider auth.KeyProvider, opts ...ReceiveOption) (*livekit.WebhookEvent, error) {
+	data, err := Receive(r, provider, opts...)
 	if err != nil {
 		return nil, err
 	}
+	return newReceiveOptions(opts).decode(data)
+}
+
+// ReceiveDelivery verifies a delivery received by a WebhookReceiver service, and returns a parsed WebhookEvent
+func ReceiveDelivery(d *rpc.WebhookDelivery, provider auth.KeyProvider, opts ...ReceiveOption) (*livekit.WebhookEvent, error) {
+	if err := verify(d.Payload, d.Token, provider, opts...); err != nil {
+		return nil, err
+	}
+	return newReceiveOptions(opts).decode(d.Payload)
+}
 
-	secret := provider.GetSecret(v.APIKey())
-	if secret == "" {
-		return nil, ErrSecretNotFound
+// verify checks that authToken is signed with key/secret pair and carries the checksum of data
+func verify(data []byte, authToken string, provider auth.KeyProvider, opts ...ReceiveOption) error {
+	if authToken == "" {
+		return ErrNoAuthHeader
 	}
+	o := newReceiveOptions(opts)
 
-	claims, err := v.Verify(secret)
+	v, err := auth.ParseAPIToken(authToken)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if o.leeway != nil {
+		v.WithLeeway(*o.leeway)
+	}
+
+	// a KeySet may hold several secrets for the API key while keys are rotated
+	var keys []any
+	if vp, ok := provider.(auth.VerificationKeyProvider); ok {
+		keys = vp.GetVerificationKeys(v.APIKey(), v.KeyID())
+	} else {
+		for _, secret := range candidateSecrets(provider, v.APIKey(), v.KeyID()) {
+			keys = append(keys, secret)
+		}
+	}
+	if len(keys) == 0 {
+		return ErrSecretNotFound
+	}
+
+	var claims *auth.ClaimGrants
+	for _, key := range keys {
+		if claims, err = v.Verify(key); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return err
 	}
 
 	// verify checksum
@@ -60,25 +138,21 @@ func Receive(r *http.Request, provider auth.KeyProvider) ([]byte, error) {
 	hash := base64.StdEncoding.EncodeToString(sha[:])
 
 	if claims.Sha256 != hash {
-		return nil, ErrInvalidChecksum
+		return ErrInvalidChecksum
 	}
-
-	return data, nil
+	return nil
 }
 
-// ReceiveWebhookEvent reads and verifies incoming webhook, and returns a parsed WebhookEvent
-func ReceiveWebhookEvent(r *http.Request, provider auth.KeyProvider) (*livekit.WebhookEvent, error) {
-	data, err := Receive(r, provider)
-	if err != nil {
-		return nil, err
-	}
+func unmarshalEvent(data []byte) (*livekit.WebhookEvent, error) {
 	unmarshalOpts := protojson.UnmarshalOptions{
 		DiscardUnknown: true,
 		AllowPartial:   true,
 	}
 	event := livekit.WebhookEvent{}
-	if err = unmarshalOpts.Unmarshal(data, &event); err != nil {
+	if err := unmarshalOpts.Unmarshal(data, &event); err != nil {
 		return nil, err
 	}
+	// events from older versions only set the legacy fields
+	event.SyncPayload()
 	return &event, nil
 }
@@ -16,18 +16,32 @@ package webhook
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/livekit/protocol/auth"
 	"github.com/livekit/protocol/livekit"
+	"github.com/livekit/protocol/logger"
+	"github.com/livekit/protocol/rpc"
+	"github.com/livekit/protocol/utils/urlguard"
 )
 
 const (
@@ -61,6 +75,9 @@ func TestWebHook(t *testing.T) {
 			},
 		}
 
+		expected := proto.Clone(event).(*livekit.WebhookEvent)
+		expected.SyncPayload()
+
 		wg := sync.WaitGroup{}
 		wg.Add(1)
 		s.handler = func(w http.ResponseWriter, r *http.Request) {
@@ -68,10 +85,14 @@ func TestWebHook(t *testing.T) {
 			decodedEvent, err := ReceiveWebhookEvent(r, authProvider)
 			require.NoError(t, err)
 
-			require.EqualValues(t, event, decodedEvent)
+			require.True(t, proto.Equal(expected, decodedEvent))
+			// the payload is set from the legacy fields
+			require.Equal(t, "TR_abcde", decodedEvent.GetTrackPayload().GetTrack().GetSid())
 		}
 		require.NoError(t, notifier.QueueNotify(context.Background(), event))
 		wg.Wait()
+		// the event of the caller is left untouched
+		require.Nil(t, event.Payload)
 	})
 
 }
@@ -173,7 +194,7 @@ func TestURLNotifierLifecycle(t *testing.T) {
 		}
 		defer urlNotifier.Stop(false)
 
-		err := urlNotifier.send(&livekit.WebhookEvent{Event: EventRoomStarted})
+		err := urlNotifier.send(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted})
 		require.Error(t, err)
 	})
 
@@ -194,7 +215,7 @@ func TestURLNotifierLifecycle(t *testing.T) {
 		defer urlNotifier.Stop(false)
 
 		startedAt := time.Now()
-		err = urlNotifier.send(&livekit.WebhookEvent{Event: EventRoomStarted})
+		err = urlNotifier.send(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted})
 		require.Error(t, err)
 		require.Less(t, time.Since(startedAt).Seconds(), float64(2))
 	})
@@ -367,6 +388,9 @@ func TestResourceWebHook(t *testing.T) {
 			},
 		}
 
+		expected := proto.Clone(event).(*livekit.WebhookEvent)
+		expected.SyncPayload()
+
 		wg := sync.WaitGroup{}
 		wg.Add(1)
 		s.handler = func(w http.ResponseWriter, r *http.Request) {
@@ -374,10 +398,11 @@ func TestResourceWebHook(t *testing.T) {
 			decodedEvent, err := ReceiveWebhookEvent(r, authProvider)
 			require.NoError(t, err)
 
-			require.EqualValues(t, event, decodedEvent)
+			require.True(t, proto.Equal(expected, decodedEvent))
 		}
 		require.NoError(t, resourceURLNotifier.QueueNotify(context.Background(), event))
 		wg.Wait()
+		require.Nil(t, event.Payload)
 	})
 
 }
@@ -397,20 +422,20 @@ func TestResourceURLNotifierDropped(t *testing.T) {
 			require.NoError(t, err)
 			totalReceived.Inc()
 		}
-		// send multiple notifications
-		for i := 0; i < 10; i++ {
-			err := resourceURLNotifier.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted})
-			if err == errQueueFull {
-				totalDropped.Inc()
-			}
-			err = resourceURLNotifier.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventParticipantJoined})
-			if err == errQueueFull {
-				totalDropped.Inc()
-			}
-			err = resourceURLNotifier.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomFinished})
-			if err == errQueueFull {
+		totalDroppedHigh := atomic.Int32{}
+		resourceURLNotifier.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+			if whi.IsDropped {
 				totalDropped.Inc()
+				if whi.Event != EventParticipantJoined {
+					totalDroppedHigh.Inc()
+				}
 			}
+		})
+		// send multiple notifications
+		for i := 0; i < 10; i++ {
+			_ = resourceURLNotifier.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted})
+			_ = resourceURLNotifier.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventParticipantJoined})
+			_ = resourceURLNotifier.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomFinished})
 		}
 
 		time.Sleep(webhookCheckInterval)
@@ -426,6 +451,8 @@ func TestResourceURLNotifierDropped(t *testing.T) {
 		// at least one request dropped, but not all dropped
 		require.Less(t, int32(0), totalDropped.Load())
 		require.Less(t, int32(0), totalReceived.Load())
+		// lifecycle events are never dropped for lower priority ones
+		require.Zero(t, totalDroppedHigh.Load())
 	})
 
 	t.Run("age drop", func(t *testing.T) {
@@ -644,7 +671,7 @@ func TestResourceURLNotifierLifecycle(t *testing.T) {
 		}
 		defer resourceURLNotifier.Stop(false)
 
-		err := resourceURLNotifier.send(&livekit.WebhookEvent{Event: EventRoomStarted})
+		err := resourceURLNotifier.send(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted})
 		require.Error(t, err)
 	})
 
@@ -669,7 +696,7 @@ func TestResourceURLNotifierLifecycle(t *testing.T) {
 		defer resourceURLNotifier.Stop(false)
 
 		startedAt := time.Now()
-		err = resourceURLNotifier.send(&livekit.WebhookEvent{Event: EventRoomStarted})
+		err = resourceURLNotifier.send(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted})
 		require.Error(t, err)
 		require.Less(t, time.Since(startedAt).Seconds(), float64(2))
 	})
@@ -856,3 +883,1851 @@ func (s *testServer) Start() error {
 func (s *testServer) Stop() {
 	_ = s.server.Shutdown(context.Background())
 }
+
+func TestFilter(t *testing.T) {
+	room := func(name string) *livekit.WebhookEvent {
+		return &livekit.WebhookEvent{Event: EventRoomStarted, Room: &livekit.Room{Name: name}}
+	}
+	participant := func(identity string, kind livekit.ParticipantInfo_Kind) *livekit.WebhookEvent {
+		return &livekit.WebhookEvent{
+			Event:       EventParticipantJoined,
+			Room:        &livekit.Room{Name: "prod-1"},
+			Participant: &livekit.ParticipantInfo{Identity: identity, Kind: kind},
+		}
+	}
+
+	cases := []struct {
+		name   string
+		params FilterParams
+		event  *livekit.WebhookEvent
+		exp    bool
+	}{
+		{"no filter", FilterParams{}, room("test"), true},
+		{"room glob match", FilterParams{IncludeRooms: []string{"prod-*"}}, room("prod-1"), true},
+		{"room glob no match", FilterParams{IncludeRooms: []string{"prod-*"}}, room("staging-1"), false},
+		{"room glob single char", FilterParams{IncludeRooms: []string{"prod-?"}}, room("prod-12"), false},
+		{"room glob literal", FilterParams{IncludeRooms: []string{"prod.1"}}, room("prodx1"), false},
+		{"room regex", FilterParams{IncludeRooms: []string{"/^prod-[0-9]+$/"}}, room("prod-12"), true},
+		{"room exclude", FilterParams{ExcludeRooms: []string{"test-*"}}, room("test-1"), false},
+		{"room include precedence", FilterParams{IncludeRooms: []string{"prod-*"}, ExcludeRooms: []string{"prod-*"}}, room("prod-1"), true},
+		{"invalid regex", FilterParams{IncludeRooms: []string{"/(/"}}, room("prod-1"), false},
+		{
+			"egress room",
+			FilterParams{IncludeRooms: []string{"prod-*"}},
+			&livekit.WebhookEvent{Event: EventEgressStarted, EgressInfo: &livekit.EgressInfo{RoomName: "staging-1"}},
+			false,
+		},
+		{
+			"sip call room",
+			FilterParams{IncludeRooms: []string{"prod-*"}},
+			&livekit.WebhookEvent{Event: EventSIPCallStarted, SipCall: &livekit.SIPCallInfo{RoomName: "prod-1"}},
+			true,
+		},
+		{
+			"agent job room",
+			FilterParams{IncludeRooms: []string{"prod-*"}},
+			&livekit.WebhookEvent{Event: EventAgentJobStarted, AgentJob: &livekit.Job{Room: &livekit.Room{Name: "staging-1"}}},
+			false,
+		},
+		{
+			"no room",
+			FilterParams{IncludeRooms: []string{"prod-*"}},
+			&livekit.WebhookEvent{Event: EventEgressStarted, EgressInfo: &livekit.EgressInfo{}},
+			true,
+		},
+		{"event and room", FilterParams{IncludeEvents: []string{EventRoomFinished}, IncludeRooms: []string{"prod-*"}}, room("prod-1"), false},
+		{"identity match", FilterParams{IncludeParticipantIdentities: []string{"user_*"}}, participant("user_1", livekit.ParticipantInfo_STANDARD), true},
+		{"identity exclude", FilterParams{ExcludeParticipantIdentities: []string{"bot_*"}}, participant("bot_1", livekit.ParticipantInfo_STANDARD), false},
+		{"identity without participant", FilterParams{IncludeParticipantIdentities: []string{"user_*"}}, room("prod-1"), true},
+		{"kind include", FilterParams{IncludeParticipantKinds: []livekit.ParticipantInfo_Kind{livekit.ParticipantInfo_SIP}}, participant("a", livekit.ParticipantInfo_STANDARD), false},
+		{"kind exclude", FilterParams{ExcludeParticipantKinds: []livekit.ParticipantInfo_Kind{livekit.ParticipantInfo_AGENT}}, participant("a", livekit.ParticipantInfo_AGENT), false},
+		{"kind allowed", FilterParams{ExcludeParticipantKinds: []livekit.ParticipantInfo_Kind{livekit.ParticipantInfo_AGENT}}, participant("a", livekit.ParticipantInfo_SIP), true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.exp, newFilter(c.params).IsAllowed(c.event))
+		})
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	t.Run("memory store", func(t *testing.T) {
+		s := NewMemoryDedupeStore()
+		ok, err := s.SetIfNotExists(context.Background(), "a", 50*time.Millisecond)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		ok, err = s.SetIfNotExists(context.Background(), "a", 50*time.Millisecond)
+		require.NoError(t, err)
+		require.False(t, ok)
+
+		ok, err = s.SetIfNotExists(context.Background(), "b", 50*time.Millisecond)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		time.Sleep(60 * time.Millisecond)
+		ok, err = s.SetIfNotExists(context.Background(), "a", 50*time.Millisecond)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, 1, s.keys.Len())
+
+		require.NoError(t, s.Delete(context.Background(), "a"))
+		ok, err = s.SetIfNotExists(context.Background(), "a", 50*time.Millisecond)
+		require.NoError(t, err)
+		require.True(t, ok)
+	})
+
+	t.Run("dropped events are forgotten", func(t *testing.T) {
+		store := NewMemoryDedupeStore()
+		n := NewURLNotifier(URLNotifierParams{
+			URL:          testUrl,
+			APIKey:       testAPIKey,
+			APISecret:    testAPISecret,
+			DedupeParams: DedupeParams{DedupeStore: store},
+		})
+		n.Stop(true)
+
+		_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Id: "EV_1"})
+		require.Equal(t, int32(1), n.queue.dropped.Load())
+
+		ok, err := store.SetIfNotExists(context.Background(), testUrl+"|EV_1", time.Minute)
+		require.NoError(t, err)
+		require.True(t, ok)
+	})
+
+	t.Run("abandoned events are forgotten", func(t *testing.T) {
+		d := newDeduper(DedupeParams{DedupeStore: NewMemoryDedupeStore()}, testUrl)
+		numProcessed := atomic.Int32{}
+		q := newDeliveryQueue(deliveryQueueParams{
+			Logger:      logger.GetLogger(),
+			Config:      URLNotifierConfig{NumWorkers: 1, QueueSize: 10},
+			Destination: testUrl,
+			Deduper:     d,
+			Send: func(ctx context.Context, event *livekit.WebhookEvent) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			Processed: func(context.Context, *livekit.WebhookEvent, time.Time, time.Duration, time.Time, time.Duration, bool, error) {
+				numProcessed.Inc()
+			},
+		})
+
+		events := []*livekit.WebhookEvent{
+			{Event: EventRoomStarted, Id: "EV_1", Room: &livekit.Room{Name: "room"}},
+			{Event: EventRoomStarted, Id: "EV_2", Room: &livekit.Room{Name: "room"}},
+		}
+		for _, event := range events {
+			require.False(t, d.IsDuplicate(context.Background(), event))
+			q.Enqueue(context.Background(), event, true)
+		}
+
+		// the first event is in flight when the queue is stopped
+		time.Sleep(webhookCheckInterval)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.Equal(t, 2, q.StopContext(ctx))
+		require.Eventually(t, func() bool { return numProcessed.Load() == 2 }, time.Second, 10*time.Millisecond)
+
+		for _, event := range events {
+			require.False(t, d.IsDuplicate(context.Background(), event))
+		}
+	})
+
+	t.Run("notifier", func(t *testing.T) {
+		s := newServer(testAddr)
+		require.NoError(t, s.Start())
+		defer s.Stop()
+
+		notifiers := []QueuedNotifier{
+			NewURLNotifier(URLNotifierParams{
+				URL:          testUrl,
+				APIKey:       testAPIKey,
+				APISecret:    testAPISecret,
+				DedupeParams: DedupeParams{DedupeStore: NewMemoryDedupeStore()},
+			}),
+			NewResourceURLNotifier(ResourceURLNotifierParams{
+				URL:          testUrl,
+				APIKey:       testAPIKey,
+				APISecret:    testAPISecret,
+				DedupeParams: DedupeParams{DedupeStore: NewMemoryDedupeStore()},
+			}),
+		}
+		for _, n := range notifiers {
+			numCalled := atomic.Int32{}
+			s.handler = func(w http.ResponseWriter, r *http.Request) {
+				numCalled.Inc()
+			}
+
+			_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Id: "EV_1"})
+			_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Id: "EV_1"})
+			_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Id: "EV_2"})
+			_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted})
+			_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted})
+			n.Stop(false)
+
+			require.Eventually(t, func() bool { return numCalled.Load() == 4 }, 5*time.Second, webhookCheckInterval)
+			time.Sleep(webhookCheckInterval)
+			require.Equal(t, int32(4), numCalled.Load())
+		}
+	})
+}
+
+func TestURLNotifierSendTest(t *testing.T) {
+	s := newServer(testAddr)
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	urlNotifier := newTestNotifier()
+	defer urlNotifier.Stop(true)
+
+	t.Run("success", func(t *testing.T) {
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			decodedEvent, err := ReceiveWebhookEvent(r, authProvider)
+			require.NoError(t, err)
+			require.Equal(t, EventWebhookTest, decodedEvent.Event)
+			require.NotEmpty(t, decodedEvent.Id)
+		}
+		res, err := urlNotifier.SendTest(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Greater(t, res.Latency, time.Duration(0))
+	})
+
+	t.Run("invalid key pair", func(t *testing.T) {
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			if _, err := Receive(r, auth.NewSimpleKeyProvider(testAPIKey, "other")); err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		}
+		res, err := urlNotifier.SendTest(context.Background())
+		require.ErrorIs(t, err, ErrUnexpectedStatus)
+		require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+}
+
+func TestURLNotifierGuard(t *testing.T) {
+	s := newServer(testAddr)
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	newNotifier := func(config urlguard.Config) *URLNotifier {
+		g, err := urlguard.New(config)
+		require.NoError(t, err)
+		return NewURLNotifier(URLNotifierParams{
+			HTTPClientParams: HTTPClientParams{URLGuard: g},
+			URL:              testUrl,
+			APIKey:           testAPIKey,
+			APISecret:        testAPISecret,
+		})
+	}
+
+	t.Run("host rejected", func(t *testing.T) {
+		n := newNotifier(urlguard.Config{})
+		defer n.Stop(true)
+		_, err := n.SendTest(context.Background())
+		require.ErrorIs(t, err, urlguard.ErrHostNotAllowed)
+	})
+
+	t.Run("resolved address rejected", func(t *testing.T) {
+		n := newNotifier(urlguard.Config{AllowedHosts: []string{"localhost"}})
+		defer n.Stop(true)
+		_, err := n.SendTest(context.Background())
+		require.ErrorIs(t, err, urlguard.ErrAddressNotAllowed)
+
+		var sent atomic.Bool
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			sent.Store(true)
+		}
+		require.NoError(t, n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted}))
+		n.Stop(false)
+		require.False(t, sent.Load())
+	})
+
+	t.Run("allowed range", func(t *testing.T) {
+		n := newNotifier(urlguard.Config{AllowedHosts: []string{"localhost"}, AllowedCIDRs: []string{"127.0.0.0/8", "::1/128"}})
+		defer n.Stop(true)
+		s.handler = func(w http.ResponseWriter, r *http.Request) {}
+		res, err := n.SendTest(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+	})
+
+	t.Run("default notifier config", func(t *testing.T) {
+		var sent atomic.Bool
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			sent.Store(true)
+		}
+		n := NewDefaultNotifier(WebHookConfig{
+			URLs:     []string{testUrl},
+			APIKey:   testAPIKey,
+			URLGuard: &urlguard.Config{AllowedHosts: []string{"localhost"}},
+		}, testAPISecret)
+		processed := make(chan *livekit.WebhookInfo, 1)
+		n.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+			processed <- whi
+		})
+		require.NoError(t, n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Room: &livekit.Room{Name: "room"}}))
+		whi := <-processed
+		n.Stop(false)
+		require.NotEmpty(t, whi.SendError)
+		require.False(t, sent.Load())
+
+		// invalid configurations send nothing rather than sending unguarded
+		n = NewDefaultNotifier(WebHookConfig{
+			URLs:     []string{testUrl},
+			URLGuard: &urlguard.Config{AllowedCIDRs: []string{"invalid"}},
+		}, testAPISecret)
+		require.NoError(t, n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Room: &livekit.Room{Name: "room"}}))
+		n.Stop(false)
+		require.False(t, sent.Load())
+	})
+}
+
+func TestURLNotifierOAuth2(t *testing.T) {
+	var tokenRequests atomic.Int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "client", id)
+		require.Equal(t, "secret", secret)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		require.Equal(t, "webhooks:write", r.PostForm.Get("scope"))
+		require.Equal(t, "receiver", r.PostForm.Get("audience"))
+
+		n := tokenRequests.Inc()
+		w.Header().Set("content-type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":3600}`, n)
+	}))
+	defer tokenServer.Close()
+
+	s := newServer(testAddr)
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	newNotifier := func(keepSignature bool) *URLNotifier {
+		return NewURLNotifier(URLNotifierParams{
+			HTTPClientParams: HTTPClientParams{
+				OAuth2: &OAuth2Config{
+					TokenURL:       tokenServer.URL,
+					ClientID:       "client",
+					ClientSecret:   "secret",
+					Scopes:         []string{"webhooks:write"},
+					EndpointParams: map[string]string{"audience": "receiver"},
+					KeepSignature:  keepSignature,
+				},
+			},
+			URL:       testUrl,
+			APIKey:    testAPIKey,
+			APISecret: testAPISecret,
+		})
+	}
+
+	t.Run("with signature", func(t *testing.T) {
+		n := newNotifier(true)
+		defer n.Stop(true)
+
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "Bearer token-1", r.Header.Get(authHeader))
+			_, err := ReceiveWebhookEvent(r, authProvider)
+			require.NoError(t, err)
+		}
+		for i := 0; i < 2; i++ {
+			_, err := n.SendTest(context.Background())
+			require.NoError(t, err)
+		}
+		require.Equal(t, int32(1), tokenRequests.Load())
+
+		// rejected tokens are refreshed
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+		require.NoError(t, n.send(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted}))
+
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "Bearer token-2", r.Header.Get(authHeader))
+		}
+		require.NoError(t, n.send(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted}))
+	})
+
+	t.Run("without signature", func(t *testing.T) {
+		n := newNotifier(false)
+		defer n.Stop(true)
+
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "Bearer token-3", r.Header.Get(authHeader))
+			require.Empty(t, r.Header.Get(signatureHeader))
+		}
+		_, err := n.SendTest(context.Background())
+		require.NoError(t, err)
+	})
+}
+
+func TestURLNotifierPriority(t *testing.T) {
+	s := newServer(testAddr)
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	n := NewURLNotifier(URLNotifierParams{
+		URL:       testUrl,
+		APIKey:    testAPIKey,
+		APISecret: testAPISecret,
+		Config: URLNotifierConfig{
+			NumWorkers:              1,
+			QueueSize:               2,
+			MaxHighPriorityOverflow: 2,
+		},
+	})
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var received []string
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		event, err := ReceiveWebhookEvent(r, authProvider)
+		require.NoError(t, err)
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+		mu.Lock()
+		received = append(received, event.Id)
+		mu.Unlock()
+	}
+
+	var dropped []string
+	n.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+		if whi.IsDropped {
+			mu.Lock()
+			dropped = append(dropped, whi.EventId)
+			mu.Unlock()
+		}
+	})
+
+	room := &livekit.Room{Name: "room"}
+	notify := func(event, id string) {
+		require.NoError(t, n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: event, Id: id, Room: room}))
+	}
+
+	notify(EventTrackPublished, "EV_0")
+	<-started
+	notify(EventTrackPublished, "EV_1")
+	notify(EventTrackPublished, "EV_2")
+	// evicts the oldest track event
+	notify(EventRoomFinished, "EV_3")
+	// the queue is full of events with the same or a higher priority
+	notify(EventTrackUnpublished, "EV_4")
+	notify(EventEgressEnded, "EV_5")
+	// high priority events are queued over the limit when there is nothing to evict, up to MaxHighPriorityOverflow
+	notify(EventEgressEnded, "EV_6")
+	notify(EventEgressEnded, "EV_7")
+	notify(EventEgressEnded, "EV_8")
+
+	close(release)
+	n.Stop(false)
+
+	require.Equal(t, []string{"EV_0", "EV_3", "EV_5", "EV_6", "EV_7"}, received)
+	require.Equal(t, []string{"EV_1", "EV_4", "EV_2", "EV_8"}, dropped)
+}
+
+func TestResourceQueuePriority(t *testing.T) {
+	release := make(chan struct{})
+	q := newResourceQueue(resourceQueueParams{
+		MaxDepth:                2,
+		MaxHighPriorityOverflow: 2,
+		Poster:                  blockingPoster{release},
+	})
+	defer q.Stop(true)
+	defer close(release)
+
+	enqueue := func(priority EventPriority, id string) (*item, error) {
+		return q.Enqueue(context.Background(), priority, &livekit.WebhookEvent{Event: EventRoomStarted, Id: id})
+	}
+
+	// the first event is in flight
+	_, err := enqueue(EventPriorityNormal, "EV_0")
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		return q.numItems == 0
+	}, time.Second, time.Millisecond)
+
+	for _, id := range []string{"EV_1", "EV_2"} {
+		_, err = enqueue(EventPriorityLow, id)
+		require.NoError(t, err)
+	}
+	_, err = enqueue(EventPriorityLow, "EV_3")
+	require.ErrorIs(t, err, errQueueFull)
+
+	// evicts the oldest lower priority event
+	for _, ids := range [][2]string{{"EV_4", "EV_1"}, {"EV_5", "EV_2"}} {
+		evicted, err := enqueue(EventPriorityHigh, ids[0])
+		require.NoError(t, err)
+		require.Equal(t, ids[1], evicted.event.Id)
+	}
+
+	// high priority events are queued over MaxDepth, up to MaxHighPriorityOverflow
+	for _, id := range []string{"EV_6", "EV_7"} {
+		_, err = enqueue(EventPriorityHigh, id)
+		require.NoError(t, err)
+	}
+	_, err = enqueue(EventPriorityHigh, "EV_8")
+	require.ErrorIs(t, err, errQueueFull)
+}
+
+func TestResourceQueueOrder(t *testing.T) {
+	run := func(maxDepth int, priorities ...EventPriority) []string {
+		poster := &recordingPoster{release: make(chan struct{})}
+		q := newResourceQueue(resourceQueueParams{
+			MaxDepth: maxDepth,
+			Poster:   poster,
+		})
+
+		// the first event is in flight
+		_, err := q.Enqueue(context.Background(), EventPriorityNormal, &livekit.WebhookEvent{Id: "EV_0"})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			q.mu.Lock()
+			defer q.mu.Unlock()
+			return q.numItems == 0
+		}, time.Second, time.Millisecond)
+
+		for i, priority := range priorities {
+			evicted, err := q.Enqueue(context.Background(), priority, &livekit.WebhookEvent{Id: fmt.Sprintf("EV_%d", i+1)})
+			require.NoError(t, err)
+			require.Nil(t, evicted)
+		}
+
+		close(poster.release)
+		q.Stop(false)
+		<-q.Done()
+		return poster.ids
+	}
+
+	// delivered in submission order, whatever their priority
+	ids := run(10, EventPriorityLow, EventPriorityHigh, EventPriorityNormal, EventPriorityHigh)
+	require.Equal(t, []string{"EV_0", "EV_1", "EV_2", "EV_3", "EV_4"}, ids)
+
+	// lifecycle events are never dropped without MaxHighPriorityOverflow
+	ids = run(1, EventPriorityHigh, EventPriorityHigh, EventPriorityHigh, EventPriorityHigh)
+	require.Equal(t, []string{"EV_0", "EV_1", "EV_2", "EV_3", "EV_4"}, ids)
+}
+
+func TestResourceQueueKill(t *testing.T) {
+	poster := &recordingPoster{release: make(chan struct{})}
+	q := newResourceQueue(resourceQueueParams{
+		MaxDepth: 10,
+		Poster:   poster,
+	})
+
+	// the first event is in flight
+	for i := 0; i < 3; i++ {
+		_, err := q.Enqueue(context.Background(), EventPriorityNormal, &livekit.WebhookEvent{Id: fmt.Sprintf("EV_%d", i)})
+		require.NoError(t, err)
+	}
+	require.Eventually(t, func() bool {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		return q.numItems == 2
+	}, time.Second, time.Millisecond)
+
+	// queued events are reported as dropped
+	q.Stop(true)
+	close(poster.release)
+	<-q.Done()
+	require.Equal(t, []string{"EV_0"}, poster.ids)
+	require.Equal(t, []string{"EV_1", "EV_2"}, poster.dropped)
+}
+
+// recordingPoster records the ids of the events it processes once released, and of those it drops
+type recordingPoster struct {
+	release chan struct{}
+	mu      sync.Mutex
+	ids     []string
+	dropped []string
+}
+
+func (p *recordingPoster) Drop(_ context.Context, event *livekit.WebhookEvent, _ error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.dropped = append(p.dropped, event.Id)
+}
+
+func (p *recordingPoster) Process(_ context.Context, _ time.Time, event *livekit.WebhookEvent) {
+	<-p.release
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.ids = append(p.ids, event.Id)
+}
+
+// blockingPoster processes events once released
+type blockingPoster struct {
+	release chan struct{}
+}
+
+func (p blockingPoster) Process(context.Context, time.Time, *livekit.WebhookEvent) {
+	<-p.release
+}
+
+func (p blockingPoster) Drop(context.Context, *livekit.WebhookEvent, error) {}
+
+func TestPriorityPoolDrain(t *testing.T) {
+	p := newPriorityPool(1, 10, 0, nil)
+
+	var ran atomic.Int32
+	block := make(chan struct{})
+	resubmitted := make(chan bool, 1)
+	p.Submit("a", EventPriorityNormal, priorityJob{run: func() { <-block }})
+	p.Submit("a", EventPriorityNormal, priorityJob{run: func() {
+		ran.Inc()
+		// jobs run by the drain can submit without blocking it
+		resubmitted <- p.Submit("a", EventPriorityNormal, priorityJob{run: func() { ran.Inc() }})
+	}})
+
+	drained := make(chan struct{})
+	go func() {
+		p.Drain()
+		close(drained)
+	}()
+	require.Eventually(t, p.Stopped, time.Second, time.Millisecond)
+	close(block)
+
+	select {
+	case <-drained:
+	case <-time.After(5 * time.Second):
+		t.Fatal("drain did not complete")
+	}
+	// jobs submitted while draining are dropped rather than left in the queue
+	require.False(t, <-resubmitted)
+	require.Equal(t, int32(1), ran.Load())
+	require.False(t, p.Submit("a", EventPriorityNormal, priorityJob{run: func() {}}))
+}
+
+func TestURLNotifierEncoder(t *testing.T) {
+	s := newServer(testAddr)
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	// strips participant metadata and adds a tenant field
+	encoder := EncoderFunc(func(event *livekit.WebhookEvent) ([]byte, string, error) {
+		data, err := json.Marshal(map[string]string{
+			"tenant":      "acme",
+			"event":       event.Event,
+			"participant": event.Participant.GetIdentity(),
+		})
+		return data, "application/json", err
+	})
+
+	n := NewURLNotifier(URLNotifierParams{
+		URL:       testUrl,
+		APIKey:    testAPIKey,
+		APISecret: testAPISecret,
+		Encoder:   encoder,
+	})
+	defer n.Stop(true)
+
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("content-type"))
+		data, err := Receive(r, authProvider)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"tenant":"acme","event":"participant_joined","participant":"alice"}`, string(data))
+	}
+	require.NoError(t, n.send(context.Background(), &livekit.WebhookEvent{
+		Event:       EventParticipantJoined,
+		Participant: &livekit.ParticipantInfo{Identity: "alice", Metadata: "secret"},
+	}))
+
+	t.Run("broker notifiers", func(t *testing.T) {
+		producer := &testKafkaProducer{}
+		n := NewKafkaNotifier(KafkaNotifierParams{
+			Producer:  producer,
+			Config:    KafkaNotifierConfig{Topic: "webhooks"},
+			APIKey:    testAPIKey,
+			APISecret: testAPISecret,
+			Encoder:   encoder,
+		})
+		_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{
+			Event:       EventParticipantJoined,
+			Room:        &livekit.Room{Name: "room"},
+			Participant: &livekit.ParticipantInfo{Identity: "alice"},
+		})
+		n.Stop(false)
+
+		require.Len(t, producer.messages, 1)
+		msg := producer.messages[0]
+		require.NoError(t, verify(msg.Value, msg.Headers[authHeader], authProvider))
+		require.JSONEq(t, `{"tenant":"acme","event":"participant_joined","participant":"alice"}`, string(msg.Value))
+	})
+
+	t.Run("receive with decoder", func(t *testing.T) {
+		payload := []byte(`{"tenant":"acme","event":"participant_joined","participant":"alice"}`)
+		token, err := signPayload(payload, SigningKey{APIKey: testAPIKey, APISecret: testAPISecret})
+		require.NoError(t, err)
+
+		decode := func(data []byte) (*livekit.WebhookEvent, error) {
+			var m map[string]string
+			if err := json.Unmarshal(data, &m); err != nil {
+				return nil, err
+			}
+			return &livekit.WebhookEvent{Event: m["event"], Participant: &livekit.ParticipantInfo{Identity: m["participant"]}}, nil
+		}
+		event, err := ReceiveDelivery(&rpc.WebhookDelivery{Payload: payload, Token: token}, authProvider, WithDecoder(decode))
+		require.NoError(t, err)
+		require.Equal(t, EventParticipantJoined, event.Event)
+		require.Equal(t, "alice", event.Participant.Identity)
+
+		_, err = ReceiveDelivery(&rpc.WebhookDelivery{Payload: payload, Token: token}, authProvider)
+		require.Error(t, err)
+	})
+}
+
+func TestURLNotifierCanary(t *testing.T) {
+	s := newServer(testAddr)
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		event, err := ReceiveWebhookEvent(r, authProvider)
+		require.NoError(t, err)
+		require.Equal(t, EventWebhookCanary, event.Event)
+		w.WriteHeader(int(status.Load()))
+	}
+
+	n := NewURLNotifier(URLNotifierParams{
+		URL:       testUrl,
+		APIKey:    testAPIKey,
+		APISecret: testAPISecret,
+		Config: URLNotifierConfig{
+			CanaryInterval: 20 * time.Millisecond,
+		},
+		// canaries are not filtered
+		FilterParams: FilterParams{IncludeEvents: []string{EventRoomStarted}},
+	})
+	defer n.Stop(true)
+
+	var failures atomic.Int32
+	n.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+		require.Equal(t, EventWebhookCanary, whi.Event)
+		if whi.SendError != "" {
+			failures.Inc()
+		}
+	})
+
+	require.Eventually(t, func() bool {
+		return n.CanaryStats().Healthy()
+	}, time.Second, 10*time.Millisecond)
+
+	status.Store(http.StatusInternalServerError)
+	require.Eventually(t, func() bool {
+		return failures.Load() > 0
+	}, time.Second, 10*time.Millisecond)
+	stats := n.CanaryStats()
+	require.False(t, stats.Healthy())
+	require.ErrorIs(t, stats.LastError, ErrUnexpectedStatus)
+	require.NotZero(t, stats.Failed)
+	require.False(t, stats.LastSuccess.IsZero())
+
+	err := n.HealthCheck(context.Background())
+	require.ErrorIs(t, err, ErrCanaryFailed)
+	require.ErrorIs(t, err, ErrUnexpectedStatus)
+
+	status.Store(http.StatusOK)
+	require.Eventually(t, func() bool {
+		return n.HealthCheck(context.Background()) == nil
+	}, time.Second, 10*time.Millisecond)
+
+	n.Stop(true)
+	require.ErrorIs(t, n.HealthCheck(context.Background()), ErrNotifierStopped)
+}
+
+func TestNotifierCanary(t *testing.T) {
+	t.Run("resource notifier", func(t *testing.T) {
+		s := newServer(testAddr)
+		require.NoError(t, s.Start())
+		defer s.Stop()
+
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			event, err := ReceiveWebhookEvent(r, authProvider)
+			require.NoError(t, err)
+			require.Equal(t, EventWebhookCanary, event.Event)
+		}
+		n := NewDefaultNotifier(WebHookConfig{
+			URLs:   []string{testUrl},
+			APIKey: testAPIKey,
+			ResourceURLNotifier: ResourceURLNotifierConfig{
+				CanaryInterval: 20 * time.Millisecond,
+			},
+		}, testAPISecret)
+
+		u := n.(*DefaultNotifier).notifiers[0].(*ResourceURLNotifier)
+		require.Eventually(t, func() bool {
+			return u.CanaryStats().Healthy()
+		}, time.Second, 10*time.Millisecond)
+		require.NoError(t, n.(HealthChecker).HealthCheck(context.Background()))
+
+		n.Stop(true)
+		require.ErrorIs(t, n.(HealthChecker).HealthCheck(context.Background()), ErrNotifierStopped)
+	})
+
+	t.Run("broker notifier", func(t *testing.T) {
+		publisher := &failingPubSubPublisher{err: errors.New("unavailable")}
+		n := NewPubSubNotifier(PubSubNotifierParams{
+			Publisher: publisher,
+			Config: PubSubNotifierConfig{
+				Topic:          "webhooks",
+				CanaryInterval: 20 * time.Millisecond,
+			},
+		})
+		defer n.Stop(true)
+
+		require.Eventually(t, func() bool {
+			return n.CanaryStats().Failed > 0
+		}, time.Second, 10*time.Millisecond)
+		err := n.HealthCheck(context.Background())
+		require.ErrorIs(t, err, ErrCanaryFailed)
+		require.ErrorIs(t, err, publisher.err)
+	})
+
+	t.Run("grpc notifier", func(t *testing.T) {
+		receiver := &testWebhookReceiver{}
+		n := newTestGRPCNotifier(t, receiver, GRPCNotifierConfig{CanaryInterval: 20 * time.Millisecond})
+		defer n.Stop(true)
+
+		require.Eventually(t, func() bool {
+			return n.CanaryStats().Healthy()
+		}, time.Second, 10*time.Millisecond)
+		require.NoError(t, n.HealthCheck(context.Background()))
+	})
+}
+
+type failingPubSubPublisher struct {
+	err error
+}
+
+func (p *failingPubSubPublisher) Publish(context.Context, *PubSubMessage) error {
+	return p.err
+}
+
+func TestURLNotifierSigningKeys(t *testing.T) {
+	s := newServer(testAddr)
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	oldKey := SigningKey{ID: "old", APIKey: testAPIKey, APISecret: testAPISecret}
+	newKey := SigningKey{ID: "new", APIKey: testAPIKey, APISecret: "new-secret"}
+
+	n := NewURLNotifier(URLNotifierParams{
+		URL:         testUrl,
+		SigningKeys: []SigningKey{oldKey, newKey},
+	})
+	defer n.Stop(true)
+
+	// the receiver accepts both keys while the sender switches over
+	keys := NewKeySet(oldKey, newKey)
+	var kid string
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		v, err := auth.ParseAPIToken(r.Header.Get(authHeader))
+		require.NoError(t, err)
+		kid = v.KeyID()
+		_, err = ReceiveWebhookEvent(r, keys)
+		require.NoError(t, err)
+	}
+	_, err := n.SendTest(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "old", kid)
+
+	require.ErrorIs(t, n.SetSigningKeys([]SigningKey{oldKey, newKey}, "unknown"), ErrSigningKeyNotFound)
+	require.NoError(t, n.SetSigningKeys([]SigningKey{oldKey, newKey}, "new"))
+	_, err = n.SendTest(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "new", kid)
+
+	// tokens without a kid are checked against every secret of the API key
+	encoded := []byte(`{"event":"room_started"}`)
+	token, err := signPayload(encoded, SigningKey{APIKey: testAPIKey, APISecret: "new-secret"})
+	require.NoError(t, err)
+	require.NoError(t, verify(encoded, token, keys))
+
+	// retired keys are rejected
+	token, err = signPayload(encoded, oldKey)
+	require.NoError(t, err)
+	require.Error(t, verify(encoded, token, NewKeySet(newKey)))
+
+	// SetKeys replaces the secret of the active key
+	n.SetKeys(testAPIKey, "newer-secret")
+	keys = NewKeySet(oldKey, SigningKey{ID: "new", APIKey: testAPIKey, APISecret: "newer-secret"})
+	_, err = n.SendTest(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "new", kid)
+}
+
+func TestSigningKeyResolver(t *testing.T) {
+	tenantKey := SigningKey{ID: "tenant", APIKey: "tenant-key", APISecret: "tenant-secret"}
+	configs := map[string]*livekit.RoomConfiguration{
+		"tenant":  {WebhookSigningKey: &livekit.WebhookSigningKey{KeyId: "tenant", ApiKey: "tenant-key"}},
+		"revoked": {WebhookSigningKey: &livekit.WebhookSigningKey{KeyId: "revoked", ApiKey: "tenant-key"}},
+		"other":   {},
+		// another project naming the key of the tenant
+		"intruder": {WebhookSigningKey: &livekit.WebhookSigningKey{KeyId: "tenant", ApiKey: "tenant-key"}},
+	}
+	resolver := RoomConfigurationResolver(func(roomName string) *livekit.RoomConfiguration {
+		return configs[roomName]
+	}, func(roomName string) auth.KeyProvider {
+		switch roomName {
+		case "tenant", "revoked":
+			return NewKeySet(tenantKey)
+		case "intruder":
+			return NewKeySet(SigningKey{ID: "intruder", APIKey: "intruder-key", APISecret: "intruder-secret"})
+		}
+		return nil
+	})
+
+	t.Run("resolves from room configuration", func(t *testing.T) {
+		key, ok := resolver(&livekit.WebhookEvent{Room: &livekit.Room{Name: "tenant"}})
+		require.True(t, ok)
+		require.Equal(t, tenantKey, key)
+
+		key, ok = resolver(&livekit.WebhookEvent{EgressInfo: &livekit.EgressInfo{RoomName: "tenant"}})
+		require.True(t, ok)
+		require.Equal(t, tenantKey, key)
+
+		_, ok = resolver(&livekit.WebhookEvent{Room: &livekit.Room{Name: "other"}})
+		require.False(t, ok)
+		// keys unknown to the server are not used
+		_, ok = resolver(&livekit.WebhookEvent{Room: &livekit.Room{Name: "revoked"}})
+		require.False(t, ok)
+		// keys of other projects are not used
+		_, ok = resolver(&livekit.WebhookEvent{Room: &livekit.Room{Name: "intruder"}})
+		require.False(t, ok)
+		_, ok = resolver(&livekit.WebhookEvent{Room: &livekit.Room{Name: "unknown"}})
+		require.False(t, ok)
+		_, ok = resolver(&livekit.WebhookEvent{})
+		require.False(t, ok)
+	})
+
+	t.Run("signs with resolved key", func(t *testing.T) {
+		s := newServer(testAddr)
+		require.NoError(t, s.Start())
+		defer s.Stop()
+
+		n := NewURLNotifier(URLNotifierParams{
+			URL:                testUrl,
+			APIKey:             testAPIKey,
+			APISecret:          testAPISecret,
+			SigningKeyResolver: resolver,
+		})
+		defer n.Stop(true)
+
+		received := make(chan string, 2)
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			v, err := auth.ParseAPIToken(r.Header.Get(authHeader))
+			require.NoError(t, err)
+			// each tenant only holds its own secret
+			keys := NewKeySet(tenantKey, SigningKey{APIKey: testAPIKey, APISecret: testAPISecret})
+			event, err := ReceiveWebhookEvent(r, keys)
+			require.NoError(t, err)
+			received <- event.Room.Name + ":" + v.APIKey()
+		}
+
+		require.NoError(t, n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Room: &livekit.Room{Name: "tenant"}}))
+		require.Equal(t, "tenant:tenant-key", <-received)
+		require.NoError(t, n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Room: &livekit.Room{Name: "other"}}))
+		require.Equal(t, "other:"+testAPIKey, <-received)
+	})
+}
+
+func TestEventMetrics(t *testing.T) {
+	m := NewEventMetrics(nil)
+	room := &livekit.Room{Sid: "RM_1", Name: "room"}
+	p1 := &livekit.ParticipantInfo{Sid: "PA_1", Identity: "a"}
+	p2 := &livekit.ParticipantInfo{Sid: "PA_2", Identity: "b", Kind: livekit.ParticipantInfo_AGENT}
+
+	m.Observe(&livekit.WebhookEvent{Event: EventRoomStarted, Room: room})
+	m.Observe(&livekit.WebhookEvent{Event: EventParticipantJoined, Room: room, Participant: p1})
+	m.Observe(&livekit.WebhookEvent{Event: EventParticipantJoined, Room: room, Participant: p1})
+	m.Observe(&livekit.WebhookEvent{Event: EventParticipantJoined, Room: room, Participant: p2})
+	require.Equal(t, 1.0, testutil.ToFloat64(m.roomsActive))
+	require.Equal(t, 2.0, testutil.ToFloat64(m.participantsActive))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.participantsJoined.WithLabelValues("STANDARD")))
+	require.Equal(t, 3.0, testutil.ToFloat64(m.eventsTotal.WithLabelValues(EventParticipantJoined)))
+
+	m.Observe(&livekit.WebhookEvent{Event: EventParticipantLeft, Room: room, Participant: p1})
+	require.Equal(t, 1.0, testutil.ToFloat64(m.participantsActive))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.participantsLeft.WithLabelValues("STANDARD")))
+
+	m.Observe(&livekit.WebhookEvent{Event: EventEgressEnded, EgressInfo: &livekit.EgressInfo{Status: livekit.EgressStatus_EGRESS_FAILED}})
+	require.Equal(t, 1.0, testutil.ToFloat64(m.egressEnded.WithLabelValues("EGRESS_FAILED")))
+
+	call := &livekit.SIPCallInfo{CallId: "SCL_1", CallDirection: livekit.SIPCallDirection_SCD_INBOUND}
+	m.Observe(&livekit.WebhookEvent{Event: EventSIPCallStarted, SipCall: call})
+	call.CallStatus = livekit.SIPCallStatus_SCS_DISCONNECTED
+	m.Observe(&livekit.WebhookEvent{Event: EventSIPCallEnded, SipCall: call})
+	require.Equal(t, 1.0, testutil.ToFloat64(m.sipCallsEnded.WithLabelValues("SCD_INBOUND", "SCS_DISCONNECTED")))
+
+	job := &livekit.Job{Id: "AJ_1", AgentName: "agent", Room: room}
+	m.Observe(&livekit.WebhookEvent{Event: EventAgentJobStarted, AgentJob: job})
+	job.State = &livekit.JobState{Status: livekit.JobStatus_JS_SUCCESS, TerminationReason: livekit.JobTerminationReason_JTR_ROOM_CLOSED}
+	m.Observe(&livekit.WebhookEvent{Event: EventAgentJobEnded, AgentJob: job})
+	require.Equal(t, 1.0, testutil.ToFloat64(m.agentJobsEnded.WithLabelValues("JS_SUCCESS", "JTR_ROOM_CLOSED")))
+
+	m.Observe(&livekit.WebhookEvent{Event: EventRoomFinished, Room: room})
+	require.Equal(t, 0.0, testutil.ToFloat64(m.roomsActive))
+	require.Equal(t, 0.0, testutil.ToFloat64(m.participantsActive))
+
+	require.NoError(t, prometheus.NewRegistry().Register(m))
+}
+
+func TestLogFieldsRedacted(t *testing.T) {
+	event := &livekit.WebhookEvent{Event: EventParticipantJoined, Id: "EV_1"}
+	event.SetParticipantPayload(&livekit.ParticipantWebhookPayload{
+		Room:        &livekit.Room{Name: "room"},
+		Participant: &livekit.ParticipantInfo{Identity: "alice", Metadata: "secret"},
+	})
+
+	fields := logFields(event, testUrl)
+	var payload zapcore.ObjectMarshaler
+	for i := 0; i < len(fields); i += 2 {
+		if fields[i] == "payload" {
+			payload = fields[i+1].(zapcore.ObjectMarshaler)
+		}
+	}
+	require.NotNil(t, payload)
+
+	enc := zapcore.NewMapObjectEncoder()
+	require.NoError(t, payload.MarshalLogObject(enc))
+	p := enc.Fields["participant"].(map[string]any)
+	require.Equal(t, "alice", p["identity"])
+	require.Empty(t, p["metadata"])
+	require.Equal(t, "secret", event.Participant.Metadata)
+}
+
+func TestProvenance(t *testing.T) {
+	t.Run("stamp", func(t *testing.T) {
+		p := ProvenanceParams{
+			Provenance: Provenance{Region: "us-east", NodeID: "ND_1"},
+			ProvenanceHook: func(event *livekit.WebhookEvent) Provenance {
+				return Provenance{ProjectID: "p_" + event.Room.GetName()}
+			},
+		}
+		event := &livekit.WebhookEvent{Event: EventRoomStarted, Room: &livekit.Room{Name: "room"}}
+		stamped := p.stampProvenance(event)
+		require.Equal(t, "us-east", stamped.Region)
+		require.Equal(t, "ND_1", stamped.NodeId)
+		require.Equal(t, "p_room", stamped.ProjectId)
+		// shared events are not modified
+		require.Empty(t, event.Region)
+
+		event.Region = "eu-west"
+		event.NodeId = "ND_2"
+		event.ProjectId = "p_other"
+		require.Same(t, event, p.stampProvenance(event))
+		empty := &livekit.WebhookEvent{}
+		require.Same(t, empty, ProvenanceParams{}.stampProvenance(empty))
+	})
+
+	t.Run("notifier", func(t *testing.T) {
+		s := newServer(testAddr)
+		require.NoError(t, s.Start())
+		defer s.Stop()
+
+		n := NewURLNotifier(URLNotifierParams{
+			URL:              testUrl,
+			APIKey:           testAPIKey,
+			APISecret:        testAPISecret,
+			ProvenanceParams: ProvenanceParams{Provenance: Provenance{Region: "us-east", NodeID: "ND_1"}},
+		})
+		defer n.Stop(false)
+
+		received := make(chan *livekit.WebhookEvent, 1)
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			event, err := ReceiveWebhookEvent(r, authProvider)
+			require.NoError(t, err)
+			received <- event
+		}
+		require.NoError(t, n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Room: &livekit.Room{Name: "room"}}))
+		event := <-received
+		require.Equal(t, "us-east", event.Region)
+		require.Equal(t, "ND_1", event.NodeId)
+	})
+}
+
+func TestURLNotifierContext(t *testing.T) {
+	s := newServer(testAddr)
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	type ctxKey struct{}
+
+	t.Run("propagates values", func(t *testing.T) {
+		urlNotifier := newTestNotifier()
+		defer urlNotifier.Stop(false)
+
+		s.handler = func(w http.ResponseWriter, r *http.Request) {}
+		processed := make(chan any, 1)
+		urlNotifier.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+			processed <- ctx.Value(ctxKey{})
+		})
+
+		ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "trace"))
+		require.NoError(t, urlNotifier.QueueNotify(ctx, &livekit.WebhookEvent{Event: EventRoomStarted}))
+		// canceling the caller context must not drop the event
+		cancel()
+		require.Equal(t, "trace", <-processed)
+	})
+
+	t.Run("drops expired events", func(t *testing.T) {
+		urlNotifier := NewURLNotifier(URLNotifierParams{
+			URL:       testUrl,
+			APIKey:    testAPIKey,
+			APISecret: testAPISecret,
+			Config: URLNotifierConfig{
+				NumWorkers: 1,
+				QueueSize:  20,
+				MaxAge:     50 * time.Millisecond,
+			},
+		})
+		defer urlNotifier.Stop(false)
+
+		numCalled := atomic.Int32{}
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			numCalled.Inc()
+			time.Sleep(100 * time.Millisecond)
+		}
+		numDropped := atomic.Int32{}
+		urlNotifier.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+			if whi.IsDropped {
+				numDropped.Inc()
+			}
+		})
+
+		// second event is queued behind the slow first one and exceeds max age
+		room := &livekit.Room{Name: "room"}
+		_ = urlNotifier.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Room: room})
+		_ = urlNotifier.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomFinished, Room: room})
+
+		// deadline passes before the event is sent
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_ = urlNotifier.QueueNotify(ctx, &livekit.WebhookEvent{Event: EventRoomFinished, Room: room})
+
+		require.Eventually(t, func() bool { return numDropped.Load() == 2 }, 5*time.Second, webhookCheckInterval)
+		require.Equal(t, int32(1), numCalled.Load())
+	})
+}
+
+func TestSessionStitcher(t *testing.T) {
+	s := NewSessionStitcher()
+	room := &livekit.Room{Name: "room"}
+
+	session := s.Observe(&livekit.WebhookEvent{
+		Event:       EventParticipantJoined,
+		Room:        room,
+		Participant: &livekit.ParticipantInfo{Sid: "PA_1", Identity: "a", SessionId: "PS_1"},
+		CreatedAt:   100,
+	})
+	require.Equal(t, "PS_1", session.SessionID)
+	require.Equal(t, time.Unix(100, 0), session.JoinedAt)
+
+	s.Observe(&livekit.WebhookEvent{
+		Event:       EventParticipantJoined,
+		Room:        room,
+		Participant: &livekit.ParticipantInfo{Sid: "PA_2", Identity: "a", SessionId: "PS_1"},
+	})
+	session = s.Observe(&livekit.WebhookEvent{
+		Event: EventParticipantReconnected,
+		Room:  room,
+		ParticipantReconnect: &livekit.ParticipantReconnect{
+			SessionId:              "PS_1",
+			PreviousParticipantSid: "PA_1",
+			ParticipantSid:         "PA_2",
+			GapMs:                  1500,
+		},
+	})
+	require.Equal(t, []string{"PA_1", "PA_2"}, session.ParticipantSids)
+	require.Equal(t, 1, session.Reconnects)
+	require.Equal(t, 1500*time.Millisecond, session.TotalGap)
+
+	// reconnects without the new participant sid are ignored
+	require.Nil(t, s.Observe(&livekit.WebhookEvent{
+		Event:                EventParticipantReconnected,
+		Room:                 room,
+		ParticipantReconnect: &livekit.ParticipantReconnect{SessionId: "PS_1", PreviousParticipantSid: "PA_1"},
+	}))
+	require.Equal(t, []string{"PA_1", "PA_2"}, session.ParticipantSids)
+	require.Equal(t, 1, session.Reconnects)
+
+	// previous connection leaving keeps the session active
+	session = s.Observe(&livekit.WebhookEvent{
+		Event:       EventParticipantLeft,
+		Room:        room,
+		Participant: &livekit.ParticipantInfo{Sid: "PA_1", Identity: "a", SessionId: "PS_1"},
+	})
+	require.True(t, session.Active())
+
+	got, ok := s.Session("PA_2")
+	require.True(t, ok)
+	require.Equal(t, "PS_1", got.SessionID)
+
+	session = s.Observe(&livekit.WebhookEvent{
+		Event:       EventParticipantLeft,
+		Room:        room,
+		Participant: &livekit.ParticipantInfo{Sid: "PA_2", Identity: "a", SessionId: "PS_1"},
+		CreatedAt:   200,
+	})
+	require.False(t, session.Active())
+	require.Equal(t, time.Unix(200, 0), session.LeftAt)
+
+	_, ok = s.Session("PA_2")
+	require.False(t, ok)
+	require.Empty(t, s.sessions)
+	require.Empty(t, s.bySid)
+
+	t.Run("moved", func(t *testing.T) {
+		s := NewSessionStitcher()
+		s.Observe(&livekit.WebhookEvent{
+			Event:       EventParticipantJoined,
+			Room:        &livekit.Room{Name: "lobby"},
+			Participant: &livekit.ParticipantInfo{Sid: "PA_1", Identity: "a", SessionId: "PS_1"},
+		})
+		session := s.Observe(&livekit.WebhookEvent{
+			Event:       EventParticipantMoved,
+			Room:        &livekit.Room{Name: "agent"},
+			Participant: &livekit.ParticipantInfo{Sid: "PA_2", Identity: "a", SessionId: "PS_1"},
+			ParticipantMove: &livekit.ParticipantMove{
+				SourceRoom:             "lobby",
+				PreviousParticipantSid: "PA_1",
+			},
+		})
+		require.Equal(t, "PS_1", session.SessionID)
+		require.Equal(t, "agent", session.RoomName)
+		require.Equal(t, []string{"PA_1", "PA_2"}, session.ParticipantSids)
+	})
+}
+
+func TestNotifierStopContext(t *testing.T) {
+	s := newServer(testAddr)
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	t.Run("drains before deadline", func(t *testing.T) {
+		urlNotifier := newTestNotifier()
+		numCalled := atomic.Int32{}
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			numCalled.Inc()
+		}
+		for i := 0; i < 10; i++ {
+			_ = urlNotifier.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted})
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		require.Equal(t, 0, urlNotifier.StopContext(ctx))
+		require.Equal(t, int32(10), numCalled.Load())
+	})
+
+	t.Run("abandons after deadline", func(t *testing.T) {
+		urlNotifier := NewURLNotifier(URLNotifierParams{
+			URL:       testUrl,
+			APIKey:    testAPIKey,
+			APISecret: testAPISecret,
+			Config: URLNotifierConfig{
+				NumWorkers: 1,
+				QueueSize:  20,
+			},
+		})
+		numCalled := atomic.Int32{}
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			numCalled.Inc()
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}
+		for i := 0; i < 5; i++ {
+			_ = urlNotifier.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted})
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		require.Equal(t, 5, urlNotifier.StopContext(ctx))
+		require.Less(t, time.Since(start), time.Second)
+
+		time.Sleep(webhookCheckInterval)
+		require.Equal(t, int32(1), numCalled.Load())
+	})
+
+	t.Run("resource notifier", func(t *testing.T) {
+		n := newTestResourceNotifier(time.Minute, time.Minute, 20)
+		numDropped := atomic.Int32{}
+		n.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+			if whi.IsDropped {
+				numDropped.Inc()
+			}
+		})
+		numCalled := atomic.Int32{}
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			numCalled.Inc()
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}
+		for i := 0; i < 5; i++ {
+			_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Room: &livekit.Room{Name: "room"}})
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		require.Equal(t, 5, n.StopContext(ctx))
+		require.Less(t, time.Since(start), time.Second)
+
+		time.Sleep(webhookCheckInterval)
+		require.Equal(t, int32(1), numCalled.Load())
+
+		// queued events are reported as dropped
+		require.Eventually(t, func() bool { return n.pending.Load() == 0 }, time.Second, 10*time.Millisecond)
+		require.Equal(t, int32(4), numDropped.Load())
+	})
+
+	t.Run("broker notifier", func(t *testing.T) {
+		publisher := &blockingPubSubPublisher{started: make(chan struct{}, 5)}
+		store := NewMemoryDedupeStore()
+		n := NewPubSubNotifier(PubSubNotifierParams{
+			Publisher:    publisher,
+			Config:       PubSubNotifierConfig{Topic: "webhooks"},
+			DedupeParams: DedupeParams{DedupeStore: store},
+		})
+		var processed sync.WaitGroup
+		processed.Add(5)
+		numDropped := atomic.Int32{}
+		n.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+			if whi.IsDropped {
+				numDropped.Inc()
+			}
+			processed.Done()
+		})
+		for i := 0; i < 5; i++ {
+			_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Id: fmt.Sprintf("EV_%d", i), Room: &livekit.Room{Name: "room"}})
+		}
+
+		// stop once the first event is in flight
+		<-publisher.started
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.Equal(t, 5, n.StopContext(ctx))
+
+		// queued events are reported as dropped, and abandoned events are forgotten so they can be delivered again
+		processed.Wait()
+		require.Equal(t, int32(4), numDropped.Load())
+		require.Equal(t, int32(1), publisher.published.Load())
+		for i := 0; i < 5; i++ {
+			ok, err := store.SetIfNotExists(context.Background(), fmt.Sprintf("pubsub://webhooks|EV_%d", i), time.Minute)
+			require.NoError(t, err)
+			require.True(t, ok)
+		}
+	})
+
+	t.Run("default notifier", func(t *testing.T) {
+		n := NewDefaultNotifier(WebHookConfig{URLs: []string{testUrl, testUrl}, APIKey: testAPIKey}, testAPISecret)
+		numCalled := atomic.Int32{}
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			numCalled.Inc()
+		}
+		for i := 0; i < 5; i++ {
+			_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Room: &livekit.Room{Name: "room"}})
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		require.Equal(t, 0, n.(ContextStopper).StopContext(ctx))
+		require.Equal(t, int32(10), numCalled.Load())
+	})
+}
+
+// blockingPubSubPublisher publishes once its context is done
+type blockingPubSubPublisher struct {
+	published atomic.Int32
+	// receives a value when a publish starts
+	started chan struct{}
+}
+
+func (p *blockingPubSubPublisher) Publish(ctx context.Context, _ *PubSubMessage) error {
+	p.published.Inc()
+	p.started <- struct{}{}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestJournalReplay(t *testing.T) {
+	s := newServer(testAddr)
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	journal := NewMemoryJournal(0)
+	notifiers := []QueuedNotifier{
+		NewURLNotifier(URLNotifierParams{
+			URL:           testUrl,
+			APIKey:        testAPIKey,
+			APISecret:     testAPISecret,
+			JournalParams: JournalParams{Journal: journal},
+		}),
+		NewResourceURLNotifier(ResourceURLNotifierParams{
+			URL:           testUrl + "/resource",
+			APIKey:        testAPIKey,
+			APISecret:     testAPISecret,
+			JournalParams: JournalParams{Journal: journal},
+		}),
+	}
+
+	start := time.Now().Add(-time.Second)
+	for _, n := range notifiers {
+		numCalled := atomic.Int32{}
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			numCalled.Inc()
+		}
+
+		now := time.Now().Unix()
+		_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Id: "EV_1", Room: &livekit.Room{Name: "a"}, CreatedAt: now})
+		_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomFinished, Id: "EV_2", Room: &livekit.Room{Name: "a"}, CreatedAt: now})
+		_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomFinished, Id: "EV_3", Room: &livekit.Room{Name: "b"}, CreatedAt: now})
+		require.Eventually(t, func() bool { return numCalled.Load() == 3 }, 5*time.Second, webhookCheckInterval)
+
+		replayed, err := n.(Replayer).Replay(context.Background(), start, time.Now().Add(time.Second), FilterParams{
+			IncludeEvents: []string{EventRoomFinished},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, replayed)
+		require.Eventually(t, func() bool { return numCalled.Load() == 5 }, 5*time.Second, webhookCheckInterval)
+
+		// replayed deliveries are journaled too, but each event is replayed once
+		replayed, err = n.(Replayer).Replay(context.Background(), start, time.Now().Add(time.Second), FilterParams{})
+		require.NoError(t, err)
+		require.Equal(t, 3, replayed)
+
+		replayed, err = n.(Replayer).Replay(context.Background(), start.Add(-time.Hour), start, FilterParams{})
+		require.NoError(t, err)
+		require.Equal(t, 0, replayed)
+
+		n.Stop(false)
+		require.Eventually(t, func() bool { return numCalled.Load() == 8 }, 5*time.Second, webhookCheckInterval)
+	}
+
+	t.Run("canaries are not journaled", func(t *testing.T) {
+		journal := NewMemoryJournal(0)
+		n := NewURLNotifier(URLNotifierParams{
+			URL:           testUrl,
+			APIKey:        testAPIKey,
+			APISecret:     testAPISecret,
+			Config:        URLNotifierConfig{CanaryInterval: 10 * time.Millisecond},
+			JournalParams: JournalParams{Journal: journal},
+		})
+		s.handler = func(w http.ResponseWriter, r *http.Request) {}
+		_, err := n.SendTest(context.Background())
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return n.CanaryStats().Sent >= 2 }, 5*time.Second, webhookCheckInterval)
+		n.Stop(false)
+
+		replayed, err := n.Replay(context.Background(), start, time.Now().Add(time.Second), FilterParams{})
+		require.NoError(t, err)
+		require.Zero(t, replayed)
+	})
+}
+
+type testKafkaProducer struct {
+	mu       sync.Mutex
+	messages []*KafkaMessage
+	err      error
+}
+
+func (p *testKafkaProducer) Produce(_ context.Context, msg *KafkaMessage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+func TestKafkaNotifier(t *testing.T) {
+	producer := &testKafkaProducer{}
+	n := NewKafkaNotifier(KafkaNotifierParams{
+		Producer: producer,
+		Config: KafkaNotifierConfig{
+			Topic:           "webhooks",
+			PartitionByRoom: true,
+		},
+		APIKey:       testAPIKey,
+		APISecret:    testAPISecret,
+		FilterParams: FilterParams{ExcludeEvents: []string{EventTrackPublished}},
+	})
+
+	processed := atomic.Int32{}
+	n.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+		require.Equal(t, "kafka://webhooks", whi.Url)
+		processed.Inc()
+	})
+
+	room := &livekit.Room{Name: "room", Sid: "RM_1"}
+	_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Id: "EV_1", Room: room})
+	_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventTrackPublished, Id: "EV_2", Room: room})
+	_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventEgressStarted, Id: "EV_3", EgressInfo: &livekit.EgressInfo{EgressId: "EG_1", RoomName: "room"}})
+	n.Stop(false)
+
+	require.Equal(t, int32(2), processed.Load())
+	require.Len(t, producer.messages, 2)
+	for _, msg := range producer.messages {
+		require.Equal(t, "webhooks", msg.Topic)
+		require.Equal(t, "room", string(msg.Key))
+		require.NotEmpty(t, msg.Headers[authHeader])
+
+		event := &livekit.WebhookEvent{}
+		require.NoError(t, protojson.Unmarshal(msg.Value, event))
+		require.Equal(t, event.Id, msg.Headers[kafkaHeaderEventID])
+	}
+}
+
+type testPubSubPublisher struct {
+	mu       sync.Mutex
+	messages []*PubSubMessage
+}
+
+func (p *testPubSubPublisher) Publish(_ context.Context, msg *PubSubMessage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+func TestPubSubNotifier(t *testing.T) {
+	publisher := &testPubSubPublisher{}
+	n := NewPubSubNotifier(PubSubNotifierParams{
+		Publisher: publisher,
+		Config: PubSubNotifierConfig{
+			Topic:       "webhooks",
+			OrderingKey: PubSubOrderingRoom,
+			Attributes:  []string{PubSubAttributeEvent, PubSubAttributeParticipantIdentity},
+		},
+		AttributesHook: func(event *livekit.WebhookEvent, attrs map[string]string) {
+			attrs["project"] = "p1"
+		},
+		FilterParams: FilterParams{IncludeRooms: []string{"room-*"}},
+	})
+
+	processed := atomic.Int32{}
+	n.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+		require.Equal(t, "pubsub://webhooks", whi.Url)
+		processed.Inc()
+	})
+
+	_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{
+		Event:       EventParticipantJoined,
+		Id:          "EV_1",
+		Room:        &livekit.Room{Name: "room-1"},
+		Participant: &livekit.ParticipantInfo{Identity: "alice"},
+	})
+	_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Id: "EV_2", Room: &livekit.Room{Name: "other"}})
+	n.Stop(false)
+
+	require.Equal(t, int32(1), processed.Load())
+	require.Len(t, publisher.messages, 1)
+	msg := publisher.messages[0]
+	require.Equal(t, "webhooks", msg.Topic)
+	require.Equal(t, "room-1", msg.OrderingKey)
+	require.Equal(t, map[string]string{
+		PubSubAttributeEvent:               EventParticipantJoined,
+		PubSubAttributeParticipantIdentity: "alice",
+		"project":                          "p1",
+	}, msg.Attributes)
+
+	// events whose deadline passed before they were published are dropped
+	publisher = &testPubSubPublisher{}
+	n = NewPubSubNotifier(PubSubNotifierParams{
+		Publisher: publisher,
+		Config:    PubSubNotifierConfig{Topic: "webhooks"},
+	})
+	dropped := atomic.Int32{}
+	n.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+		if whi.IsDropped {
+			dropped.Inc()
+		}
+	})
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	_ = n.QueueNotify(ctx, &livekit.WebhookEvent{Event: EventRoomStarted, Id: "EV_3", Room: &livekit.Room{Name: "room-1"}})
+	n.Stop(false)
+
+	require.Equal(t, int32(1), dropped.Load())
+	require.Empty(t, publisher.messages)
+}
+
+func TestRouter(t *testing.T) {
+	s := newServer(testAddr)
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	router := NewRouter(RouterParams{
+		KeyProvider:      authProvider,
+		IdempotencyStore: NewMemoryIdempotencyStore(),
+	})
+	s.handler = router.ServeHTTP
+
+	var calls atomic.Int32
+	var fail atomic.Bool
+	fail.Store(true)
+	router.Handle(EventRoomFinished, func(ctx context.Context, event *livekit.WebhookEvent) error {
+		calls.Inc()
+		if fail.Load() {
+			return fmt.Errorf("billing unavailable")
+		}
+		return nil
+	})
+
+	event := &livekit.WebhookEvent{Event: EventRoomFinished, Id: "EV_1", Room: &livekit.Room{Name: "room"}}
+
+	// failed handlers release the event for a retry
+	require.Error(t, router.Dispatch(context.Background(), event))
+	fail.Store(false)
+	require.NoError(t, router.Dispatch(context.Background(), event))
+	require.NoError(t, router.Dispatch(context.Background(), event))
+	require.Equal(t, int32(2), calls.Load())
+
+	// events without handlers are accepted
+	require.NoError(t, router.Dispatch(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Id: "EV_2"}))
+
+	t.Run("in progress", func(t *testing.T) {
+		store := NewMemoryIdempotencyStore()
+		state, token, err := store.Claim(context.Background(), "EV_1", time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, IdempotencyClaimed, state)
+
+		r := NewRouter(RouterParams{IdempotencyStore: store})
+		r.HandleDefault(func(ctx context.Context, event *livekit.WebhookEvent) error { return nil })
+		require.ErrorIs(t, r.Dispatch(context.Background(), event), ErrEventInProgress)
+
+		require.NoError(t, store.Release(context.Background(), "EV_1", token))
+		require.NoError(t, r.Dispatch(context.Background(), event))
+		state, _, err = store.Claim(context.Background(), "EV_1", time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, IdempotencyProcessed, state)
+	})
+
+	t.Run("lease expired", func(t *testing.T) {
+		ctx := context.Background()
+		store := NewMemoryIdempotencyStore()
+		state, stale, err := store.Claim(ctx, "EV_1", 10*time.Millisecond)
+		require.NoError(t, err)
+		require.Equal(t, IdempotencyClaimed, state)
+
+		time.Sleep(20 * time.Millisecond)
+		state, token, err := store.Claim(ctx, "EV_1", time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, IdempotencyClaimed, state)
+		require.NotEqual(t, stale, token)
+
+		// the expired claim neither releases nor completes the new one
+		require.NoError(t, store.Release(ctx, "EV_1", stale))
+		require.ErrorIs(t, store.Complete(ctx, "EV_1", stale, time.Minute), ErrIdempotencyClaimLost)
+		state, _, err = store.Claim(ctx, "EV_1", time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, IdempotencyInProgress, state)
+
+		require.NoError(t, store.Complete(ctx, "EV_1", token, time.Minute))
+		state, _, err = store.Claim(ctx, "EV_1", time.Minute)
+		require.NoError(t, err)
+		require.Equal(t, IdempotencyProcessed, state)
+	})
+
+	t.Run("http", func(t *testing.T) {
+		n := newTestNotifier()
+		_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomFinished, Id: "EV_3", Room: &livekit.Room{Name: "room"}})
+		_ = n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomFinished, Id: "EV_3", Room: &livekit.Room{Name: "room"}})
+		n.Stop(false)
+		require.Equal(t, int32(3), calls.Load())
+	})
+}
+
+func TestRedisStreamNotifier(t *testing.T) {
+	_, err := NewRedisStreamNotifier(RedisStreamNotifierParams{})
+	require.Error(t, err)
+
+	event := &livekit.WebhookEvent{Event: EventRoomStarted, Id: "EV_1"}
+	args := redisStreamArgs(RedisStreamNotifierConfig{Stream: "events", MaxLen: 100}, event, &brokerMessage{
+		Payload: []byte("{}"),
+		Token:   "token",
+	})
+	require.Equal(t, "events", args.Stream)
+	require.Equal(t, int64(100), args.MaxLen)
+	require.True(t, args.Approx)
+	require.Equal(t, []any{
+		RedisStreamFieldEvent, EventRoomStarted,
+		RedisStreamFieldID, "EV_1",
+		RedisStreamFieldData, []byte("{}"),
+		RedisStreamFieldToken, "token",
+	}, args.Values)
+
+	args = redisStreamArgs(RedisStreamNotifierConfig{Stream: "events", MaxLen: -1}, event, &brokerMessage{Payload: []byte("{}")})
+	require.Zero(t, args.MaxLen)
+	require.Len(t, args.Values, 6)
+}
+
+type testWebhookReceiver struct {
+	rpc.UnimplementedWebhookReceiverServer
+
+	mu       sync.Mutex
+	streams  int
+	received []string
+	// called for each delivery, returning false ends the stream without acking
+	handle func(stream int, event *livekit.WebhookEvent) bool
+}
+
+func (r *testWebhookReceiver) StreamEvents(stream rpc.WebhookReceiver_StreamEventsServer) error {
+	r.mu.Lock()
+	r.streams++
+	n := r.streams
+	r.mu.Unlock()
+
+	for {
+		d, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		event, err := ReceiveDelivery(d, authProvider)
+		if err != nil {
+			return err
+		}
+
+		r.mu.Lock()
+		r.received = append(r.received, event.Id)
+		r.mu.Unlock()
+
+		if r.handle != nil && !r.handle(n, event) {
+			return errors.New("stream reset")
+		}
+		if err = stream.Send(&rpc.WebhookAck{Sequence: d.Sequence}); err != nil {
+			return err
+		}
+	}
+}
+
+func newTestGRPCNotifier(t *testing.T, receiver *testWebhookReceiver, config GRPCNotifierConfig) *GRPCNotifier {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	rpc.RegisterWebhookReceiverServer(srv, receiver)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return NewGRPCNotifier(GRPCNotifierParams{
+		Client:    rpc.NewWebhookReceiverClient(conn),
+		Config:    config,
+		APIKey:    testAPIKey,
+		APISecret: testAPISecret,
+	})
+}
+
+func TestGRPCNotifier(t *testing.T) {
+	t.Run("delivers and drains", func(t *testing.T) {
+		receiver := &testWebhookReceiver{}
+		n := newTestGRPCNotifier(t, receiver, GRPCNotifierConfig{})
+
+		var processed, failed atomic.Int32
+		n.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+			require.False(t, whi.IsDropped)
+			processed.Inc()
+			if whi.SendError != "" {
+				failed.Inc()
+			}
+		})
+
+		for i := 0; i < 20; i++ {
+			require.NoError(t, n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Id: fmt.Sprintf("EV_%d", i)}))
+		}
+		n.Stop(false)
+
+		require.Equal(t, int32(20), processed.Load())
+		require.Zero(t, failed.Load())
+		require.Len(t, receiver.received, 20)
+		for i, id := range receiver.received {
+			require.Equal(t, fmt.Sprintf("EV_%d", i), id)
+		}
+		require.Error(t, n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted}))
+	})
+
+	t.Run("resends after reconnect", func(t *testing.T) {
+		receiver := &testWebhookReceiver{
+			handle: func(stream int, event *livekit.WebhookEvent) bool {
+				return stream > 1 || event.Id != "EV_1"
+			},
+		}
+		n := newTestGRPCNotifier(t, receiver, GRPCNotifierConfig{
+			MaxInFlight:      1,
+			ReconnectBackoff: 10 * time.Millisecond,
+		})
+
+		var processed atomic.Int32
+		n.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+			require.False(t, whi.IsDropped)
+			processed.Inc()
+		})
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Id: fmt.Sprintf("EV_%d", i)}))
+		}
+		n.Stop(false)
+
+		require.Equal(t, int32(3), processed.Load())
+		require.Equal(t, 2, receiver.streams)
+		require.Equal(t, []string{"EV_0", "EV_1", "EV_1", "EV_2"}, receiver.received)
+	})
+
+	t.Run("backpressure", func(t *testing.T) {
+		release := make(chan struct{})
+		receiver := &testWebhookReceiver{
+			handle: func(stream int, event *livekit.WebhookEvent) bool {
+				<-release
+				return true
+			},
+		}
+		n := newTestGRPCNotifier(t, receiver, GRPCNotifierConfig{
+			QueueSize:   1,
+			MaxInFlight: 1,
+		})
+
+		var dropped atomic.Int32
+		n.RegisterProcessedHook(func(ctx context.Context, whi *livekit.WebhookInfo) {
+			if whi.IsDropped {
+				dropped.Inc()
+			}
+		})
+
+		require.NoError(t, n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Id: "EV_0"}))
+		require.Eventually(t, func() bool {
+			return n.QueueNotify(context.Background(), &livekit.WebhookEvent{Event: EventRoomStarted, Id: "EV_1"}) == nil
+		}, time.Second, 10*time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, n.QueueNotify(ctx, &livekit.WebhookEvent{Event: EventRoomStarted, Id: "EV_2"}), context.DeadlineExceeded)
+		require.Equal(t, int32(1), dropped.Load())
+
+		close(release)
+		n.Stop(false)
+		require.Equal(t, int32(1), dropped.Load())
+		require.Equal(t, []string{"EV_0", "EV_1"}, receiver.received)
+	})
+}